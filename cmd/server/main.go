@@ -75,6 +75,42 @@ func main() {
 	}
 	defer container.GetJobs().RefreshRevokeJob.Stop()
 
+	// start point expire job
+	if err := container.GetJobs().PointExpireJob.Start(); err != nil {
+		log.Fatalf("Failed to start point expire job: %v", err)
+	}
+	defer container.GetJobs().PointExpireJob.Stop()
+
+	// start customer level job
+	if err := container.GetJobs().CustomerLevelJob.Start(); err != nil {
+		log.Fatalf("Failed to start customer level job: %v", err)
+	}
+	defer container.GetJobs().CustomerLevelJob.Stop()
+
+	// start birthday benefit job
+	if err := container.GetJobs().BirthdayBenefitJob.Start(); err != nil {
+		log.Fatalf("Failed to start birthday benefit job: %v", err)
+	}
+	defer container.GetJobs().BirthdayBenefitJob.Stop()
+
+	// start win back job
+	if err := container.GetJobs().WinBackJob.Start(); err != nil {
+		log.Fatalf("Failed to start win back job: %v", err)
+	}
+	defer container.GetJobs().WinBackJob.Stop()
+
+	// start customer metric job
+	if err := container.GetJobs().CustomerMetricJob.Start(); err != nil {
+		log.Fatalf("Failed to start customer metric job: %v", err)
+	}
+	defer container.GetJobs().CustomerMetricJob.Stop()
+
+	// start campaign resume job
+	if err := container.GetJobs().CampaignResumeJob.Start(); err != nil {
+		log.Fatalf("Failed to start campaign resume job: %v", err)
+	}
+	defer container.GetJobs().CampaignResumeJob.Stop()
+
 	if err := router.Run(":" + cfg.Server.Port); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/invoice"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -43,7 +45,13 @@ type Handlers struct {
 }
 
 type Jobs struct {
-	RefreshRevokeJob *job.RefreshRevokeJob
+	RefreshRevokeJob   *job.RefreshRevokeJob
+	PointExpireJob     *job.PointExpireJob
+	CustomerLevelJob   *job.CustomerLevelJob
+	BirthdayBenefitJob *job.BirthdayBenefitJob
+	WinBackJob         *job.WinBackJob
+	CustomerMetricJob  *job.CustomerMetricJob
+	CampaignResumeJob  *job.CampaignResumeJob
 }
 
 func NewContainer(cfg *config.Config, database *db.Database, redisClient *redis.Client) (*Container, error) {
@@ -52,13 +60,19 @@ func NewContainer(cfg *config.Config, database *db.Database, redisClient *redis.
 	authCache := cache.NewAuthCache(redisClient)
 	activityLog := cache.NewActivityLogCache(redisClient)
 
+	invoiceProvider, err := invoice.NewProvider(cfg.Invoice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoice provider: %w", err)
+	}
+	invoiceIssuer := invoice.NewIssuer(database.PgxPool, invoiceProvider)
+
 	repositories := Repositories{
 		SQLX: sqlx.NewRepositories(database.Sqlx),
 	}
 
 	// Initialize services using separated containers
 	publicServices := NewPublicServices(queries, database, repositories, cfg, lineMessenger, authCache, activityLog)
-	adminServices := NewAdminServices(queries, database, repositories, cfg, lineMessenger, authCache, activityLog)
+	adminServices := NewAdminServices(queries, database, repositories, cfg, lineMessenger, authCache, activityLog, invoiceIssuer)
 
 	services := Services{
 		Public: publicServices,
@@ -80,8 +94,44 @@ func NewContainer(cfg *config.Config, database *db.Database, redisClient *redis.
 		return nil, fmt.Errorf("failed to create refresh revoke job: %w", err)
 	}
 
+	pointExpireJob, err := job.NewPointExpireJob(cfg, queries, database.PgxPool, redisClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create point expire job: %w", err)
+	}
+
+	customerLevelJob, err := job.NewCustomerLevelJob(cfg, queries, database.PgxPool, redisClient, authCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer level job: %w", err)
+	}
+
+	birthdayBenefitJob, err := job.NewBirthdayBenefitJob(cfg, queries, database.PgxPool, redisClient, lineMessenger, activityLog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create birthday benefit job: %w", err)
+	}
+
+	winBackJob, err := job.NewWinBackJob(cfg, queries, database.PgxPool, redisClient, lineMessenger, activityLog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create win back job: %w", err)
+	}
+
+	customerMetricJob, err := job.NewCustomerMetricJob(cfg, queries, database.PgxPool, redisClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer metric job: %w", err)
+	}
+
+	campaignResumeJob, err := job.NewCampaignResumeJob(cfg, queries, redisClient, campaign.NewRunner(queries, database.PgxPool, lineMessenger), campaign.NewLineRunner(queries, repositories.SQLX, lineMessenger))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign resume job: %w", err)
+	}
+
 	jobs := Jobs{
-		RefreshRevokeJob: refreshRevokeJob,
+		RefreshRevokeJob:   refreshRevokeJob,
+		PointExpireJob:     pointExpireJob,
+		CustomerLevelJob:   customerLevelJob,
+		BirthdayBenefitJob: birthdayBenefitJob,
+		WinBackJob:         winBackJob,
+		CustomerMetricJob:  customerMetricJob,
+		CampaignResumeJob:  campaignResumeJob,
 	}
 
 	return &Container{
@@ -5,24 +5,52 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/infra/db"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/invoice"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 
 	// Admin handlers
 	adminAccountHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account"
+	adminAccountStatementImportHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_statement_import"
+	adminAccountStatementLayoutHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_statement_layout"
+	adminAccountStatementLineHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_statement_line"
 	adminAccountTransactionHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_transaction"
+	adminAccountTransactionAuditHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_transaction_audit"
+	adminAccountTransferHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/account_transfer"
 	adminActivityLogHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/activity_log"
 	adminAuthHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/auth"
+	adminBirthdayBenefitSettingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/birthday_benefit_setting"
+	adminBlacklistRuleHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/blacklist_rule"
 	adminBookingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/booking"
 	adminBookingProductHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/booking_product"
 	adminBrandHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/brand"
+	adminCashDrawerCloseHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/cash_drawer_close"
 	adminCheckoutHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/checkout"
 	adminCouponHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/coupon"
+	adminCouponCampaignHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/coupon_campaign"
 	adminCustomerHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer"
+	adminCustomerBlacklistDecisionHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_blacklist_decision"
 	adminCustomerCouponHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_coupon"
+	adminCustomerDataRequestHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_data_request"
+	adminCustomerHealthQuestionnaireHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_health_questionnaire"
+	adminCustomerLevelHistoryHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_level_history"
+	adminCustomerLevelRuleHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_level_rule"
+	adminCustomerLinkRequestHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_link_request"
+	adminCustomerMergeHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_merge"
+	adminCustomerNoteHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_note"
+	adminCustomerPointHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_point"
+	adminCustomerReferralHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_referral"
+	adminCustomerSegmentHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_segment"
+	adminCustomerWalletHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/customer_wallet"
 	adminExpenseHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/expense"
 	adminExpenseItemHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/expense_item"
+	adminGiftCardHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/gift_card"
+	adminInvoiceHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/invoice"
+	adminLineCampaignHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/line_campaign"
 	adminProductHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/product"
 	adminProductCategoryHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/product_category"
+	adminPromotionHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/promotion"
+	adminReferralSettingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/referral_setting"
 	adminReportHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/report"
 	adminScheduleHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/schedule"
 	adminServiceHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/service"
@@ -30,28 +58,58 @@ import (
 	adminStockUsagesHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/stock_usages"
 	adminStoreHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/store"
 	adminStoreAccessHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/store_access"
+	adminStoreAccountMappingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/store_account_mapping"
+	adminStoreLoyaltySettingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/store_loyalty_setting"
+	adminStoreWinBackSettingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/store_win_back_setting"
 	adminStylistHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/stylist"
 	adminSupplierHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/supplier"
+	adminTermsDocumentHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/terms_document"
 	adminTimeSlotTemplateHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/time-slot-template"
 	adminTimeSlotHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/time_slot"
 	adminTimeSlotTemplateItemHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/admin/time_slot_template_item"
 
 	// Admin services
 	adminAccountService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account"
+	adminAccountStatementImportService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_import"
+	adminAccountStatementLayoutService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_layout"
+	adminAccountStatementLineService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_line"
 	adminAccountTransactionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_transaction"
+	adminAccountTransactionAuditService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_transaction_audit"
+	adminAccountTransferService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_transfer"
 	adminActivityLogService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/activity_log"
 	adminAuthService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/auth"
+	adminBirthdayBenefitSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/birthday_benefit_setting"
+	adminBlacklistRuleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/blacklist_rule"
 	adminBookingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/booking"
 	adminBookingProductService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/booking_product"
 	adminBrandService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/brand"
+	adminCashDrawerCloseService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/cash_drawer_close"
 	adminCheckoutService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/checkout"
 	adminCouponService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/coupon"
+	adminCouponCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/coupon_campaign"
 	adminCustomerService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer"
+	adminCustomerBlacklistDecisionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_blacklist_decision"
 	adminCustomerCouponService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_coupon"
+	adminCustomerDataRequestService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_data_request"
+	adminCustomerHealthQuestionnaireService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_health_questionnaire"
+	adminCustomerLevelHistoryService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_level_history"
+	adminCustomerLevelRuleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_level_rule"
+	adminCustomerLinkRequestService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_link_request"
+	adminCustomerMergeService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_merge"
+	adminCustomerNoteService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_note"
+	adminCustomerPointService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_point"
+	adminCustomerReferralService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_referral"
+	adminCustomerSegmentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_segment"
+	adminCustomerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_wallet"
 	adminExpenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/expense"
 	adminExpenseItemService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/expense_item"
+	adminGiftCardService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/gift_card"
+	adminInvoiceService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/invoice"
+	adminLineCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/line_campaign"
 	adminProductService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/product"
 	adminProductCategoryService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/product_category"
+	adminPromotionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/promotion"
+	adminReferralSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/referral_setting"
 	adminReportService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/report"
 	adminScheduleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/schedule"
 	adminServiceService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/service"
@@ -59,8 +117,12 @@ import (
 	adminStockUsagesService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/stock_usages"
 	adminStoreService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store"
 	adminStoreAccessService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_access"
+	adminStoreAccountMappingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_account_mapping"
+	adminStoreLoyaltySettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_loyalty_setting"
+	adminStoreWinBackSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_win_back_setting"
 	adminStylistService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/stylist"
 	adminSupplierService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/supplier"
+	adminTermsDocumentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/terms_document"
 	adminTimeSlotTemplateService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/time-slot-template"
 	adminTimeSlotService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/time_slot"
 	adminTimeSlotTemplateItemService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/time_slot_template_item"
@@ -101,10 +163,36 @@ type AdminServices struct {
 	AccountUpdate adminAccountService.UpdateInterface
 
 	// Account transaction management services
-	AccountTransactionGetAll adminAccountTransactionService.GetAllInterface
-	AccountTransactionCreate adminAccountTransactionService.CreateInterface
-	AccountTransactionUpdate adminAccountTransactionService.UpdateInterface
-	AccountTransactionDelete adminAccountTransactionService.DeleteInterface
+	AccountTransactionGetAll     adminAccountTransactionService.GetAllInterface
+	AccountTransactionCreate     adminAccountTransactionService.CreateInterface
+	AccountTransactionUpdate     adminAccountTransactionService.UpdateInterface
+	AccountTransactionDelete     adminAccountTransactionService.DeleteInterface
+	AccountTransactionDeleteByID adminAccountTransactionService.DeleteByIDInterface
+
+	// Account transaction audit services
+	AccountTransactionAuditGetAll adminAccountTransactionAuditService.GetAllInterface
+
+	// Account statement services
+	AccountStatementLayoutGet             adminAccountStatementLayoutService.GetInterface
+	AccountStatementLayoutUpdate          adminAccountStatementLayoutService.UpdateInterface
+	AccountStatementImportCreate          adminAccountStatementImportService.CreateInterface
+	AccountStatementImportGetAll          adminAccountStatementImportService.GetAllInterface
+	AccountStatementLineGetAll            adminAccountStatementLineService.GetAllInterface
+	AccountStatementLineCreateTransaction adminAccountStatementLineService.CreateTransactionInterface
+	AccountStatementLineUpdateIgnore      adminAccountStatementLineService.UpdateIgnoreInterface
+
+	// Account transfer services
+	AccountTransferCreate adminAccountTransferService.CreateInterface
+
+	// Store account mapping services
+	StoreAccountMappingGetAll adminStoreAccountMappingService.GetAllInterface
+	StoreAccountMappingUpdate adminStoreAccountMappingService.UpdateInterface
+
+	// Store loyalty setting services
+	StoreLoyaltySettingGet    adminStoreLoyaltySettingService.GetInterface
+	StoreLoyaltySettingUpdate adminStoreLoyaltySettingService.UpdateInterface
+	StoreWinBackSettingGet    adminStoreWinBackSettingService.GetInterface
+	StoreWinBackSettingUpdate adminStoreWinBackSettingService.UpdateInterface
 
 	// Brand management services
 	BrandCreate adminBrandService.CreateInterface
@@ -127,6 +215,10 @@ type AdminServices struct {
 	ExpenseItemUpdate adminExpenseItemService.UpdateInterface
 	ExpenseItemDelete adminExpenseItemService.DeleteInterface
 
+	// Invoice management services
+	InvoiceGetAll adminInvoiceService.GetAllInterface
+	InvoiceRetry  adminInvoiceService.RetryInterface
+
 	// Product management services
 	ProductCreate adminProductService.CreateInterface
 	ProductGetAll adminProductService.GetAllInterface
@@ -152,6 +244,7 @@ type AdminServices struct {
 	CustomerGetAll adminCustomerService.GetAllInterface
 	CustomerGet    adminCustomerService.GetInterface
 	CustomerUpdate adminCustomerService.UpdateInterface
+	CustomerCreate adminCustomerService.CreateInterface
 
 	// Booking management services
 	BookingCreate          adminBookingService.CreateInterface
@@ -193,18 +286,113 @@ type AdminServices struct {
 	CouponGetAll adminCouponService.GetAllInterface
 	CouponUpdate adminCouponService.UpdateInterface
 
+	// Coupon campaign services
+	CouponCampaignCreate adminCouponCampaignService.CreateInterface
+	CouponCampaignGetAll adminCouponCampaignService.GetAllInterface
+	CouponCampaignGet    adminCouponCampaignService.GetInterface
+
+	// Promotion services
+	PromotionCreate adminPromotionService.CreateInterface
+	PromotionGetAll adminPromotionService.GetAllInterface
+	PromotionUpdate adminPromotionService.UpdateInterface
+
+	// Customer segment services
+	CustomerSegmentCreate       adminCustomerSegmentService.CreateInterface
+	CustomerSegmentGetAll       adminCustomerSegmentService.GetAllInterface
+	CustomerSegmentGetCustomers adminCustomerSegmentService.GetCustomersInterface
+
+	// LINE campaign services
+	LineCampaignCreate        adminLineCampaignService.CreateInterface
+	LineCampaignGetAll        adminLineCampaignService.GetAllInterface
+	LineCampaignGet           adminLineCampaignService.GetInterface
+	LineCampaignGetRecipients adminLineCampaignService.GetRecipientsInterface
+
 	// Customer coupon services
 	CustomerCouponGetAll adminCustomerCouponService.GetAllInterface
 	CustomerCouponCreate adminCustomerCouponService.CreateInterface
 	CustomerCouponDelete adminCustomerCouponService.DeleteInterface
 
+	// Customer wallet services
+	CustomerWalletGet                adminCustomerWalletService.GetInterface
+	CustomerWalletGetAllTransactions adminCustomerWalletService.GetAllTransactionsInterface
+	CustomerWalletTopUp              adminCustomerWalletService.TopUpInterface
+	CustomerWalletAdjust             adminCustomerWalletService.AdjustInterface
+	CustomerWalletRedeem             adminCustomerWalletService.RedeemInterface
+
+	// Customer point services
+	CustomerPointGet                adminCustomerPointService.GetInterface
+	CustomerPointGetAllTransactions adminCustomerPointService.GetAllTransactionsInterface
+	CustomerPointAdjust             adminCustomerPointService.AdjustInterface
+
+	// Customer level services
+	CustomerLevelRuleGetAll    adminCustomerLevelRuleService.GetAllInterface
+	CustomerLevelRuleUpdate    adminCustomerLevelRuleService.UpdateInterface
+	CustomerLevelHistoryGetAll adminCustomerLevelHistoryService.GetAllInterface
+
+	// Blacklist services
+	BlacklistRuleGetAll               adminBlacklistRuleService.GetAllInterface
+	BlacklistRuleUpdate               adminBlacklistRuleService.UpdateInterface
+	CustomerBlacklistDecisionGetAll   adminCustomerBlacklistDecisionService.GetAllInterface
+	CustomerBlacklistDecisionOverturn adminCustomerBlacklistDecisionService.OverturnInterface
+
+	// Customer note services
+	CustomerNoteCreate adminCustomerNoteService.CreateInterface
+	CustomerNoteGetAll adminCustomerNoteService.GetAllInterface
+	CustomerNoteUpdate adminCustomerNoteService.UpdateInterface
+	CustomerNoteDelete adminCustomerNoteService.DeleteInterface
+
+	// Customer health questionnaire services
+	CustomerHealthQuestionnaireGetAll adminCustomerHealthQuestionnaireService.GetAllInterface
+
+	// Customer merge services
+	CustomerMergeCreate adminCustomerMergeService.CreateInterface
+	CustomerMergeGetAll adminCustomerMergeService.GetAllInterface
+
+	// Customer link request services
+	CustomerLinkRequestGetAll  adminCustomerLinkRequestService.GetAllInterface
+	CustomerLinkRequestApprove adminCustomerLinkRequestService.ApproveInterface
+	CustomerLinkRequestReject  adminCustomerLinkRequestService.RejectInterface
+
+	// Customer data request services
+	CustomerDataRequestExport adminCustomerDataRequestService.ExportInterface
+	CustomerDataRequestErase  adminCustomerDataRequestService.EraseInterface
+	CustomerDataRequestGetAll adminCustomerDataRequestService.GetAllInterface
+
+	// Terms document services
+	TermsDocumentCreate adminTermsDocumentService.CreateInterface
+	TermsDocumentGetAll adminTermsDocumentService.GetAllInterface
+	TermsDocumentGet    adminTermsDocumentService.GetInterface
+	TermsDocumentUpdate adminTermsDocumentService.UpdateInterface
+
+	// Referral services
+	CustomerReferralGetAll adminCustomerReferralService.GetAllInterface
+	ReferralSettingGet     adminReferralSettingService.GetInterface
+	ReferralSettingUpdate  adminReferralSettingService.UpdateInterface
+
+	// Birthday benefit services
+	BirthdayBenefitSettingGet    adminBirthdayBenefitSettingService.GetInterface
+	BirthdayBenefitSettingUpdate adminBirthdayBenefitSettingService.UpdateInterface
+
+	// Gift card services
+	GiftCardCreate adminGiftCardService.CreateInterface
+	GiftCardGetAll adminGiftCardService.GetAllInterface
+
 	// Checkout services
-	CheckoutCreateBulk adminCheckoutService.CreateBulkInterface
+	CheckoutCreateBulk  adminCheckoutService.CreateBulkInterface
+	CheckoutRefund      adminCheckoutService.RefundInterface
+	CheckoutGetReceipt  adminCheckoutService.GetReceiptInterface
+	CheckoutSendReceipt adminCheckoutService.SendReceiptInterface
+
+	// Cash drawer close services
+	CashDrawerCloseCreate adminCashDrawerCloseService.CreateInterface
+	CashDrawerCloseGetAll adminCashDrawerCloseService.GetAllInterface
 
 	// Report services
 	ReportGetPerformanceMe    adminReportService.GetPerformanceMeInterface
 	ReportGetStorePerformance adminReportService.GetStorePerformanceInterface
 	ReportGetStoreExpense     adminReportService.GetStoreExpenseInterface
+	ReportGetReferral         adminReportService.GetReferralInterface
+	ReportGetStoreWinBack     adminReportService.GetStoreWinBackInterface
 
 	// Stock usages services
 	StockUsagesCreate       adminStockUsagesService.CreateInterface
@@ -250,10 +438,36 @@ type AdminHandlers struct {
 	AccountUpdate *adminAccountHandler.Update
 
 	// Account transaction management handlers
-	AccountTransactionGetAll *adminAccountTransactionHandler.GetAll
-	AccountTransactionCreate *adminAccountTransactionHandler.Create
-	AccountTransactionUpdate *adminAccountTransactionHandler.Update
-	AccountTransactionDelete *adminAccountTransactionHandler.Delete
+	AccountTransactionGetAll     *adminAccountTransactionHandler.GetAll
+	AccountTransactionCreate     *adminAccountTransactionHandler.Create
+	AccountTransactionUpdate     *adminAccountTransactionHandler.Update
+	AccountTransactionDelete     *adminAccountTransactionHandler.Delete
+	AccountTransactionDeleteByID *adminAccountTransactionHandler.DeleteByID
+
+	// Account transaction audit handlers
+	AccountTransactionAuditGetAll *adminAccountTransactionAuditHandler.GetAll
+
+	// Account statement handlers
+	AccountStatementLayoutGet             *adminAccountStatementLayoutHandler.Get
+	AccountStatementLayoutUpdate          *adminAccountStatementLayoutHandler.Update
+	AccountStatementImportCreate          *adminAccountStatementImportHandler.Create
+	AccountStatementImportGetAll          *adminAccountStatementImportHandler.GetAll
+	AccountStatementLineGetAll            *adminAccountStatementLineHandler.GetAll
+	AccountStatementLineCreateTransaction *adminAccountStatementLineHandler.CreateTransaction
+	AccountStatementLineUpdateIgnore      *adminAccountStatementLineHandler.UpdateIgnore
+
+	// Account transfer handlers
+	AccountTransferCreate *adminAccountTransferHandler.Create
+
+	// Store account mapping handlers
+	StoreAccountMappingGetAll *adminStoreAccountMappingHandler.GetAll
+	StoreAccountMappingUpdate *adminStoreAccountMappingHandler.Update
+
+	// Store loyalty setting handlers
+	StoreLoyaltySettingGet    *adminStoreLoyaltySettingHandler.Get
+	StoreLoyaltySettingUpdate *adminStoreLoyaltySettingHandler.Update
+	StoreWinBackSettingGet    *adminStoreWinBackSettingHandler.Get
+	StoreWinBackSettingUpdate *adminStoreWinBackSettingHandler.Update
 
 	// Brand management handlers
 	BrandCreate *adminBrandHandler.Create
@@ -276,6 +490,10 @@ type AdminHandlers struct {
 	ExpenseItemUpdate *adminExpenseItemHandler.Update
 	ExpenseItemDelete *adminExpenseItemHandler.Delete
 
+	// Invoice management handlers
+	InvoiceGetAll *adminInvoiceHandler.GetAll
+	InvoiceRetry  *adminInvoiceHandler.Retry
+
 	// Product management handlers
 	ProductCreate *adminProductHandler.Create
 	ProductGetAll *adminProductHandler.GetAll
@@ -301,6 +519,7 @@ type AdminHandlers struct {
 	CustomerGetAll *adminCustomerHandler.GetAll
 	CustomerGet    *adminCustomerHandler.Get
 	CustomerUpdate *adminCustomerHandler.Update
+	CustomerCreate *adminCustomerHandler.Create
 
 	// Booking management handlers
 	BookingCreate          *adminBookingHandler.Create
@@ -342,18 +561,113 @@ type AdminHandlers struct {
 	CouponGetAll *adminCouponHandler.GetAll
 	CouponUpdate *adminCouponHandler.Update
 
+	// Coupon campaign handlers
+	CouponCampaignCreate *adminCouponCampaignHandler.Create
+	CouponCampaignGetAll *adminCouponCampaignHandler.GetAll
+	CouponCampaignGet    *adminCouponCampaignHandler.Get
+
+	// Promotion handlers
+	PromotionCreate *adminPromotionHandler.Create
+	PromotionGetAll *adminPromotionHandler.GetAll
+	PromotionUpdate *adminPromotionHandler.Update
+
+	// Customer segment handlers
+	CustomerSegmentCreate       *adminCustomerSegmentHandler.Create
+	CustomerSegmentGetAll       *adminCustomerSegmentHandler.GetAll
+	CustomerSegmentGetCustomers *adminCustomerSegmentHandler.GetCustomers
+
+	// LINE campaign handlers
+	LineCampaignCreate        *adminLineCampaignHandler.Create
+	LineCampaignGetAll        *adminLineCampaignHandler.GetAll
+	LineCampaignGet           *adminLineCampaignHandler.Get
+	LineCampaignGetRecipients *adminLineCampaignHandler.GetRecipients
+
 	// Customer coupon handlers
 	CustomerCouponGetAll *adminCustomerCouponHandler.GetAll
 	CustomerCouponCreate *adminCustomerCouponHandler.Create
 	CustomerCouponDelete *adminCustomerCouponHandler.Delete
 
+	// Customer wallet handlers
+	CustomerWalletGet                *adminCustomerWalletHandler.Get
+	CustomerWalletGetAllTransactions *adminCustomerWalletHandler.GetAllTransactions
+	CustomerWalletTopUp              *adminCustomerWalletHandler.TopUp
+	CustomerWalletAdjust             *adminCustomerWalletHandler.Adjust
+	CustomerWalletRedeem             *adminCustomerWalletHandler.Redeem
+
+	// Customer point handlers
+	CustomerPointGet                *adminCustomerPointHandler.Get
+	CustomerPointGetAllTransactions *adminCustomerPointHandler.GetAllTransactions
+	CustomerPointAdjust             *adminCustomerPointHandler.Adjust
+
+	// Customer level handlers
+	CustomerLevelRuleGetAll    *adminCustomerLevelRuleHandler.GetAll
+	CustomerLevelRuleUpdate    *adminCustomerLevelRuleHandler.Update
+	CustomerLevelHistoryGetAll *adminCustomerLevelHistoryHandler.GetAll
+
+	// Blacklist handlers
+	BlacklistRuleGetAll               *adminBlacklistRuleHandler.GetAll
+	BlacklistRuleUpdate               *adminBlacklistRuleHandler.Update
+	CustomerBlacklistDecisionGetAll   *adminCustomerBlacklistDecisionHandler.GetAll
+	CustomerBlacklistDecisionOverturn *adminCustomerBlacklistDecisionHandler.Overturn
+
+	// Customer note handlers
+	CustomerNoteCreate *adminCustomerNoteHandler.Create
+	CustomerNoteGetAll *adminCustomerNoteHandler.GetAll
+	CustomerNoteUpdate *adminCustomerNoteHandler.Update
+	CustomerNoteDelete *adminCustomerNoteHandler.Delete
+
+	// Customer health questionnaire handlers
+	CustomerHealthQuestionnaireGetAll *adminCustomerHealthQuestionnaireHandler.GetAll
+
+	// Customer merge handlers
+	CustomerMergeCreate *adminCustomerMergeHandler.Create
+	CustomerMergeGetAll *adminCustomerMergeHandler.GetAll
+
+	// Customer link request handlers
+	CustomerLinkRequestGetAll  *adminCustomerLinkRequestHandler.GetAll
+	CustomerLinkRequestApprove *adminCustomerLinkRequestHandler.Approve
+	CustomerLinkRequestReject  *adminCustomerLinkRequestHandler.Reject
+
+	// Customer data request handlers
+	CustomerDataRequestExport *adminCustomerDataRequestHandler.Export
+	CustomerDataRequestErase  *adminCustomerDataRequestHandler.Erase
+	CustomerDataRequestGetAll *adminCustomerDataRequestHandler.GetAll
+
+	// Terms document handlers
+	TermsDocumentCreate *adminTermsDocumentHandler.Create
+	TermsDocumentGetAll *adminTermsDocumentHandler.GetAll
+	TermsDocumentGet    *adminTermsDocumentHandler.Get
+	TermsDocumentUpdate *adminTermsDocumentHandler.Update
+
+	// Referral handlers
+	CustomerReferralGetAll *adminCustomerReferralHandler.GetAll
+	ReferralSettingGet     *adminReferralSettingHandler.Get
+	ReferralSettingUpdate  *adminReferralSettingHandler.Update
+
+	// Birthday benefit handlers
+	BirthdayBenefitSettingGet    *adminBirthdayBenefitSettingHandler.Get
+	BirthdayBenefitSettingUpdate *adminBirthdayBenefitSettingHandler.Update
+
+	// Gift card handlers
+	GiftCardCreate *adminGiftCardHandler.Create
+	GiftCardGetAll *adminGiftCardHandler.GetAll
+
 	// Checkout handlers
-	CheckoutCreateBulk *adminCheckoutHandler.CreateBulk
+	CheckoutCreateBulk  *adminCheckoutHandler.CreateBulk
+	CheckoutRefund      *adminCheckoutHandler.Refund
+	CheckoutGetReceipt  *adminCheckoutHandler.GetReceipt
+	CheckoutSendReceipt *adminCheckoutHandler.SendReceipt
+
+	// Cash drawer close handlers
+	CashDrawerCloseCreate *adminCashDrawerCloseHandler.Create
+	CashDrawerCloseGetAll *adminCashDrawerCloseHandler.GetAll
 
 	// Report handlers
 	ReportGetPerformanceMe    *adminReportHandler.GetPerformanceMe
 	ReportGetStorePerformance *adminReportHandler.GetStorePerformance
 	ReportGetStoreExpense     *adminReportHandler.GetStoreExpense
+	ReportGetReferral         *adminReportHandler.GetReferral
+	ReportGetStoreWinBack     *adminReportHandler.GetStoreWinBack
 
 	// Stock usages handlers
 	StockUsagesCreate       *adminStockUsagesHandler.Create
@@ -365,7 +679,7 @@ type AdminHandlers struct {
 }
 
 // NewAdminServices creates and initializes all admin services
-func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositories Repositories, cfg *config.Config, _ *utils.LineMessageClient, authCache cache.AuthCacheInterface, activityLog cache.ActivityLogCacheInterface) AdminServices {
+func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositories Repositories, cfg *config.Config, lineMessenger *utils.LineMessageClient, authCache cache.AuthCacheInterface, activityLog cache.ActivityLogCacheInterface, invoiceIssuer invoice.IssuerInterface) AdminServices {
 	return AdminServices{
 		// Authentication services
 		AuthStaffLogin:        adminAuthService.NewLogin(queries, cfg.JWT, cfg.Cookie),
@@ -398,10 +712,36 @@ func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositorie
 		AccountUpdate: adminAccountService.NewUpdate(repositories.SQLX.Account),
 
 		// Account transaction management services
-		AccountTransactionGetAll: adminAccountTransactionService.NewGetAll(repositories.SQLX),
-		AccountTransactionCreate: adminAccountTransactionService.NewCreate(queries),
-		AccountTransactionUpdate: adminAccountTransactionService.NewUpdate(queries, repositories.SQLX),
-		AccountTransactionDelete: adminAccountTransactionService.NewDelete(queries),
+		AccountTransactionGetAll:     adminAccountTransactionService.NewGetAll(repositories.SQLX),
+		AccountTransactionCreate:     adminAccountTransactionService.NewCreate(queries, database.PgxPool),
+		AccountTransactionUpdate:     adminAccountTransactionService.NewUpdate(queries, database.PgxPool),
+		AccountTransactionDelete:     adminAccountTransactionService.NewDelete(queries, database.PgxPool),
+		AccountTransactionDeleteByID: adminAccountTransactionService.NewDeleteByID(queries, database.PgxPool),
+
+		// Account transaction audit services
+		AccountTransactionAuditGetAll: adminAccountTransactionAuditService.NewGetAll(queries, repositories.SQLX),
+
+		// Account statement services
+		AccountStatementLayoutGet:             adminAccountStatementLayoutService.NewGet(queries),
+		AccountStatementLayoutUpdate:          adminAccountStatementLayoutService.NewUpdate(queries),
+		AccountStatementImportCreate:          adminAccountStatementImportService.NewCreate(queries, database.PgxPool),
+		AccountStatementImportGetAll:          adminAccountStatementImportService.NewGetAll(queries, repositories.SQLX),
+		AccountStatementLineGetAll:            adminAccountStatementLineService.NewGetAll(queries, repositories.SQLX),
+		AccountStatementLineCreateTransaction: adminAccountStatementLineService.NewCreateTransaction(queries, database.PgxPool),
+		AccountStatementLineUpdateIgnore:      adminAccountStatementLineService.NewUpdateIgnore(queries, database.PgxPool),
+
+		// Account transfer services
+		AccountTransferCreate: adminAccountTransferService.NewCreate(queries, database.PgxPool),
+
+		// Store account mapping services
+		StoreAccountMappingGetAll: adminStoreAccountMappingService.NewGetAll(queries),
+		StoreAccountMappingUpdate: adminStoreAccountMappingService.NewUpdate(queries, database.PgxPool),
+
+		// Store loyalty setting services
+		StoreLoyaltySettingGet:    adminStoreLoyaltySettingService.NewGet(queries),
+		StoreLoyaltySettingUpdate: adminStoreLoyaltySettingService.NewUpdate(queries),
+		StoreWinBackSettingGet:    adminStoreWinBackSettingService.NewGet(queries),
+		StoreWinBackSettingUpdate: adminStoreWinBackSettingService.NewUpdate(queries),
 
 		// Brand management services
 		BrandCreate: adminBrandService.NewCreate(queries),
@@ -417,13 +757,17 @@ func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositorie
 		ExpenseCreate: adminExpenseService.NewCreate(queries, database.PgxPool),
 		ExpenseGetAll: adminExpenseService.NewGetAll(repositories.SQLX),
 		ExpenseGet:    adminExpenseService.NewGet(queries),
-		ExpenseUpdate: adminExpenseService.NewUpdate(queries, repositories.SQLX),
+		ExpenseUpdate: adminExpenseService.NewUpdate(queries, repositories.SQLX, database.PgxPool),
 
 		// Expense item management services
 		ExpenseItemCreate: adminExpenseItemService.NewCreate(queries, database.PgxPool),
-		ExpenseItemUpdate: adminExpenseItemService.NewUpdate(queries, repositories.SQLX, database.Sqlx),
+		ExpenseItemUpdate: adminExpenseItemService.NewUpdate(queries, repositories.SQLX, database.Sqlx, database.PgxPool),
 		ExpenseItemDelete: adminExpenseItemService.NewDelete(queries, database.PgxPool),
 
+		// Invoice management services
+		InvoiceGetAll: adminInvoiceService.NewGetAll(repositories.SQLX),
+		InvoiceRetry:  adminInvoiceService.NewRetry(queries, invoiceIssuer),
+
 		// Product management services
 		ProductCreate: adminProductService.NewCreate(queries),
 		ProductGetAll: adminProductService.NewGetAll(repositories.SQLX),
@@ -449,11 +793,12 @@ func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositorie
 		CustomerGetAll: adminCustomerService.NewGetAll(repositories.SQLX),
 		CustomerGet:    adminCustomerService.NewGet(queries),
 		CustomerUpdate: adminCustomerService.NewUpdate(queries, repositories.SQLX, authCache),
+		CustomerCreate: adminCustomerService.NewCreate(queries),
 		// Booking management services
 		BookingCreate:          adminBookingService.NewCreate(queries, database.PgxPool, activityLog),
 		BookingGetAll:          adminBookingService.NewGetAll(queries, repositories.SQLX),
 		BookingUpdate:          adminBookingService.NewUpdate(queries, repositories.SQLX, database.Sqlx, activityLog),
-		BookingCancel:          adminBookingService.NewCancel(queries, database.Sqlx, repositories.SQLX, activityLog),
+		BookingCancel:          adminBookingService.NewCancel(queries, database.Sqlx, repositories.SQLX, database.PgxPool, activityLog, authCache),
 		BookingGet:             adminBookingService.NewGet(queries),
 		BookingUpdateCompleted: adminBookingService.NewUpdateCompleted(queries, repositories.SQLX),
 
@@ -485,22 +830,117 @@ func NewAdminServices(queries *dbgen.Queries, database *db.Database, repositorie
 		TimeSlotTemplateDeleteItem: adminTimeSlotTemplateItemService.NewDelete(queries),
 
 		// Coupon management services
-		CouponCreate: adminCouponService.NewCreate(queries, repositories.SQLX),
-		CouponGetAll: adminCouponService.NewGetAll(repositories.SQLX),
-		CouponUpdate: adminCouponService.NewUpdate(queries, repositories.SQLX),
+		CouponCreate: adminCouponService.NewCreate(queries, database.PgxPool, repositories.SQLX),
+		CouponGetAll: adminCouponService.NewGetAll(queries, repositories.SQLX),
+		CouponUpdate: adminCouponService.NewUpdate(queries, database.Sqlx, repositories.SQLX),
+
+		// Coupon campaign services
+		CouponCampaignCreate: adminCouponCampaignService.NewCreate(queries, campaign.NewRunner(queries, database.PgxPool, lineMessenger)),
+		CouponCampaignGetAll: adminCouponCampaignService.NewGetAll(repositories.SQLX),
+		CouponCampaignGet:    adminCouponCampaignService.NewGet(queries),
+
+		// Promotion services
+		PromotionCreate: adminPromotionService.NewCreate(queries, database.PgxPool),
+		PromotionGetAll: adminPromotionService.NewGetAll(queries, repositories.SQLX),
+		PromotionUpdate: adminPromotionService.NewUpdate(queries, database.Sqlx, repositories.SQLX),
+
+		// Customer segment services
+		CustomerSegmentCreate:       adminCustomerSegmentService.NewCreate(queries),
+		CustomerSegmentGetAll:       adminCustomerSegmentService.NewGetAll(repositories.SQLX),
+		CustomerSegmentGetCustomers: adminCustomerSegmentService.NewGetCustomers(queries, repositories.SQLX),
+
+		// LINE campaign services
+		LineCampaignCreate:        adminLineCampaignService.NewCreate(queries, campaign.NewLineRunner(queries, repositories.SQLX, lineMessenger)),
+		LineCampaignGetAll:        adminLineCampaignService.NewGetAll(repositories.SQLX),
+		LineCampaignGet:           adminLineCampaignService.NewGet(queries),
+		LineCampaignGetRecipients: adminLineCampaignService.NewGetRecipients(queries, repositories.SQLX),
 
 		// Customer coupon services
 		CustomerCouponGetAll: adminCustomerCouponService.NewGetAll(queries, repositories.SQLX),
 		CustomerCouponCreate: adminCustomerCouponService.NewCreate(queries),
 		CustomerCouponDelete: adminCustomerCouponService.NewDelete(queries),
 
+		// Customer wallet services
+		CustomerWalletGet:                adminCustomerWalletService.NewGet(queries),
+		CustomerWalletGetAllTransactions: adminCustomerWalletService.NewGetAllTransactions(queries, repositories.SQLX),
+		CustomerWalletTopUp:              adminCustomerWalletService.NewTopUp(queries, database.PgxPool),
+		CustomerWalletAdjust:             adminCustomerWalletService.NewAdjust(queries, database.PgxPool),
+		CustomerWalletRedeem:             adminCustomerWalletService.NewRedeem(queries, database.PgxPool),
+
+		// Customer point services
+		CustomerPointGet:                adminCustomerPointService.NewGet(queries),
+		CustomerPointGetAllTransactions: adminCustomerPointService.NewGetAllTransactions(queries, repositories.SQLX),
+		CustomerPointAdjust:             adminCustomerPointService.NewAdjust(queries, database.PgxPool),
+
+		// Customer level services
+		CustomerLevelRuleGetAll:    adminCustomerLevelRuleService.NewGetAll(queries),
+		CustomerLevelRuleUpdate:    adminCustomerLevelRuleService.NewUpdate(queries),
+		CustomerLevelHistoryGetAll: adminCustomerLevelHistoryService.NewGetAll(queries, repositories.SQLX),
+
+		// Blacklist services
+		BlacklistRuleGetAll:               adminBlacklistRuleService.NewGetAll(queries),
+		BlacklistRuleUpdate:               adminBlacklistRuleService.NewUpdate(queries),
+		CustomerBlacklistDecisionGetAll:   adminCustomerBlacklistDecisionService.NewGetAll(repositories.SQLX),
+		CustomerBlacklistDecisionOverturn: adminCustomerBlacklistDecisionService.NewOverturn(queries, database.PgxPool, authCache),
+
+		// Customer note services
+		CustomerNoteCreate: adminCustomerNoteService.NewCreate(queries),
+		CustomerNoteGetAll: adminCustomerNoteService.NewGetAll(queries),
+		CustomerNoteUpdate: adminCustomerNoteService.NewUpdate(queries),
+		CustomerNoteDelete: adminCustomerNoteService.NewDelete(queries),
+
+		// Customer health questionnaire services
+		CustomerHealthQuestionnaireGetAll: adminCustomerHealthQuestionnaireService.NewGetAll(queries),
+
+		// Customer merge services
+		CustomerMergeCreate: adminCustomerMergeService.NewCreate(queries, database.PgxPool, authCache),
+		CustomerMergeGetAll: adminCustomerMergeService.NewGetAll(queries),
+
+		// Customer link request services
+		CustomerLinkRequestGetAll:  adminCustomerLinkRequestService.NewGetAll(queries),
+		CustomerLinkRequestApprove: adminCustomerLinkRequestService.NewApprove(queries, database.PgxPool, authCache),
+		CustomerLinkRequestReject:  adminCustomerLinkRequestService.NewReject(queries, database.PgxPool),
+
+		// Customer data request services
+		CustomerDataRequestExport: adminCustomerDataRequestService.NewExport(queries),
+		CustomerDataRequestErase:  adminCustomerDataRequestService.NewErase(database.PgxPool, authCache),
+		CustomerDataRequestGetAll: adminCustomerDataRequestService.NewGetAll(queries),
+
+		// Terms document services
+		TermsDocumentCreate: adminTermsDocumentService.NewCreate(queries, authCache),
+		TermsDocumentGetAll: adminTermsDocumentService.NewGetAll(queries),
+		TermsDocumentGet:    adminTermsDocumentService.NewGet(queries),
+		TermsDocumentUpdate: adminTermsDocumentService.NewUpdate(queries),
+
+		// Referral services
+		CustomerReferralGetAll: adminCustomerReferralService.NewGetAll(queries, repositories.SQLX),
+		ReferralSettingGet:     adminReferralSettingService.NewGet(queries),
+		ReferralSettingUpdate:  adminReferralSettingService.NewUpdate(queries),
+
+		// Birthday benefit services
+		BirthdayBenefitSettingGet:    adminBirthdayBenefitSettingService.NewGet(queries),
+		BirthdayBenefitSettingUpdate: adminBirthdayBenefitSettingService.NewUpdate(queries),
+
+		// Gift card services
+		GiftCardCreate: adminGiftCardService.NewCreate(queries),
+		GiftCardGetAll: adminGiftCardService.NewGetAll(repositories.SQLX),
+
 		// Checkout services
-		CheckoutCreateBulk: adminCheckoutService.NewCreateBulk(queries, repositories.SQLX, database.PgxPool, activityLog),
+		CheckoutCreateBulk:  adminCheckoutService.NewCreateBulk(queries, repositories.SQLX, database.PgxPool, activityLog, authCache, invoiceIssuer),
+		CheckoutRefund:      adminCheckoutService.NewRefund(queries, database.PgxPool, invoiceIssuer),
+		CheckoutGetReceipt:  adminCheckoutService.NewGetReceipt(queries),
+		CheckoutSendReceipt: adminCheckoutService.NewSendReceipt(queries, lineMessenger),
+
+		// Cash drawer close services
+		CashDrawerCloseCreate: adminCashDrawerCloseService.NewCreate(queries, database.PgxPool),
+		CashDrawerCloseGetAll: adminCashDrawerCloseService.NewGetAll(repositories.SQLX),
 
 		// Report services
 		ReportGetPerformanceMe:    adminReportService.NewGetPerformanceMe(queries),
 		ReportGetStorePerformance: adminReportService.NewGetStorePerformance(queries),
 		ReportGetStoreExpense:     adminReportService.NewGetStoreExpense(queries),
+		ReportGetReferral:         adminReportService.NewGetReferral(repositories.SQLX),
+		ReportGetStoreWinBack:     adminReportService.NewGetStoreWinBack(queries),
 
 		// Stock usages services
 		StockUsagesCreate:       adminStockUsagesService.NewCreate(queries, database.PgxPool),
@@ -546,10 +986,36 @@ func NewAdminHandlers(services AdminServices, cfg *config.Config) AdminHandlers
 		AccountUpdate: adminAccountHandler.NewUpdate(services.AccountUpdate),
 
 		// Account transaction management handlers
-		AccountTransactionGetAll: adminAccountTransactionHandler.NewGetAll(services.AccountTransactionGetAll),
-		AccountTransactionCreate: adminAccountTransactionHandler.NewCreate(services.AccountTransactionCreate),
-		AccountTransactionUpdate: adminAccountTransactionHandler.NewUpdate(services.AccountTransactionUpdate),
-		AccountTransactionDelete: adminAccountTransactionHandler.NewDelete(services.AccountTransactionDelete),
+		AccountTransactionGetAll:     adminAccountTransactionHandler.NewGetAll(services.AccountTransactionGetAll),
+		AccountTransactionCreate:     adminAccountTransactionHandler.NewCreate(services.AccountTransactionCreate),
+		AccountTransactionUpdate:     adminAccountTransactionHandler.NewUpdate(services.AccountTransactionUpdate),
+		AccountTransactionDelete:     adminAccountTransactionHandler.NewDelete(services.AccountTransactionDelete),
+		AccountTransactionDeleteByID: adminAccountTransactionHandler.NewDeleteByID(services.AccountTransactionDeleteByID),
+
+		// Account transaction audit handlers
+		AccountTransactionAuditGetAll: adminAccountTransactionAuditHandler.NewGetAll(services.AccountTransactionAuditGetAll),
+
+		// Account statement handlers
+		AccountStatementLayoutGet:             adminAccountStatementLayoutHandler.NewGet(services.AccountStatementLayoutGet),
+		AccountStatementLayoutUpdate:          adminAccountStatementLayoutHandler.NewUpdate(services.AccountStatementLayoutUpdate),
+		AccountStatementImportCreate:          adminAccountStatementImportHandler.NewCreate(services.AccountStatementImportCreate),
+		AccountStatementImportGetAll:          adminAccountStatementImportHandler.NewGetAll(services.AccountStatementImportGetAll),
+		AccountStatementLineGetAll:            adminAccountStatementLineHandler.NewGetAll(services.AccountStatementLineGetAll),
+		AccountStatementLineCreateTransaction: adminAccountStatementLineHandler.NewCreateTransaction(services.AccountStatementLineCreateTransaction),
+		AccountStatementLineUpdateIgnore:      adminAccountStatementLineHandler.NewUpdateIgnore(services.AccountStatementLineUpdateIgnore),
+
+		// Account transfer handlers
+		AccountTransferCreate: adminAccountTransferHandler.NewCreate(services.AccountTransferCreate),
+
+		// Store account mapping handlers
+		StoreAccountMappingGetAll: adminStoreAccountMappingHandler.NewGetAll(services.StoreAccountMappingGetAll),
+		StoreAccountMappingUpdate: adminStoreAccountMappingHandler.NewUpdate(services.StoreAccountMappingUpdate),
+
+		// Store loyalty setting handlers
+		StoreLoyaltySettingGet:    adminStoreLoyaltySettingHandler.NewGet(services.StoreLoyaltySettingGet),
+		StoreLoyaltySettingUpdate: adminStoreLoyaltySettingHandler.NewUpdate(services.StoreLoyaltySettingUpdate),
+		StoreWinBackSettingGet:    adminStoreWinBackSettingHandler.NewGet(services.StoreWinBackSettingGet),
+		StoreWinBackSettingUpdate: adminStoreWinBackSettingHandler.NewUpdate(services.StoreWinBackSettingUpdate),
 
 		// Brand management handlers
 		BrandCreate: adminBrandHandler.NewCreate(services.BrandCreate),
@@ -597,6 +1063,7 @@ func NewAdminHandlers(services AdminServices, cfg *config.Config) AdminHandlers
 		CustomerGetAll: adminCustomerHandler.NewGetAll(services.CustomerGetAll),
 		CustomerGet:    adminCustomerHandler.NewGet(services.CustomerGet),
 		CustomerUpdate: adminCustomerHandler.NewUpdate(services.CustomerUpdate),
+		CustomerCreate: adminCustomerHandler.NewCreate(services.CustomerCreate),
 
 		// Booking management handlers
 		BookingCreate:          adminBookingHandler.NewCreate(services.BookingCreate),
@@ -638,18 +1105,113 @@ func NewAdminHandlers(services AdminServices, cfg *config.Config) AdminHandlers
 		CouponGetAll: adminCouponHandler.NewGetAll(services.CouponGetAll),
 		CouponUpdate: adminCouponHandler.NewUpdate(services.CouponUpdate),
 
+		// Coupon campaign handlers
+		CouponCampaignCreate: adminCouponCampaignHandler.NewCreate(services.CouponCampaignCreate),
+		CouponCampaignGetAll: adminCouponCampaignHandler.NewGetAll(services.CouponCampaignGetAll),
+		CouponCampaignGet:    adminCouponCampaignHandler.NewGet(services.CouponCampaignGet),
+
+		// Promotion handlers
+		PromotionCreate: adminPromotionHandler.NewCreate(services.PromotionCreate),
+		PromotionGetAll: adminPromotionHandler.NewGetAll(services.PromotionGetAll),
+		PromotionUpdate: adminPromotionHandler.NewUpdate(services.PromotionUpdate),
+
+		// Customer segment handlers
+		CustomerSegmentCreate:       adminCustomerSegmentHandler.NewCreate(services.CustomerSegmentCreate),
+		CustomerSegmentGetAll:       adminCustomerSegmentHandler.NewGetAll(services.CustomerSegmentGetAll),
+		CustomerSegmentGetCustomers: adminCustomerSegmentHandler.NewGetCustomers(services.CustomerSegmentGetCustomers),
+
+		// LINE campaign handlers
+		LineCampaignCreate:        adminLineCampaignHandler.NewCreate(services.LineCampaignCreate),
+		LineCampaignGetAll:        adminLineCampaignHandler.NewGetAll(services.LineCampaignGetAll),
+		LineCampaignGet:           adminLineCampaignHandler.NewGet(services.LineCampaignGet),
+		LineCampaignGetRecipients: adminLineCampaignHandler.NewGetRecipients(services.LineCampaignGetRecipients),
+
 		// Customer coupon handlers
 		CustomerCouponGetAll: adminCustomerCouponHandler.NewGetAll(services.CustomerCouponGetAll),
 		CustomerCouponCreate: adminCustomerCouponHandler.NewCreate(services.CustomerCouponCreate),
 		CustomerCouponDelete: adminCustomerCouponHandler.NewDelete(services.CustomerCouponDelete),
 
+		// Customer wallet handlers
+		CustomerWalletGet:                adminCustomerWalletHandler.NewGet(services.CustomerWalletGet),
+		CustomerWalletGetAllTransactions: adminCustomerWalletHandler.NewGetAllTransactions(services.CustomerWalletGetAllTransactions),
+		CustomerWalletTopUp:              adminCustomerWalletHandler.NewTopUp(services.CustomerWalletTopUp),
+		CustomerWalletAdjust:             adminCustomerWalletHandler.NewAdjust(services.CustomerWalletAdjust),
+		CustomerWalletRedeem:             adminCustomerWalletHandler.NewRedeem(services.CustomerWalletRedeem),
+
+		// Customer point handlers
+		CustomerPointGet:                adminCustomerPointHandler.NewGet(services.CustomerPointGet),
+		CustomerPointGetAllTransactions: adminCustomerPointHandler.NewGetAllTransactions(services.CustomerPointGetAllTransactions),
+		CustomerPointAdjust:             adminCustomerPointHandler.NewAdjust(services.CustomerPointAdjust),
+
+		// Customer level handlers
+		CustomerLevelRuleGetAll:    adminCustomerLevelRuleHandler.NewGetAll(services.CustomerLevelRuleGetAll),
+		CustomerLevelRuleUpdate:    adminCustomerLevelRuleHandler.NewUpdate(services.CustomerLevelRuleUpdate),
+		CustomerLevelHistoryGetAll: adminCustomerLevelHistoryHandler.NewGetAll(services.CustomerLevelHistoryGetAll),
+
+		// Blacklist handlers
+		BlacklistRuleGetAll:               adminBlacklistRuleHandler.NewGetAll(services.BlacklistRuleGetAll),
+		BlacklistRuleUpdate:               adminBlacklistRuleHandler.NewUpdate(services.BlacklistRuleUpdate),
+		CustomerBlacklistDecisionGetAll:   adminCustomerBlacklistDecisionHandler.NewGetAll(services.CustomerBlacklistDecisionGetAll),
+		CustomerBlacklistDecisionOverturn: adminCustomerBlacklistDecisionHandler.NewOverturn(services.CustomerBlacklistDecisionOverturn),
+
+		// Customer note handlers
+		CustomerNoteCreate: adminCustomerNoteHandler.NewCreate(services.CustomerNoteCreate),
+		CustomerNoteGetAll: adminCustomerNoteHandler.NewGetAll(services.CustomerNoteGetAll),
+		CustomerNoteUpdate: adminCustomerNoteHandler.NewUpdate(services.CustomerNoteUpdate),
+		CustomerNoteDelete: adminCustomerNoteHandler.NewDelete(services.CustomerNoteDelete),
+
+		// Customer health questionnaire handlers
+		CustomerHealthQuestionnaireGetAll: adminCustomerHealthQuestionnaireHandler.NewGetAll(services.CustomerHealthQuestionnaireGetAll),
+
+		// Customer merge handlers
+		CustomerMergeCreate: adminCustomerMergeHandler.NewCreate(services.CustomerMergeCreate),
+		CustomerMergeGetAll: adminCustomerMergeHandler.NewGetAll(services.CustomerMergeGetAll),
+
+		// Customer link request handlers
+		CustomerLinkRequestGetAll:  adminCustomerLinkRequestHandler.NewGetAll(services.CustomerLinkRequestGetAll),
+		CustomerLinkRequestApprove: adminCustomerLinkRequestHandler.NewApprove(services.CustomerLinkRequestApprove),
+		CustomerLinkRequestReject:  adminCustomerLinkRequestHandler.NewReject(services.CustomerLinkRequestReject),
+
+		// Customer data request handlers
+		CustomerDataRequestExport: adminCustomerDataRequestHandler.NewExport(services.CustomerDataRequestExport),
+		CustomerDataRequestErase:  adminCustomerDataRequestHandler.NewErase(services.CustomerDataRequestErase),
+		CustomerDataRequestGetAll: adminCustomerDataRequestHandler.NewGetAll(services.CustomerDataRequestGetAll),
+
+		// Terms document handlers
+		TermsDocumentCreate: adminTermsDocumentHandler.NewCreate(services.TermsDocumentCreate),
+		TermsDocumentGetAll: adminTermsDocumentHandler.NewGetAll(services.TermsDocumentGetAll),
+		TermsDocumentGet:    adminTermsDocumentHandler.NewGet(services.TermsDocumentGet),
+		TermsDocumentUpdate: adminTermsDocumentHandler.NewUpdate(services.TermsDocumentUpdate),
+
+		// Referral handlers
+		CustomerReferralGetAll: adminCustomerReferralHandler.NewGetAll(services.CustomerReferralGetAll),
+		ReferralSettingGet:     adminReferralSettingHandler.NewGet(services.ReferralSettingGet),
+		ReferralSettingUpdate:  adminReferralSettingHandler.NewUpdate(services.ReferralSettingUpdate),
+
+		// Birthday benefit handlers
+		BirthdayBenefitSettingGet:    adminBirthdayBenefitSettingHandler.NewGet(services.BirthdayBenefitSettingGet),
+		BirthdayBenefitSettingUpdate: adminBirthdayBenefitSettingHandler.NewUpdate(services.BirthdayBenefitSettingUpdate),
+
+		// Gift card handlers
+		GiftCardCreate: adminGiftCardHandler.NewCreate(services.GiftCardCreate),
+		GiftCardGetAll: adminGiftCardHandler.NewGetAll(services.GiftCardGetAll),
+
 		// Checkout handlers
-		CheckoutCreateBulk: adminCheckoutHandler.NewCreateBulk(services.CheckoutCreateBulk),
+		CheckoutCreateBulk:  adminCheckoutHandler.NewCreateBulk(services.CheckoutCreateBulk),
+		CheckoutRefund:      adminCheckoutHandler.NewRefund(services.CheckoutRefund),
+		CheckoutGetReceipt:  adminCheckoutHandler.NewGetReceipt(services.CheckoutGetReceipt),
+		CheckoutSendReceipt: adminCheckoutHandler.NewSendReceipt(services.CheckoutSendReceipt),
+
+		// Cash drawer close handlers
+		CashDrawerCloseCreate: adminCashDrawerCloseHandler.NewCreate(services.CashDrawerCloseCreate),
+		CashDrawerCloseGetAll: adminCashDrawerCloseHandler.NewGetAll(services.CashDrawerCloseGetAll),
 
 		// Report handlers
 		ReportGetPerformanceMe:    adminReportHandler.NewGetPerformanceMe(services.ReportGetPerformanceMe),
 		ReportGetStorePerformance: adminReportHandler.NewGetStorePerformance(services.ReportGetStorePerformance),
 		ReportGetStoreExpense:     adminReportHandler.NewGetStoreExpense(services.ReportGetStoreExpense),
+		ReportGetReferral:         adminReportHandler.NewGetReferral(services.ReportGetReferral),
+		ReportGetStoreWinBack:     adminReportHandler.NewGetStoreWinBack(services.ReportGetStoreWinBack),
 
 		// Stock usages handlers
 		StockUsagesCreate:       adminStockUsagesHandler.NewCreate(services.StockUsagesCreate),
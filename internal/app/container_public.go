@@ -12,10 +12,13 @@ import (
 	bookingHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/booking"
 	customerHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/customer"
 	customerCouponHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/customer_coupon"
+	customerHealthQuestionnaireHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/customer_health_questionnaire"
+	customerWalletHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/customer_wallet"
 	scheduleHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/schedule"
 	serviceHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/service"
 	storeHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/store"
 	stylistHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/stylist"
+	termsHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/terms"
 	timeSlotHandler "github.com/tkoleo84119/nail-salon-backend/internal/handler/time_slot"
 
 	// Public services
@@ -23,10 +26,13 @@ import (
 	bookingService "github.com/tkoleo84119/nail-salon-backend/internal/service/booking"
 	customerService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer"
 	customerCouponService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_coupon"
+	customerHealthQuestionnaireService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_health_questionnaire"
+	customerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_wallet"
 	scheduleService "github.com/tkoleo84119/nail-salon-backend/internal/service/schedule"
 	serviceService "github.com/tkoleo84119/nail-salon-backend/internal/service/service"
 	storeService "github.com/tkoleo84119/nail-salon-backend/internal/service/store"
 	stylistService "github.com/tkoleo84119/nail-salon-backend/internal/service/stylist"
+	termsService "github.com/tkoleo84119/nail-salon-backend/internal/service/terms"
 	timeSlotService "github.com/tkoleo84119/nail-salon-backend/internal/service/time_slot"
 )
 
@@ -38,13 +44,27 @@ type PublicServices struct {
 	AuthRefreshToken authService.RefreshTokenInterface
 	AuthAcceptTerm   authService.AcceptTermInterface
 
+	// Terms services
+	TermsGetCurrent termsService.GetCurrentInterface
+
 	// Customer services
 	CustomerGetMe    customerService.GetMeInterface
 	CustomerUpdateMe customerService.UpdateMeInterface
+	CustomerExportMe customerService.ExportMeInterface
+	CustomerEraseMe  customerService.EraseMeInterface
 
 	// CustomerCoupon services
 	CustomerCouponGetAll customerCouponService.GetAllInterface
 
+	// CustomerWallet services
+	CustomerWalletGetMe             customerWalletService.GetMeInterface
+	CustomerWalletGetMyTransactions customerWalletService.GetMyTransactionsInterface
+	CustomerWalletRedeem            customerWalletService.RedeemInterface
+
+	// CustomerHealthQuestionnaire services
+	CustomerHealthQuestionnaireGetMe    customerHealthQuestionnaireService.GetMeInterface
+	CustomerHealthQuestionnaireSubmitMe customerHealthQuestionnaireService.SubmitMeInterface
+
 	// Booking services
 	BookingCreate      bookingService.CreateInterface
 	BookingUpdate      bookingService.UpdateInterface
@@ -76,13 +96,27 @@ type PublicHandlers struct {
 	AuthRefreshToken *authHandler.RefreshToken
 	AuthAcceptTerm   *authHandler.AcceptTerm
 
+	// Terms handlers
+	TermsGetCurrent *termsHandler.GetCurrent
+
 	// Customer handlers
 	CustomerGetMe    *customerHandler.GetMe
 	CustomerUpdateMe *customerHandler.UpdateMe
+	CustomerExportMe *customerHandler.ExportMe
+	CustomerEraseMe  *customerHandler.EraseMe
 
 	// CustomerCoupon handlers
 	CustomerCouponGetAll *customerCouponHandler.GetAll
 
+	// CustomerWallet handlers
+	CustomerWalletGetMe             *customerWalletHandler.GetMe
+	CustomerWalletGetMyTransactions *customerWalletHandler.GetMyTransactions
+	CustomerWalletRedeem            *customerWalletHandler.Redeem
+
+	// CustomerHealthQuestionnaire handlers
+	CustomerHealthQuestionnaireGetMe    *customerHealthQuestionnaireHandler.GetMe
+	CustomerHealthQuestionnaireSubmitMe *customerHealthQuestionnaireHandler.SubmitMe
+
 	// Booking handlers
 	BookingCreate      *bookingHandler.Create
 	BookingUpdate      *bookingHandler.Update
@@ -115,17 +149,31 @@ func NewPublicServices(queries *dbgen.Queries, database *db.Database, repositori
 		AuthRefreshToken: authService.NewRefreshToken(queries, cfg.JWT, activityLog, cfg.Cookie),
 		AuthAcceptTerm:   authService.NewAcceptTerm(queries),
 
+		// Terms services
+		TermsGetCurrent: termsService.NewGetCurrent(queries),
+
 		// Customer services
 		CustomerGetMe:    customerService.NewGetMe(queries),
 		CustomerUpdateMe: customerService.NewUpdateMe(queries, repositories.SQLX, authCache),
+		CustomerExportMe: customerService.NewExportMe(queries),
+		CustomerEraseMe:  customerService.NewEraseMe(database.PgxPool, authCache),
 
 		// CustomerCoupon services
 		CustomerCouponGetAll: customerCouponService.NewGetAll(queries, repositories.SQLX),
 
+		// CustomerWallet services
+		CustomerWalletGetMe:             customerWalletService.NewGetMe(queries),
+		CustomerWalletGetMyTransactions: customerWalletService.NewGetMyTransactions(repositories.SQLX),
+		CustomerWalletRedeem:            customerWalletService.NewRedeem(database.PgxPool),
+
+		// CustomerHealthQuestionnaire services
+		CustomerHealthQuestionnaireGetMe:    customerHealthQuestionnaireService.NewGetMe(queries),
+		CustomerHealthQuestionnaireSubmitMe: customerHealthQuestionnaireService.NewSubmitMe(queries),
+
 		// Booking services
 		BookingCreate:      bookingService.NewCreate(queries, database.PgxPool, lineMessenger, activityLog),
 		BookingUpdate:      bookingService.NewUpdate(queries, repositories.SQLX, database.Sqlx, lineMessenger, activityLog),
-		BookingCancel:      bookingService.NewCancel(queries, database.PgxPool, lineMessenger, activityLog),
+		BookingCancel:      bookingService.NewCancel(queries, database.PgxPool, lineMessenger, activityLog, authCache),
 		BookingGetAll:      bookingService.NewGetAll(repositories.SQLX),
 		BookingGetMySingle: bookingService.NewGet(queries),
 
@@ -155,13 +203,27 @@ func NewPublicHandlers(services PublicServices, cfg *config.Config) PublicHandle
 		AuthRefreshToken: authHandler.NewRefreshToken(services.AuthRefreshToken, cfg),
 		AuthAcceptTerm:   authHandler.NewAcceptTerm(services.AuthAcceptTerm),
 
+		// Terms handlers
+		TermsGetCurrent: termsHandler.NewGetCurrent(services.TermsGetCurrent),
+
 		// Customer handlers
 		CustomerGetMe:    customerHandler.NewGetMe(services.CustomerGetMe),
 		CustomerUpdateMe: customerHandler.NewUpdateMe(services.CustomerUpdateMe),
+		CustomerExportMe: customerHandler.NewExportMe(services.CustomerExportMe),
+		CustomerEraseMe:  customerHandler.NewEraseMe(services.CustomerEraseMe, cfg),
 
 		// CustomerCoupon handlers
 		CustomerCouponGetAll: customerCouponHandler.NewGetAll(services.CustomerCouponGetAll),
 
+		// CustomerWallet handlers
+		CustomerWalletGetMe:             customerWalletHandler.NewGetMe(services.CustomerWalletGetMe),
+		CustomerWalletGetMyTransactions: customerWalletHandler.NewGetMyTransactions(services.CustomerWalletGetMyTransactions),
+		CustomerWalletRedeem:            customerWalletHandler.NewRedeem(services.CustomerWalletRedeem),
+
+		// CustomerHealthQuestionnaire handlers
+		CustomerHealthQuestionnaireGetMe:    customerHealthQuestionnaireHandler.NewGetMe(services.CustomerHealthQuestionnaireGetMe),
+		CustomerHealthQuestionnaireSubmitMe: customerHealthQuestionnaireHandler.NewSubmitMe(services.CustomerHealthQuestionnaireSubmitMe),
+
 		// Booking handlers
 		BookingCreate:      bookingHandler.NewCreate(services.BookingCreate),
 		BookingUpdate:      bookingHandler.NewUpdate(services.BookingUpdate),
@@ -42,6 +42,7 @@ func SetupRoutes(container *Container) *gin.Engine {
 			setupAdminStaffRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminStylistRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminCustomerRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminTermsDocumentRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminStoreRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminAccountRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminBrandRoutes(admin, cfg, queries, authCache, handlers)
@@ -52,7 +53,17 @@ func SetupRoutes(container *Container) *gin.Engine {
 			setupAdminScheduleRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminTimeSlotTemplateRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminCouponRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminCouponCampaignRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminPromotionRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminCustomerSegmentRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminLineCampaignRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminCustomerCouponRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminCustomerLevelRuleRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminBlacklistRuleRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminCustomerBlacklistDecisionRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminCustomerLinkRequestRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminReferralSettingRoutes(admin, cfg, queries, authCache, handlers)
+			setupAdminBirthdayBenefitSettingRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminReportRoutes(admin, cfg, queries, authCache, handlers)
 			setupAdminActivityLogRoutes(admin, cfg, queries, authCache, handlers)
 		}
@@ -77,7 +88,13 @@ func setupPublicAuthRoutes(api *gin.RouterGroup, cfg *config.Config, queries *db
 		}
 
 		// Customer terms acceptance
-		auth.POST("/accept-term", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.AuthAcceptTerm.AcceptTerm)
+		auth.POST("/accept-term", middleware.CustomerJWTAuthSkipTerms(*cfg, queries, authCache), handlers.Public.AuthAcceptTerm.AcceptTerm)
+	}
+
+	terms := api.Group("/terms")
+	{
+		// Current terms document, shown before register and re-acceptance
+		terms.GET("/current", handlers.Public.TermsGetCurrent.GetCurrent)
 	}
 }
 
@@ -87,6 +104,19 @@ func setupPublicCustomerRoutes(api *gin.RouterGroup, cfg *config.Config, queries
 		// Customer self-service
 		customers.GET("/me", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerGetMe.GetMe)
 		customers.PATCH("/me", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerUpdateMe.UpdateMe)
+
+		// Customer personal data
+		customers.GET("/me/data-export", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerExportMe.ExportMe)
+		customers.POST("/me/data-erasure", middleware.CustomerJWTAuthSkipTerms(*cfg, queries, authCache), handlers.Public.CustomerEraseMe.EraseMe)
+
+		// Customer wallet
+		customers.GET("/me/wallet", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerWalletGetMe.GetMe)
+		customers.GET("/me/wallet/transactions", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerWalletGetMyTransactions.GetMyTransactions)
+		customers.POST("/me/wallet/redeem", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerWalletRedeem.Redeem)
+
+		// Customer health questionnaire
+		customers.GET("/me/health-questionnaire", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerHealthQuestionnaireGetMe.GetMe)
+		customers.POST("/me/health-questionnaire", middleware.CustomerJWTAuth(*cfg, queries, authCache), handlers.Public.CustomerHealthQuestionnaireSubmitMe.SubmitMe)
 	}
 
 	// Customer coupons
@@ -210,6 +240,28 @@ func setupAdminStoreRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *
 
 		// Store checkouts routes
 		stores.POST("/:storeId/bookings/checkouts/bulk", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CheckoutCreateBulk.CreateBulk)
+		stores.POST("/:storeId/checkouts/:checkoutId/refund", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.CheckoutRefund.Refund)
+		stores.GET("/:storeId/checkouts/:checkoutId/receipt", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CheckoutGetReceipt.GetReceipt)
+		stores.POST("/:storeId/checkouts/:checkoutId/receipt/line", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CheckoutSendReceipt.SendReceipt)
+
+		// Store invoices routes
+		stores.GET("/:storeId/invoices", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.InvoiceGetAll.GetAll)
+		stores.POST("/:storeId/invoices/:invoiceId/retry", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.InvoiceRetry.Retry)
+
+		// Store customer wallets routes
+		stores.POST("/:storeId/customers/:customerId/wallet/top-up", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerWalletTopUp.TopUp)
+		stores.POST("/:storeId/customers/:customerId/wallet/adjust", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.CustomerWalletAdjust.Adjust)
+
+		// Store customer points routes
+		stores.POST("/:storeId/customers/:customerId/points/adjust", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.CustomerPointAdjust.Adjust)
+
+		// Store gift cards routes
+		stores.GET("/:storeId/gift-cards", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.GiftCardGetAll.GetAll)
+		stores.POST("/:storeId/gift-cards", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.GiftCardCreate.Create)
+
+		// Store cash drawer closes routes
+		stores.GET("/:storeId/cash-drawer-closes", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.CashDrawerCloseGetAll.GetAll)
+		stores.POST("/:storeId/cash-drawer-closes", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.CashDrawerCloseCreate.Create)
 
 		// Store booking products routes
 		stores.GET("/:storeId/bookings/:bookingId/products", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.BookingProductGetAll.GetAll)
@@ -235,6 +287,34 @@ func setupAdminStoreRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *
 		stores.POST("/:storeId/accounts/:accountId/transactions", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransactionCreate.Create)
 		stores.PATCH("/:storeId/accounts/:accountId/transactions/:transactionId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransactionUpdate.Update)
 		stores.DELETE("/:storeId/accounts/:accountId/transactions/latest", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransactionDelete.Delete)
+		stores.DELETE("/:storeId/accounts/:accountId/transactions/:transactionId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransactionDeleteByID.DeleteByID)
+
+		// Store account transaction audits routes
+		stores.GET("/:storeId/accounts/:accountId/transaction-audits", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransactionAuditGetAll.GetAll)
+
+		// Store account statement routes
+		stores.GET("/:storeId/accounts/:accountId/statement-layout", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementLayoutGet.Get)
+		stores.PUT("/:storeId/accounts/:accountId/statement-layout", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementLayoutUpdate.Update)
+		stores.GET("/:storeId/accounts/:accountId/statement-imports", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementImportGetAll.GetAll)
+		stores.POST("/:storeId/accounts/:accountId/statement-imports", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementImportCreate.Create)
+		stores.GET("/:storeId/accounts/:accountId/statement-lines", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementLineGetAll.GetAll)
+		stores.POST("/:storeId/accounts/:accountId/statement-lines/:lineId/transaction", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementLineCreateTransaction.CreateTransaction)
+		stores.PATCH("/:storeId/accounts/:accountId/statement-lines/:lineId/ignore", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountStatementLineUpdateIgnore.UpdateIgnore)
+
+		// Store account transfers routes
+		stores.POST("/:storeId/account-transfers", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.AccountTransferCreate.Create)
+
+		// Store account mappings routes
+		stores.GET("/:storeId/account-mappings", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.StoreAccountMappingGetAll.GetAll)
+		stores.PUT("/:storeId/account-mappings", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.StoreAccountMappingUpdate.Update)
+
+		// Store loyalty setting routes
+		stores.GET("/:storeId/loyalty-setting", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.StoreLoyaltySettingGet.Get)
+		stores.PUT("/:storeId/loyalty-setting", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.StoreLoyaltySettingUpdate.Update)
+
+		// Store win back setting routes
+		stores.GET("/:storeId/win-back-setting", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.StoreWinBackSettingGet.Get)
+		stores.PUT("/:storeId/win-back-setting", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.StoreWinBackSettingUpdate.Update)
 	}
 }
 
@@ -262,9 +342,52 @@ func setupAdminCustomerRoutes(admin *gin.RouterGroup, cfg *config.Config, querie
 	customers := admin.Group("/customers")
 	{
 		// Customer management - all staff can view customers
+		customers.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerCreate.Create)
 		customers.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerGetAll.GetAll)
 		customers.GET("/:customerId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerGet.Get)
 		customers.PATCH("/:customerId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerUpdate.Update)
+
+		// Customer wallet
+		customers.GET("/:customerId/wallet", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerWalletGet.Get)
+		customers.GET("/:customerId/wallet/transactions", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerWalletGetAllTransactions.GetAllTransactions)
+		customers.POST("/:customerId/wallet/redeem", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerWalletRedeem.Redeem)
+		customers.GET("/:customerId/points", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerPointGet.Get)
+		customers.GET("/:customerId/points/transactions", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerPointGetAllTransactions.GetAllTransactions)
+
+		// Customer level histories
+		customers.GET("/:customerId/level-histories", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerLevelHistoryGetAll.GetAll)
+
+		// Customer notes - stylists can only edit or delete their own notes
+		customers.POST("/:customerId/notes", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerNoteCreate.Create)
+		customers.GET("/:customerId/notes", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerNoteGetAll.GetAll)
+		customers.PATCH("/:customerId/notes/:noteId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerNoteUpdate.Update)
+		customers.DELETE("/:customerId/notes/:noteId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerNoteDelete.Delete)
+
+		// Customer health questionnaires
+		customers.GET("/:customerId/health-questionnaires", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerHealthQuestionnaireGetAll.GetAll)
+
+		// Customer merges
+		customers.POST("/:customerId/merges", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerMergeCreate.Create)
+		customers.GET("/:customerId/merges", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerMergeGetAll.GetAll)
+
+		// Customer personal data requests
+		customers.GET("/:customerId/data-export", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerDataRequestExport.Export)
+		customers.POST("/:customerId/data-erasure", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerDataRequestErase.Erase)
+		customers.GET("/:customerId/data-requests", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerDataRequestGetAll.GetAll)
+
+		// Customer referrals
+		customers.GET("/:customerId/referrals", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerReferralGetAll.GetAll)
+	}
+}
+
+func setupAdminTermsDocumentRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	termsDocuments := admin.Group("/terms-documents")
+	{
+		// Terms document management - all staff can view, admin can create and update
+		termsDocuments.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.TermsDocumentGetAll.GetAll)
+		termsDocuments.GET("/:termsDocumentId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.TermsDocumentGet.Get)
+		termsDocuments.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.TermsDocumentCreate.Create)
+		termsDocuments.PATCH("/:termsDocumentId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.TermsDocumentUpdate.Update)
 	}
 }
 
@@ -294,6 +417,43 @@ func setupAdminCouponRoutes(admin *gin.RouterGroup, cfg *config.Config, queries
 	}
 }
 
+func setupAdminCouponCampaignRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	campaigns := admin.Group("/coupon-campaigns")
+	{
+		campaigns.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CouponCampaignGetAll.GetAll)
+		campaigns.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CouponCampaignCreate.Create)
+		campaigns.GET("/:campaignId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CouponCampaignGet.Get)
+	}
+}
+
+func setupAdminPromotionRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	promotions := admin.Group("/promotions")
+	{
+		promotions.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.PromotionGetAll.GetAll)
+		promotions.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.PromotionCreate.Create)
+		promotions.PATCH("/:promotionId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.PromotionUpdate.Update)
+	}
+}
+
+func setupAdminCustomerSegmentRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	segments := admin.Group("/customer-segments")
+	{
+		segments.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerSegmentGetAll.GetAll)
+		segments.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerSegmentCreate.Create)
+		segments.GET("/:segmentId/customers", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerSegmentGetCustomers.GetCustomers)
+	}
+}
+
+func setupAdminLineCampaignRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	campaigns := admin.Group("/line-campaigns")
+	{
+		campaigns.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.LineCampaignGetAll.GetAll)
+		campaigns.POST("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.LineCampaignCreate.Create)
+		campaigns.GET("/:campaignId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.LineCampaignGet.Get)
+		campaigns.GET("/:campaignId/recipients", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.LineCampaignGetRecipients.GetRecipients)
+	}
+}
+
 func setupAdminCustomerCouponRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
 	customerCoupons := admin.Group("/customer_coupons")
 	{
@@ -303,6 +463,55 @@ func setupAdminCustomerCouponRoutes(admin *gin.RouterGroup, cfg *config.Config,
 	}
 }
 
+func setupAdminCustomerLevelRuleRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	customerLevelRules := admin.Group("/customer-level-rules")
+	{
+		customerLevelRules.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerLevelRuleGetAll.GetAll)
+		customerLevelRules.PUT("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerLevelRuleUpdate.Update)
+	}
+}
+
+func setupAdminBlacklistRuleRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	blacklistRules := admin.Group("/blacklist-rules")
+	{
+		blacklistRules.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.BlacklistRuleGetAll.GetAll)
+		blacklistRules.PUT("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.BlacklistRuleUpdate.Update)
+	}
+}
+
+func setupAdminCustomerBlacklistDecisionRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	decisions := admin.Group("/customer-blacklist-decisions")
+	{
+		decisions.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerBlacklistDecisionGetAll.GetAll)
+		decisions.PATCH("/:decisionId/overturn", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerBlacklistDecisionOverturn.Overturn)
+	}
+}
+
+func setupAdminCustomerLinkRequestRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	linkRequests := admin.Group("/customer-link-requests")
+	{
+		linkRequests.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.CustomerLinkRequestGetAll.GetAll)
+		linkRequests.PATCH("/:linkRequestId/approve", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerLinkRequestApprove.Approve)
+		linkRequests.PATCH("/:linkRequestId/reject", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.CustomerLinkRequestReject.Reject)
+	}
+}
+
+func setupAdminReferralSettingRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	referralSetting := admin.Group("/referral-setting")
+	{
+		referralSetting.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.ReferralSettingGet.Get)
+		referralSetting.PUT("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.ReferralSettingUpdate.Update)
+	}
+}
+
+func setupAdminBirthdayBenefitSettingRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
+	birthdayBenefitSetting := admin.Group("/birthday-benefit-setting")
+	{
+		birthdayBenefitSetting.GET("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAnyStaffRole(), handlers.Admin.BirthdayBenefitSettingGet.Get)
+		birthdayBenefitSetting.PUT("", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.BirthdayBenefitSettingUpdate.Update)
+	}
+}
+
 func setupAdminBrandRoutes(admin *gin.RouterGroup, cfg *config.Config, queries *dbgen.Queries, authCache cache.AuthCacheInterface, handlers Handlers) {
 	brands := admin.Group("/brands")
 	{
@@ -359,6 +568,8 @@ func setupAdminReportRoutes(admin *gin.RouterGroup, cfg *config.Config, queries
 		reports.GET("/performance/me", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireNotSuperAdmin(), handlers.Admin.ReportGetPerformanceMe.GetPerformanceMe)
 		reports.GET("/performance/store/:storeId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.ReportGetStorePerformance.GetStorePerformance)
 		reports.GET("/expense/store/:storeId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireAdminRoles(), handlers.Admin.ReportGetStoreExpense.GetStoreExpense)
+		reports.GET("/referrals", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.ReportGetReferral.GetReferral)
+		reports.GET("/win-back/store/:storeId", middleware.JWTAuth(*cfg, queries, authCache), middleware.RequireManagerOrAbove(), handlers.Admin.ReportGetStoreWinBack.GetStoreWinBack)
 	}
 }
 
@@ -42,8 +42,19 @@ type RedisConfig struct {
 	DB       int
 }
 
+type InvoiceConfig struct {
+	// Provider is the e-invoice provider name, currently only "stub" is supported
+	Provider string
+}
+
 type SchedulerConfig struct {
-	RefreshRevokeCron string
+	RefreshRevokeCron   string
+	PointExpireCron     string
+	CustomerLevelCron   string
+	BirthdayBenefitCron string
+	WinBackCron         string
+	CustomerMetricCron  string
+	CampaignResumeCron  string
 }
 
 type CORSConfig struct {
@@ -85,6 +96,7 @@ type Config struct {
 	JWT       JWTConfig
 	Line      LineConfig
 	Redis     RedisConfig
+	Invoice   InvoiceConfig
 	Scheduler SchedulerConfig
 	Server    ServerConfig
 	CORS      CORSConfig
@@ -128,8 +140,18 @@ func Load() *Config {
 		DB:       getenvIntDefault("REDIS_DB", 0),
 	}
 
+	invoiceConfig := InvoiceConfig{
+		Provider: getenvDefault("INVOICE_PROVIDER", "stub"),
+	}
+
 	schedulerConfig := SchedulerConfig{
-		RefreshRevokeCron: getAndCheckCronExpression("REFRESH_REVOKE_CRON"),
+		RefreshRevokeCron:   getAndCheckCronExpression("REFRESH_REVOKE_CRON"),
+		PointExpireCron:     getAndCheckCronExpression("POINT_EXPIRE_CRON"),
+		CustomerLevelCron:   getAndCheckCronExpression("CUSTOMER_LEVEL_CRON"),
+		BirthdayBenefitCron: getAndCheckCronExpression("BIRTHDAY_BENEFIT_CRON"),
+		WinBackCron:         getAndCheckCronExpression("WIN_BACK_CRON"),
+		CustomerMetricCron:  getAndCheckCronExpression("CUSTOMER_METRIC_CRON"),
+		CampaignResumeCron:  getAndCheckCronExpression("CAMPAIGN_RESUME_CRON"),
 	}
 
 	serverConfig := ServerConfig{
@@ -166,6 +188,7 @@ func Load() *Config {
 		JWT:       jwtConfig,
 		Line:      lineConfig,
 		Redis:     redisConfig,
+		Invoice:   invoiceConfig,
 		Scheduler: schedulerConfig,
 		Server:    serverConfig,
 		CORS:      corsConfig,
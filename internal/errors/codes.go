@@ -14,6 +14,7 @@ const (
 	AuthPermissionDenied = "AuthPermissionDenied"
 	AuthRefreshTokenInvalid = "AuthRefreshTokenInvalid"
 	AuthStaffFailed = "AuthStaffFailed"
+	AuthTermsNotAccepted = "AuthTermsNotAccepted"
 	AuthTokenFormatError = "AuthTokenFormatError"
 	AuthTokenInvalid = "AuthTokenInvalid"
 	AuthTokenMissing = "AuthTokenMissing"
@@ -56,10 +57,18 @@ const (
 	BookingWithMultipleCustomersNotAllowedToCheckout = "BookingWithMultipleCustomersNotAllowedToCheckout"
 
 	// CUSTOMER - customer related errors
+	CustomerAlreadyErased = "CustomerAlreadyErased"
 	CustomerAlreadyExists = "CustomerAlreadyExists"
+	CustomerAlreadyMerged = "CustomerAlreadyMerged"
 	CustomerAuthNotFound = "CustomerAuthNotFound"
+	CustomerDepositRequired = "CustomerDepositRequired"
+	CustomerErasureHasUpcomingBookings = "CustomerErasureHasUpcomingBookings"
+	CustomerErasureHasWalletBalance = "CustomerErasureHasWalletBalance"
+	CustomerInvoiceCarrierInvalid = "CustomerInvoiceCarrierInvalid"
 	CustomerIsBlacklisted = "CustomerIsBlacklisted"
+	CustomerMergeSameCustomer = "CustomerMergeSameCustomer"
 	CustomerNotFound = "CustomerNotFound"
+	CustomerPhoneAlreadyExists = "CustomerPhoneAlreadyExists"
 
 	// SCHEDULED - scheduled related errors
 	ScheduleAlreadyBookedDoNotDelete = "ScheduleAlreadyBookedDoNotDelete"
@@ -90,6 +99,7 @@ const (
 
 	// STORE - store related errors
 	StoreAlreadyExists = "StoreAlreadyExists"
+	StoreLoyaltySettingNotFound = "StoreLoyaltySettingNotFound"
 	StoreNotActive = "StoreNotActive"
 	StoreNotFound = "StoreNotFound"
 
@@ -115,10 +125,27 @@ const (
 
 	// ACCOUNT - account related errors
 	AccountBalanceNotEnough = "AccountBalanceNotEnough"
+	AccountNotActive = "AccountNotActive"
 	AccountNotBelongToStore = "AccountNotBelongToStore"
 	AccountNotFound = "AccountNotFound"
+	AccountStatementFileEmpty = "AccountStatementFileEmpty"
+	AccountStatementFileInvalid = "AccountStatementFileInvalid"
+	AccountStatementLayoutAmountColumnInvalid = "AccountStatementLayoutAmountColumnInvalid"
+	AccountStatementLayoutNotFound = "AccountStatementLayoutNotFound"
+	AccountStatementLineNotFound = "AccountStatementLineNotFound"
+	AccountStatementLineNotUnmatched = "AccountStatementLineNotUnmatched"
+	AccountTransactionChanged = "AccountTransactionChanged"
 	AccountTransactionNotBelongToAccount = "AccountTransactionNotBelongToAccount"
 	AccountTransactionNotFound = "AccountTransactionNotFound"
+	AccountTransactionPostedBySource = "AccountTransactionPostedBySource"
+	AccountTransferSameAccount = "AccountTransferSameAccount"
+	AccountTransferTypeNotUpdatable = "AccountTransferTypeNotUpdatable"
+
+	// BIRTHDAY_BENEFIT - birthday benefit related errors
+	BirthdayBenefitCouponRequired = "BirthdayBenefitCouponRequired"
+
+	// BLACKLIST_RULE - blacklist rule related errors
+	BlacklistRuleLateCancelHoursRequired = "BlacklistRuleLateCancelHoursRequired"
 
 	// BOOKING_DETAIL - booking detail related errors
 	BookingDetailNotFound = "BookingDetailNotFound"
@@ -127,14 +154,43 @@ const (
 	BrandNameAlreadyExists = "BrandNameAlreadyExists"
 	BrandNotFound = "BrandNotFound"
 
+	// CASH_DRAWER_CLOSE - cash drawer close related errors
+	CashDrawerCloseAlreadyExists = "CashDrawerCloseAlreadyExists"
+	CashDrawerCloseDateInFuture = "CashDrawerCloseDateInFuture"
+	CashDrawerClosedNotAllowCheckout = "CashDrawerClosedNotAllowCheckout"
+	CashDrawerClosedNotAllowRefund = "CashDrawerClosedNotAllowRefund"
+	CashDrawerClosedNotAllowTopUp = "CashDrawerClosedNotAllowTopUp"
+
+	// CHECKOUT - checkout related errors
+	CheckoutAlreadyRefunded = "CheckoutAlreadyRefunded"
+	CheckoutCustomerLineNotLinked = "CheckoutCustomerLineNotLinked"
+	CheckoutNotBelongToStore = "CheckoutNotBelongToStore"
+	CheckoutNotFound = "CheckoutNotFound"
+	CheckoutReceiptSendFailed = "CheckoutReceiptSendFailed"
+
 	// COUPON - coupon related errors
 	CouponCodeAlreadyExists = "CouponCodeAlreadyExists"
+	CouponCustomerUsageLimitReached = "CouponCustomerUsageLimitReached"
+	CouponDateRangeInvalid = "CouponDateRangeInvalid"
 	CouponDiscountAmountNotDivisibleByApplyCount = "CouponDiscountAmountNotDivisibleByApplyCount"
 	CouponDiscountExclusive = "CouponDiscountExclusive"
 	CouponDiscountRequired = "CouponDiscountRequired"
+	CouponEnded = "CouponEnded"
+	CouponMinSpendNotReached = "CouponMinSpendNotReached"
 	CouponNameAlreadyExists = "CouponNameAlreadyExists"
 	CouponNotActive = "CouponNotActive"
 	CouponNotFound = "CouponNotFound"
+	CouponNotStarted = "CouponNotStarted"
+	CouponServiceNotEligible = "CouponServiceNotEligible"
+	CouponTotalUsageLimitReached = "CouponTotalUsageLimitReached"
+
+	// COUPON_CAMPAIGN - coupon campaign related errors
+	CouponCampaignLastVisitRangeInvalid = "CouponCampaignLastVisitRangeInvalid"
+	CouponCampaignNotFound = "CouponCampaignNotFound"
+
+	// CUSTOMER_BLACKLIST_DECISION - customer blacklist decision related errors
+	CustomerBlacklistDecisionAlreadyOverturned = "CustomerBlacklistDecisionAlreadyOverturned"
+	CustomerBlacklistDecisionNotFound = "CustomerBlacklistDecisionNotFound"
 
 	// CUSTOMER_COUPON - customer coupon related errors
 	CustomerCouponAlreadyExists = "CustomerCouponAlreadyExists"
@@ -143,6 +199,38 @@ const (
 	CustomerCouponNotBelongToCustomer = "CustomerCouponNotBelongToCustomer"
 	CustomerCouponNotFound = "CustomerCouponNotFound"
 
+	// CUSTOMER_HEALTH - customer health related errors
+	CustomerHealthQuestionnaireVersionOutdated = "CustomerHealthQuestionnaireVersionOutdated"
+
+	// CUSTOMER_LEVEL_RULE - customer level rule related errors
+	CustomerLevelRuleThresholdRequired = "CustomerLevelRuleThresholdRequired"
+
+	// CUSTOMER_LINK_REQUEST - customer link request related errors
+	CustomerLinkRequestAlreadyReviewed = "CustomerLinkRequestAlreadyReviewed"
+	CustomerLinkRequestNotFound = "CustomerLinkRequestNotFound"
+
+	// CUSTOMER_NOTE - customer note related errors
+	CustomerNoteBookingNotBelongToCustomer = "CustomerNoteBookingNotBelongToCustomer"
+	CustomerNoteNotAuthor = "CustomerNoteNotAuthor"
+	CustomerNoteNotFound = "CustomerNoteNotFound"
+
+	// CUSTOMER_POINT - customer point related errors
+	CustomerPointInsufficientBalance = "CustomerPointInsufficientBalance"
+	CustomerPointNotEnabled = "CustomerPointNotEnabled"
+	CustomerPointRedeemExceedsAmount = "CustomerPointRedeemExceedsAmount"
+	CustomerPointRedeemUnitInvalid = "CustomerPointRedeemUnitInvalid"
+
+	// CUSTOMER_REFERRAL - customer referral related errors
+	CustomerReferralCodeInvalid = "CustomerReferralCodeInvalid"
+
+	// CUSTOMER_SEGMENT - customer segment related errors
+	CustomerSegmentNotFound = "CustomerSegmentNotFound"
+	CustomerSegmentSpendRangeInvalid = "CustomerSegmentSpendRangeInvalid"
+	CustomerSegmentVisitRangeInvalid = "CustomerSegmentVisitRangeInvalid"
+
+	// CUSTOMER_WALLET - customer wallet related errors
+	CustomerWalletInsufficientBalance = "CustomerWalletInsufficientBalance"
+
 	// EXPENSE - expense related errors
 	ExpenseItemAllArrivedNotAllowToCreateItem = "ExpenseItemAllArrivedNotAllowToCreateItem"
 	ExpenseItemArrivedNotAllowToChangePrice = "ExpenseItemArrivedNotAllowToChangePrice"
@@ -163,6 +251,20 @@ const (
 	ExpenseReimbursedNotAllowToUpdateProductInfo = "ExpenseReimbursedNotAllowToUpdateProductInfo"
 	ExpenseReimbursementNotAllowItemNotArrived = "ExpenseReimbursementNotAllowItemNotArrived"
 
+	// GIFT_CARD - gift card related errors
+	GiftCardAlreadyRedeemed = "GiftCardAlreadyRedeemed"
+	GiftCardExpired = "GiftCardExpired"
+	GiftCardNotFound = "GiftCardNotFound"
+
+	// INVOICE - invoice related errors
+	InvoiceNotBelongToStore = "InvoiceNotBelongToStore"
+	InvoiceNotFound = "InvoiceNotFound"
+	InvoiceProviderFailed = "InvoiceProviderFailed"
+	InvoiceStatusNotAllowedToRetry = "InvoiceStatusNotAllowedToRetry"
+
+	// LINE_CAMPAIGN - line campaign related errors
+	LineCampaignNotFound = "LineCampaignNotFound"
+
 	// PRODUCT - product related errors
 	ProductNameBrandAlreadyExistsInStore = "ProductNameBrandAlreadyExistsInStore"
 	ProductNotBelongToStore = "ProductNotBelongToStore"
@@ -173,6 +275,13 @@ const (
 	CategoryNameAlreadyExists = "CategoryNameAlreadyExists"
 	CategoryNotFound = "CategoryNotFound"
 
+	// PROMOTION - promotion related errors
+	PromotionDateRangeInvalid = "PromotionDateRangeInvalid"
+	PromotionDiscountExclusive = "PromotionDiscountExclusive"
+	PromotionDiscountRequired = "PromotionDiscountRequired"
+	PromotionNotFound = "PromotionNotFound"
+	PromotionTimeRangeInvalid = "PromotionTimeRangeInvalid"
+
 	// REPORT - report related errors
 	ReportDateRangeExceed1Year = "ReportDateRangeExceed1Year"
 	ReportDateRangeExceed3Years = "ReportDateRangeExceed3Years"
@@ -181,8 +290,19 @@ const (
 	StockUsageNotFound = "StockUsageNotFound"
 	StockUsageNotInUse = "StockUsageNotInUse"
 
+	// STORE_ACCOUNT_MAPPING - store account mapping related errors
+	StoreAccountMappingDuplicate = "StoreAccountMappingDuplicate"
+	StoreAccountMappingPayerNotAllowed = "StoreAccountMappingPayerNotAllowed"
+	StoreAccountMappingPaymentMethodRequired = "StoreAccountMappingPaymentMethodRequired"
+
 	// SUPPLIER - supplier related errors
 	SupplierNameAlreadyExists = "SupplierNameAlreadyExists"
 	SupplierNotFound = "SupplierNotFound"
 
+	// TERMS_DOCUMENT - terms document related errors
+	TermsDocumentNotEditable = "TermsDocumentNotEditable"
+	TermsDocumentNotEffective = "TermsDocumentNotEffective"
+	TermsDocumentNotFound = "TermsDocumentNotFound"
+	TermsDocumentVersionAlreadyExists = "TermsDocumentVersionAlreadyExists"
+
 )
@@ -0,0 +1,116 @@
+package adminAccountStatementImport
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminAccountStatementImportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_import"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminAccountStatementImportService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_import"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const maxStatementFileSize = 5 << 20
+
+type Create struct {
+	service adminAccountStatementImportService.CreateInterface
+}
+
+func NewCreate(service adminAccountStatementImportService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get account ID from path parameter
+	accountID := c.Param("accountId")
+	if accountID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"accountId": "accountId 為必填項目",
+		})
+		return
+	}
+	parsedAccountID, err := utils.ParseID(accountID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"accountId": "accountId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get statement file from multipart form
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldRequired, map[string]string{
+			"file": "file 為必填項目",
+		})
+		return
+	}
+	if fileHeader.Size > maxStatementFileSize {
+		errorCodes.AbortWithError(c, errorCodes.AccountStatementFileInvalid, map[string]string{
+			"file": "file 大小不可超過 5MB",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.AccountStatementFileInvalid, map[string]string{
+			"file": "file 無法讀取",
+		})
+		return
+	}
+	defer file.Close()
+
+	fileName := []rune(fileHeader.Filename)
+	if len(fileName) > 255 {
+		fileName = fileName[:255]
+	}
+
+	parsedReq := adminAccountStatementImportModel.CreateParsedRequest{
+		FileName: string(fileName),
+		File:     file,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.Create(c.Request.Context(), parsedStoreID, parsedAccountID, parsedReq, staffContext.UserID, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,79 @@
+package adminAccountStatementLayout
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminAccountStatementLayoutService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_layout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	service adminAccountStatementLayoutService.GetInterface
+}
+
+func NewGet(service adminAccountStatementLayoutService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get account ID from path parameter
+	accountID := c.Param("accountId")
+	if accountID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"accountId": "accountId 為必填項目",
+		})
+		return
+	}
+	parsedAccountID, err := utils.ParseID(accountID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"accountId": "accountId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.Get(c.Request.Context(), parsedStoreID, parsedAccountID, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,104 @@
+package adminAccountStatementLine
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminAccountStatementLineService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CreateTransaction struct {
+	service adminAccountStatementLineService.CreateTransactionInterface
+}
+
+func NewCreateTransaction(service adminAccountStatementLineService.CreateTransactionInterface) *CreateTransaction {
+	return &CreateTransaction{
+		service: service,
+	}
+}
+
+func (h *CreateTransaction) CreateTransaction(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get account ID from path parameter
+	accountID := c.Param("accountId")
+	if accountID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"accountId": "accountId 為必填項目",
+		})
+		return
+	}
+	parsedAccountID, err := utils.ParseID(accountID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"accountId": "accountId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get line ID from path parameter
+	lineID := c.Param("lineId")
+	if lineID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"lineId": "lineId 為必填項目",
+		})
+		return
+	}
+	parsedLineID, err := utils.ParseID(lineID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"lineId": "lineId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req adminAccountStatementLineModel.CreateTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.CreateTransaction(c.Request.Context(), parsedStoreID, parsedAccountID, parsedLineID, req, staffContext.UserID, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,112 @@
+package adminAccountStatementLine
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminAccountStatementLineService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminAccountStatementLineService.GetAllInterface
+}
+
+func NewGetAll(service adminAccountStatementLineService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get account ID from path parameter
+	accountID := c.Param("accountId")
+	if accountID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"accountId": "accountId 為必填項目",
+		})
+		return
+	}
+	parsedAccountID, err := utils.ParseID(accountID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"accountId": "accountId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse query parameters
+	var req adminAccountStatementLineModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	var importID *int64
+	if req.ImportID != nil && *req.ImportID != "" {
+		parsedImportID, err := utils.ParseID(*req.ImportID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"importId": "importId 類型轉換失敗",
+			})
+			return
+		}
+		importID = &parsedImportID
+	}
+
+	parsedReq := adminAccountStatementLineModel.GetAllParsedRequest{
+		ImportID: importID,
+		Status:   req.Status,
+		Limit:    limit,
+		Offset:   offset,
+		Sort:     sort,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	storeIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		storeIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.GetAll(c.Request.Context(), parsedStoreID, parsedAccountID, parsedReq, staffContext.Role, storeIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
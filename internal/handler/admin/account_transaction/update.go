@@ -93,7 +93,20 @@ func (h *Update) Update(c *gin.Context) {
 	}
 
 	parsedReq := adminAccountTransactionModel.UpdateParsedRequest{
-		Note: req.Note,
+		Type:   req.Type,
+		Amount: req.Amount,
+		Note:   req.Note,
+	}
+
+	if req.TransactionDate != nil {
+		parsedTransactionDate, err := utils.DateStringToTime(*req.TransactionDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"transactionDate": "transactionDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.TransactionDate = &parsedTransactionDate
 	}
 
 	// Get staff context from middleware
@@ -109,7 +122,7 @@ func (h *Update) Update(c *gin.Context) {
 	}
 
 	// Call service
-	response, err := h.service.Update(c.Request.Context(), parsedStoreID, parsedAccountID, parsedTransactionID, parsedReq, staffContext.Role, creatorStoreIDs)
+	response, err := h.service.Update(c.Request.Context(), parsedStoreID, parsedAccountID, parsedTransactionID, parsedReq, staffContext.UserID, staffContext.Role, creatorStoreIDs)
 	if err != nil {
 		errorCodes.RespondWithServiceError(c, err)
 		return
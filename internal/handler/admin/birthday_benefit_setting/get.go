@@ -0,0 +1,31 @@
+package adminBirthdayBenefitSetting
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminBirthdayBenefitSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/birthday_benefit_setting"
+)
+
+type Get struct {
+	service adminBirthdayBenefitSettingService.GetInterface
+}
+
+func NewGet(service adminBirthdayBenefitSettingService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	response, err := h.service.Get(c.Request.Context())
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,68 @@
+package adminBirthdayBenefitSetting
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBirthdayBenefitSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/birthday_benefit_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminBirthdayBenefitSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/birthday_benefit_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminBirthdayBenefitSettingService.UpdateInterface
+}
+
+func NewUpdate(service adminBirthdayBenefitSettingService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Parse and validate request
+	var req adminBirthdayBenefitSettingModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var couponID *int64
+	if req.CouponID != nil && *req.CouponID != "" {
+		parsedCouponID, err := utils.ParseID(*req.CouponID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"couponId": "couponId 類型轉換失敗",
+			})
+			return
+		}
+		couponID = &parsedCouponID
+	}
+
+	if req.LineMessage != nil {
+		*req.LineMessage = strings.TrimSpace(*req.LineMessage)
+	}
+
+	parsedReq := adminBirthdayBenefitSettingModel.UpdateParsedRequest{
+		IsEnabled:       *req.IsEnabled,
+		CouponID:        couponID,
+		CouponValidDays: req.CouponValidDays,
+		IssuePeriod:     req.IssuePeriod,
+		LineMessage:     req.LineMessage,
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
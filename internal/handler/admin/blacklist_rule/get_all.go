@@ -0,0 +1,31 @@
+package adminBlacklistRule
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminBlacklistRuleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/blacklist_rule"
+)
+
+type GetAll struct {
+	service adminBlacklistRuleService.GetAllInterface
+}
+
+func NewGetAll(service adminBlacklistRuleService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	response, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
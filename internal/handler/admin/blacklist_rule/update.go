@@ -0,0 +1,43 @@
+package adminBlacklistRule
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBlacklistRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/blacklist_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminBlacklistRuleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/blacklist_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminBlacklistRuleService.UpdateInterface
+}
+
+func NewUpdate(service adminBlacklistRuleService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Parse and validate request
+	var req adminBlacklistRuleModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), req)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,101 @@
+package adminCashDrawerClose
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCashDrawerCloseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/cash_drawer_close"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCashDrawerCloseService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/cash_drawer_close"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCashDrawerCloseService.CreateInterface
+}
+
+func NewCreate(service adminCashDrawerCloseService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req adminCashDrawerCloseModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim note
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	parsedCloseDate, err := utils.DateStringToTime(req.CloseDate)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+			"closeDate": "closeDate 日期格式錯誤，應為 YYYY-MM-DD",
+		})
+		return
+	}
+
+	parsedAccountID, err := utils.ParseID(req.AccountID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"accountId": "accountId 類型轉換失敗",
+		})
+		return
+	}
+
+	parsedReq := adminCashDrawerCloseModel.CreateParsedRequest{
+		CloseDate:   parsedCloseDate,
+		CountedCash: *req.CountedCash,
+		AccountID:   parsedAccountID,
+		Note:        req.Note,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.Create(c.Request.Context(), parsedStoreID, parsedReq, staffContext.UserID, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,109 @@
+package adminCashDrawerClose
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCashDrawerCloseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/cash_drawer_close"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCashDrawerCloseService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/cash_drawer_close"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCashDrawerCloseService.GetAllInterface
+}
+
+func NewGetAll(service adminCashDrawerCloseService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse query parameters
+	var req adminCashDrawerCloseModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	var startDate *time.Time
+	if req.StartDate != nil {
+		parsedStartDate, err := utils.DateStringToTime(*req.StartDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		startDate = &parsedStartDate
+	}
+
+	var endDate *time.Time
+	if req.EndDate != nil {
+		parsedEndDate, err := utils.DateStringToTime(*req.EndDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		endDate = &parsedEndDate
+	}
+
+	parsedReq := adminCashDrawerCloseModel.GetAllParsedRequest{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Limit:     limit,
+		Offset:    offset,
+		Sort:      sort,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.GetAll(c.Request.Context(), parsedStoreID, parsedReq, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
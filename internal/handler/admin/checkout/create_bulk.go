@@ -93,11 +93,17 @@ func (h *CreateBulk) CreateBulk(c *gin.Context) {
 			}
 		}
 
+		var redeemPoints int32
+		if checkout.RedeemPoints != nil {
+			redeemPoints = *checkout.RedeemPoints
+		}
+
 		checkouts[i] = adminCheckoutModel.CreateBulkParsedCheckoutItems{
-			BookingID:  parsedBookingID,
-			PaidAmount: checkout.PaidAmount,
-			ApplyCount: int64(applyCount),
-			Details:    details,
+			BookingID:    parsedBookingID,
+			PaidAmount:   checkout.PaidAmount,
+			ApplyCount:   int64(applyCount),
+			RedeemPoints: redeemPoints,
+			Details:      details,
 		}
 	}
 
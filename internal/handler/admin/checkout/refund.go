@@ -0,0 +1,78 @@
+package adminCheckout
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCheckoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCheckoutService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Refund struct {
+	service adminCheckoutService.RefundInterface
+}
+
+func NewRefund(service adminCheckoutService.RefundInterface) *Refund {
+	return &Refund{
+		service: service,
+	}
+}
+
+func (h *Refund) Refund(c *gin.Context) {
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	checkoutID := c.Param("checkoutId")
+	if checkoutID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"checkoutId": "checkoutId 為必填項目",
+		})
+		return
+	}
+	parsedCheckoutID, err := utils.ParseID(checkoutID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"checkoutId": "checkoutId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCheckoutModel.RefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Get staff context from JWT middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Refund(c.Request.Context(), parsedStoreID, parsedCheckoutID, req, staffContext)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
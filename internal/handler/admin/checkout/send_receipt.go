@@ -0,0 +1,79 @@
+package adminCheckout
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCheckoutService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type SendReceipt struct {
+	service adminCheckoutService.SendReceiptInterface
+}
+
+func NewSendReceipt(service adminCheckoutService.SendReceiptInterface) *SendReceipt {
+	return &SendReceipt{
+		service: service,
+	}
+}
+
+func (h *SendReceipt) SendReceipt(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get checkout ID from path parameter
+	checkoutID := c.Param("checkoutId")
+	if checkoutID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"checkoutId": "checkoutId 為必填項目",
+		})
+		return
+	}
+	parsedCheckoutID, err := utils.ParseID(checkoutID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"checkoutId": "checkoutId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.SendReceipt(c.Request.Context(), parsedStoreID, parsedCheckoutID, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
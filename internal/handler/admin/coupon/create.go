@@ -39,7 +39,67 @@ func (h *Create) Create(c *gin.Context) {
 		*req.Note = strings.TrimSpace(*req.Note)
 	}
 
-	response, err := h.service.Create(c.Request.Context(), req)
+	parsedReq := adminCouponModel.CreateParsedRequest{
+		Name:                  req.Name,
+		DisplayName:           req.DisplayName,
+		Code:                  req.Code,
+		DiscountRate:          req.DiscountRate,
+		DiscountAmount:        req.DiscountAmount,
+		MinSpendAmount:        req.MinSpendAmount,
+		ServiceScope:          common.CouponServiceScopeAll,
+		ServiceIds:            []int64{},
+		TotalUsageLimit:       req.TotalUsageLimit,
+		PerCustomerUsageLimit: req.PerCustomerUsageLimit,
+		Note:                  req.Note,
+	}
+	if req.ServiceScope != nil {
+		parsedReq.ServiceScope = *req.ServiceScope
+	}
+	if req.IsRepeatable != nil {
+		parsedReq.IsRepeatable = *req.IsRepeatable
+	}
+
+	if req.StartDate != nil && *req.StartDate != "" {
+		startDate, err := utils.DateStringToTime(*req.StartDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.StartDate = &startDate
+	}
+	if req.EndDate != nil && *req.EndDate != "" {
+		endDate, err := utils.DateStringToTime(*req.EndDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.EndDate = &endDate
+	}
+
+	// parse service ids and skip duplicates
+	if req.ServiceIds != nil {
+		seen := make(map[int64]bool)
+		for _, serviceID := range *req.ServiceIds {
+			parsedServiceID, err := utils.ParseID(serviceID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					"serviceIds": "serviceIds 類型轉換失敗",
+				})
+				return
+			}
+			if seen[parsedServiceID] {
+				continue
+			}
+			seen[parsedServiceID] = true
+			parsedReq.ServiceIds = append(parsedReq.ServiceIds, parsedServiceID)
+		}
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedReq)
 	if err != nil {
 		errorCodes.RespondWithServiceError(c, err)
 		return
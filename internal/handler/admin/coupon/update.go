@@ -3,6 +3,7 @@ package adminCoupon
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -60,7 +61,67 @@ func (h *Update) Update(c *gin.Context) {
 		*req.Note = strings.TrimSpace(*req.Note)
 	}
 
-	response, err := h.service.Update(c.Request.Context(), couponID, req)
+	parsedReq := adminCouponModel.UpdateParsedRequest{
+		Name:                  req.Name,
+		IsActive:              req.IsActive,
+		MinSpendAmount:        req.MinSpendAmount,
+		ServiceScope:          req.ServiceScope,
+		TotalUsageLimit:       req.TotalUsageLimit,
+		PerCustomerUsageLimit: req.PerCustomerUsageLimit,
+		IsRepeatable:          req.IsRepeatable,
+		Note:                  req.Note,
+	}
+
+	// empty date clears the date
+	if req.StartDate != nil {
+		startDate := time.Time{}
+		if *req.StartDate != "" {
+			startDate, err = utils.DateStringToTime(*req.StartDate)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+					"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+				})
+				return
+			}
+		}
+		parsedReq.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate := time.Time{}
+		if *req.EndDate != "" {
+			endDate, err = utils.DateStringToTime(*req.EndDate)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+					"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+				})
+				return
+			}
+		}
+		parsedReq.EndDate = &endDate
+	}
+
+	// parse service ids and skip duplicates, empty array clears the eligible services
+	if req.ServiceIds != nil {
+		serviceIDs := []int64{}
+		seen := make(map[int64]bool)
+		for _, serviceID := range *req.ServiceIds {
+			parsedServiceID, err := utils.ParseID(serviceID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					"serviceIds": "serviceIds 類型轉換失敗",
+				})
+				return
+			}
+			if seen[parsedServiceID] {
+				continue
+			}
+			seen[parsedServiceID] = true
+			serviceIDs = append(serviceIDs, parsedServiceID)
+		}
+		parsedReq.ServiceIds = &serviceIDs
+	}
+
+	response, err := h.service.Update(c.Request.Context(), couponID, parsedReq)
 	if err != nil {
 		errorCodes.RespondWithServiceError(c, err)
 		return
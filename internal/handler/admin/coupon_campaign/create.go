@@ -0,0 +1,115 @@
+package adminCouponCampaign
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCouponCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCouponCampaignService.CreateInterface
+}
+
+func NewCreate(service adminCouponCampaignService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminCouponCampaignModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name, lineMessage
+	req.Name = strings.TrimSpace(req.Name)
+	if req.LineMessage != nil {
+		*req.LineMessage = strings.TrimSpace(*req.LineMessage)
+	}
+
+	couponID, err := utils.ParseID(req.CouponID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"couponId": "couponId 類型轉換失敗",
+		})
+		return
+	}
+
+	parsedReq := adminCouponCampaignModel.CreateParsedRequest{
+		Name:           req.Name,
+		CouponID:       couponID,
+		ValidMonths:    req.ValidMonths,
+		CustomerLevels: []string{},
+		BirthdayMonth:  req.BirthdayMonth,
+		SendLine:       req.SendLine,
+		LineMessage:    req.LineMessage,
+	}
+
+	// skip duplicate levels
+	seen := make(map[string]bool, len(req.CustomerLevels))
+	for _, level := range req.CustomerLevels {
+		if seen[level] {
+			continue
+		}
+		seen[level] = true
+		parsedReq.CustomerLevels = append(parsedReq.CustomerLevels, level)
+	}
+
+	if req.LastVisitFrom != nil && *req.LastVisitFrom != "" {
+		lastVisitFrom, err := utils.DateStringToTime(*req.LastVisitFrom)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"lastVisitFrom": "lastVisitFrom 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.LastVisitFrom = &lastVisitFrom
+	}
+	if req.LastVisitTo != nil && *req.LastVisitTo != "" {
+		lastVisitTo, err := utils.DateStringToTime(*req.LastVisitTo)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"lastVisitTo": "lastVisitTo 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.LastVisitTo = &lastVisitTo
+	}
+
+	if req.StoreID != nil && *req.StoreID != "" {
+		storeID, err := utils.ParseID(*req.StoreID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"storeId": "storeId 類型轉換失敗",
+			})
+			return
+		}
+		parsedReq.StoreID = &storeID
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedReq, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
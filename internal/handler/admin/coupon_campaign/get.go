@@ -0,0 +1,47 @@
+package adminCouponCampaign
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCouponCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	service adminCouponCampaignService.GetInterface
+}
+
+func NewGet(service adminCouponCampaignService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	campaignID := c.Param("campaignId")
+	if campaignID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"campaignId": "campaignId 為必填項目",
+		})
+		return
+	}
+	parsedCampaignID, err := utils.ParseID(campaignID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"campaignId": "campaignId 類型轉換失敗",
+		})
+		return
+	}
+
+	response, err := h.service.Get(c.Request.Context(), parsedCampaignID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,52 @@
+package adminCouponCampaign
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCouponCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCouponCampaignService.GetAllInterface
+}
+
+func NewGetAll(service adminCouponCampaignService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Parse query parameters
+	var req adminCouponCampaignModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCouponCampaignModel.GetAllParsedRequest{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
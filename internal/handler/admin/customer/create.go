@@ -0,0 +1,59 @@
+package adminCustomer
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCustomerService.CreateInterface
+}
+
+func NewCreate(service adminCustomerService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminCustomerModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name, email, city and storeNote
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Email != nil {
+		*req.Email = strings.TrimSpace(*req.Email)
+	}
+	if req.City != nil {
+		*req.City = strings.TrimSpace(*req.City)
+	}
+	if req.StoreNote != nil {
+		*req.StoreNote = strings.TrimSpace(*req.StoreNote)
+	}
+
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), req, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -43,20 +43,34 @@ func (h *GetAll) GetAll(c *gin.Context) {
 		*req.Phone = strings.TrimSpace(*req.Phone)
 	}
 
+	// tags are comma-separated
+	var tags *[]string
+	if req.Tags != nil {
+		parsedTags := normalizeTags(strings.Split(*req.Tags, ","))
+		tags = &parsedTags
+	}
+
 	// set limit and offset
 	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
 	sort := utils.TransformSort(req.Sort)
 
 	parsedReq := adminCustomerModel.GetAllParsedRequest{
-		Name:          req.Name,
-		LineName:      req.LineName,
-		Phone:         req.Phone,
-		Level:         req.Level,
-		IsBlacklisted: req.IsBlacklisted,
-		MinPastDays:   req.MinPastDays,
-		Limit:         limit,
-		Offset:        offset,
-		Sort:          sort,
+		Name:                req.Name,
+		LineName:            req.LineName,
+		Phone:               req.Phone,
+		Level:               req.Level,
+		IsBlacklisted:       req.IsBlacklisted,
+		RequiresDeposit:     req.RequiresDeposit,
+		MinPastDays:         req.MinPastDays,
+		Tags:                tags,
+		ChurnRiskLevel:      req.ChurnRiskLevel,
+		MinChurnRiskScore:   req.MinChurnRiskScore,
+		MaxChurnRiskScore:   req.MaxChurnRiskScore,
+		MinNoShowRate:       req.MinNoShowRate,
+		MinCancellationRate: req.MinCancellationRate,
+		Limit:               limit,
+		Offset:              offset,
+		Sort:                sort,
 	}
 
 	// Service layer call
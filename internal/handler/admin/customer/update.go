@@ -2,10 +2,12 @@ package adminCustomer
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
 	adminCustomerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	adminCustomerService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer"
@@ -50,12 +52,25 @@ func (h *Update) Update(c *gin.Context) {
 		return
 	}
 
-	// trim storeNote
+	// trim storeNote and levelNote
 	if req.StoreNote != nil {
 		*req.StoreNote = strings.TrimSpace(*req.StoreNote)
 	}
+	if req.LevelNote != nil {
+		*req.LevelNote = strings.TrimSpace(*req.LevelNote)
+	}
+	if req.Tags != nil {
+		tags := normalizeTags(*req.Tags)
+		req.Tags = &tags
+	}
+
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
 
-	response, err := h.service.Update(c.Request.Context(), parsedCustomerID, req)
+	response, err := h.service.Update(c.Request.Context(), parsedCustomerID, req, staffContext.UserID)
 	if err != nil {
 		errorCodes.RespondWithServiceError(c, err)
 		return
@@ -63,3 +78,17 @@ func (h *Update) Update(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.SuccessResponse(response))
 }
+
+// normalizeTags trims the tags and removes the empty and duplicated ones, keeping the original order
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || slices.Contains(result, tag) {
+			continue
+		}
+		result = append(result, tag)
+	}
+
+	return result
+}
@@ -0,0 +1,65 @@
+package adminCustomerBlacklistDecision
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerBlacklistDecisionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerBlacklistDecisionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCustomerBlacklistDecisionService.GetAllInterface
+}
+
+func NewGetAll(service adminCustomerBlacklistDecisionService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Parse query parameters
+	var req adminCustomerBlacklistDecisionModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var customerID *int64
+	if req.CustomerID != nil {
+		parsedCustomerID, err := utils.ParseID(*req.CustomerID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{"customerId": "customerId 轉換類型失敗"})
+			return
+		}
+		customerID = &parsedCustomerID
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCustomerBlacklistDecisionModel.GetAllParsedRequest{
+		CustomerID: customerID,
+		Status:     req.Status,
+		RuleType:   req.RuleType,
+		Action:     req.Action,
+		Limit:      limit,
+		Offset:     offset,
+		Sort:       sort,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,64 @@
+package adminCustomerBlacklistDecision
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerBlacklistDecisionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerBlacklistDecisionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Overturn struct {
+	service adminCustomerBlacklistDecisionService.OverturnInterface
+}
+
+func NewOverturn(service adminCustomerBlacklistDecisionService.OverturnInterface) *Overturn {
+	return &Overturn{
+		service: service,
+	}
+}
+
+func (h *Overturn) Overturn(c *gin.Context) {
+	decisionIDStr := c.Param("decisionId")
+	if decisionIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"decisionId": "decisionId 為必填項目",
+		})
+		return
+	}
+	decisionID, err := utils.ParseID(decisionIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"decisionId": "decisionId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerBlacklistDecisionModel.OverturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+
+	staff, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Overturn(c.Request.Context(), decisionID, req, staff.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,69 @@
+package adminCustomerDataRequest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerDataRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerDataRequestService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Erase struct {
+	service adminCustomerDataRequestService.EraseInterface
+}
+
+func NewErase(service adminCustomerDataRequestService.EraseInterface) *Erase {
+	return &Erase{
+		service: service,
+	}
+}
+
+func (h *Erase) Erase(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerDataRequestModel.EraseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim note
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Erase(c.Request.Context(), parsedCustomerID, req, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
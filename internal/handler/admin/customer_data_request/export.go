@@ -0,0 +1,55 @@
+package adminCustomerDataRequest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerDataRequestService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Export struct {
+	service adminCustomerDataRequestService.ExportInterface
+}
+
+func NewExport(service adminCustomerDataRequestService.ExportInterface) *Export {
+	return &Export{
+		service: service,
+	}
+}
+
+func (h *Export) Export(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Export(c.Request.Context(), parsedCustomerID, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,62 @@
+package adminCustomerHealthQuestionnaire
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerHealthQuestionnaireService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCustomerHealthQuestionnaireService.GetAllInterface
+}
+
+func NewGetAll(service adminCustomerHealthQuestionnaireService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	customerIDStr := c.Param("customerId")
+	if customerIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	customerID, err := utils.ParseID(customerIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerHealthQuestionnaireModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+
+	parsedReq := adminCustomerHealthQuestionnaireModel.GetAllParsedRequest{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), customerID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,65 @@
+package adminCustomerLevelRule
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLevelRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerLevelRuleService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_level_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminCustomerLevelRuleService.UpdateInterface
+}
+
+func NewUpdate(service adminCustomerLevelRuleService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Parse and validate request
+	var req adminCustomerLevelRuleModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var benefitCouponID *int64
+	if req.BenefitCouponID != nil && *req.BenefitCouponID != "" {
+		parsedCouponID, err := utils.ParseID(*req.BenefitCouponID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"benefitCouponId": "benefitCouponId 類型轉換失敗",
+			})
+			return
+		}
+		benefitCouponID = &parsedCouponID
+	}
+
+	parsedReq := adminCustomerLevelRuleModel.UpdateParsedRequest{
+		Level:                    req.Level,
+		IsActive:                 *req.IsActive,
+		PeriodMonths:             req.PeriodMonths,
+		MinSpendAmount:           *req.MinSpendAmount,
+		MinVisitCount:            *req.MinVisitCount,
+		BenefitCouponID:          benefitCouponID,
+		BenefitCouponValidMonths: req.BenefitCouponValidMonths,
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,68 @@
+package adminCustomerLinkRequest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerLinkRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_link_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerLinkRequestService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_link_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Approve struct {
+	service adminCustomerLinkRequestService.ApproveInterface
+}
+
+func NewApprove(service adminCustomerLinkRequestService.ApproveInterface) *Approve {
+	return &Approve{
+		service: service,
+	}
+}
+
+func (h *Approve) Approve(c *gin.Context) {
+	linkRequestIDStr := c.Param("linkRequestId")
+	if linkRequestIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"linkRequestId": "linkRequestId 為必填項目",
+		})
+		return
+	}
+	linkRequestID, err := utils.ParseID(linkRequestIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"linkRequestId": "linkRequestId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerLinkRequestModel.ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim note
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	staff, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Approve(c.Request.Context(), linkRequestID, req, staff.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,82 @@
+package adminCustomerMerge
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerMergeModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerMergeService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCustomerMergeService.CreateInterface
+}
+
+func NewCreate(service adminCustomerMergeService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerMergeModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim note
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	parsedMergedCustomerID, err := utils.ParseID(req.MergedCustomerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"mergedCustomerId": "mergedCustomerId 類型轉換失敗",
+		})
+		return
+	}
+
+	parsedReq := adminCustomerMergeModel.CreateParsedRequest{
+		MergedCustomerID: parsedMergedCustomerID,
+		Note:             req.Note,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedCustomerID, parsedReq, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,86 @@
+package adminCustomerNote
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerNoteService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCustomerNoteService.CreateInterface
+}
+
+func NewCreate(service adminCustomerNoteService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	customerIDStr := c.Param("customerId")
+	if customerIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	customerID, err := utils.ParseID(customerIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerNoteModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var parsedBookingID *int64
+	if req.BookingID != nil {
+		bookingID, err := utils.ParseID(*req.BookingID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"bookingId": "bookingId 類型轉換失敗",
+			})
+			return
+		}
+		parsedBookingID = &bookingID
+	}
+
+	isPinned := false
+	if req.IsPinned != nil {
+		isPinned = *req.IsPinned
+	}
+
+	parsedReq := adminCustomerNoteModel.CreateParsedRequest{
+		Content:   strings.TrimSpace(req.Content),
+		BookingID: parsedBookingID,
+		IsPinned:  isPinned,
+	}
+
+	staff, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), customerID, parsedReq, staff.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,69 @@
+package adminCustomerNote
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerNoteService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Delete struct {
+	service adminCustomerNoteService.DeleteInterface
+}
+
+func NewDelete(service adminCustomerNoteService.DeleteInterface) *Delete {
+	return &Delete{
+		service: service,
+	}
+}
+
+func (h *Delete) Delete(c *gin.Context) {
+	customerIDStr := c.Param("customerId")
+	if customerIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	customerID, err := utils.ParseID(customerIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	noteIDStr := c.Param("noteId")
+	if noteIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"noteId": "noteId 為必填項目",
+		})
+		return
+	}
+	noteID, err := utils.ParseID(noteIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"noteId": "noteId 類型轉換失敗",
+		})
+		return
+	}
+
+	staff, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Delete(c.Request.Context(), customerID, noteID, staff.UserID, staff.Role)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,62 @@
+package adminCustomerNote
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerNoteService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCustomerNoteService.GetAllInterface
+}
+
+func NewGetAll(service adminCustomerNoteService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	customerIDStr := c.Param("customerId")
+	if customerIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	customerID, err := utils.ParseID(customerIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerNoteModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+
+	parsedReq := adminCustomerNoteModel.GetAllParsedRequest{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), customerID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,87 @@
+package adminCustomerNote
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerNoteService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminCustomerNoteService.UpdateInterface
+}
+
+func NewUpdate(service adminCustomerNoteService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	customerIDStr := c.Param("customerId")
+	if customerIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	customerID, err := utils.ParseID(customerIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	noteIDStr := c.Param("noteId")
+	if noteIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"noteId": "noteId 為必填項目",
+		})
+		return
+	}
+	noteID, err := utils.ParseID(noteIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"noteId": "noteId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerNoteModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	if !req.HasUpdates() {
+		errorCodes.AbortWithError(c, errorCodes.ValAllFieldsEmpty, nil)
+		return
+	}
+
+	if req.Content != nil {
+		*req.Content = strings.TrimSpace(*req.Content)
+	}
+
+	staff, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Update(c.Request.Context(), customerID, noteID, req, staff.UserID, staff.Role)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,67 @@
+package adminCustomerPoint
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerPointModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_point"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerPointService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_point"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAllTransactions struct {
+	service adminCustomerPointService.GetAllTransactionsInterface
+}
+
+func NewGetAllTransactions(service adminCustomerPointService.GetAllTransactionsInterface) *GetAllTransactions {
+	return &GetAllTransactions{
+		service: service,
+	}
+}
+
+func (h *GetAllTransactions) GetAllTransactions(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse query parameters
+	var req adminCustomerPointModel.GetAllTransactionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCustomerPointModel.GetAllTransactionsParsedRequest{
+		Type:   req.Type,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetAllTransactions(c.Request.Context(), parsedCustomerID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
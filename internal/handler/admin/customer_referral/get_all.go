@@ -0,0 +1,67 @@
+package adminCustomerReferral
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerReferralModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_referral"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerReferralService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_referral"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCustomerReferralService.GetAllInterface
+}
+
+func NewGetAll(service adminCustomerReferralService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse query parameters
+	var req adminCustomerReferralModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCustomerReferralModel.GetAllParsedRequest{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedCustomerID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
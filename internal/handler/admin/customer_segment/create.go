@@ -0,0 +1,55 @@
+package adminCustomerSegment
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerSegmentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminCustomerSegmentService.CreateInterface
+}
+
+func NewCreate(service adminCustomerSegmentService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminCustomerSegmentModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name, description
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Description != nil {
+		*req.Description = strings.TrimSpace(*req.Description)
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), req, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
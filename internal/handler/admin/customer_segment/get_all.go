@@ -0,0 +1,56 @@
+package adminCustomerSegment
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerSegmentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminCustomerSegmentService.GetAllInterface
+}
+
+func NewGetAll(service adminCustomerSegmentService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	var req adminCustomerSegmentModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name
+	if req.Name != nil {
+		*req.Name = strings.TrimSpace(*req.Name)
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCustomerSegmentModel.GetAllParsedRequest{
+		Name:   req.Name,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
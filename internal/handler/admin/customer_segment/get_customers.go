@@ -0,0 +1,64 @@
+package adminCustomerSegment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerSegmentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetCustomers struct {
+	service adminCustomerSegmentService.GetCustomersInterface
+}
+
+func NewGetCustomers(service adminCustomerSegmentService.GetCustomersInterface) *GetCustomers {
+	return &GetCustomers{
+		service: service,
+	}
+}
+
+func (h *GetCustomers) GetCustomers(c *gin.Context) {
+	segmentID := c.Param("segmentId")
+	if segmentID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"segmentId": "segmentId 為必填項目",
+		})
+		return
+	}
+	parsedSegmentID, err := utils.ParseID(segmentID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"segmentId": "segmentId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerSegmentModel.GetCustomersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminCustomerSegmentModel.GetCustomersParsedRequest{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetCustomers(c.Request.Context(), parsedSegmentID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
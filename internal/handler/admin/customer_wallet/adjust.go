@@ -0,0 +1,83 @@
+package adminCustomerWallet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Adjust struct {
+	service adminCustomerWalletService.AdjustInterface
+}
+
+func NewAdjust(service adminCustomerWalletService.AdjustInterface) *Adjust {
+	return &Adjust{
+		service: service,
+	}
+}
+
+func (h *Adjust) Adjust(c *gin.Context) {
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerWalletModel.AdjustRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	storeIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		storeIDs[i] = store.ID
+	}
+
+	response, err := h.service.Adjust(c.Request.Context(), parsedStoreID, parsedCustomerID, req, staffContext.UserID, staffContext.Role, storeIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,47 @@
+package adminCustomerWallet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	service adminCustomerWalletService.GetInterface
+}
+
+func NewGet(service adminCustomerWalletService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	response, err := h.service.Get(c.Request.Context(), parsedCustomerID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
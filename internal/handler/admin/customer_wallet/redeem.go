@@ -0,0 +1,63 @@
+package adminCustomerWallet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminCustomerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Redeem struct {
+	service adminCustomerWalletService.RedeemInterface
+}
+
+func NewRedeem(service adminCustomerWalletService.RedeemInterface) *Redeem {
+	return &Redeem{
+		service: service,
+	}
+}
+
+func (h *Redeem) Redeem(c *gin.Context) {
+	customerID := c.Param("customerId")
+	if customerID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"customerId": "customerId 為必填項目",
+		})
+		return
+	}
+	parsedCustomerID, err := utils.ParseID(customerID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"customerId": "customerId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminCustomerWalletModel.RedeemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Redeem(c.Request.Context(), parsedCustomerID, req, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,87 @@
+package adminGiftCard
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminGiftCardModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/gift_card"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminGiftCardService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/gift_card"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminGiftCardService.CreateInterface
+}
+
+func NewCreate(service adminGiftCardService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminGiftCardModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil && *req.ExpiresAt != "" {
+		parsedExpiresAt, err := utils.DateStringToTime(*req.ExpiresAt)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"expiresAt": "expiresAt 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		expiresAt = &parsedExpiresAt
+	}
+
+	parsedReq := adminGiftCardModel.CreateParsedRequest{
+		Amount:    req.Amount,
+		ExpiresAt: expiresAt,
+		Note:      req.Note,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	storeIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		storeIDs[i] = store.ID
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedStoreID, parsedReq, staffContext.UserID, staffContext.Role, storeIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
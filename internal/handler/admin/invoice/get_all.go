@@ -0,0 +1,97 @@
+package adminInvoice
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminInvoiceModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminInvoiceService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminInvoiceService.GetAllInterface
+}
+
+func NewGetAll(service adminInvoiceService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse query parameters
+	var req adminInvoiceModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	var checkoutID *int64
+	if req.CheckoutID != nil && *req.CheckoutID != "" {
+		parsedCheckoutID, err := utils.ParseID(*req.CheckoutID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"checkoutId": "checkoutId 類型轉換失敗",
+			})
+			return
+		}
+		checkoutID = &parsedCheckoutID
+	}
+
+	parsedReq := adminInvoiceModel.GetAllParsedRequest{
+		Status:        req.Status,
+		InvoiceNumber: req.InvoiceNumber,
+		CheckoutID:    checkoutID,
+		Limit:         limit,
+		Offset:        offset,
+		Sort:          sort,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	storeIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		storeIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.GetAll(c.Request.Context(), parsedStoreID, parsedReq, staffContext.Role, storeIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
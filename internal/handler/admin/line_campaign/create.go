@@ -0,0 +1,97 @@
+package adminLineCampaign
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminLineCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminLineCampaignService.CreateInterface
+}
+
+func NewCreate(service adminLineCampaignService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminLineCampaignModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name, text, altText
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Text != nil {
+		*req.Text = strings.TrimSpace(*req.Text)
+	}
+	if req.AltText != nil {
+		*req.AltText = strings.TrimSpace(*req.AltText)
+	}
+
+	// the content of the message type is required
+	if req.MessageType == common.LineCampaignMessageTypeText && (req.Text == nil || *req.Text == "") {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldRequired, map[string]string{
+			"text": "text 為必填項目",
+		})
+		return
+	}
+	if req.MessageType == common.LineCampaignMessageTypeFlex {
+		if req.AltText == nil || *req.AltText == "" {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldRequired, map[string]string{
+				"altText": "altText 為必填項目",
+			})
+			return
+		}
+		if len(req.FlexContents) == 0 {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldRequired, map[string]string{
+				"flexContents": "flexContents 為必填項目",
+			})
+			return
+		}
+	}
+
+	segmentID, err := utils.ParseID(req.SegmentID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"segmentId": "segmentId 類型轉換失敗",
+		})
+		return
+	}
+
+	parsedReq := adminLineCampaignModel.CreateParsedRequest{
+		Name:         req.Name,
+		SegmentID:    segmentID,
+		MessageType:  req.MessageType,
+		Text:         req.Text,
+		AltText:      req.AltText,
+		FlexContents: req.FlexContents,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedReq, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
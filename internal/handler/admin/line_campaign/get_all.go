@@ -0,0 +1,63 @@
+package adminLineCampaign
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminLineCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminLineCampaignService.GetAllInterface
+}
+
+func NewGetAll(service adminLineCampaignService.GetAllInterface) *GetAll {
+	return &GetAll{
+		service: service,
+	}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	// Parse query parameters
+	var req adminLineCampaignModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminLineCampaignModel.GetAllParsedRequest{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	if req.SegmentID != nil && *req.SegmentID != "" {
+		segmentID, err := utils.ParseID(*req.SegmentID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"segmentId": "segmentId 類型轉換失敗",
+			})
+			return
+		}
+		parsedReq.SegmentID = &segmentID
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
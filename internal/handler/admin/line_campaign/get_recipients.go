@@ -0,0 +1,63 @@
+package adminLineCampaign
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminLineCampaignService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetRecipients struct {
+	service adminLineCampaignService.GetRecipientsInterface
+}
+
+func NewGetRecipients(service adminLineCampaignService.GetRecipientsInterface) *GetRecipients {
+	return &GetRecipients{
+		service: service,
+	}
+}
+
+func (h *GetRecipients) GetRecipients(c *gin.Context) {
+	campaignID := c.Param("campaignId")
+	if campaignID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"campaignId": "campaignId 為必填項目",
+		})
+		return
+	}
+	parsedCampaignID, err := utils.ParseID(campaignID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"campaignId": "campaignId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminLineCampaignModel.GetRecipientsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+
+	parsedReq := adminLineCampaignModel.GetRecipientsParsedRequest{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	response, err := h.service.GetRecipients(c.Request.Context(), parsedCampaignID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,157 @@
+package adminPromotion
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminPromotionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminPromotionService.CreateInterface
+}
+
+func NewCreate(service adminPromotionService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminPromotionModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name, note
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	parsedReq := adminPromotionModel.CreateParsedRequest{
+		Name:           req.Name,
+		DiscountRate:   req.DiscountRate,
+		DiscountAmount: req.DiscountAmount,
+		ServiceScope:   common.CouponServiceScopeAll,
+		ServiceIds:     []int64{},
+		Note:           req.Note,
+	}
+	if req.ServiceScope != nil {
+		parsedReq.ServiceScope = *req.ServiceScope
+	}
+	if req.StackWithCoupon != nil {
+		parsedReq.StackWithCoupon = *req.StackWithCoupon
+	}
+	if req.Weekdays != nil {
+		parsedReq.Weekdays = uniqueWeekdays(*req.Weekdays)
+	}
+
+	if req.StoreID != nil && *req.StoreID != "" {
+		storeID, err := utils.ParseID(*req.StoreID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"storeId": "storeId 類型轉換失敗",
+			})
+			return
+		}
+		parsedReq.StoreID = &storeID
+	}
+
+	startDate, err := utils.DateStringToTime(req.StartDate)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+			"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+		})
+		return
+	}
+	parsedReq.StartDate = startDate
+
+	endDate, err := utils.DateStringToTime(req.EndDate)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+			"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+		})
+		return
+	}
+	parsedReq.EndDate = endDate
+
+	if req.StartTime != nil && *req.StartTime != "" {
+		startTime, err := utils.TimeStringToTime(*req.StartTime)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldTimeFormat, map[string]string{
+				"startTime": "startTime 時間格式錯誤，應為 HH:mm",
+			})
+			return
+		}
+		parsedReq.StartTime = &startTime
+	}
+	if req.EndTime != nil && *req.EndTime != "" {
+		endTime, err := utils.TimeStringToTime(*req.EndTime)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldTimeFormat, map[string]string{
+				"endTime": "endTime 時間格式錯誤，應為 HH:mm",
+			})
+			return
+		}
+		parsedReq.EndTime = &endTime
+	}
+
+	// parse service ids and skip duplicates
+	if req.ServiceIds != nil {
+		seen := make(map[int64]bool)
+		for _, serviceID := range *req.ServiceIds {
+			parsedServiceID, err := utils.ParseID(serviceID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					"serviceIds": "serviceIds 類型轉換失敗",
+				})
+				return
+			}
+			if seen[parsedServiceID] {
+				continue
+			}
+			seen[parsedServiceID] = true
+			parsedReq.ServiceIds = append(parsedReq.ServiceIds, parsedServiceID)
+		}
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedReq, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
+
+// uniqueWeekdays skips duplicate weekdays
+func uniqueWeekdays(weekdays []int32) []int32 {
+	result := []int32{}
+	seen := make(map[int32]bool, len(weekdays))
+	for _, weekday := range weekdays {
+		if seen[weekday] {
+			continue
+		}
+		seen[weekday] = true
+		result = append(result, weekday)
+	}
+
+	return result
+}
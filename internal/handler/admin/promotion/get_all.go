@@ -0,0 +1,66 @@
+package adminPromotion
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminPromotionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	service adminPromotionService.GetAllInterface
+}
+
+func NewGetAll(service adminPromotionService.GetAllInterface) *GetAll {
+	return &GetAll{service: service}
+}
+
+func (h *GetAll) GetAll(c *gin.Context) {
+	var req adminPromotionModel.GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim name
+	if req.Name != nil {
+		*req.Name = strings.TrimSpace(*req.Name)
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := adminPromotionModel.GetAllParsedRequest{
+		Name:     req.Name,
+		IsActive: req.IsActive,
+		Limit:    limit,
+		Offset:   offset,
+		Sort:     sort,
+	}
+
+	if req.StoreID != nil && *req.StoreID != "" {
+		storeID, err := utils.ParseID(*req.StoreID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"storeId": "storeId 類型轉換失敗",
+			})
+			return
+		}
+		parsedReq.StoreID = &storeID
+	}
+
+	response, err := h.service.GetAll(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
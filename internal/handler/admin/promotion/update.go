@@ -0,0 +1,168 @@
+package adminPromotion
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminPromotionService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminPromotionService.UpdateInterface
+}
+
+func NewUpdate(service adminPromotionService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	promotionIDStr := c.Param("promotionId")
+	if promotionIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"promotionId": "promotionId 為必填項目",
+		})
+		return
+	}
+
+	promotionID, err := utils.ParseID(promotionIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"promotionId": "promotionId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminPromotionModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	if !req.HasUpdates() {
+		errorCodes.AbortWithError(c, errorCodes.ValAllFieldsEmpty, nil)
+		return
+	}
+
+	// trim name, note
+	if req.Name != nil {
+		*req.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	parsedReq := adminPromotionModel.UpdateParsedRequest{
+		Name:            req.Name,
+		ServiceScope:    req.ServiceScope,
+		StackWithCoupon: req.StackWithCoupon,
+		IsActive:        req.IsActive,
+		Note:            req.Note,
+	}
+	if req.Weekdays != nil {
+		weekdays := uniqueWeekdays(*req.Weekdays)
+		parsedReq.Weekdays = &weekdays
+	}
+
+	// empty store id applies the promotion to all stores
+	if req.StoreID != nil {
+		storeID := int64(0)
+		if *req.StoreID != "" {
+			storeID, err = utils.ParseID(*req.StoreID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					"storeId": "storeId 類型轉換失敗",
+				})
+				return
+			}
+		}
+		parsedReq.StoreID = &storeID
+	}
+
+	if req.StartDate != nil {
+		startDate, err := utils.DateStringToTime(*req.StartDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate, err := utils.DateStringToTime(*req.EndDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		parsedReq.EndDate = &endDate
+	}
+
+	// empty time clears the time limit
+	if req.StartTime != nil {
+		startTime := time.Time{}
+		if *req.StartTime != "" {
+			startTime, err = utils.TimeStringToTime(*req.StartTime)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValFieldTimeFormat, map[string]string{
+					"startTime": "startTime 時間格式錯誤，應為 HH:mm",
+				})
+				return
+			}
+		}
+		parsedReq.StartTime = &startTime
+	}
+	if req.EndTime != nil {
+		endTime := time.Time{}
+		if *req.EndTime != "" {
+			endTime, err = utils.TimeStringToTime(*req.EndTime)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValFieldTimeFormat, map[string]string{
+					"endTime": "endTime 時間格式錯誤，應為 HH:mm",
+				})
+				return
+			}
+		}
+		parsedReq.EndTime = &endTime
+	}
+
+	// parse service ids and skip duplicates, empty array clears the eligible services
+	if req.ServiceIds != nil {
+		serviceIDs := []int64{}
+		seen := make(map[int64]bool)
+		for _, serviceID := range *req.ServiceIds {
+			parsedServiceID, err := utils.ParseID(serviceID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					"serviceIds": "serviceIds 類型轉換失敗",
+				})
+				return
+			}
+			if seen[parsedServiceID] {
+				continue
+			}
+			seen[parsedServiceID] = true
+			serviceIDs = append(serviceIDs, parsedServiceID)
+		}
+		parsedReq.ServiceIds = &serviceIDs
+	}
+
+	response, err := h.service.Update(c.Request.Context(), promotionID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,31 @@
+package adminReferralSetting
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminReferralSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/referral_setting"
+)
+
+type Get struct {
+	service adminReferralSettingService.GetInterface
+}
+
+func NewGet(service adminReferralSettingService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	response, err := h.service.Get(c.Request.Context())
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
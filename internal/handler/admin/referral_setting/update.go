@@ -0,0 +1,74 @@
+package adminReferralSetting
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReferralSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/referral_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminReferralSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/referral_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminReferralSettingService.UpdateInterface
+}
+
+func NewUpdate(service adminReferralSettingService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Parse and validate request
+	var req adminReferralSettingModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var referrerCouponID *int64
+	if req.ReferrerCouponID != nil && *req.ReferrerCouponID != "" {
+		parsedCouponID, err := utils.ParseID(*req.ReferrerCouponID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"referrerCouponId": "referrerCouponId 類型轉換失敗",
+			})
+			return
+		}
+		referrerCouponID = &parsedCouponID
+	}
+
+	var refereeCouponID *int64
+	if req.RefereeCouponID != nil && *req.RefereeCouponID != "" {
+		parsedCouponID, err := utils.ParseID(*req.RefereeCouponID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"refereeCouponId": "refereeCouponId 類型轉換失敗",
+			})
+			return
+		}
+		refereeCouponID = &parsedCouponID
+	}
+
+	parsedReq := adminReferralSettingModel.UpdateParsedRequest{
+		IsEnabled:         *req.IsEnabled,
+		ReferrerCouponID:  referrerCouponID,
+		RefereeCouponID:   refereeCouponID,
+		CouponValidMonths: req.CouponValidMonths,
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
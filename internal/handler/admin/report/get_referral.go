@@ -0,0 +1,77 @@
+package adminReport
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/report"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminReportService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/report"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetReferral struct {
+	service adminReportService.GetReferralInterface
+}
+
+func NewGetReferral(service adminReportService.GetReferralInterface) *GetReferral {
+	return &GetReferral{
+		service: service,
+	}
+}
+
+func (h *GetReferral) GetReferral(c *gin.Context) {
+	var req adminReportModel.GetReferralRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// Set default values
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	var startDate *time.Time
+	if req.StartDate != nil {
+		parsedStartDate, err := utils.DateStringToTime(*req.StartDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"startDate": "startDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		startDate = &parsedStartDate
+	}
+
+	var endDate *time.Time
+	if req.EndDate != nil {
+		parsedEndDate, err := utils.DateStringToTime(*req.EndDate)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+				"endDate": "endDate 日期格式錯誤，應為 YYYY-MM-DD",
+			})
+			return
+		}
+		endDate = &parsedEndDate
+	}
+
+	parsedReq := adminReportModel.GetReferralParsedRequest{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Limit:     limit,
+		Offset:    offset,
+		Sort:      sort,
+	}
+
+	response, err := h.service.GetReferral(c.Request.Context(), parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,107 @@
+package adminStoreAccountMapping
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminStoreAccountMappingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_account_mapping"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminStoreAccountMappingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_account_mapping"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminStoreAccountMappingService.UpdateInterface
+}
+
+func NewUpdate(service adminStoreAccountMappingService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req adminStoreAccountMappingModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	parsedItems := make([]adminStoreAccountMappingModel.UpdateParsedItem, len(req.Items))
+	for i, item := range req.Items {
+		accountID, err := utils.ParseID(item.AccountID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				fmt.Sprintf("items[%d].accountId", i): "accountId 類型轉換失敗",
+			})
+			return
+		}
+
+		var payerID *int64
+		if item.PayerID != nil && *item.PayerID != "" {
+			parsedPayerID, err := utils.ParseID(*item.PayerID)
+			if err != nil {
+				errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+					fmt.Sprintf("items[%d].payerId", i): "payerId 類型轉換失敗",
+				})
+				return
+			}
+			payerID = &parsedPayerID
+		}
+
+		parsedItems[i] = adminStoreAccountMappingModel.UpdateParsedItem{
+			MappingType:   item.MappingType,
+			PaymentMethod: item.PaymentMethod,
+			PayerID:       payerID,
+			AccountID:     accountID,
+		}
+	}
+
+	parsedReq := adminStoreAccountMappingModel.UpdateParsedRequest{
+		Items: parsedItems,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), parsedStoreID, parsedReq, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,97 @@
+package adminStoreWinBackSetting
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminStoreWinBackSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_win_back_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminStoreWinBackSettingService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/store_win_back_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminStoreWinBackSettingService.UpdateInterface
+}
+
+func NewUpdate(service adminStoreWinBackSettingService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	// Get store ID from path parameter
+	storeID := c.Param("storeId")
+	if storeID == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"storeId": "storeId 為必填項目",
+		})
+		return
+	}
+	parsedStoreID, err := utils.ParseID(storeID)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"storeId": "storeId 類型轉換失敗",
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req adminStoreWinBackSettingModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	var couponID *int64
+	if req.CouponID != nil && *req.CouponID != "" {
+		parsedCouponID, err := utils.ParseID(*req.CouponID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"couponId": "couponId 類型轉換失敗",
+			})
+			return
+		}
+		couponID = &parsedCouponID
+	}
+
+	if req.LineMessage != nil {
+		*req.LineMessage = strings.TrimSpace(*req.LineMessage)
+	}
+
+	parsedReq := adminStoreWinBackSettingModel.UpdateParsedRequest{
+		IsEnabled:       *req.IsEnabled,
+		InactiveDays:    req.InactiveDays,
+		CouponID:        couponID,
+		CouponValidDays: req.CouponValidDays,
+		LineMessage:     req.LineMessage,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	creatorStoreIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		creatorStoreIDs[i] = store.ID
+	}
+
+	// Call service
+	response, err := h.service.Update(c.Request.Context(), parsedStoreID, parsedReq, staffContext.Role, creatorStoreIDs)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,74 @@
+package adminTermsDocument
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminTermsDocumentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	service adminTermsDocumentService.CreateInterface
+}
+
+func NewCreate(service adminTermsDocumentService.CreateInterface) *Create {
+	return &Create{
+		service: service,
+	}
+}
+
+func (h *Create) Create(c *gin.Context) {
+	var req adminTermsDocumentModel.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim version, title
+	req.Version = strings.TrimSpace(req.Version)
+	req.Title = strings.TrimSpace(req.Title)
+
+	parsedEffectiveDate, err := utils.DateStringToTime(req.EffectiveDate)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValFieldDateFormat, map[string]string{
+			"effectiveDate": "effectiveDate 日期格式錯誤，應為 YYYY-MM-DD",
+		})
+		return
+	}
+
+	isRequired := true
+	if req.IsRequired != nil {
+		isRequired = *req.IsRequired
+	}
+
+	parsedReq := adminTermsDocumentModel.CreateParsedRequest{
+		Version:       req.Version,
+		Title:         req.Title,
+		Content:       req.Content,
+		EffectiveDate: parsedEffectiveDate,
+		IsRequired:    isRequired,
+	}
+
+	// Get staff context from middleware
+	staffContext, exists := middleware.GetStaffFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Create(c.Request.Context(), parsedReq, staffContext.UserID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
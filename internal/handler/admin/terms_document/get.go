@@ -0,0 +1,47 @@
+package adminTermsDocument
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminTermsDocumentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	service adminTermsDocumentService.GetInterface
+}
+
+func NewGet(service adminTermsDocumentService.GetInterface) *Get {
+	return &Get{
+		service: service,
+	}
+}
+
+func (h *Get) Get(c *gin.Context) {
+	termsDocumentIDStr := c.Param("termsDocumentId")
+	if termsDocumentIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"termsDocumentId": "termsDocumentId 是必填項目",
+		})
+		return
+	}
+	termsDocumentID, err := utils.ParseID(termsDocumentIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"termsDocumentId": "termsDocumentId 類型轉換失敗",
+		})
+		return
+	}
+
+	response, err := h.service.Get(c.Request.Context(), termsDocumentID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
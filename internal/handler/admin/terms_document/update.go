@@ -0,0 +1,66 @@
+package adminTermsDocument
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	adminTermsDocumentService "github.com/tkoleo84119/nail-salon-backend/internal/service/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	service adminTermsDocumentService.UpdateInterface
+}
+
+func NewUpdate(service adminTermsDocumentService.UpdateInterface) *Update {
+	return &Update{
+		service: service,
+	}
+}
+
+func (h *Update) Update(c *gin.Context) {
+	termsDocumentIDStr := c.Param("termsDocumentId")
+	if termsDocumentIDStr == "" {
+		errorCodes.AbortWithError(c, errorCodes.ValPathParamMissing, map[string]string{
+			"termsDocumentId": "termsDocumentId 是必填項目",
+		})
+		return
+	}
+	termsDocumentID, err := utils.ParseID(termsDocumentIDStr)
+	if err != nil {
+		errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+			"termsDocumentId": "termsDocumentId 類型轉換失敗",
+		})
+		return
+	}
+
+	var req adminTermsDocumentModel.UpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	if !req.HasUpdates() {
+		errorCodes.AbortWithError(c, errorCodes.ValAllFieldsEmpty, nil)
+		return
+	}
+
+	// trim title
+	if req.Title != nil {
+		*req.Title = strings.TrimSpace(*req.Title)
+	}
+
+	response, err := h.service.Update(c.Request.Context(), termsDocumentID, req)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
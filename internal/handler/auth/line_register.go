@@ -59,6 +59,9 @@ func (h *LineRegister) LineRegister(c *gin.Context) {
 	if req.Referrer != nil {
 		*req.Referrer = strings.TrimSpace(*req.Referrer)
 	}
+	if req.ReferralCode != nil {
+		*req.ReferralCode = strings.ToUpper(strings.TrimSpace(*req.ReferralCode))
+	}
 	if req.CustomerNote != nil {
 		*req.CustomerNote = strings.TrimSpace(*req.CustomerNote)
 	}
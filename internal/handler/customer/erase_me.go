@@ -0,0 +1,59 @@
+package customer
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer"
+	customerService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type EraseMe struct {
+	service customerService.EraseMeInterface
+	cfg     *config.Config
+}
+
+func NewEraseMe(service customerService.EraseMeInterface, cfg *config.Config) *EraseMe {
+	return &EraseMe{
+		service: service,
+		cfg:     cfg,
+	}
+}
+
+func (h *EraseMe) EraseMe(c *gin.Context) {
+	var req customerModel.EraseMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim note
+	if req.Note != nil {
+		*req.Note = strings.TrimSpace(*req.Note)
+	}
+
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.EraseMe(c.Request.Context(), customerContext.CustomerID, req)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	// the refresh tokens are deleted with the personal data
+	utils.ClearCustomerRefreshCookie(c, h.cfg.Cookie)
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,38 @@
+package customer
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer"
+)
+
+type ExportMe struct {
+	service customerService.ExportMeInterface
+}
+
+func NewExportMe(service customerService.ExportMeInterface) *ExportMe {
+	return &ExportMe{
+		service: service,
+	}
+}
+
+func (h *ExportMe) ExportMe(c *gin.Context) {
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.ExportMe(c.Request.Context(), customerContext.CustomerID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -46,6 +46,16 @@ func (h *GetAll) GetAll(c *gin.Context) {
 		Offset: offset,
 		Sort:   sort,
 	}
+	if req.BookingID != nil && *req.BookingID != "" {
+		bookingID, err := utils.ParseID(*req.BookingID)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.ValTypeConversionFailed, map[string]string{
+				"bookingId": "bookingId 類型轉換失敗",
+			})
+			return
+		}
+		parsedReq.BookingID = &bookingID
+	}
 
 	resp, err := h.service.GetAll(c.Request.Context(), customerContext.CustomerID, parsedReq)
 	if err != nil {
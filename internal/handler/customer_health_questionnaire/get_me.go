@@ -0,0 +1,38 @@
+package customerHealthQuestionnaire
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerHealthQuestionnaireService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_health_questionnaire"
+)
+
+type GetMe struct {
+	service customerHealthQuestionnaireService.GetMeInterface
+}
+
+func NewGetMe(service customerHealthQuestionnaireService.GetMeInterface) *GetMe {
+	return &GetMe{
+		service: service,
+	}
+}
+
+func (h *GetMe) GetMe(c *gin.Context) {
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.GetMe(c.Request.Context(), customerContext.CustomerID)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,56 @@
+package customerHealthQuestionnaire
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_health_questionnaire"
+	customerHealthQuestionnaireService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type SubmitMe struct {
+	service customerHealthQuestionnaireService.SubmitMeInterface
+}
+
+func NewSubmitMe(service customerHealthQuestionnaireService.SubmitMeInterface) *SubmitMe {
+	return &SubmitMe{
+		service: service,
+	}
+}
+
+func (h *SubmitMe) SubmitMe(c *gin.Context) {
+	var req customerHealthQuestionnaireModel.SubmitMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	// trim notes, blank notes are not stored
+	req.Version = strings.TrimSpace(req.Version)
+	for _, note := range []*string{req.AllergyNote, req.SkinConditionNote, req.OtherNote} {
+		if note != nil {
+			*note = strings.TrimSpace(*note)
+		}
+	}
+
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.SubmitMe(c.Request.Context(), customerContext.CustomerID, req)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.SuccessResponse(response))
+}
@@ -0,0 +1,57 @@
+package customerWallet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+	customerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetMyTransactions struct {
+	service customerWalletService.GetMyTransactionsInterface
+}
+
+func NewGetMyTransactions(service customerWalletService.GetMyTransactionsInterface) *GetMyTransactions {
+	return &GetMyTransactions{
+		service: service,
+	}
+}
+
+func (h *GetMyTransactions) GetMyTransactions(c *gin.Context) {
+	var req customerWalletModel.GetMyTransactionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(req.Limit, req.Offset, 20, 0)
+	sort := utils.TransformSort(req.Sort)
+
+	parsedReq := customerWalletModel.GetMyTransactionsParsedRequest{
+		Type:   req.Type,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+
+	response, err := h.service.GetMyTransactions(c.Request.Context(), customerContext.CustomerID, parsedReq)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,47 @@
+package customerWallet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/middleware"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+	customerWalletService "github.com/tkoleo84119/nail-salon-backend/internal/service/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Redeem struct {
+	service customerWalletService.RedeemInterface
+}
+
+func NewRedeem(service customerWalletService.RedeemInterface) *Redeem {
+	return &Redeem{
+		service: service,
+	}
+}
+
+func (h *Redeem) Redeem(c *gin.Context) {
+	var req customerWalletModel.RedeemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := utils.ExtractValidationErrors(err)
+		errorCodes.RespondWithValidationErrors(c, validationErrors)
+		return
+	}
+
+	customerContext, exists := middleware.GetCustomerFromContext(c)
+	if !exists {
+		errorCodes.AbortWithError(c, errorCodes.AuthContextMissing, nil)
+		return
+	}
+
+	response, err := h.service.Redeem(c.Request.Context(), customerContext.CustomerID, req)
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
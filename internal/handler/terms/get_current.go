@@ -0,0 +1,31 @@
+package terms
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	termsService "github.com/tkoleo84119/nail-salon-backend/internal/service/terms"
+)
+
+type GetCurrent struct {
+	service termsService.GetCurrentInterface
+}
+
+func NewGetCurrent(service termsService.GetCurrentInterface) *GetCurrent {
+	return &GetCurrent{
+		service: service,
+	}
+}
+
+func (h *GetCurrent) GetCurrent(c *gin.Context) {
+	response, err := h.service.GetCurrent(c.Request.Context())
+	if err != nil {
+		errorCodes.RespondWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.SuccessResponse(response))
+}
@@ -0,0 +1,256 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const (
+	BirthdayBenefitJobLockKey = "birthday_benefit_job_lock"
+	BirthdayBenefitLockTTL    = 30 * time.Minute
+	BirthdayBenefitBatchSize  = 200
+)
+
+type BirthdayBenefitJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	db             *pgxpool.Pool
+	redisClient    *redis.Client
+	lineMessenger  *utils.LineMessageClient
+	activityLog    cache.ActivityLogCacheInterface
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewBirthdayBenefitJob(cfg *config.Config, queries *dbgen.Queries, db *pgxpool.Pool, redisClient *redis.Client, lineMessenger *utils.LineMessageClient, activityLog cache.ActivityLogCacheInterface) (*BirthdayBenefitJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &BirthdayBenefitJob{
+		cfg:            cfg,
+		queries:        queries,
+		db:             db,
+		redisClient:    redisClient,
+		lineMessenger:  lineMessenger,
+		activityLog:    activityLog,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *BirthdayBenefitJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.BirthdayBenefitCron, j.executeBirthdayBenefitJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule birthday benefit job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Birthday benefit job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.BirthdayBenefitCron)
+
+	return nil
+}
+
+func (j *BirthdayBenefitJob) Stop() {
+	j.cron.Stop()
+	log.Println("Birthday benefit job stopped")
+}
+
+func (j *BirthdayBenefitJob) executeBirthdayBenefitJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, BirthdayBenefitJobLockKey, "locked", BirthdayBenefitLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for birthday benefit job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running birthday benefit job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, BirthdayBenefitJobLockKey); err != nil {
+			log.Printf("Failed to release lock for birthday benefit job: %v", err)
+		}
+	}()
+
+	if err := j.processBirthdayBenefit(ctx); err != nil {
+		log.Printf("failed to process birthday benefit: %v", err)
+		return
+	}
+
+	log.Println("Birthday benefit job execution completed successfully")
+}
+
+// processBirthdayBenefit issues the birthday coupon to the customers whose birthday is in the current month (or today,
+// depending on the issue period) batch by batch, ordered by customer id. Customers who already received the benefit
+// this year are excluded, so the job can run daily without issuing twice.
+func (j *BirthdayBenefitJob) processBirthdayBenefit(ctx context.Context) error {
+	setting, err := j.queries.GetBirthdayBenefitSetting(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if !setting.IsEnabled || !setting.CouponID.Valid {
+		return nil
+	}
+
+	coupons, err := j.queries.GetCouponByIDs(ctx, []int64{setting.CouponID.Int64})
+	if err != nil {
+		return err
+	}
+	if len(coupons) == 0 || !utils.PgBoolToBool(coupons[0].IsActive) {
+		log.Printf("Birthday benefit coupon %d is not active, skipping...", setting.CouponID.Int64)
+		return nil
+	}
+
+	now := time.Now().In(j.taiwanLocation)
+
+	// customers born on Feb 29 receive the benefit on Feb 28 in common years
+	var birthdayDays []int32
+	if setting.IssuePeriod == common.BirthdayBenefitIssuePeriodDay {
+		birthdayDays = []int32{int32(now.Day())}
+		if now.Month() == time.February && now.Day() == 28 && now.AddDate(0, 0, 1).Month() == time.March {
+			birthdayDays = append(birthdayDays, 29)
+		}
+	}
+
+	var lastCustomerID int64
+	issuedCount := 0
+	failedCount := 0
+	for {
+		customers, err := j.queries.GetBirthdayBenefitTargetCustomers(ctx, dbgen.GetBirthdayBenefitTargetCustomersParams{
+			BirthdayMonth:  int32(now.Month()),
+			BirthdayDays:   birthdayDays,
+			Year:           int32(now.Year()),
+			LastCustomerID: lastCustomerID,
+			BatchSize:      BirthdayBenefitBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(customers) == 0 {
+			break
+		}
+
+		for _, customer := range customers {
+			// a failed customer is retried on the next run, it must not block the customers after it
+			validTo, err := j.issueBenefit(ctx, setting, customer.ID, now)
+			if err != nil {
+				log.Printf("failed to issue birthday benefit to customer %d: %v", customer.ID, err)
+				failedCount++
+				continue
+			}
+			if validTo == nil {
+				continue
+			}
+			issuedCount++
+
+			// walk-in customers without LINE only receive the coupon
+			if customer.LineUid == "" {
+				continue
+			}
+			message := buildBirthdayMessage(setting, customer.Name, coupons[0].DisplayName, *validTo)
+			if err := j.lineMessenger.SendTextMessage(customer.LineUid, message); err != nil {
+				log.Printf("failed to send birthday message to customer %d: %v", customer.ID, err)
+			}
+		}
+
+		lastCustomerID = customers[len(customers)-1].ID
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if issuedCount > 0 {
+		if err := j.activityLog.LogBirthdayBenefitIssued(ctx, issuedCount); err != nil {
+			log.Printf("failed to log birthday benefit issued activity: %v", err)
+		}
+	}
+
+	log.Printf("Birthday benefit job issued coupons to %d customers, %d failed", issuedCount, failedCount)
+	return nil
+}
+
+// issueBenefit records the benefit of the year and issues the coupon to the customer in a transaction,
+// and returns the valid to of the issued coupon. Nil is returned when the benefit of the year already exists.
+func (j *BirthdayBenefitJob) issueBenefit(ctx context.Context, setting dbgen.BirthdayBenefitSetting, customerID int64, now time.Time) (*time.Time, error) {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	benefitID, err := qtx.CreateCustomerBirthdayBenefit(ctx, dbgen.CreateCustomerBirthdayBenefitParams{
+		ID:         utils.GenerateID(),
+		CustomerID: customerID,
+		Year:       int32(now.Year()),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	sourceType := common.CustomerCouponSourceBirthday
+	customerCouponID, err := coupon.Issue(ctx, qtx, coupon.IssueParams{
+		CustomerID: customerID,
+		CouponID:   setting.CouponID.Int64,
+		ValidDays:  &setting.CouponValidDays,
+		SourceType: &sourceType,
+		SourceID:   &benefitID,
+		Now:        now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if customerCouponID == nil {
+		return nil, nil
+	}
+
+	if err := qtx.UpdateCustomerBirthdayBenefitCustomerCoupon(ctx, dbgen.UpdateCustomerBirthdayBenefitCustomerCouponParams{
+		ID:               benefitID,
+		CustomerCouponID: utils.Int64PtrToPgInt8(customerCouponID),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return coupon.ValidTo(nil, &setting.CouponValidDays, now), nil
+}
+
+// buildBirthdayMessage returns the birthday message of the setting, a default message is used when not set
+func buildBirthdayMessage(setting dbgen.BirthdayBenefitSetting, customerName, couponName string, validTo time.Time) string {
+	if setting.LineMessage.Valid && setting.LineMessage.String != "" {
+		return setting.LineMessage.String
+	}
+
+	return fmt.Sprintf("%s 生日快樂！送您一張生日禮優惠券「%s」，有效期限至 %s，歡迎預約使用！", customerName, couponName, validTo.Format("2006-01-02"))
+}
@@ -0,0 +1,131 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/campaign"
+)
+
+const (
+	CampaignResumeJobLockKey = "campaign_resume_job_lock"
+	CampaignResumeLockTTL    = 2 * time.Hour
+)
+
+// CampaignResumeJob resumes the campaigns whose background runner stopped with the server,
+// it runs once on start and then on schedule
+type CampaignResumeJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	redisClient    *redis.Client
+	couponRunner   campaign.RunnerInterface
+	lineRunner     campaign.LineRunnerInterface
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewCampaignResumeJob(cfg *config.Config, queries *dbgen.Queries, redisClient *redis.Client, couponRunner campaign.RunnerInterface, lineRunner campaign.LineRunnerInterface) (*CampaignResumeJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &CampaignResumeJob{
+		cfg:            cfg,
+		queries:        queries,
+		redisClient:    redisClient,
+		couponRunner:   couponRunner,
+		lineRunner:     lineRunner,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *CampaignResumeJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.CampaignResumeCron, j.executeCampaignResumeJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule campaign resume job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Campaign resume job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.CampaignResumeCron)
+
+	// campaigns interrupted by the last shutdown are resumed once they become stale
+	go j.executeCampaignResumeJob()
+
+	return nil
+}
+
+func (j *CampaignResumeJob) Stop() {
+	j.cron.Stop()
+	log.Println("Campaign resume job stopped")
+}
+
+func (j *CampaignResumeJob) executeCampaignResumeJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, CampaignResumeJobLockKey, "locked", CampaignResumeLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for campaign resume job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running campaign resume job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, CampaignResumeJobLockKey); err != nil {
+			log.Printf("Failed to release lock for campaign resume job: %v", err)
+		}
+	}()
+
+	staleBefore := pgtype.Timestamptz{Time: time.Now().Add(-campaign.StaleTimeout), Valid: true}
+
+	couponCampaignIDs, err := j.queries.GetStaleCouponCampaignIDs(ctx, staleBefore)
+	if err != nil {
+		log.Printf("failed to get stale coupon campaigns: %v", err)
+		return
+	}
+	resumedCount := j.resumeCampaigns(ctx, "coupon", couponCampaignIDs, j.couponRunner.Resume)
+	log.Printf("Campaign resume job resumed %d coupon campaigns", resumedCount)
+
+	lineCampaignIDs, err := j.queries.GetStaleLineCampaignIDs(ctx, staleBefore)
+	if err != nil {
+		log.Printf("failed to get stale line campaigns: %v", err)
+		return
+	}
+	resumedCount = j.resumeCampaigns(ctx, "line", lineCampaignIDs, j.lineRunner.Resume)
+	log.Printf("Campaign resume job resumed %d line campaigns", resumedCount)
+
+	log.Println("Campaign resume job execution completed successfully")
+}
+
+// resumeCampaigns resumes the PENDING and RUNNING campaigns without progress since campaign.StaleTimeout one by one
+// and returns the resumed count, a campaign claimed by another runner in the meantime is skipped
+func (j *CampaignResumeJob) resumeCampaigns(ctx context.Context, kind string, campaignIDs []int64, resume func(ctx context.Context, campaignID int64) error) int {
+	resumedCount := 0
+	for _, campaignID := range campaignIDs {
+		if err := resume(ctx, campaignID); err != nil {
+			if !errors.Is(err, campaign.ErrStatusNotAllowed) {
+				log.Printf("failed to resume %s campaign %d: %v", kind, campaignID, err)
+			}
+			continue
+		}
+		resumedCount++
+	}
+
+	return resumedCount
+}
@@ -0,0 +1,239 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/level"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const (
+	CustomerLevelJobLockKey = "customer_level_job_lock"
+	CustomerLevelLockTTL    = 30 * time.Minute
+	CustomerLevelBatchSize  = 200
+)
+
+type CustomerLevelJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	db             *pgxpool.Pool
+	redisClient    *redis.Client
+	authCache      cache.AuthCacheInterface
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewCustomerLevelJob(cfg *config.Config, queries *dbgen.Queries, db *pgxpool.Pool, redisClient *redis.Client, authCache cache.AuthCacheInterface) (*CustomerLevelJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &CustomerLevelJob{
+		cfg:            cfg,
+		queries:        queries,
+		db:             db,
+		redisClient:    redisClient,
+		authCache:      authCache,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *CustomerLevelJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.CustomerLevelCron, j.executeCustomerLevelJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule customer level job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Customer level job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.CustomerLevelCron)
+
+	return nil
+}
+
+func (j *CustomerLevelJob) Stop() {
+	j.cron.Stop()
+	log.Println("Customer level job stopped")
+}
+
+func (j *CustomerLevelJob) executeCustomerLevelJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, CustomerLevelJobLockKey, "locked", CustomerLevelLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for customer level job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running customer level job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, CustomerLevelJobLockKey); err != nil {
+			log.Printf("Failed to release lock for customer level job: %v", err)
+		}
+	}()
+
+	if err := j.processCustomerLevel(ctx); err != nil {
+		log.Printf("failed to process customer level: %v", err)
+		return
+	}
+
+	log.Println("Customer level job execution completed successfully")
+}
+
+// processCustomerLevel promotes the customers with checkouts within the longest rule period batch by batch, ordered by customer id,
+// then demotes the customers no longer meeting the rule of their current level
+func (j *CustomerLevelJob) processCustomerLevel(ctx context.Context) error {
+	rules, err := j.queries.GetActiveCustomerLevelRules(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var maxPeriodMonths int32
+	for _, rule := range rules {
+		if rule.PeriodMonths > maxPeriodMonths {
+			maxPeriodMonths = rule.PeriodMonths
+		}
+	}
+
+	now := time.Now().In(j.taiwanLocation)
+	since := now.AddDate(0, -int(maxPeriodMonths), 0)
+
+	var lastCustomerID int64
+	promotedCount := 0
+	for {
+		customerIDs, err := j.queries.GetCustomerIDsWithCheckoutsSince(ctx, dbgen.GetCustomerIDsWithCheckoutsSinceParams{
+			CreatedAt:  utils.TimePtrToPgTimestamptz(&since),
+			CustomerID: lastCustomerID,
+			Limit:      CustomerLevelBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(customerIDs) == 0 {
+			break
+		}
+
+		for _, customerID := range customerIDs {
+			promoted, err := j.promoteCustomer(ctx, customerID, now)
+			if err != nil {
+				return fmt.Errorf("customer %d: %w", customerID, err)
+			}
+			if promoted {
+				promotedCount++
+			}
+		}
+
+		lastCustomerID = customerIDs[len(customerIDs)-1]
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// customers holding a ruled level are checked against it, the ones just promoted still qualify
+	levels := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		levels = append(levels, rule.Level)
+	}
+
+	lastCustomerID = 0
+	demotedCount := 0
+	for {
+		customerIDs, err := j.queries.GetCustomerIDsWithLevels(ctx, dbgen.GetCustomerIDsWithLevelsParams{
+			Levels:     levels,
+			CustomerID: lastCustomerID,
+			Limit:      CustomerLevelBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(customerIDs) == 0 {
+			break
+		}
+
+		for _, customerID := range customerIDs {
+			demoted, err := j.demoteCustomer(ctx, customerID, now)
+			if err != nil {
+				return fmt.Errorf("customer %d: %w", customerID, err)
+			}
+			if demoted {
+				demotedCount++
+			}
+		}
+
+		lastCustomerID = customerIDs[len(customerIDs)-1]
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	log.Printf("Customer level job promoted %d customers, demoted %d customers", promotedCount, demotedCount)
+	return nil
+}
+
+func (j *CustomerLevelJob) promoteCustomer(ctx context.Context, customerID int64, now time.Time) (bool, error) {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := level.PromoteCustomer(ctx, dbgen.New(tx), customerID, now)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	if result != nil {
+		if cacheErr := j.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+			log.Printf("failed to delete customer context from cache: %v", cacheErr)
+		}
+	}
+
+	return result != nil, nil
+}
+
+func (j *CustomerLevelJob) demoteCustomer(ctx context.Context, customerID int64, now time.Time) (bool, error) {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := level.DemoteCustomer(ctx, dbgen.New(tx), customerID, now)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	if result != nil {
+		if cacheErr := j.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+			log.Printf("failed to delete customer context from cache: %v", cacheErr)
+		}
+	}
+
+	return result != nil, nil
+}
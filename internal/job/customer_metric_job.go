@@ -0,0 +1,148 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/metric"
+)
+
+const (
+	CustomerMetricJobLockKey = "customer_metric_job_lock"
+	CustomerMetricLockTTL    = 30 * time.Minute
+	CustomerMetricBatchSize  = 200
+)
+
+type CustomerMetricJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	db             *pgxpool.Pool
+	redisClient    *redis.Client
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewCustomerMetricJob(cfg *config.Config, queries *dbgen.Queries, db *pgxpool.Pool, redisClient *redis.Client) (*CustomerMetricJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &CustomerMetricJob{
+		cfg:            cfg,
+		queries:        queries,
+		db:             db,
+		redisClient:    redisClient,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *CustomerMetricJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.CustomerMetricCron, j.executeCustomerMetricJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule customer metric job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Customer metric job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.CustomerMetricCron)
+
+	return nil
+}
+
+func (j *CustomerMetricJob) Stop() {
+	j.cron.Stop()
+	log.Println("Customer metric job stopped")
+}
+
+func (j *CustomerMetricJob) executeCustomerMetricJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, CustomerMetricJobLockKey, "locked", CustomerMetricLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for customer metric job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running customer metric job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, CustomerMetricJobLockKey); err != nil {
+			log.Printf("Failed to release lock for customer metric job: %v", err)
+		}
+	}()
+
+	if err := j.processCustomerMetrics(ctx); err != nil {
+		log.Printf("failed to process customer metrics: %v", err)
+		return
+	}
+
+	log.Println("Customer metric job execution completed successfully")
+}
+
+// processCustomerMetrics recalculates the metrics of every active customer batch by batch, ordered by customer id
+func (j *CustomerMetricJob) processCustomerMetrics(ctx context.Context) error {
+	now := time.Now().In(j.taiwanLocation)
+
+	var lastCustomerID int64
+	calculatedCount := 0
+	for {
+		sources, err := j.queries.GetCustomerMetricSources(ctx, dbgen.GetCustomerMetricSourcesParams{
+			AfterID:    lastCustomerID,
+			LimitCount: CustomerMetricBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(sources) == 0 {
+			break
+		}
+
+		if err := j.saveCustomerMetrics(ctx, sources, now); err != nil {
+			return err
+		}
+
+		calculatedCount += len(sources)
+		lastCustomerID = sources[len(sources)-1].CustomerID
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	log.Printf("Customer metric job calculated %d customers", calculatedCount)
+	return nil
+}
+
+func (j *CustomerMetricJob) saveCustomerMetrics(ctx context.Context, sources []dbgen.GetCustomerMetricSourcesRow, now time.Time) error {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+	for _, source := range sources {
+		params, err := metric.Calculate(source, now)
+		if err != nil {
+			return fmt.Errorf("customer %d: %w", source.CustomerID, err)
+		}
+
+		if err := qtx.UpsertCustomerMetric(ctx, params); err != nil {
+			return fmt.Errorf("customer %d: %w", source.CustomerID, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,137 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/points"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const (
+	PointExpireJobLockKey = "point_expire_job_lock"
+	PointExpireLockTTL    = 30 * time.Minute
+	PointExpireBatchSize  = 200
+)
+
+type PointExpireJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	db             *pgxpool.Pool
+	redisClient    *redis.Client
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewPointExpireJob(cfg *config.Config, queries *dbgen.Queries, db *pgxpool.Pool, redisClient *redis.Client) (*PointExpireJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &PointExpireJob{
+		cfg:            cfg,
+		queries:        queries,
+		db:             db,
+		redisClient:    redisClient,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *PointExpireJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.PointExpireCron, j.executePointExpireJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule point expire job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Point expire job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.PointExpireCron)
+
+	return nil
+}
+
+func (j *PointExpireJob) Stop() {
+	j.cron.Stop()
+	log.Println("Point expire job stopped")
+}
+
+func (j *PointExpireJob) executePointExpireJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, PointExpireJobLockKey, "locked", PointExpireLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for point expire job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running point expire job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, PointExpireJobLockKey); err != nil {
+			log.Printf("Failed to release lock for point expire job: %v", err)
+		}
+	}()
+
+	if err := j.processPointExpire(ctx); err != nil {
+		log.Printf("failed to process point expire: %v", err)
+		return
+	}
+
+	log.Println("Point expire job execution completed successfully")
+}
+
+// processPointExpire expires the points of customers batch by batch, customers are dropped from the next batch once expired
+func (j *PointExpireJob) processPointExpire(ctx context.Context) error {
+	now := time.Now().In(j.taiwanLocation)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	for {
+		customerIDs, err := j.queries.GetCustomerIDsWithExpiredPoints(ctx, dbgen.GetCustomerIDsWithExpiredPointsParams{
+			ExpiresAt: utils.TimePtrToPgDate(&today),
+			Limit:     PointExpireBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(customerIDs) == 0 {
+			return nil
+		}
+
+		for _, customerID := range customerIDs {
+			if err := j.expireCustomerPoints(ctx, customerID, today); err != nil {
+				return fmt.Errorf("customer %d: %w", customerID, err)
+			}
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (j *PointExpireJob) expireCustomerPoints(ctx context.Context, customerID int64, today time.Time) error {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := points.ExpirePoints(ctx, dbgen.New(tx), customerID, today); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
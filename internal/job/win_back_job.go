@@ -0,0 +1,258 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+	"github.com/tkoleo84119/nail-salon-backend/internal/infra/redis"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const (
+	WinBackJobLockKey = "win_back_job_lock"
+	WinBackLockTTL    = 30 * time.Minute
+	WinBackBatchSize  = 200
+)
+
+type WinBackJob struct {
+	cfg            *config.Config
+	queries        *dbgen.Queries
+	db             *pgxpool.Pool
+	redisClient    *redis.Client
+	lineMessenger  *utils.LineMessageClient
+	activityLog    cache.ActivityLogCacheInterface
+	cron           *cron.Cron
+	taiwanLocation *time.Location
+}
+
+func NewWinBackJob(cfg *config.Config, queries *dbgen.Queries, db *pgxpool.Pool, redisClient *redis.Client, lineMessenger *utils.LineMessageClient, activityLog cache.ActivityLogCacheInterface) (*WinBackJob, error) {
+	taiwanLocation, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Taiwan timezone: %w", err)
+	}
+
+	c := cron.New(cron.WithLocation(taiwanLocation))
+
+	return &WinBackJob{
+		cfg:            cfg,
+		queries:        queries,
+		db:             db,
+		redisClient:    redisClient,
+		lineMessenger:  lineMessenger,
+		activityLog:    activityLog,
+		cron:           c,
+		taiwanLocation: taiwanLocation,
+	}, nil
+}
+
+func (j *WinBackJob) Start() error {
+	_, err := j.cron.AddFunc(j.cfg.Scheduler.WinBackCron, j.executeWinBackJob)
+	if err != nil {
+		return fmt.Errorf("failed to schedule win back job: %w", err)
+	}
+
+	j.cron.Start()
+	log.Printf("Win back job started with schedule: %s (Taiwan timezone)", j.cfg.Scheduler.WinBackCron)
+
+	return nil
+}
+
+func (j *WinBackJob) Stop() {
+	j.cron.Stop()
+	log.Println("Win back job stopped")
+}
+
+func (j *WinBackJob) executeWinBackJob() {
+	ctx := context.Background()
+
+	lockAcquired, err := j.redisClient.SetLock(ctx, WinBackJobLockKey, "locked", WinBackLockTTL)
+	if err != nil {
+		log.Printf("Failed to acquire lock for win back job: %v", err)
+		return
+	}
+
+	if !lockAcquired {
+		log.Println("Another instance is already running win back job, skipping...")
+		return
+	}
+
+	defer func() {
+		if err := j.redisClient.ReleaseLock(ctx, WinBackJobLockKey); err != nil {
+			log.Printf("Failed to release lock for win back job: %v", err)
+		}
+	}()
+
+	settings, err := j.queries.GetEnabledStoreWinBackSettings(ctx)
+	if err != nil {
+		log.Printf("failed to get win back settings: %v", err)
+		return
+	}
+
+	// a failed store does not stop the other stores, customers not contacted are picked up by the next run
+	for _, setting := range settings {
+		if err := j.processStoreWinBack(ctx, setting); err != nil {
+			log.Printf("failed to process win back of store %d: %v", setting.StoreID, err)
+		}
+	}
+
+	log.Println("Win back job execution completed successfully")
+}
+
+// processStoreWinBack contacts the lapsed customers of the store batch by batch, ordered by customer id.
+// A customer belongs to the store of the last checkout and is lapsed when the last visit is more than inactive days ago.
+// The contact is keyed by the last visit, so the customer is contacted once per cycle until the next visit.
+func (j *WinBackJob) processStoreWinBack(ctx context.Context, setting dbgen.StoreWinBackSetting) error {
+	store, err := j.queries.GetStoreByID(ctx, setting.StoreID)
+	if err != nil {
+		return err
+	}
+	if !utils.PgBoolToBool(store.IsActive) {
+		return nil
+	}
+
+	var couponInfo *dbgen.GetCouponByIDsRow
+	if setting.CouponID.Valid {
+		coupons, err := j.queries.GetCouponByIDs(ctx, []int64{setting.CouponID.Int64})
+		if err != nil {
+			return err
+		}
+		// skip the store instead of contacting without the coupon, so the cycle is not used up
+		if len(coupons) == 0 || !utils.PgBoolToBool(coupons[0].IsActive) {
+			log.Printf("Win back coupon %d of store %d is not active, skipping...", setting.CouponID.Int64, setting.StoreID)
+			return nil
+		}
+		couponInfo = &coupons[0]
+	}
+
+	now := time.Now().In(j.taiwanLocation)
+	inactiveBefore := now.AddDate(0, 0, -int(setting.InactiveDays))
+
+	var lastCustomerID int64
+	contactedCount := 0
+	for {
+		customers, err := j.queries.GetWinBackTargetCustomers(ctx, dbgen.GetWinBackTargetCustomersParams{
+			StoreID:        setting.StoreID,
+			InactiveBefore: utils.TimePtrToPgTimestamptz(&inactiveBefore),
+			LastCustomerID: lastCustomerID,
+			BatchSize:      WinBackBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(customers) == 0 {
+			break
+		}
+
+		for _, customer := range customers {
+			contacted, validTo, err := j.contact(ctx, setting, customer.ID, customer.LastVisitAt, now)
+			if err != nil {
+				return fmt.Errorf("customer %d: %w", customer.ID, err)
+			}
+			if !contacted {
+				continue
+			}
+			contactedCount++
+
+			message := buildWinBackMessage(setting, couponInfo, customer.Name, validTo)
+			if err := j.lineMessenger.SendTextMessage(customer.LineUid, message); err != nil {
+				log.Printf("failed to send win back message to customer %d: %v", customer.ID, err)
+			}
+		}
+
+		lastCustomerID = customers[len(customers)-1].ID
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if contactedCount > 0 {
+		if err := j.activityLog.LogWinBackContacted(ctx, store.Name, contactedCount); err != nil {
+			log.Printf("failed to log win back contacted activity: %v", err)
+		}
+	}
+
+	log.Printf("Win back job contacted %d customers of store %d", contactedCount, setting.StoreID)
+	return nil
+}
+
+// contact records the contact of the cycle and issues the coupon (if set) to the customer in a transaction.
+// It returns false when the cycle was already contacted, and the valid to of the issued coupon, nil when no coupon is issued.
+func (j *WinBackJob) contact(ctx context.Context, setting dbgen.StoreWinBackSetting, customerID int64, lastVisitAt pgtype.Timestamptz, now time.Time) (bool, *time.Time, error) {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	contactID, err := qtx.CreateWinBackContact(ctx, dbgen.CreateWinBackContactParams{
+		ID:          utils.GenerateID(),
+		StoreID:     setting.StoreID,
+		CustomerID:  customerID,
+		LastVisitAt: lastVisitAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+
+	var validTo *time.Time
+	if setting.CouponID.Valid {
+		sourceType := common.CustomerCouponSourceWinBack
+		customerCouponID, err := coupon.Issue(ctx, qtx, coupon.IssueParams{
+			CustomerID: customerID,
+			CouponID:   setting.CouponID.Int64,
+			ValidDays:  &setting.CouponValidDays,
+			SourceType: &sourceType,
+			SourceID:   &contactID,
+			Now:        now,
+		})
+		if err != nil {
+			return false, nil, err
+		}
+
+		if customerCouponID != nil {
+			if err := qtx.UpdateWinBackContactCustomerCoupon(ctx, dbgen.UpdateWinBackContactCustomerCouponParams{
+				ID:               contactID,
+				CustomerCouponID: utils.Int64PtrToPgInt8(customerCouponID),
+			}); err != nil {
+				return false, nil, err
+			}
+			validTo = coupon.ValidTo(nil, &setting.CouponValidDays, now)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, nil, err
+	}
+
+	return true, validTo, nil
+}
+
+// buildWinBackMessage returns the win back message of the setting, a default message is used when not set
+func buildWinBackMessage(setting dbgen.StoreWinBackSetting, couponInfo *dbgen.GetCouponByIDsRow, customerName string, validTo *time.Time) string {
+	if setting.LineMessage.Valid && setting.LineMessage.String != "" {
+		return setting.LineMessage.String
+	}
+
+	if couponInfo != nil && validTo != nil {
+		return fmt.Sprintf("%s 好久不見！我們很想念您，送您一張回娘家優惠券「%s」，有效期限至 %s，歡迎預約回來坐坐！", customerName, couponInfo.DisplayName, validTo.Format("2006-01-02"))
+	}
+
+	return fmt.Sprintf("%s 好久不見！我們很想念您，歡迎隨時預約回來坐坐！", customerName)
+}
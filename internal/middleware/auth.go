@@ -2,10 +2,13 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
 	"github.com/tkoleo84119/nail-salon-backend/internal/config"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
@@ -18,6 +21,8 @@ import (
 const (
 	UserContextKey     = "user"
 	CustomerContextKey = "customer"
+
+	RequiredTermsVersionHeader = "X-Required-Terms-Version"
 )
 
 func JWTAuth(cfg config.Config, db dbgen.Querier, authCache cache.AuthCacheInterface) gin.HandlerFunc {
@@ -154,8 +159,17 @@ func RequireNotSuperAdmin() gin.HandlerFunc {
 	return RequireRoles(common.RoleAdmin, common.RoleManager, common.RoleStylist)
 }
 
-// CustomerJWTAuth middleware for customer authentication
+// CustomerJWTAuth middleware for customer authentication, blocks mutations until the required terms are accepted
 func CustomerJWTAuth(cfg config.Config, db dbgen.Querier, authCache cache.AuthCacheInterface) gin.HandlerFunc {
+	return customerJWTAuth(cfg, db, authCache, true)
+}
+
+// CustomerJWTAuthSkipTerms middleware for customer authentication on routes that must stay open before accepting the terms
+func CustomerJWTAuthSkipTerms(cfg config.Config, db dbgen.Querier, authCache cache.AuthCacheInterface) gin.HandlerFunc {
+	return customerJWTAuth(cfg, db, authCache, false)
+}
+
+func customerJWTAuth(cfg config.Config, db dbgen.Querier, authCache cache.AuthCacheInterface, enforceTerms bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -181,6 +195,21 @@ func CustomerJWTAuth(cfg config.Config, db dbgen.Querier, authCache cache.AuthCa
 			return
 		}
 
+		requiredVersion, err := checkCustomerTerms(c, db, authCache)
+		if err != nil {
+			errorCodes.AbortWithError(c, errorCodes.SysDatabaseError, nil)
+			return
+		}
+
+		if requiredVersion != "" {
+			c.Header(RequiredTermsVersionHeader, requiredVersion)
+
+			if enforceTerms && !isReadOnlyMethod(c.Request.Method) {
+				errorCodes.AbortWithError(c, errorCodes.AuthTermsNotAccepted, nil)
+				return
+			}
+		}
+
 		c.Next()
 	}
 }
@@ -208,6 +237,14 @@ func validateCustomerToken(c *gin.Context, db dbgen.Querier, authCache cache.Aut
 		return err
 	}
 
+	// a merged customer logs in as the surviving customer, its old tokens are no longer accepted
+	if customer.MergedIntoCustomerID.Valid {
+		return errors.New("customer has been merged")
+	}
+	if customer.ErasedAt.Valid {
+		return errors.New("customer has been erased")
+	}
+
 	customerContext = &common.CustomerContext{
 		CustomerID:    customerID,
 		LineUID:       customer.LineUid,
@@ -216,6 +253,12 @@ func validateCustomerToken(c *gin.Context, db dbgen.Querier, authCache cache.Aut
 		IsBlacklisted: utils.PgBoolToBool(customer.IsBlacklisted),
 	}
 
+	acceptedTerms, err := db.GetCustomerLatestAcceptedTermsEffectiveDate(c.Request.Context(), customerID)
+	if err != nil {
+		return err
+	}
+	customerContext.AcceptedTermsEffectiveDate = utils.PgDateToDateString(acceptedTerms)
+
 	// write data to cache (failed does not affect main process)
 	if cacheErr := authCache.SetCustomerContext(c.Request.Context(), customerID, customerContext); cacheErr != nil {
 		// do nothing
@@ -225,6 +268,63 @@ func validateCustomerToken(c *gin.Context, db dbgen.Querier, authCache cache.Aut
 	return nil
 }
 
+// checkCustomerTerms returns the required terms version when the customer has not accepted it, empty string otherwise
+func checkCustomerTerms(c *gin.Context, db dbgen.Querier, authCache cache.AuthCacheInterface) (string, error) {
+	ctx := c.Request.Context()
+
+	requiredTerms, err := authCache.GetRequiredTerms(ctx)
+	if err != nil {
+		row, err := db.GetCurrentRequiredTermsDocument(ctx)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return "", nil
+			}
+			return "", err
+		}
+
+		requiredTerms = &common.RequiredTerms{
+			Version:       row.Version,
+			EffectiveDate: utils.PgDateToDateString(row.EffectiveDate),
+		}
+
+		// write data to cache (failed does not affect main process)
+		if cacheErr := authCache.SetRequiredTerms(ctx, requiredTerms); cacheErr != nil {
+			// do nothing
+		}
+	}
+
+	customerContext, _ := GetCustomerFromContext(c)
+	// dates are yyyy-mm-dd, so string comparison follows the date order
+	if customerContext.AcceptedTermsEffectiveDate >= requiredTerms.EffectiveDate {
+		return "", nil
+	}
+
+	// the cached context may be older than the acceptance, check the database before blocking
+	acceptedTerms, err := db.GetCustomerLatestAcceptedTermsEffectiveDate(ctx, customerContext.CustomerID)
+	if err != nil {
+		return "", err
+	}
+
+	acceptedDate := utils.PgDateToDateString(acceptedTerms)
+	if acceptedDate != customerContext.AcceptedTermsEffectiveDate {
+		customerContext.AcceptedTermsEffectiveDate = acceptedDate
+		if cacheErr := authCache.SetCustomerContext(ctx, customerContext.CustomerID, customerContext); cacheErr != nil {
+			// do nothing
+		}
+		c.Set(CustomerContextKey, *customerContext)
+	}
+
+	if acceptedDate >= requiredTerms.EffectiveDate {
+		return "", nil
+	}
+
+	return requiredTerms.Version, nil
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
 // GetCustomerFromContext extracts customer context from gin context
 func GetCustomerFromContext(c *gin.Context) (*common.CustomerContext, bool) {
 	customer, exists := c.Get(CustomerContextKey)
@@ -0,0 +1,15 @@
+package adminAccountStatementImport
+
+import "io"
+
+type CreateParsedRequest struct {
+	FileName string
+	File     io.Reader
+}
+
+type CreateResponse struct {
+	ID             string `json:"id"`
+	TotalLines     int    `json:"totalLines"`
+	MatchedLines   int    `json:"matchedLines"`
+	UnmatchedLines int    `json:"unmatchedLines"`
+}
@@ -0,0 +1,33 @@
+package adminAccountStatementImport
+
+type GetAllRequest struct {
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID             string    `json:"id"`
+	FileName       string    `json:"fileName"`
+	TotalLines     int32     `json:"totalLines"`
+	MatchedLines   int32     `json:"matchedLines"`
+	UnmatchedLines int32     `json:"unmatchedLines"`
+	CreatedBy      CreatedBy `json:"createdBy"`
+	CreatedAt      string    `json:"createdAt"`
+}
+
+type CreatedBy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,16 @@
+package adminAccountStatementLayout
+
+type GetResponse struct {
+	AccountID         string `json:"accountId"`
+	Encoding          string `json:"encoding"`
+	HasHeader         bool   `json:"hasHeader"`
+	SkipRows          int32  `json:"skipRows"`
+	Delimiter         string `json:"delimiter"`
+	DateColumn        int32  `json:"dateColumn"`
+	DateFormat        string `json:"dateFormat"`
+	DescriptionColumn *int32 `json:"descriptionColumn"`
+	AmountColumn      *int32 `json:"amountColumn"`
+	DepositColumn     *int32 `json:"depositColumn"`
+	WithdrawalColumn  *int32 `json:"withdrawalColumn"`
+	UpdatedAt         string `json:"updatedAt"`
+}
@@ -0,0 +1,18 @@
+package adminAccountStatementLayout
+
+type UpdateRequest struct {
+	Encoding          *string `json:"encoding" binding:"omitempty,oneof=UTF-8 BIG5"`
+	HasHeader         *bool   `json:"hasHeader" binding:"omitempty"`
+	SkipRows          *int32  `json:"skipRows" binding:"omitempty,min=0,max=20"`
+	Delimiter         *string `json:"delimiter" binding:"omitempty,len=1"`
+	DateColumn        int32   `json:"dateColumn" binding:"required,min=1,max=50"`
+	DateFormat        string  `json:"dateFormat" binding:"required,oneof=YYYY-MM-DD YYYY/MM/DD YYYYMMDD ROC"`
+	DescriptionColumn *int32  `json:"descriptionColumn" binding:"omitempty,min=1,max=50"`
+	AmountColumn      *int32  `json:"amountColumn" binding:"omitempty,min=1,max=50"`
+	DepositColumn     *int32  `json:"depositColumn" binding:"omitempty,min=1,max=50"`
+	WithdrawalColumn  *int32  `json:"withdrawalColumn" binding:"omitempty,min=1,max=50"`
+}
+
+type UpdateResponse struct {
+	AccountID string `json:"accountId"`
+}
@@ -0,0 +1,10 @@
+package adminAccountStatementLine
+
+type CreateTransactionRequest struct {
+	Note *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateTransactionResponse struct {
+	ID            string `json:"id"`
+	TransactionID string `json:"transactionId"`
+}
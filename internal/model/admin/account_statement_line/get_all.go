@@ -0,0 +1,35 @@
+package adminAccountStatementLine
+
+type GetAllRequest struct {
+	ImportID *string `form:"importId" binding:"omitempty"`
+	Status   *string `form:"status" binding:"omitempty,oneof=MATCHED UNMATCHED CREATED IGNORED"`
+	Limit    *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset   *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort     *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	ImportID *int64
+	Status   *string
+	Limit    int
+	Offset   int
+	Sort     []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID              string `json:"id"`
+	ImportID        string `json:"importId"`
+	LineNumber      int32  `json:"lineNumber"`
+	TransactionDate string `json:"transactionDate"`
+	Description     string `json:"description"`
+	Type            string `json:"type"`
+	Amount          int64  `json:"amount"`
+	Status          string `json:"status"`
+	TransactionID   string `json:"transactionId"`
+	UpdatedAt       string `json:"updatedAt"`
+}
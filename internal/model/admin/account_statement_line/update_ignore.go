@@ -0,0 +1,5 @@
+package adminAccountStatementLine
+
+type UpdateIgnoreResponse struct {
+	ID string `json:"id"`
+}
@@ -22,6 +22,8 @@ type GetAllItem struct {
 	Amount          int64  `json:"amount"`
 	Balance         int64  `json:"balance"`
 	Note            string `json:"note"`
+	SourceType      string `json:"sourceType"`
+	SourceID        string `json:"sourceId"`
 	CreatedAt       string `json:"createdAt"`
 	UpdatedAt       string `json:"updatedAt"`
 }
@@ -1,11 +1,19 @@
 package adminAccountTransaction
 
+import "time"
+
 type UpdateRequest struct {
-	Note *string `json:"note" binding:"omitempty,max=255"`
+	TransactionDate *string `json:"transactionDate" binding:"omitempty"`
+	Type            *string `json:"type" binding:"omitempty,oneof=INCOME EXPENSE"`
+	Amount          *int64  `json:"amount" binding:"omitempty,min=1,max=1000000"`
+	Note            *string `json:"note" binding:"omitempty,max=255"`
 }
 
 type UpdateParsedRequest struct {
-	Note *string
+	TransactionDate *time.Time
+	Type            *string
+	Amount          *int64
+	Note            *string
 }
 
 type UpdateResponse struct {
@@ -13,5 +21,5 @@ type UpdateResponse struct {
 }
 
 func (r *UpdateRequest) HasUpdates() bool {
-	return r.Note != nil
+	return r.TransactionDate != nil || r.Type != nil || r.Amount != nil || r.Note != nil
 }
@@ -0,0 +1,44 @@
+package adminAccountTransactionAudit
+
+type GetAllRequest struct {
+	TransactionID *string `form:"transactionId" binding:"omitempty"`
+	Action        *string `form:"action" binding:"omitempty,oneof=CREATE UPDATE DELETE"`
+	Limit         *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset        *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort          *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	TransactionID *int64
+	Action        *string
+	Limit         int
+	Offset        int
+	Sort          []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID            string              `json:"id"`
+	TransactionID string              `json:"transactionId"`
+	Action        string              `json:"action"`
+	BeforeData    *TransactionContent `json:"beforeData"`
+	AfterData     *TransactionContent `json:"afterData"`
+	CreatedBy     CreatedBy           `json:"createdBy"`
+	CreatedAt     string              `json:"createdAt"`
+}
+
+type TransactionContent struct {
+	TransactionDate string `json:"transactionDate"`
+	Type            string `json:"type"`
+	Amount          int64  `json:"amount"`
+	Note            string `json:"note"`
+}
+
+type CreatedBy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
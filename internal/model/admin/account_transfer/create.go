@@ -0,0 +1,25 @@
+package adminAccountTransfer
+
+import "time"
+
+type CreateRequest struct {
+	FromAccountID   string  `json:"fromAccountId" binding:"required"`
+	ToAccountID     string  `json:"toAccountId" binding:"required"`
+	TransactionDate string  `json:"transactionDate" binding:"required"`
+	Amount          int64   `json:"amount" binding:"required,min=1,max=1000000"`
+	Note            *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	FromAccountID   int64
+	ToAccountID     int64
+	TransactionDate time.Time
+	Amount          int64
+	Note            *string
+}
+
+type CreateResponse struct {
+	ID                   string `json:"id"`
+	ExpenseTransactionID string `json:"expenseTransactionId"`
+	IncomeTransactionID  string `json:"incomeTransactionId"`
+}
@@ -0,0 +1,10 @@
+package adminBirthdayBenefitSetting
+
+type GetResponse struct {
+	IsEnabled       bool    `json:"isEnabled"`
+	CouponID        *string `json:"couponId"`
+	CouponValidDays int32   `json:"couponValidDays"`
+	IssuePeriod     string  `json:"issuePeriod"`
+	LineMessage     string  `json:"lineMessage"`
+	UpdatedAt       string  `json:"updatedAt"`
+}
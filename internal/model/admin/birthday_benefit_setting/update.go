@@ -0,0 +1,21 @@
+package adminBirthdayBenefitSetting
+
+type UpdateRequest struct {
+	IsEnabled       *bool   `json:"isEnabled" binding:"required"`
+	CouponID        *string `json:"couponId" binding:"omitempty"`
+	CouponValidDays int32   `json:"couponValidDays" binding:"required,min=1,max=365"`
+	IssuePeriod     string  `json:"issuePeriod" binding:"required,oneof=MONTH DAY"`
+	LineMessage     *string `json:"lineMessage" binding:"omitempty,max=500"`
+}
+
+type UpdateParsedRequest struct {
+	IsEnabled       bool
+	CouponID        *int64
+	CouponValidDays int32
+	IssuePeriod     string
+	LineMessage     *string
+}
+
+type UpdateResponse struct {
+	IsEnabled bool `json:"isEnabled"`
+}
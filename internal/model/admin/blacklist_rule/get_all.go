@@ -0,0 +1,15 @@
+package adminBlacklistRule
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	Type            string `json:"type"`
+	IsActive        bool   `json:"isActive"`
+	ThresholdCount  int32  `json:"thresholdCount"`
+	PeriodMonths    int32  `json:"periodMonths"`
+	LateCancelHours *int32 `json:"lateCancelHours"`
+	Action          string `json:"action"`
+	UpdatedAt       string `json:"updatedAt"`
+}
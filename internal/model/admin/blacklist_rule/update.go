@@ -0,0 +1,14 @@
+package adminBlacklistRule
+
+type UpdateRequest struct {
+	Type            string `json:"type" binding:"required,oneof=NO_SHOW LATE_CANCEL"`
+	IsActive        *bool  `json:"isActive" binding:"required"`
+	ThresholdCount  int32  `json:"thresholdCount" binding:"required,min=1,max=20"`
+	PeriodMonths    int32  `json:"periodMonths" binding:"required,min=1,max=36"`
+	LateCancelHours *int32 `json:"lateCancelHours" binding:"omitempty,min=1,max=168"`
+	Action          string `json:"action" binding:"required,oneof=BLACKLIST DEPOSIT_REQUIRED"`
+}
+
+type UpdateResponse struct {
+	Type string `json:"type"`
+}
@@ -1,5 +1,7 @@
 package adminBooking
 
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
 type CreateRequest struct {
 	CustomerID    string    `json:"customerId" binding:"required"`
 	TimeSlotID    string    `json:"timeSlotId" binding:"required"`
@@ -19,5 +21,6 @@ type CreateParsedRequest struct {
 }
 
 type CreateResponse struct {
-	ID string `json:"id"`
+	ID             string                        `json:"id"`
+	HealthWarnings []common.HealthAllergyWarning `json:"healthWarnings"`
 }
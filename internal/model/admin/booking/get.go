@@ -1,26 +1,41 @@
 package adminBooking
 
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
 type GetResponse struct {
-	ID                 string                 `json:"id"`
-	Customer           GetCustomer            `json:"customer"`
-	Stylist            GetStylist             `json:"stylist"`
-	TimeSlot           GetTimeSlot            `json:"timeSlot"`
-	ActualDuration     *int32                 `json:"actualDuration,omitempty"`
-	Status             string                 `json:"status"`
-	IsChatEnabled      bool                   `json:"isChatEnabled"`
-	Note               string                 `json:"note"`
-	CancelReason       string                 `json:"cancelReason"`
-	StoreNote          string                 `json:"storeNote"`
-	PinterestImageUrls []string               `json:"pinterestImageUrls"`
-	CreatedAt          string                 `json:"createdAt"`
-	UpdatedAt          string                 `json:"updatedAt"`
-	BookingDetails     []GetBookingDetailItem `json:"bookingDetails"`
-	Checkout           *GetCheckout           `json:"checkout"`
+	ID                 string                        `json:"id"`
+	Customer           GetCustomer                   `json:"customer"`
+	Stylist            GetStylist                    `json:"stylist"`
+	TimeSlot           GetTimeSlot                   `json:"timeSlot"`
+	ActualDuration     *int32                        `json:"actualDuration,omitempty"`
+	Status             string                        `json:"status"`
+	IsChatEnabled      bool                          `json:"isChatEnabled"`
+	Note               string                        `json:"note"`
+	CancelReason       string                        `json:"cancelReason"`
+	StoreNote          string                        `json:"storeNote"`
+	PinterestImageUrls []string                      `json:"pinterestImageUrls"`
+	CreatedAt          string                        `json:"createdAt"`
+	UpdatedAt          string                        `json:"updatedAt"`
+	BookingDetails     []GetBookingDetailItem        `json:"bookingDetails"`
+	HealthWarnings     []common.HealthAllergyWarning `json:"healthWarnings"`
+	Checkout           *GetCheckout                  `json:"checkout"`
 }
 
 type GetCustomer struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Tags        []string                    `json:"tags"`
+	Notes       []GetCustomerNote           `json:"notes"`
+	HealthFlags *common.CustomerHealthFlags `json:"healthFlags"`
+}
+
+type GetCustomerNote struct {
+	ID                string `json:"id"`
+	BookingID         string `json:"bookingId"`
+	Content           string `json:"content"`
+	IsPinned          bool   `json:"isPinned"`
+	CreatedByUsername string `json:"createdByUsername"`
+	CreatedAt         string `json:"createdAt"`
 }
 
 type GetStylist struct {
@@ -49,13 +64,19 @@ type GetService struct {
 }
 
 type GetCheckout struct {
-	ID            string     `json:"id"`
-	PaymentMethod string     `json:"paymentMethod"`
-	TotalAmount   int64      `json:"totalAmount"`
-	FinalAmount   int64      `json:"finalAmount"`
-	PaidAmount    int64      `json:"paidAmount"`
-	CheckoutUser  string     `json:"checkoutUser"`
-	Coupon        *GetCoupon `json:"coupon"`
+	ID                   string     `json:"id"`
+	PaymentMethod        string     `json:"paymentMethod"`
+	TotalAmount          int64      `json:"totalAmount"`
+	FinalAmount          int64      `json:"finalAmount"`
+	PaidAmount           int64      `json:"paidAmount"`
+	CheckoutUser         string     `json:"checkoutUser"`
+	Coupon               *GetCoupon `json:"coupon"`
+	PointsRedeemed       int32      `json:"pointsRedeemed"`
+	PointsDiscountAmount int64      `json:"pointsDiscountAmount"`
+	RefundedAt           string     `json:"refundedAt"`
+	RefundReason         string     `json:"refundReason"`
+	InvoiceNumber        string     `json:"invoiceNumber"`
+	InvoiceStatus        string     `json:"invoiceStatus"`
 }
 
 type GetCoupon struct {
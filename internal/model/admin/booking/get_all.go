@@ -1,6 +1,10 @@
 package adminBooking
 
-import "time"
+import (
+	"time"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+)
 
 type GetAllRequest struct {
 	StylistID  *string `form:"stylistId" binding:"omitempty"`
@@ -43,9 +47,10 @@ type GetAllItem struct {
 }
 
 type GetAllCustomer struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	LineName string `json:"lineName"`
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	LineName    string                      `json:"lineName"`
+	HealthFlags *common.CustomerHealthFlags `json:"healthFlags"`
 }
 
 type GetAllStylist struct {
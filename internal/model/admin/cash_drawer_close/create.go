@@ -0,0 +1,26 @@
+package adminCashDrawerClose
+
+import "time"
+
+type CreateRequest struct {
+	CloseDate   string  `json:"closeDate" binding:"required"`
+	CountedCash *int64  `json:"countedCash" binding:"required,min=0,max=100000000"`
+	AccountID   string  `json:"accountId" binding:"required"`
+	Note        *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	CloseDate   time.Time
+	CountedCash int64
+	AccountID   int64
+	Note        *string
+}
+
+type CreateResponse struct {
+	ID            string `json:"id"`
+	CloseDate     string `json:"closeDate"`
+	CheckoutCount int32  `json:"checkoutCount"`
+	ExpectedCash  int64  `json:"expectedCash"`
+	CountedCash   int64  `json:"countedCash"`
+	Difference    int64  `json:"difference"`
+}
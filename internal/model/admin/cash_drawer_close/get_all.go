@@ -0,0 +1,48 @@
+package adminCashDrawerClose
+
+import "time"
+
+type GetAllRequest struct {
+	StartDate *string `form:"startDate" binding:"omitempty"`
+	EndDate   *string `form:"endDate" binding:"omitempty"`
+	Limit     *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset    *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort      *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Limit     int
+	Offset    int
+	Sort      []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                   string        `json:"id"`
+	CloseDate            string        `json:"closeDate"`
+	CheckoutCount        int32         `json:"checkoutCount"`
+	ExpectedCash         int64         `json:"expectedCash"`
+	CountedCash          int64         `json:"countedCash"`
+	Difference           int64         `json:"difference"`
+	Account              GetAllAccount `json:"account"`
+	AccountTransactionID string        `json:"accountTransactionId"`
+	Note                 string        `json:"note"`
+	ClosedBy             GetAllStaff   `json:"closedBy"`
+	CreatedAt            string        `json:"createdAt"`
+}
+
+type GetAllAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GetAllStaff struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -1,15 +1,16 @@
 package adminCheckout
 
 type CreateBulkRequest struct {
-	PaymentMethod    string                    `json:"paymentMethod" binding:"required,oneof=CASH LINE_PAY"`
+	PaymentMethod    string                    `json:"paymentMethod" binding:"required,oneof=CASH LINE_PAY WALLET"`
 	CustomerCouponID *string                   `json:"customerCouponId" binding:"omitempty"`
 	Checkouts        []CreateBulkCheckoutItems `json:"checkouts" binding:"required,min=1,max=10"`
 }
 
 type CreateBulkCheckoutItems struct {
-	BookingID  string                  `json:"bookingId" binding:"required"`
-	PaidAmount int64                   `json:"paidAmount" binding:"required,min=0,max=1000000"`
-	Details    []CreateBulkDetailItems `json:"details" binding:"required,min=1,max=10"`
+	BookingID    string                  `json:"bookingId" binding:"required"`
+	PaidAmount   int64                   `json:"paidAmount" binding:"required,min=0,max=1000000"`
+	RedeemPoints *int32                  `json:"redeemPoints" binding:"omitempty,min=1,max=1000000"`
+	Details      []CreateBulkDetailItems `json:"details" binding:"required,min=1,max=10"`
 }
 
 type CreateBulkDetailItems struct {
@@ -25,10 +26,11 @@ type CreateBulkParsedRequest struct {
 }
 
 type CreateBulkParsedCheckoutItems struct {
-	BookingID  int64
-	PaidAmount int64
-	ApplyCount int64
-	Details    []CreateBulkParsedDetailItems
+	BookingID    int64
+	PaidAmount   int64
+	ApplyCount   int64
+	RedeemPoints int32
+	Details      []CreateBulkParsedDetailItems
 }
 
 type CreateBulkParsedDetailItems struct {
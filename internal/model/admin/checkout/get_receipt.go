@@ -0,0 +1,7 @@
+package adminCheckout
+
+type GetReceiptResponse struct {
+	ID   string `json:"id"`
+	Html string `json:"html"`
+	Text string `json:"text"`
+}
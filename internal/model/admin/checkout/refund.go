@@ -0,0 +1,11 @@
+package adminCheckout
+
+type RefundRequest struct {
+	Reason *string `json:"reason" binding:"omitempty,max=255"`
+}
+
+type RefundResponse struct {
+	ID            string `json:"id"`
+	RefundedAt    string `json:"refundedAt"`
+	InvoiceStatus string `json:"invoiceStatus"`
+}
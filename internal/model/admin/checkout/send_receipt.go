@@ -0,0 +1,5 @@
+package adminCheckout
+
+type SendReceiptResponse struct {
+	ID string `json:"id"`
+}
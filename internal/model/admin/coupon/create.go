@@ -1,12 +1,39 @@
 package adminCoupon
 
+import "time"
+
 type CreateRequest struct {
-	Name           string   `json:"name" binding:"required,noBlank,max=100"`
-	DisplayName    string   `json:"displayName" binding:"required,noBlank,max=100"`
-	Code           string   `json:"code" binding:"required,noBlank,max=100"`
-	DiscountRate   *float64 `json:"discountRate" binding:"omitempty,min=0.1,max=0.99"`
-	DiscountAmount *int64   `json:"discountAmount" binding:"omitempty,min=1,max=1000000"`
-	Note           *string  `json:"note" binding:"omitempty,max=255"`
+	Name                  string    `json:"name" binding:"required,noBlank,max=100"`
+	DisplayName           string    `json:"displayName" binding:"required,noBlank,max=100"`
+	Code                  string    `json:"code" binding:"required,noBlank,max=100"`
+	DiscountRate          *float64  `json:"discountRate" binding:"omitempty,min=0.1,max=0.99"`
+	DiscountAmount        *int64    `json:"discountAmount" binding:"omitempty,min=1,max=1000000"`
+	StartDate             *string   `json:"startDate" binding:"omitempty"`
+	EndDate               *string   `json:"endDate" binding:"omitempty"`
+	MinSpendAmount        *int64    `json:"minSpendAmount" binding:"omitempty,min=1,max=1000000"`
+	ServiceScope          *string   `json:"serviceScope" binding:"omitempty,oneof=ALL MAIN ADDON"`
+	ServiceIds            *[]string `json:"serviceIds" binding:"omitempty,max=50"`
+	TotalUsageLimit       *int32    `json:"totalUsageLimit" binding:"omitempty,min=1,max=1000000"`
+	PerCustomerUsageLimit *int32    `json:"perCustomerUsageLimit" binding:"omitempty,min=1,max=1000"`
+	IsRepeatable          *bool     `json:"isRepeatable" binding:"omitempty"`
+	Note                  *string   `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	Name                  string
+	DisplayName           string
+	Code                  string
+	DiscountRate          *float64
+	DiscountAmount        *int64
+	StartDate             *time.Time
+	EndDate               *time.Time
+	MinSpendAmount        *int64
+	ServiceScope          string
+	ServiceIds            []int64
+	TotalUsageLimit       *int32
+	PerCustomerUsageLimit *int32
+	IsRepeatable          bool
+	Note                  *string
 }
 
 type CreateResponse struct {
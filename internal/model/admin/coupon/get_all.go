@@ -24,14 +24,23 @@ type GetAllResponse struct {
 }
 
 type GetAllCouponItemDTO struct {
-	ID             string  `json:"id"`
-	Name           string  `json:"name"`
-	DisplayName    string  `json:"displayName"`
-	Code           string  `json:"code"`
-	DiscountRate   float64 `json:"discountRate"`
-	DiscountAmount int64   `json:"discountAmount"`
-	IsActive       bool    `json:"isActive"`
-	Note           string  `json:"note"`
-	CreatedAt      string  `json:"createdAt"`
-	UpdatedAt      string  `json:"updatedAt"`
+	ID                    string   `json:"id"`
+	Name                  string   `json:"name"`
+	DisplayName           string   `json:"displayName"`
+	Code                  string   `json:"code"`
+	DiscountRate          float64  `json:"discountRate"`
+	DiscountAmount        int64    `json:"discountAmount"`
+	IsActive              bool     `json:"isActive"`
+	StartDate             string   `json:"startDate"`
+	EndDate               string   `json:"endDate"`
+	MinSpendAmount        int64    `json:"minSpendAmount"`
+	ServiceScope          string   `json:"serviceScope"`
+	ServiceIds            []string `json:"serviceIds"`
+	TotalUsageLimit       *int32   `json:"totalUsageLimit"`
+	PerCustomerUsageLimit *int32   `json:"perCustomerUsageLimit"`
+	IsRepeatable          bool     `json:"isRepeatable"`
+	UsedCount             int64    `json:"usedCount"`
+	Note                  string   `json:"note"`
+	CreatedAt             string   `json:"createdAt"`
+	UpdatedAt             string   `json:"updatedAt"`
 }
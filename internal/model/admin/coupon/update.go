@@ -1,9 +1,34 @@
 package adminCoupon
 
+import "time"
+
 type UpdateRequest struct {
-	Name     *string `json:"name" binding:"omitempty,noBlank,max=100"`
-	IsActive *bool   `json:"isActive" binding:"omitempty"`
-	Note     *string `json:"note" binding:"omitempty,max=255"`
+	Name                  *string   `json:"name" binding:"omitempty,noBlank,max=100"`
+	IsActive              *bool     `json:"isActive" binding:"omitempty"`
+	StartDate             *string   `json:"startDate" binding:"omitempty"`
+	EndDate               *string   `json:"endDate" binding:"omitempty"`
+	MinSpendAmount        *int64    `json:"minSpendAmount" binding:"omitempty,min=0,max=1000000"`
+	ServiceScope          *string   `json:"serviceScope" binding:"omitempty,oneof=ALL MAIN ADDON"`
+	ServiceIds            *[]string `json:"serviceIds" binding:"omitempty,max=50"`
+	TotalUsageLimit       *int32    `json:"totalUsageLimit" binding:"omitempty,min=0,max=1000000"`
+	PerCustomerUsageLimit *int32    `json:"perCustomerUsageLimit" binding:"omitempty,min=0,max=1000"`
+	IsRepeatable          *bool     `json:"isRepeatable" binding:"omitempty"`
+	Note                  *string   `json:"note" binding:"omitempty,max=255"`
+}
+
+// UpdateParsedRequest holds the parsed update fields, a zero date or a zero limit clears the rule
+type UpdateParsedRequest struct {
+	Name                  *string
+	IsActive              *bool
+	StartDate             *time.Time
+	EndDate               *time.Time
+	MinSpendAmount        *int64
+	ServiceScope          *string
+	ServiceIds            *[]int64
+	TotalUsageLimit       *int32
+	PerCustomerUsageLimit *int32
+	IsRepeatable          *bool
+	Note                  *string
 }
 
 type UpdateResponse struct {
@@ -11,5 +36,7 @@ type UpdateResponse struct {
 }
 
 func (r UpdateRequest) HasUpdates() bool {
-	return r.Name != nil || r.IsActive != nil || r.Note != nil
+	return r.Name != nil || r.IsActive != nil || r.StartDate != nil || r.EndDate != nil || r.MinSpendAmount != nil ||
+		r.ServiceScope != nil || r.ServiceIds != nil || r.TotalUsageLimit != nil || r.PerCustomerUsageLimit != nil ||
+		r.IsRepeatable != nil || r.Note != nil
 }
@@ -0,0 +1,35 @@
+package adminCouponCampaign
+
+import "time"
+
+type CreateRequest struct {
+	Name           string   `json:"name" binding:"required,min=1,max=100"`
+	CouponID       string   `json:"couponId" binding:"required"`
+	ValidMonths    *int32   `json:"validMonths" binding:"omitempty,min=1,max=36"`
+	CustomerLevels []string `json:"customerLevels" binding:"omitempty,max=3,dive,oneof=NORMAL VIP VVIP"`
+	LastVisitFrom  *string  `json:"lastVisitFrom" binding:"omitempty"`
+	LastVisitTo    *string  `json:"lastVisitTo" binding:"omitempty"`
+	BirthdayMonth  *int32   `json:"birthdayMonth" binding:"omitempty,min=1,max=12"`
+	StoreID        *string  `json:"storeId" binding:"omitempty"`
+	SendLine       bool     `json:"sendLine" binding:"omitempty"`
+	LineMessage    *string  `json:"lineMessage" binding:"omitempty,max=500"`
+}
+
+type CreateParsedRequest struct {
+	Name           string
+	CouponID       int64
+	ValidMonths    *int32
+	CustomerLevels []string
+	LastVisitFrom  *time.Time
+	LastVisitTo    *time.Time
+	BirthdayMonth  *int32
+	StoreID        *int64
+	SendLine       bool
+	LineMessage    *string
+}
+
+type CreateResponse struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	TargetCount int64  `json:"targetCount"`
+}
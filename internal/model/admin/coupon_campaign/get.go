@@ -0,0 +1,27 @@
+package adminCouponCampaign
+
+type GetResponse struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	CouponID        string   `json:"couponId"`
+	ValidMonths     *int32   `json:"validMonths"`
+	CustomerLevels  []string `json:"customerLevels"`
+	LastVisitFrom   string   `json:"lastVisitFrom"`
+	LastVisitTo     string   `json:"lastVisitTo"`
+	BirthdayMonth   *int32   `json:"birthdayMonth"`
+	StoreID         string   `json:"storeId"`
+	SendLine        bool     `json:"sendLine"`
+	LineMessage     string   `json:"lineMessage"`
+	Status          string   `json:"status"`
+	TargetCount     int32    `json:"targetCount"`
+	IssuedCount     int32    `json:"issuedCount"`
+	SkippedCount    int32    `json:"skippedCount"`
+	LineSentCount   int32    `json:"lineSentCount"`
+	LineFailedCount int32    `json:"lineFailedCount"`
+	ErrorMessage    string   `json:"errorMessage"`
+	StartedAt       string   `json:"startedAt"`
+	FinishedAt      string   `json:"finishedAt"`
+	CreatedBy       string   `json:"createdBy"`
+	CreatedAt       string   `json:"createdAt"`
+	UpdatedAt       string   `json:"updatedAt"`
+}
@@ -0,0 +1,37 @@
+package adminCouponCampaign
+
+type GetAllRequest struct {
+	Status *string `form:"status" binding:"omitempty,oneof=PENDING RUNNING COMPLETED FAILED"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Status *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	CouponID        string `json:"couponId"`
+	CouponName      string `json:"couponName"`
+	Status          string `json:"status"`
+	SendLine        bool   `json:"sendLine"`
+	TargetCount     int32  `json:"targetCount"`
+	IssuedCount     int32  `json:"issuedCount"`
+	SkippedCount    int32  `json:"skippedCount"`
+	LineSentCount   int32  `json:"lineSentCount"`
+	LineFailedCount int32  `json:"lineFailedCount"`
+	StartedAt       string `json:"startedAt"`
+	FinishedAt      string `json:"finishedAt"`
+	CreatedAt       string `json:"createdAt"`
+}
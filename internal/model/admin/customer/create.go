@@ -0,0 +1,14 @@
+package adminCustomer
+
+type CreateRequest struct {
+	Name      string  `json:"name" binding:"required,noBlank,max=100"`
+	Phone     string  `json:"phone" binding:"required,taiwanmobile"`
+	Birthday  string  `json:"birthday" binding:"required"`
+	Email     *string `json:"email" binding:"omitempty,email"`
+	City      *string `json:"city" binding:"omitempty,max=100"`
+	StoreNote *string `json:"storeNote" binding:"omitempty,max=255"`
+}
+
+type CreateResponse struct {
+	ID string `json:"id"`
+}
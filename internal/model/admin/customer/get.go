@@ -1,24 +1,55 @@
 package adminCustomer
 
 type GetResponse struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	LineName       string   `json:"lineName"`
-	Phone          string   `json:"phone"`
-	Birthday       string   `json:"birthday"`
-	Email          string   `json:"email"`
-	City           string   `json:"city"`
-	FavoriteShapes []string `json:"favoriteShapes"`
-	FavoriteColors []string `json:"favoriteColors"`
-	FavoriteStyles []string `json:"favoriteStyles"`
-	IsIntrovert    bool     `json:"isIntrovert"`
-	ReferralSource []string `json:"referralSource"`
-	Referrer       string   `json:"referrer"`
-	CustomerNote   string   `json:"customerNote"`
-	StoreNote      string   `json:"storeNote"`
-	Level          string   `json:"level"`
-	IsBlacklisted  bool     `json:"isBlacklisted"`
-	LastVisitAt    string   `json:"lastVisitAt"`
-	CreatedAt      string   `json:"createdAt"`
-	UpdatedAt      string   `json:"updatedAt"`
+	ID                   string      `json:"id"`
+	Name                 string      `json:"name"`
+	LineName             string      `json:"lineName"`
+	Phone                string      `json:"phone"`
+	Birthday             string      `json:"birthday"`
+	Email                string      `json:"email"`
+	City                 string      `json:"city"`
+	FavoriteShapes       []string    `json:"favoriteShapes"`
+	FavoriteColors       []string    `json:"favoriteColors"`
+	FavoriteStyles       []string    `json:"favoriteStyles"`
+	IsIntrovert          bool        `json:"isIntrovert"`
+	ReferralSource       []string    `json:"referralSource"`
+	Referrer             string      `json:"referrer"`
+	ReferralCode         string      `json:"referralCode"`
+	CustomerNote         string      `json:"customerNote"`
+	StoreNote            string      `json:"storeNote"`
+	Level                string      `json:"level"`
+	IsBlacklisted        bool        `json:"isBlacklisted"`
+	RequiresDeposit      bool        `json:"requiresDeposit"`
+	LastVisitAt          string      `json:"lastVisitAt"`
+	MergedIntoCustomerID *string     `json:"mergedIntoCustomerId"`
+	ErasedAt             string      `json:"erasedAt"`
+	Tags                 []string    `json:"tags"`
+	Notes                []GetNote   `json:"notes"`
+	Metrics              *GetMetrics `json:"metrics"`
+	CreatedAt            string      `json:"createdAt"`
+	UpdatedAt            string      `json:"updatedAt"`
+}
+
+type GetNote struct {
+	ID                string `json:"id"`
+	BookingID         string `json:"bookingId"`
+	Content           string `json:"content"`
+	IsPinned          bool   `json:"isPinned"`
+	CreatedBy         string `json:"createdBy"`
+	CreatedByUsername string `json:"createdByUsername"`
+	CreatedAt         string `json:"createdAt"`
+}
+
+// GetMetrics are calculated by the nightly job, nil before the first calculation
+type GetMetrics struct {
+	LifetimeSpend      int64    `json:"lifetimeSpend"`
+	AverageTicket      float64  `json:"averageTicket"`
+	VisitCount         int32    `json:"visitCount"`
+	VisitFrequencyDays *float64 `json:"visitFrequencyDays"`
+	DaysSinceLastVisit *int32   `json:"daysSinceLastVisit"`
+	NoShowRate         float64  `json:"noShowRate"`
+	CancellationRate   float64  `json:"cancellationRate"`
+	ChurnRiskScore     *int32   `json:"churnRiskScore"`
+	ChurnRiskLevel     string   `json:"churnRiskLevel"`
+	CalculatedAt       string   `json:"calculatedAt"`
 }
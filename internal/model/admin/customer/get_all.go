@@ -1,27 +1,41 @@
 package adminCustomer
 
 type GetAllRequest struct {
-	Name          *string `form:"name" binding:"omitempty,noBlank,max=100"`
-	LineName      *string `form:"lineName" binding:"omitempty,noBlank,max=100"`
-	Phone         *string `form:"phone" binding:"omitempty,noBlank,max=20"`
-	Level         *string `form:"level" binding:"omitempty,oneof=NORMAL VIP VVIP"`
-	IsBlacklisted *bool   `form:"isBlacklisted" binding:"omitempty"`
-	MinPastDays   *int    `form:"minPastDays" binding:"omitempty,min=0,max=365"`
-	Limit         *int    `form:"limit" binding:"omitempty,min=1,max=100"`
-	Offset        *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
-	Sort          *string `form:"sort" binding:"omitempty"`
+	Name                *string  `form:"name" binding:"omitempty,noBlank,max=100"`
+	LineName            *string  `form:"lineName" binding:"omitempty,noBlank,max=100"`
+	Phone               *string  `form:"phone" binding:"omitempty,noBlank,max=20"`
+	Level               *string  `form:"level" binding:"omitempty,oneof=NORMAL VIP VVIP"`
+	IsBlacklisted       *bool    `form:"isBlacklisted" binding:"omitempty"`
+	RequiresDeposit     *bool    `form:"requiresDeposit" binding:"omitempty"`
+	MinPastDays         *int     `form:"minPastDays" binding:"omitempty,min=0,max=365"`
+	Tags                *string  `form:"tags" binding:"omitempty,noBlank,max=500"`
+	ChurnRiskLevel      *string  `form:"churnRiskLevel" binding:"omitempty,oneof=LOW MEDIUM HIGH"`
+	MinChurnRiskScore   *int     `form:"minChurnRiskScore" binding:"omitempty,min=0,max=100"`
+	MaxChurnRiskScore   *int     `form:"maxChurnRiskScore" binding:"omitempty,min=0,max=100"`
+	MinNoShowRate       *float64 `form:"minNoShowRate" binding:"omitempty,min=0,max=1"`
+	MinCancellationRate *float64 `form:"minCancellationRate" binding:"omitempty,min=0,max=1"`
+	Limit               *int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset              *int     `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort                *string  `form:"sort" binding:"omitempty"`
 }
 
 type GetAllParsedRequest struct {
-	Name          *string
-	LineName      *string
-	Phone         *string
-	Level         *string
-	IsBlacklisted *bool
-	MinPastDays   *int
-	Limit         int
-	Offset        int
-	Sort          []string
+	Name                *string
+	LineName            *string
+	Phone               *string
+	Level               *string
+	IsBlacklisted       *bool
+	RequiresDeposit     *bool
+	MinPastDays         *int
+	Tags                *[]string
+	ChurnRiskLevel      *string
+	MinChurnRiskScore   *int
+	MaxChurnRiskScore   *int
+	MinNoShowRate       *float64
+	MinCancellationRate *float64
+	Limit               int
+	Offset              int
+	Sort                []string
 }
 
 type GetAllResponse struct {
@@ -30,14 +44,19 @@ type GetAllResponse struct {
 }
 
 type GetAllCustomerItem struct {
-	ID            string `json:"id"`
-	Name          string `json:"name"`
-	LineName      string `json:"lineName"`
-	Phone         string `json:"phone"`
-	Birthday      string `json:"birthday"`
-	City          string `json:"city"`
-	Level         string `json:"level"`
-	IsBlacklisted bool   `json:"isBlacklisted"`
-	LastVisitAt   string `json:"lastVisitAt,omitempty"`
-	UpdatedAt     string `json:"updatedAt"`
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	LineName        string   `json:"lineName"`
+	Phone           string   `json:"phone"`
+	Birthday        string   `json:"birthday"`
+	City            string   `json:"city"`
+	Level           string   `json:"level"`
+	IsBlacklisted   bool     `json:"isBlacklisted"`
+	RequiresDeposit bool     `json:"requiresDeposit"`
+	LastVisitAt     string   `json:"lastVisitAt,omitempty"`
+	Tags            []string `json:"tags"`
+	LifetimeSpend   *int64   `json:"lifetimeSpend"`
+	ChurnRiskScore  *int32   `json:"churnRiskScore"`
+	ChurnRiskLevel  string   `json:"churnRiskLevel"`
+	UpdatedAt       string   `json:"updatedAt"`
 }
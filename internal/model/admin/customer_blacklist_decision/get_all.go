@@ -0,0 +1,45 @@
+package adminCustomerBlacklistDecision
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type GetAllRequest struct {
+	CustomerID *string `form:"customerId" binding:"omitempty"`
+	Status     *string `form:"status" binding:"omitempty,oneof=ACTIVE OVERTURNED"`
+	RuleType   *string `form:"ruleType" binding:"omitempty,oneof=NO_SHOW LATE_CANCEL"`
+	Action     *string `form:"action" binding:"omitempty,oneof=BLACKLIST DEPOSIT_REQUIRED"`
+	Limit      *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset     *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort       *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	CustomerID *int64
+	Status     *string
+	RuleType   *string
+	Action     *string
+	Limit      int
+	Offset     int
+	Sort       []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID             string                     `json:"id"`
+	CustomerID     string                     `json:"customerId"`
+	CustomerName   string                     `json:"customerName"`
+	CustomerPhone  string                     `json:"customerPhone"`
+	RuleType       string                     `json:"ruleType"`
+	Action         string                     `json:"action"`
+	ThresholdCount int32                      `json:"thresholdCount"`
+	PeriodMonths   int32                      `json:"periodMonths"`
+	Evidence       []common.BlacklistEvidence `json:"evidence"`
+	Status         string                     `json:"status"`
+	OverturnedBy   string                     `json:"overturnedBy"`
+	OverturnReason string                     `json:"overturnReason"`
+	OverturnedAt   string                     `json:"overturnedAt"`
+	CreatedAt      string                     `json:"createdAt"`
+}
@@ -0,0 +1,13 @@
+package adminCustomerBlacklistDecision
+
+type OverturnRequest struct {
+	Reason string `json:"reason" binding:"required,noBlank,max=255"`
+}
+
+type OverturnResponse struct {
+	ID              string `json:"id"`
+	CustomerID      string `json:"customerId"`
+	Status          string `json:"status"`
+	IsBlacklisted   bool   `json:"isBlacklisted"`
+	RequiresDeposit bool   `json:"requiresDeposit"`
+}
@@ -0,0 +1,9 @@
+package adminCustomerDataRequest
+
+type EraseRequest struct {
+	Note *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type EraseResponse struct {
+	ID string `json:"id"`
+}
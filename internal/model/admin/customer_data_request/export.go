@@ -0,0 +1,5 @@
+package adminCustomerDataRequest
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type ExportResponse = common.CustomerDataExport
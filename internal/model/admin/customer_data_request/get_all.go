@@ -0,0 +1,14 @@
+package adminCustomerDataRequest
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID          string `json:"id"`
+	RequestType string `json:"requestType"`
+	RequestedBy string `json:"requestedBy"`
+	StaffUserID string `json:"staffUserId"`
+	Note        string `json:"note"`
+	CreatedAt   string `json:"createdAt"`
+}
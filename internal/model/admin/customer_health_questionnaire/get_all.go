@@ -0,0 +1,28 @@
+package adminCustomerHealthQuestionnaire
+
+type GetAllRequest struct {
+	Limit  *int `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int `form:"offset" binding:"omitempty,min=0,max=1000000"`
+}
+
+type GetAllParsedRequest struct {
+	Limit  int
+	Offset int
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                string   `json:"id"`
+	Version           string   `json:"version"`
+	Allergies         []string `json:"allergies"`
+	AllergyNote       string   `json:"allergyNote"`
+	SkinConditions    []string `json:"skinConditions"`
+	SkinConditionNote string   `json:"skinConditionNote"`
+	IsPregnant        bool     `json:"isPregnant"`
+	OtherNote         string   `json:"otherNote"`
+	AnsweredAt        string   `json:"answeredAt"`
+}
@@ -0,0 +1,30 @@
+package adminCustomerLevelHistory
+
+type GetAllRequest struct {
+	Reason *string `form:"reason" binding:"omitempty,oneof=RULE MANUAL MERGE"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Reason *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID        string `json:"id"`
+	FromLevel string `json:"fromLevel"`
+	ToLevel   string `json:"toLevel"`
+	Reason    string `json:"reason"`
+	Note      string `json:"note"`
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
@@ -0,0 +1,16 @@
+package adminCustomerLevelRule
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	Level                    string  `json:"level"`
+	IsActive                 bool    `json:"isActive"`
+	PeriodMonths             int32   `json:"periodMonths"`
+	MinSpendAmount           int64   `json:"minSpendAmount"`
+	MinVisitCount            int32   `json:"minVisitCount"`
+	BenefitCouponID          *string `json:"benefitCouponId"`
+	BenefitCouponValidMonths *int32  `json:"benefitCouponValidMonths"`
+	UpdatedAt                string  `json:"updatedAt"`
+}
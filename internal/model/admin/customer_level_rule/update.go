@@ -0,0 +1,25 @@
+package adminCustomerLevelRule
+
+type UpdateRequest struct {
+	Level                    string  `json:"level" binding:"required,oneof=VIP VVIP"`
+	IsActive                 *bool   `json:"isActive" binding:"required"`
+	PeriodMonths             int32   `json:"periodMonths" binding:"required,min=1,max=36"`
+	MinSpendAmount           *int64  `json:"minSpendAmount" binding:"required,min=0,max=100000000"`
+	MinVisitCount            *int32  `json:"minVisitCount" binding:"required,min=0,max=1000"`
+	BenefitCouponID          *string `json:"benefitCouponId" binding:"omitempty"`
+	BenefitCouponValidMonths *int32  `json:"benefitCouponValidMonths" binding:"omitempty,min=1,max=36"`
+}
+
+type UpdateParsedRequest struct {
+	Level                    string
+	IsActive                 bool
+	PeriodMonths             int32
+	MinSpendAmount           int64
+	MinVisitCount            int32
+	BenefitCouponID          *int64
+	BenefitCouponValidMonths *int32
+}
+
+type UpdateResponse struct {
+	Level string `json:"level"`
+}
@@ -0,0 +1,18 @@
+package adminCustomerLinkRequest
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                   string `json:"id"`
+	CustomerID           string `json:"customerId"`
+	CustomerName         string `json:"customerName"`
+	CustomerLineName     string `json:"customerLineName"`
+	CustomerPhone        string `json:"customerPhone"`
+	LinkCustomerID       string `json:"linkCustomerId"`
+	LinkCustomerName     string `json:"linkCustomerName"`
+	LinkCustomerPhone    string `json:"linkCustomerPhone"`
+	LinkCustomerBirthday string `json:"linkCustomerBirthday"`
+	CreatedAt            string `json:"createdAt"`
+}
@@ -0,0 +1,13 @@
+package adminCustomerLinkRequest
+
+type ReviewRequest struct {
+	Note *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type ReviewResponse struct {
+	ID              string `json:"id"`
+	CustomerID      string `json:"customerId"`
+	LinkCustomerID  string `json:"linkCustomerId"`
+	Status          string `json:"status"`
+	CustomerMergeID string `json:"customerMergeId,omitempty"`
+}
@@ -0,0 +1,20 @@
+package adminCustomerMerge
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type CreateRequest struct {
+	MergedCustomerID string  `json:"mergedCustomerId" binding:"required"`
+	Note             *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	MergedCustomerID int64
+	Note             *string
+}
+
+type CreateResponse struct {
+	ID               string                          `json:"id"`
+	CustomerID       string                          `json:"customerId"`
+	MergedCustomerID string                          `json:"mergedCustomerId"`
+	MovedCounts      common.CustomerMergeMovedCounts `json:"movedCounts"`
+}
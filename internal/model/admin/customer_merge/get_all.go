@@ -0,0 +1,17 @@
+package adminCustomerMerge
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                 string                          `json:"id"`
+	MergedCustomerID   string                          `json:"mergedCustomerId"`
+	MergedCustomerName string                          `json:"mergedCustomerName"`
+	MovedCounts        common.CustomerMergeMovedCounts `json:"movedCounts"`
+	Note               string                          `json:"note"`
+	CreatedBy          string                          `json:"createdBy"`
+	CreatedAt          string                          `json:"createdAt"`
+}
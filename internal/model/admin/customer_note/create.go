@@ -0,0 +1,17 @@
+package adminCustomerNote
+
+type CreateRequest struct {
+	Content   string  `json:"content" binding:"required,noBlank,max=1000"`
+	BookingID *string `json:"bookingId" binding:"omitempty"`
+	IsPinned  *bool   `json:"isPinned" binding:"omitempty"`
+}
+
+type CreateParsedRequest struct {
+	Content   string
+	BookingID *int64
+	IsPinned  bool
+}
+
+type CreateResponse struct {
+	ID string `json:"id"`
+}
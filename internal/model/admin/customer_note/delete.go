@@ -0,0 +1,5 @@
+package adminCustomerNote
+
+type DeleteResponse struct {
+	Deleted string `json:"deleted"`
+}
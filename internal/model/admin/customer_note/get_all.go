@@ -0,0 +1,27 @@
+package adminCustomerNote
+
+type GetAllRequest struct {
+	Limit  *int `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int `form:"offset" binding:"omitempty,min=0,max=1000000"`
+}
+
+type GetAllParsedRequest struct {
+	Limit  int
+	Offset int
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                string `json:"id"`
+	BookingID         string `json:"bookingId"`
+	Content           string `json:"content"`
+	IsPinned          bool   `json:"isPinned"`
+	CreatedBy         string `json:"createdBy"`
+	CreatedByUsername string `json:"createdByUsername"`
+	CreatedAt         string `json:"createdAt"`
+	UpdatedAt         string `json:"updatedAt"`
+}
@@ -0,0 +1,14 @@
+package adminCustomerNote
+
+type UpdateRequest struct {
+	Content  *string `json:"content" binding:"omitempty,noBlank,max=1000"`
+	IsPinned *bool   `json:"isPinned" binding:"omitempty"`
+}
+
+type UpdateResponse struct {
+	ID string `json:"id"`
+}
+
+func (r UpdateRequest) HasUpdates() bool {
+	return r.Content != nil || r.IsPinned != nil
+}
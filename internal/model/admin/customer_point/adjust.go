@@ -0,0 +1,11 @@
+package adminCustomerPoint
+
+type AdjustRequest struct {
+	Points int32  `json:"points" binding:"required,min=-1000000,max=1000000"`
+	Note   string `json:"note" binding:"required,noBlank,max=255"`
+}
+
+type AdjustResponse struct {
+	CustomerID string `json:"customerId"`
+	Balance    int32  `json:"balance"`
+}
@@ -0,0 +1,7 @@
+package adminCustomerPoint
+
+type GetResponse struct {
+	CustomerID string `json:"customerId"`
+	Balance    int32  `json:"balance"`
+	UpdatedAt  string `json:"updatedAt"`
+}
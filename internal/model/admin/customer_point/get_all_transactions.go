@@ -0,0 +1,36 @@
+package adminCustomerPoint
+
+type GetAllTransactionsRequest struct {
+	Type   *string `form:"type" binding:"omitempty,oneof=EARN REDEEM ADJUST EXPIRE REFUND"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllTransactionsParsedRequest struct {
+	Type   *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllTransactionsResponse struct {
+	Total int                      `json:"total"`
+	Items []GetAllTransactionsItem `json:"items"`
+}
+
+type GetAllTransactionsItem struct {
+	ID              string `json:"id"`
+	StoreID         string `json:"storeId"`
+	StoreName       string `json:"storeName"`
+	Type            string `json:"type"`
+	Points          int32  `json:"points"`
+	Balance         int32  `json:"balance"`
+	RemainingPoints int32  `json:"remainingPoints"`
+	ExpiresAt       string `json:"expiresAt"`
+	SourceType      string `json:"sourceType"`
+	SourceID        string `json:"sourceId"`
+	Note            string `json:"note"`
+	CreatedBy       string `json:"createdBy"`
+	CreatedAt       string `json:"createdAt"`
+}
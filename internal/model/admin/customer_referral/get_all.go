@@ -0,0 +1,37 @@
+package adminCustomerReferral
+
+type GetAllRequest struct {
+	Status *string `form:"status" binding:"omitempty,oneof=PENDING COMPLETED"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Status *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                       string           `json:"id"`
+	Referee                  GetAllRefereeDTO `json:"referee"`
+	Status                   string           `json:"status"`
+	FirstCheckoutID          string           `json:"firstCheckoutId"`
+	ReferrerCustomerCouponID string           `json:"referrerCustomerCouponId"`
+	RefereeCustomerCouponID  string           `json:"refereeCustomerCouponId"`
+	CompletedAt              string           `json:"completedAt"`
+	CreatedAt                string           `json:"createdAt"`
+}
+
+type GetAllRefereeDTO struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+}
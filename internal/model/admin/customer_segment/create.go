@@ -0,0 +1,18 @@
+package adminCustomerSegment
+
+type CreateRequest struct {
+	Name              string  `json:"name" binding:"required,noBlank,max=100"`
+	Description       *string `json:"description" binding:"omitempty,max=255"`
+	Level             *string `json:"level" binding:"omitempty,oneof=NORMAL VIP VVIP"`
+	IsBlacklisted     *bool   `json:"isBlacklisted" binding:"omitempty"`
+	MinPastDays       *int32  `json:"minPastDays" binding:"omitempty,min=1,max=365"`
+	SpendPeriodMonths *int32  `json:"spendPeriodMonths" binding:"omitempty,min=1,max=60"`
+	MinTotalSpend     *int64  `json:"minTotalSpend" binding:"omitempty,min=0,max=100000000"`
+	MaxTotalSpend     *int64  `json:"maxTotalSpend" binding:"omitempty,min=0,max=100000000"`
+	MinVisitCount     *int32  `json:"minVisitCount" binding:"omitempty,min=0,max=1000"`
+	MaxVisitCount     *int32  `json:"maxVisitCount" binding:"omitempty,min=0,max=1000"`
+}
+
+type CreateResponse struct {
+	ID string `json:"id"`
+}
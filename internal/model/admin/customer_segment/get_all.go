@@ -0,0 +1,36 @@
+package adminCustomerSegment
+
+type GetAllRequest struct {
+	Name   *string `form:"name" binding:"omitempty,noBlank,max=100"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Name   *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Level             string `json:"level"`
+	IsBlacklisted     *bool  `json:"isBlacklisted"`
+	MinPastDays       *int32 `json:"minPastDays"`
+	SpendPeriodMonths *int32 `json:"spendPeriodMonths"`
+	MinTotalSpend     *int64 `json:"minTotalSpend"`
+	MaxTotalSpend     *int64 `json:"maxTotalSpend"`
+	MinVisitCount     *int32 `json:"minVisitCount"`
+	MaxVisitCount     *int32 `json:"maxVisitCount"`
+	CreatedAt         string `json:"createdAt"`
+	UpdatedAt         string `json:"updatedAt"`
+}
@@ -0,0 +1,28 @@
+package adminCustomerSegment
+
+type GetCustomersRequest struct {
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetCustomersParsedRequest struct {
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetCustomersResponse struct {
+	Total int                `json:"total"`
+	Items []GetCustomersItem `json:"items"`
+}
+
+type GetCustomersItem struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	LineName      string `json:"lineName"`
+	Phone         string `json:"phone"`
+	Level         string `json:"level"`
+	IsBlacklisted bool   `json:"isBlacklisted"`
+	LastVisitAt   string `json:"lastVisitAt,omitempty"`
+}
@@ -0,0 +1,11 @@
+package adminCustomerWallet
+
+type AdjustRequest struct {
+	Amount int64  `json:"amount" binding:"required,min=-1000000,max=1000000"`
+	Note   string `json:"note" binding:"required,noBlank,max=255"`
+}
+
+type AdjustResponse struct {
+	CustomerID string `json:"customerId"`
+	Balance    int64  `json:"balance"`
+}
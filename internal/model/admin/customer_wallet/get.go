@@ -0,0 +1,7 @@
+package adminCustomerWallet
+
+type GetResponse struct {
+	CustomerID string `json:"customerId"`
+	Balance    int64  `json:"balance"`
+	UpdatedAt  string `json:"updatedAt"`
+}
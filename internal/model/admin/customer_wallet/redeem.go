@@ -0,0 +1,11 @@
+package adminCustomerWallet
+
+type RedeemRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+}
+
+type RedeemResponse struct {
+	CustomerID string `json:"customerId"`
+	Amount     int64  `json:"amount"`
+	Balance    int64  `json:"balance"`
+}
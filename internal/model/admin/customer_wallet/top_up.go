@@ -0,0 +1,12 @@
+package adminCustomerWallet
+
+type TopUpRequest struct {
+	Amount        int64   `json:"amount" binding:"required,min=1,max=1000000"`
+	PaymentMethod string  `json:"paymentMethod" binding:"required,oneof=CASH LINE_PAY"`
+	Note          *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type TopUpResponse struct {
+	CustomerID string `json:"customerId"`
+	Balance    int64  `json:"balance"`
+}
@@ -0,0 +1,23 @@
+package adminGiftCard
+
+import "time"
+
+type CreateRequest struct {
+	Amount    int64   `json:"amount" binding:"required,min=1,max=1000000"`
+	ExpiresAt *string `json:"expiresAt" binding:"omitempty"`
+	Note      *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	Amount    int64
+	ExpiresAt *time.Time
+	Note      *string
+}
+
+type CreateResponse struct {
+	ID        string `json:"id"`
+	Code      string `json:"code"`
+	Amount    int64  `json:"amount"`
+	Status    string `json:"status"`
+	ExpiresAt string `json:"expiresAt"`
+}
@@ -0,0 +1,36 @@
+package adminGiftCard
+
+type GetAllRequest struct {
+	Code   *string `form:"code" binding:"omitempty,max=20"`
+	Status *string `form:"status" binding:"omitempty,oneof=ACTIVE REDEEMED"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Code   *string
+	Status *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID                   string `json:"id"`
+	Code                 string `json:"code"`
+	Amount               int64  `json:"amount"`
+	Status               string `json:"status"`
+	ExpiresAt            string `json:"expiresAt"`
+	Note                 string `json:"note"`
+	RedeemedCustomerID   string `json:"redeemedCustomerId"`
+	RedeemedCustomerName string `json:"redeemedCustomerName"`
+	RedeemedAt           string `json:"redeemedAt"`
+	CreatedAt            string `json:"createdAt"`
+	UpdatedAt            string `json:"updatedAt"`
+}
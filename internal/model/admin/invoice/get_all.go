@@ -0,0 +1,42 @@
+package adminInvoice
+
+type GetAllRequest struct {
+	Status        *string `form:"status" binding:"omitempty,oneof=PENDING ISSUED FAILED VOID_PENDING VOIDED VOID_FAILED"`
+	InvoiceNumber *string `form:"invoiceNumber" binding:"omitempty,max=20"`
+	CheckoutID    *string `form:"checkoutId" binding:"omitempty"`
+	Limit         *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset        *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort          *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Status        *string
+	InvoiceNumber *string
+	CheckoutID    *int64
+	Limit         int
+	Offset        int
+	Sort          []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID            string `json:"id"`
+	CheckoutID    string `json:"checkoutId"`
+	CustomerID    string `json:"customerId"`
+	CustomerName  string `json:"customerName"`
+	Amount        int64  `json:"amount"`
+	CarrierType   string `json:"carrierType"`
+	CarrierValue  string `json:"carrierValue"`
+	InvoiceNumber string `json:"invoiceNumber"`
+	Status        string `json:"status"`
+	ErrorMessage  string `json:"errorMessage"`
+	IssuedAt      string `json:"issuedAt"`
+	VoidedAt      string `json:"voidedAt"`
+	VoidReason    string `json:"voidReason"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
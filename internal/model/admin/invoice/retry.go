@@ -0,0 +1,10 @@
+package adminInvoice
+
+type RetryResponse struct {
+	ID            string `json:"id"`
+	InvoiceNumber string `json:"invoiceNumber"`
+	Status        string `json:"status"`
+	ErrorMessage  string `json:"errorMessage"`
+	IssuedAt      string `json:"issuedAt"`
+	VoidedAt      string `json:"voidedAt"`
+}
@@ -0,0 +1,24 @@
+package adminLineCampaign
+
+type CreateRequest struct {
+	Name         string                 `json:"name" binding:"required,noBlank,max=100"`
+	SegmentID    string                 `json:"segmentId" binding:"required"`
+	MessageType  string                 `json:"messageType" binding:"required,oneof=TEXT FLEX"`
+	Text         *string                `json:"text" binding:"omitempty,max=5000"`
+	AltText      *string                `json:"altText" binding:"omitempty,max=400"`
+	FlexContents map[string]interface{} `json:"flexContents" binding:"omitempty"`
+}
+
+type CreateParsedRequest struct {
+	Name         string
+	SegmentID    int64
+	MessageType  string
+	Text         *string
+	AltText      *string
+	FlexContents map[string]interface{}
+}
+
+type CreateResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
@@ -0,0 +1,23 @@
+package adminLineCampaign
+
+import "encoding/json"
+
+type GetResponse struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	SegmentID    string          `json:"segmentId"`
+	MessageType  string          `json:"messageType"`
+	Text         string          `json:"text"`
+	AltText      string          `json:"altText"`
+	FlexContents json.RawMessage `json:"flexContents"`
+	Status       string          `json:"status"`
+	TargetCount  int32           `json:"targetCount"`
+	SentCount    int32           `json:"sentCount"`
+	FailedCount  int32           `json:"failedCount"`
+	ErrorMessage string          `json:"errorMessage"`
+	StartedAt    string          `json:"startedAt"`
+	FinishedAt   string          `json:"finishedAt"`
+	CreatedBy    string          `json:"createdBy"`
+	CreatedAt    string          `json:"createdAt"`
+	UpdatedAt    string          `json:"updatedAt"`
+}
@@ -0,0 +1,37 @@
+package adminLineCampaign
+
+type GetAllRequest struct {
+	SegmentID *string `form:"segmentId" binding:"omitempty"`
+	Status    *string `form:"status" binding:"omitempty,oneof=PENDING RUNNING COMPLETED FAILED"`
+	Limit     *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset    *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort      *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	SegmentID *int64
+	Status    *string
+	Limit     int
+	Offset    int
+	Sort      []string
+}
+
+type GetAllResponse struct {
+	Total int          `json:"total"`
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	SegmentID   string `json:"segmentId"`
+	SegmentName string `json:"segmentName"`
+	MessageType string `json:"messageType"`
+	Status      string `json:"status"`
+	TargetCount int32  `json:"targetCount"`
+	SentCount   int32  `json:"sentCount"`
+	FailedCount int32  `json:"failedCount"`
+	StartedAt   string `json:"startedAt"`
+	FinishedAt  string `json:"finishedAt"`
+	CreatedAt   string `json:"createdAt"`
+}
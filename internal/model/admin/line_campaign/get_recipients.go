@@ -0,0 +1,27 @@
+package adminLineCampaign
+
+type GetRecipientsRequest struct {
+	Status *string `form:"status" binding:"omitempty,oneof=PENDING SENT FAILED"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+}
+
+type GetRecipientsParsedRequest struct {
+	Status *string
+	Limit  int
+	Offset int
+}
+
+type GetRecipientsResponse struct {
+	Total int                 `json:"total"`
+	Items []GetRecipientsItem `json:"items"`
+}
+
+type GetRecipientsItem struct {
+	CustomerID   string `json:"customerId"`
+	CustomerName string `json:"customerName"`
+	Status       string `json:"status"`
+	ErrorMessage string `json:"errorMessage"`
+	SentAt       string `json:"sentAt"`
+	UpdatedAt    string `json:"updatedAt"`
+}
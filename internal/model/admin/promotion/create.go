@@ -0,0 +1,39 @@
+package adminPromotion
+
+import "time"
+
+type CreateRequest struct {
+	Name            string    `json:"name" binding:"required,noBlank,max=100"`
+	StoreID         *string   `json:"storeId" binding:"omitempty"`
+	DiscountRate    *float64  `json:"discountRate" binding:"omitempty,min=0.1,max=0.99"`
+	DiscountAmount  *int64    `json:"discountAmount" binding:"omitempty,min=1,max=1000000"`
+	StartDate       string    `json:"startDate" binding:"required"`
+	EndDate         string    `json:"endDate" binding:"required"`
+	Weekdays        *[]int32  `json:"weekdays" binding:"omitempty,max=7,dive,min=0,max=6"`
+	StartTime       *string   `json:"startTime" binding:"omitempty"`
+	EndTime         *string   `json:"endTime" binding:"omitempty"`
+	ServiceScope    *string   `json:"serviceScope" binding:"omitempty,oneof=ALL MAIN ADDON"`
+	ServiceIds      *[]string `json:"serviceIds" binding:"omitempty,max=50"`
+	StackWithCoupon *bool     `json:"stackWithCoupon" binding:"omitempty"`
+	Note            *string   `json:"note" binding:"omitempty,max=255"`
+}
+
+type CreateParsedRequest struct {
+	Name            string
+	StoreID         *int64
+	DiscountRate    *float64
+	DiscountAmount  *int64
+	StartDate       time.Time
+	EndDate         time.Time
+	Weekdays        []int32
+	StartTime       *time.Time
+	EndTime         *time.Time
+	ServiceScope    string
+	ServiceIds      []int64
+	StackWithCoupon bool
+	Note            *string
+}
+
+type CreateResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,47 @@
+package adminPromotion
+
+type GetAllRequest struct {
+	Name     *string `form:"name" binding:"omitempty,noBlank,max=100"`
+	StoreID  *string `form:"storeId" binding:"omitempty"`
+	IsActive *bool   `form:"isActive" binding:"omitempty"`
+	Limit    *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset   *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort     *string `form:"sort" binding:"omitempty"`
+}
+
+type GetAllParsedRequest struct {
+	Name     *string
+	StoreID  *int64
+	IsActive *bool
+	Limit    int
+	Offset   int
+	Sort     []string
+}
+
+type GetAllResponse struct {
+	Total int                      `json:"total"`
+	Items []GetAllPromotionItemDTO `json:"items"`
+}
+
+type GetAllPromotionItemDTO struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	StoreID             string   `json:"storeId"`
+	StoreName           string   `json:"storeName"`
+	DiscountRate        float64  `json:"discountRate"`
+	DiscountAmount      int64    `json:"discountAmount"`
+	StartDate           string   `json:"startDate"`
+	EndDate             string   `json:"endDate"`
+	Weekdays            []int32  `json:"weekdays"`
+	StartTime           string   `json:"startTime"`
+	EndTime             string   `json:"endTime"`
+	ServiceScope        string   `json:"serviceScope"`
+	ServiceIds          []string `json:"serviceIds"`
+	StackWithCoupon     bool     `json:"stackWithCoupon"`
+	IsActive            bool     `json:"isActive"`
+	AppliedCount        int64    `json:"appliedCount"`
+	TotalDiscountAmount int64    `json:"totalDiscountAmount"`
+	Note                string   `json:"note"`
+	CreatedAt           string   `json:"createdAt"`
+	UpdatedAt           string   `json:"updatedAt"`
+}
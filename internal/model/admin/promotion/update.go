@@ -0,0 +1,44 @@
+package adminPromotion
+
+import "time"
+
+type UpdateRequest struct {
+	Name            *string   `json:"name" binding:"omitempty,noBlank,max=100"`
+	StoreID         *string   `json:"storeId" binding:"omitempty"`
+	StartDate       *string   `json:"startDate" binding:"omitempty"`
+	EndDate         *string   `json:"endDate" binding:"omitempty"`
+	Weekdays        *[]int32  `json:"weekdays" binding:"omitempty,max=7,dive,min=0,max=6"`
+	StartTime       *string   `json:"startTime" binding:"omitempty"`
+	EndTime         *string   `json:"endTime" binding:"omitempty"`
+	ServiceScope    *string   `json:"serviceScope" binding:"omitempty,oneof=ALL MAIN ADDON"`
+	ServiceIds      *[]string `json:"serviceIds" binding:"omitempty,max=50"`
+	StackWithCoupon *bool     `json:"stackWithCoupon" binding:"omitempty"`
+	IsActive        *bool     `json:"isActive" binding:"omitempty"`
+	Note            *string   `json:"note" binding:"omitempty,max=255"`
+}
+
+// UpdateParsedRequest holds the parsed update fields, a zero store id, an empty weekdays or a zero time clears the limit
+type UpdateParsedRequest struct {
+	Name            *string
+	StoreID         *int64
+	StartDate       *time.Time
+	EndDate         *time.Time
+	Weekdays        *[]int32
+	StartTime       *time.Time
+	EndTime         *time.Time
+	ServiceScope    *string
+	ServiceIds      *[]int64
+	StackWithCoupon *bool
+	IsActive        *bool
+	Note            *string
+}
+
+type UpdateResponse struct {
+	ID string `json:"id"`
+}
+
+func (r UpdateRequest) HasUpdates() bool {
+	return r.Name != nil || r.StoreID != nil || r.StartDate != nil || r.EndDate != nil || r.Weekdays != nil ||
+		r.StartTime != nil || r.EndTime != nil || r.ServiceScope != nil || r.ServiceIds != nil ||
+		r.StackWithCoupon != nil || r.IsActive != nil || r.Note != nil
+}
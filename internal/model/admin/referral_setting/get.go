@@ -0,0 +1,9 @@
+package adminReferralSetting
+
+type GetResponse struct {
+	IsEnabled         bool    `json:"isEnabled"`
+	ReferrerCouponID  *string `json:"referrerCouponId"`
+	RefereeCouponID   *string `json:"refereeCouponId"`
+	CouponValidMonths *int32  `json:"couponValidMonths"`
+	UpdatedAt         string  `json:"updatedAt"`
+}
@@ -0,0 +1,19 @@
+package adminReferralSetting
+
+type UpdateRequest struct {
+	IsEnabled         *bool   `json:"isEnabled" binding:"required"`
+	ReferrerCouponID  *string `json:"referrerCouponId" binding:"omitempty"`
+	RefereeCouponID   *string `json:"refereeCouponId" binding:"omitempty"`
+	CouponValidMonths *int32  `json:"couponValidMonths" binding:"omitempty,min=1,max=36"`
+}
+
+type UpdateParsedRequest struct {
+	IsEnabled         bool
+	ReferrerCouponID  *int64
+	RefereeCouponID   *int64
+	CouponValidMonths *int32
+}
+
+type UpdateResponse struct {
+	IsEnabled bool `json:"isEnabled"`
+}
@@ -0,0 +1,34 @@
+package adminReport
+
+import "time"
+
+type GetReferralRequest struct {
+	StartDate *string `form:"startDate" binding:"omitempty"`
+	EndDate   *string `form:"endDate" binding:"omitempty"`
+	Limit     *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset    *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort      *string `form:"sort" binding:"omitempty"`
+}
+
+type GetReferralParsedRequest struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Limit     int
+	Offset    int
+	Sort      []string
+}
+
+type GetReferralResponse struct {
+	Total int            `json:"total"`
+	Items []ReferrerStat `json:"items"`
+}
+
+type ReferrerStat struct {
+	CustomerID     string `json:"customerId"`     // 推薦人ID
+	CustomerName   string `json:"customerName"`   // 推薦人姓名
+	CustomerPhone  string `json:"customerPhone"`  // 推薦人電話
+	ReferralCode   string `json:"referralCode"`   // 推薦碼
+	ReferredCount  int    `json:"referredCount"`  // 推薦人數
+	CompletedCount int    `json:"completedCount"` // 已完成首次消費人數
+	LastReferredAt string `json:"lastReferredAt"` // 最後推薦時間
+}
@@ -0,0 +1,22 @@
+package adminReport
+
+import "time"
+
+type GetStoreWinBackRequest struct {
+	StartDate string `form:"startDate" binding:"required"`
+	EndDate   string `form:"endDate" binding:"required"`
+}
+
+type GetStoreWinBackParsedRequest struct {
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type GetStoreWinBackResponse struct {
+	StartDate         string  `json:"startDate"`
+	EndDate           string  `json:"endDate"`
+	ContactedCount    int     `json:"contactedCount"`    // 已聯繫人數
+	CouponIssuedCount int     `json:"couponIssuedCount"` // 已發送優惠券人數
+	ReturnedCount     int     `json:"returnedCount"`     // 聯繫後回流人數
+	ReturnRate        float64 `json:"returnRate"`        // 回流率
+}
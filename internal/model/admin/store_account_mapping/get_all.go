@@ -0,0 +1,24 @@
+package adminStoreAccountMapping
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID            string        `json:"id"`
+	MappingType   string        `json:"mappingType"`
+	PaymentMethod *string       `json:"paymentMethod"`
+	Payer         *GetAllPayer  `json:"payer"`
+	Account       GetAllAccount `json:"account"`
+	UpdatedAt     string        `json:"updatedAt"`
+}
+
+type GetAllPayer struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GetAllAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,27 @@
+package adminStoreAccountMapping
+
+type UpdateRequest struct {
+	Items []UpdateItem `json:"items" binding:"omitempty,max=50,dive"`
+}
+
+type UpdateItem struct {
+	MappingType   string  `json:"mappingType" binding:"required,oneof=PAYMENT_METHOD EXPENSE_PAYER"`
+	PaymentMethod *string `json:"paymentMethod" binding:"omitempty,oneof=CASH LINE_PAY"`
+	PayerID       *string `json:"payerId" binding:"omitempty"`
+	AccountID     string  `json:"accountId" binding:"required"`
+}
+
+type UpdateParsedRequest struct {
+	Items []UpdateParsedItem
+}
+
+type UpdateParsedItem struct {
+	MappingType   string
+	PaymentMethod *string
+	PayerID       *int64
+	AccountID     int64
+}
+
+type UpdateResponse struct {
+	Count int `json:"count"`
+}
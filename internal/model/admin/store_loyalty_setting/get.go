@@ -0,0 +1,12 @@
+package adminStoreLoyaltySetting
+
+type GetResponse struct {
+	StoreID          string `json:"storeId"`
+	IsEnabled        bool   `json:"isEnabled"`
+	EarnAmountUnit   int32  `json:"earnAmountUnit"`
+	EarnPoints       int32  `json:"earnPoints"`
+	RedeemPointsUnit int32  `json:"redeemPointsUnit"`
+	RedeemAmount     int32  `json:"redeemAmount"`
+	ExpiryMonths     *int32 `json:"expiryMonths"`
+	UpdatedAt        string `json:"updatedAt"`
+}
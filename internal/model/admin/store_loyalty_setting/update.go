@@ -0,0 +1,14 @@
+package adminStoreLoyaltySetting
+
+type UpdateRequest struct {
+	IsEnabled        *bool  `json:"isEnabled" binding:"required"`
+	EarnAmountUnit   int32  `json:"earnAmountUnit" binding:"required,min=1,max=100000"`
+	EarnPoints       int32  `json:"earnPoints" binding:"required,min=1,max=100000"`
+	RedeemPointsUnit int32  `json:"redeemPointsUnit" binding:"required,min=1,max=100000"`
+	RedeemAmount     int32  `json:"redeemAmount" binding:"required,min=1,max=100000"`
+	ExpiryMonths     *int32 `json:"expiryMonths" binding:"omitempty,min=1,max=120"`
+}
+
+type UpdateResponse struct {
+	StoreID string `json:"storeId"`
+}
@@ -0,0 +1,11 @@
+package adminStoreWinBackSetting
+
+type GetResponse struct {
+	StoreID         string  `json:"storeId"`
+	IsEnabled       bool    `json:"isEnabled"`
+	InactiveDays    int32   `json:"inactiveDays"`
+	CouponID        *string `json:"couponId"`
+	CouponValidDays int32   `json:"couponValidDays"`
+	LineMessage     string  `json:"lineMessage"`
+	UpdatedAt       string  `json:"updatedAt"`
+}
@@ -0,0 +1,21 @@
+package adminStoreWinBackSetting
+
+type UpdateRequest struct {
+	IsEnabled       *bool   `json:"isEnabled" binding:"required"`
+	InactiveDays    int32   `json:"inactiveDays" binding:"required,min=7,max=730"`
+	CouponID        *string `json:"couponId" binding:"omitempty"`
+	CouponValidDays int32   `json:"couponValidDays" binding:"required,min=1,max=365"`
+	LineMessage     *string `json:"lineMessage" binding:"omitempty,max=500"`
+}
+
+type UpdateParsedRequest struct {
+	IsEnabled       bool
+	InactiveDays    int32
+	CouponID        *int64
+	CouponValidDays int32
+	LineMessage     *string
+}
+
+type UpdateResponse struct {
+	StoreID string `json:"storeId"`
+}
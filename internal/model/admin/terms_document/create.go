@@ -0,0 +1,23 @@
+package adminTermsDocument
+
+import "time"
+
+type CreateRequest struct {
+	Version       string `json:"version" binding:"required,noBlank,max=50"`
+	Title         string `json:"title" binding:"required,noBlank,max=100"`
+	Content       string `json:"content" binding:"required,noBlank,max=50000"`
+	EffectiveDate string `json:"effectiveDate" binding:"required"`
+	IsRequired    *bool  `json:"isRequired"`
+}
+
+type CreateParsedRequest struct {
+	Version       string
+	Title         string
+	Content       string
+	EffectiveDate time.Time
+	IsRequired    bool
+}
+
+type CreateResponse struct {
+	ID string `json:"id"`
+}
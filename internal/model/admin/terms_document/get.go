@@ -0,0 +1,10 @@
+package adminTermsDocument
+
+type GetResponse struct {
+	ID            string `json:"id"`
+	Version       string `json:"version"`
+	Title         string `json:"title"`
+	Content       string `json:"content"`
+	EffectiveDate string `json:"effectiveDate"`
+	IsRequired    bool   `json:"isRequired"`
+}
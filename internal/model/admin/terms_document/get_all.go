@@ -0,0 +1,16 @@
+package adminTermsDocument
+
+type GetAllResponse struct {
+	Items []GetAllItem `json:"items"`
+}
+
+type GetAllItem struct {
+	ID            string `json:"id"`
+	Version       string `json:"version"`
+	Title         string `json:"title"`
+	EffectiveDate string `json:"effectiveDate"`
+	IsRequired    bool   `json:"isRequired"`
+	CreatedBy     string `json:"createdBy"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
@@ -0,0 +1,14 @@
+package adminTermsDocument
+
+type UpdateRequest struct {
+	Title   *string `json:"title" binding:"omitempty,noBlank,max=100"`
+	Content *string `json:"content" binding:"omitempty,noBlank,max=50000"`
+}
+
+type UpdateResponse struct {
+	ID string `json:"id"`
+}
+
+func (r UpdateRequest) HasUpdates() bool {
+	return r.Title != nil || r.Content != nil
+}
@@ -1,7 +1,7 @@
 package auth
 
 type AcceptTermRequest struct {
-	TermsVersion string `json:"termsVersion" binding:"required,oneof=v1"`
+	TermsVersion string `json:"termsVersion" binding:"required,noBlank,max=50"`
 }
 
 type AcceptTermResponse struct {
@@ -9,6 +9,7 @@ type LineLoginRequest struct {
 type LineLoginResponse struct {
 	NeedRegister   bool                `json:"needRegister"`
 	NeedCheckTerms *bool               `json:"needCheckTerms,omitempty"`
+	TermsVersion   *string             `json:"termsVersion,omitempty"`
 	AccessToken    *string             `json:"accessToken,omitempty"`
 	RefreshToken   *string             `json:"-"`
 	ExpiresIn      *int                `json:"expiresIn,omitempty"`
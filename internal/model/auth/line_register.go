@@ -15,7 +15,9 @@ type LineRegisterRequest struct {
 	IsIntrovert    *bool     `json:"isIntrovert" binding:"omitempty"`
 	ReferralSource *[]string `json:"referralSource" binding:"omitempty,max=20"`
 	Referrer       *string   `json:"referrer" binding:"omitempty,max=100"`
+	ReferralCode   *string   `json:"referralCode" binding:"omitempty,max=20"`
 	CustomerNote   *string   `json:"customerNote" binding:"omitempty,max=255"`
+	LinkCustomer   *bool     `json:"linkCustomer" binding:"omitempty"`
 }
 
 type LoginContext struct {
@@ -0,0 +1,21 @@
+package common
+
+const (
+	AccountStatementLineStatusMatched   = "MATCHED"
+	AccountStatementLineStatusUnmatched = "UNMATCHED"
+	AccountStatementLineStatusCreated   = "CREATED"
+	AccountStatementLineStatusIgnored   = "IGNORED"
+)
+
+const (
+	AccountStatementDateFormatDash    = "YYYY-MM-DD"
+	AccountStatementDateFormatSlash   = "YYYY/MM/DD"
+	AccountStatementDateFormatCompact = "YYYYMMDD"
+	// AccountStatementDateFormatROC is the Minguo calendar date used by most Taiwan banks, e.g. 114/01/02
+	AccountStatementDateFormatROC = "ROC"
+)
+
+const (
+	AccountStatementEncodingUTF8 = "UTF-8"
+	AccountStatementEncodingBig5 = "BIG5"
+)
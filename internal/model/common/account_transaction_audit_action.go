@@ -0,0 +1,7 @@
+package common
+
+const (
+	AccountTransactionAuditActionCreate = "CREATE"
+	AccountTransactionAuditActionUpdate = "UPDATE"
+	AccountTransactionAuditActionDelete = "DELETE"
+)
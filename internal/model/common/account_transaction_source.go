@@ -0,0 +1,10 @@
+package common
+
+const (
+	AccountTransactionSourceCheckout        = "CHECKOUT"
+	AccountTransactionSourceCheckoutRefund  = "CHECKOUT_REFUND"
+	AccountTransactionSourceExpense         = "EXPENSE"
+	AccountTransactionSourceCashDrawerClose = "CASH_DRAWER_CLOSE"
+	AccountTransactionSourceTransfer        = "TRANSFER"
+	AccountTransactionSourceWalletTopUp     = "WALLET_TOP_UP"
+)
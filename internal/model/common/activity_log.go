@@ -14,6 +14,8 @@ const (
 	ActivityAdminBookingUpdate      ActivityLogType = "ADMIN_BOOKING_UPDATE"
 	ActivityAdminBookingCancel      ActivityLogType = "ADMIN_BOOKING_CANCEL"
 	ActivityAdminBookingCompleted   ActivityLogType = "ADMIN_BOOKING_COMPLETED"
+	ActivityBirthdayBenefitIssued   ActivityLogType = "BIRTHDAY_BENEFIT_ISSUED"
+	ActivityWinBackContacted        ActivityLogType = "WIN_BACK_CONTACTED"
 )
 
 type ActivityLogEntry struct {
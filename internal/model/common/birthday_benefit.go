@@ -0,0 +1,12 @@
+package common
+
+const (
+	// BirthdayBenefitIssuePeriodMonth issues the benefit to customers whose birthday is in the current month
+	BirthdayBenefitIssuePeriodMonth = "MONTH"
+	// BirthdayBenefitIssuePeriodDay issues the benefit to customers whose birthday is today
+	BirthdayBenefitIssuePeriodDay = "DAY"
+)
+
+const (
+	CustomerCouponSourceBirthday = "BIRTHDAY"
+)
@@ -0,0 +1,25 @@
+package common
+
+const (
+	BlacklistRuleTypeNoShow     = "NO_SHOW"
+	BlacklistRuleTypeLateCancel = "LATE_CANCEL"
+)
+
+const (
+	BlacklistActionBlacklist       = "BLACKLIST"
+	BlacklistActionDepositRequired = "DEPOSIT_REQUIRED"
+)
+
+const (
+	BlacklistDecisionStatusActive     = "ACTIVE"
+	BlacklistDecisionStatusOverturned = "OVERTURNED"
+)
+
+// BlacklistEvidence is a booking counted by a blacklist rule, stored in the decision as the evidence
+type BlacklistEvidence struct {
+	BookingID   string `json:"bookingId"`
+	Date        string `json:"date"`
+	StartTime   string `json:"startTime"`
+	Status      string `json:"status"`
+	CancelledAt string `json:"cancelledAt"`
+}
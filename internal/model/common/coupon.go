@@ -0,0 +1,7 @@
+package common
+
+const (
+	CouponServiceScopeAll   = "ALL"
+	CouponServiceScopeMain  = "MAIN"
+	CouponServiceScopeAddon = "ADDON"
+)
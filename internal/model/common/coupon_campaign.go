@@ -0,0 +1,12 @@
+package common
+
+const (
+	CouponCampaignStatusPending   = "PENDING"
+	CouponCampaignStatusRunning   = "RUNNING"
+	CouponCampaignStatusCompleted = "COMPLETED"
+	CouponCampaignStatusFailed    = "FAILED"
+)
+
+const (
+	CustomerCouponSourceCampaign = "CAMPAIGN"
+)
@@ -0,0 +1,101 @@
+package common
+
+const (
+	CustomerDataRequestTypeExport  = "EXPORT"
+	CustomerDataRequestTypeErasure = "ERASURE"
+)
+
+const (
+	CustomerDataRequestedByCustomer = "CUSTOMER"
+	CustomerDataRequestedByStaff    = "STAFF"
+)
+
+// ErasedCustomerName replaces the name of an erased customer so reports still show a readable label
+const ErasedCustomerName = "已刪除顧客"
+
+// CustomerDataExport is the archive returned for a personal data export request
+type CustomerDataExport struct {
+	Profile              CustomerDataExportProfile               `json:"profile"`
+	Bookings             []CustomerDataExportBooking             `json:"bookings"`
+	Checkouts            []CustomerDataExportCheckout            `json:"checkouts"`
+	Coupons              []CustomerDataExportCoupon              `json:"coupons"`
+	TermsAcceptances     []CustomerDataExportTermsAcceptance     `json:"termsAcceptances"`
+	HealthQuestionnaires []CustomerDataExportHealthQuestionnaire `json:"healthQuestionnaires"`
+	ExportedAt           string                                  `json:"exportedAt"`
+}
+
+type CustomerDataExportProfile struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	LineName            string   `json:"lineName"`
+	Phone               string   `json:"phone"`
+	Birthday            string   `json:"birthday"`
+	Email               string   `json:"email"`
+	City                string   `json:"city"`
+	FavoriteShapes      []string `json:"favoriteShapes"`
+	FavoriteColors      []string `json:"favoriteColors"`
+	FavoriteStyles      []string `json:"favoriteStyles"`
+	IsIntrovert         bool     `json:"isIntrovert"`
+	ReferralSource      []string `json:"referralSource"`
+	Referrer            string   `json:"referrer"`
+	CustomerNote        string   `json:"customerNote"`
+	Level               string   `json:"level"`
+	ReferralCode        string   `json:"referralCode"`
+	InvoiceCarrierType  string   `json:"invoiceCarrierType"`
+	InvoiceCarrierValue string   `json:"invoiceCarrierValue"`
+	LastVisitAt         string   `json:"lastVisitAt"`
+	CreatedAt           string   `json:"createdAt"`
+}
+
+type CustomerDataExportBooking struct {
+	ID           string   `json:"id"`
+	StoreName    string   `json:"storeName"`
+	StylistName  string   `json:"stylistName"`
+	Date         string   `json:"date"`
+	StartTime    string   `json:"startTime"`
+	EndTime      string   `json:"endTime"`
+	Services     []string `json:"services"`
+	Status       string   `json:"status"`
+	Note         string   `json:"note"`
+	CancelReason string   `json:"cancelReason"`
+	CreatedAt    string   `json:"createdAt"`
+}
+
+type CustomerDataExportCheckout struct {
+	ID             string `json:"id"`
+	BookingID      string `json:"bookingId"`
+	TotalAmount    int64  `json:"totalAmount"`
+	FinalAmount    int64  `json:"finalAmount"`
+	PaidAmount     int64  `json:"paidAmount"`
+	PaymentMethod  string `json:"paymentMethod"`
+	PointsRedeemed int32  `json:"pointsRedeemed"`
+	RefundedAt     string `json:"refundedAt"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+type CustomerDataExportCoupon struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Code        string `json:"code"`
+	ValidFrom   string `json:"validFrom"`
+	ValidTo     string `json:"validTo"`
+	IsUsed      bool   `json:"isUsed"`
+	UsedAt      string `json:"usedAt"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type CustomerDataExportTermsAcceptance struct {
+	TermsVersion string `json:"termsVersion"`
+	AcceptedAt   string `json:"acceptedAt"`
+}
+
+type CustomerDataExportHealthQuestionnaire struct {
+	Version           string   `json:"version"`
+	Allergies         []string `json:"allergies"`
+	AllergyNote       string   `json:"allergyNote"`
+	SkinConditions    []string `json:"skinConditions"`
+	SkinConditionNote string   `json:"skinConditionNote"`
+	IsPregnant        bool     `json:"isPregnant"`
+	OtherNote         string   `json:"otherNote"`
+	AnsweredAt        string   `json:"answeredAt"`
+}
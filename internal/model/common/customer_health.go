@@ -0,0 +1,61 @@
+package common
+
+// CustomerHealthQuestionnaireVersion is the version of the current questions, bump it when the questions change
+// so the customers answering an outdated questionnaire are asked to reload it.
+const CustomerHealthQuestionnaireVersion = "v1"
+
+// Allergies in the questionnaire, also used as the allergens of the services
+const (
+	HealthAllergyGel       = "GEL"
+	HealthAllergyAcrylic   = "ACRYLIC"
+	HealthAllergyAcetone   = "ACETONE"
+	HealthAllergyAdhesive  = "ADHESIVE"
+	HealthAllergyLatex     = "LATEX"
+	HealthAllergyFragrance = "FRAGRANCE"
+)
+
+const (
+	HealthSkinConditionEczema          = "ECZEMA"
+	HealthSkinConditionPsoriasis       = "PSORIASIS"
+	HealthSkinConditionFungalInfection = "FUNGAL_INFECTION"
+	HealthSkinConditionOpenWound       = "OPEN_WOUND"
+)
+
+type HealthQuestionnaireOption struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+var HealthAllergyOptions = []HealthQuestionnaireOption{
+	{Code: HealthAllergyGel, Label: "凝膠 (光療)"},
+	{Code: HealthAllergyAcrylic, Label: "壓克力"},
+	{Code: HealthAllergyAcetone, Label: "丙酮 (卸甲液)"},
+	{Code: HealthAllergyAdhesive, Label: "甲片膠"},
+	{Code: HealthAllergyLatex, Label: "乳膠"},
+	{Code: HealthAllergyFragrance, Label: "香料、精油"},
+}
+
+var HealthSkinConditionOptions = []HealthQuestionnaireOption{
+	{Code: HealthSkinConditionEczema, Label: "濕疹"},
+	{Code: HealthSkinConditionPsoriasis, Label: "乾癬"},
+	{Code: HealthSkinConditionFungalInfection, Label: "灰指甲、黴菌感染"},
+	{Code: HealthSkinConditionOpenWound, Label: "傷口"},
+}
+
+// CustomerHealthFlags are the critical answers of the latest questionnaire shown to the staff
+type CustomerHealthFlags struct {
+	Version           string   `json:"version"`
+	Allergies         []string `json:"allergies"`
+	AllergyNote       string   `json:"allergyNote"`
+	SkinConditions    []string `json:"skinConditions"`
+	SkinConditionNote string   `json:"skinConditionNote"`
+	IsPregnant        bool     `json:"isPregnant"`
+	AnsweredAt        string   `json:"answeredAt"`
+}
+
+// HealthAllergyWarning is a booked service containing allergens the customer is allergic to
+type HealthAllergyWarning struct {
+	ServiceID   string   `json:"serviceId"`
+	ServiceName string   `json:"serviceName"`
+	Allergens   []string `json:"allergens"`
+}
@@ -0,0 +1,25 @@
+package common
+
+const (
+	CustomerLevelNormal = "NORMAL"
+	CustomerLevelVIP    = "VIP"
+	CustomerLevelVVIP   = "VVIP"
+)
+
+const (
+	CustomerLevelChangeReasonRule   = "RULE"
+	CustomerLevelChangeReasonManual = "MANUAL"
+	CustomerLevelChangeReasonMerge  = "MERGE"
+)
+
+// CustomerLevelRank returns the rank of the level, a higher level has a higher rank
+func CustomerLevelRank(level string) int {
+	switch level {
+	case CustomerLevelVIP:
+		return 1
+	case CustomerLevelVVIP:
+		return 2
+	default:
+		return 0
+	}
+}
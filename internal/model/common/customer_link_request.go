@@ -0,0 +1,7 @@
+package common
+
+const (
+	CustomerLinkRequestStatusPending  = "PENDING"
+	CustomerLinkRequestStatusApproved = "APPROVED"
+	CustomerLinkRequestStatusRejected = "REJECTED"
+)
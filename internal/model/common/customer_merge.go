@@ -0,0 +1,18 @@
+package common
+
+// CustomerMergeMovedCounts is what was moved from the merged customer, it is also kept in the merge record
+type CustomerMergeMovedCounts struct {
+	Bookings             int64 `json:"bookings"`
+	Invoices             int64 `json:"invoices"`
+	CustomerCoupons      int64 `json:"customerCoupons"`
+	TermsAcceptances     int64 `json:"termsAcceptances"`
+	Tokens               int64 `json:"tokens"`
+	Referrals            int64 `json:"referrals"`
+	BirthdayBenefits     int64 `json:"birthdayBenefits"`
+	WinBackContacts      int64 `json:"winBackContacts"`
+	Notes                int64 `json:"notes"`
+	HealthQuestionnaires int64 `json:"healthQuestionnaires"`
+	BlacklistDecisions   int64 `json:"blacklistDecisions"`
+	WalletBalance        int64 `json:"walletBalance"`
+	Points               int32 `json:"points"`
+}
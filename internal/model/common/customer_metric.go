@@ -0,0 +1,11 @@
+package common
+
+const (
+	ChurnRiskLevelLow    = "LOW"
+	ChurnRiskLevelMedium = "MEDIUM"
+	ChurnRiskLevelHigh   = "HIGH"
+)
+
+// ChurnDefaultVisitIntervalDays is the expected visit interval of the customers visiting only once,
+// close to the usual refill cycle of gel nails.
+const ChurnDefaultVisitIntervalDays = 30
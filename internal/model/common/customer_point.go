@@ -0,0 +1,14 @@
+package common
+
+const (
+	CustomerPointTransactionTypeEarn   = "EARN"
+	CustomerPointTransactionTypeRedeem = "REDEEM"
+	CustomerPointTransactionTypeAdjust = "ADJUST"
+	CustomerPointTransactionTypeExpire = "EXPIRE"
+	CustomerPointTransactionTypeRefund = "REFUND"
+)
+
+const (
+	CustomerPointTransactionSourceCheckout      = "CHECKOUT"
+	CustomerPointTransactionSourceCustomerMerge = "CUSTOMER_MERGE"
+)
@@ -0,0 +1,10 @@
+package common
+
+const (
+	CustomerReferralStatusPending   = "PENDING"
+	CustomerReferralStatusCompleted = "COMPLETED"
+)
+
+const (
+	CustomerCouponSourceReferral = "REFERRAL"
+)
@@ -1,5 +1,7 @@
 package common
 
-const (
-	CustomerTermsVersion1 = "v1"
-)
+// RequiredTerms is the latest effective terms document the customers must accept
+type RequiredTerms struct {
+	Version       string `json:"version"`
+	EffectiveDate string `json:"effectiveDate"`
+}
@@ -0,0 +1,15 @@
+package common
+
+const (
+	CustomerWalletTransactionTypeTopUp  = "TOP_UP"
+	CustomerWalletTransactionTypeSpend  = "SPEND"
+	CustomerWalletTransactionTypeAdjust = "ADJUST"
+	CustomerWalletTransactionTypeRedeem = "REDEEM"
+	CustomerWalletTransactionTypeRefund = "REFUND"
+)
+
+const (
+	CustomerWalletTransactionSourceCheckout      = "CHECKOUT"
+	CustomerWalletTransactionSourceGiftCard      = "GIFT_CARD"
+	CustomerWalletTransactionSourceCustomerMerge = "CUSTOMER_MERGE"
+)
@@ -0,0 +1,6 @@
+package common
+
+const (
+	GiftCardStatusActive   = "ACTIVE"
+	GiftCardStatusRedeemed = "REDEEMED"
+)
@@ -0,0 +1,17 @@
+package common
+
+const (
+	InvoiceStatusPending     = "PENDING"
+	InvoiceStatusIssued      = "ISSUED"
+	InvoiceStatusFailed      = "FAILED"
+	InvoiceStatusVoidPending = "VOID_PENDING"
+	InvoiceStatusVoided      = "VOIDED"
+	InvoiceStatusVoidFailed  = "VOID_FAILED"
+)
+
+const (
+	InvoiceCarrierTypeMobileBarcode = "MOBILE_BARCODE"
+	InvoiceCarrierTypeDonation      = "DONATION"
+	// InvoiceCarrierTypeNone is only used in request to clear the saved carrier
+	InvoiceCarrierTypeNone = "NONE"
+)
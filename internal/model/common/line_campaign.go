@@ -0,0 +1,19 @@
+package common
+
+const (
+	LineCampaignStatusPending   = "PENDING"
+	LineCampaignStatusRunning   = "RUNNING"
+	LineCampaignStatusCompleted = "COMPLETED"
+	LineCampaignStatusFailed    = "FAILED"
+)
+
+const (
+	LineCampaignMessageTypeText = "TEXT"
+	LineCampaignMessageTypeFlex = "FLEX"
+)
+
+const (
+	LineCampaignRecipientStatusPending = "PENDING"
+	LineCampaignRecipientStatusSent    = "SENT"
+	LineCampaignRecipientStatusFailed  = "FAILED"
+)
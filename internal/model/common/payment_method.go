@@ -0,0 +1,7 @@
+package common
+
+const (
+	PaymentMethodCash    = "CASH"
+	PaymentMethodLinePay = "LINE_PAY"
+	PaymentMethodWallet  = "WALLET"
+)
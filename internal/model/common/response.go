@@ -26,11 +26,12 @@ type StaffJWTClaims struct {
 }
 
 type CustomerContext struct {
-	CustomerID    int64  `json:"customerId"`
-	LineUID       string `json:"lineUid"`
-	Name          string `json:"name"`
-	Level         string `json:"level"`
-	IsBlacklisted bool   `json:"isBlacklisted"`
+	CustomerID                 int64  `json:"customerId"`
+	LineUID                    string `json:"lineUid"`
+	Name                       string `json:"name"`
+	Level                      string `json:"level"`
+	IsBlacklisted              bool   `json:"isBlacklisted"`
+	AcceptedTermsEffectiveDate string `json:"acceptedTermsEffectiveDate"`
 }
 
 type LineJWTClaims struct {
@@ -0,0 +1,6 @@
+package common
+
+const (
+	StoreAccountMappingTypePaymentMethod = "PAYMENT_METHOD"
+	StoreAccountMappingTypeExpensePayer  = "EXPENSE_PAYER"
+)
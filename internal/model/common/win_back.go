@@ -0,0 +1,5 @@
+package common
+
+const (
+	CustomerCouponSourceWinBack = "WIN_BACK"
+)
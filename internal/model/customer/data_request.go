@@ -0,0 +1,13 @@
+package customer
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type ExportMeResponse = common.CustomerDataExport
+
+type EraseMeRequest struct {
+	Note *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type EraseMeResponse struct {
+	ID string `json:"id"`
+}
@@ -1,15 +1,19 @@
 package customer
 
 type GetMeResponse struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	Phone          string   `json:"phone"`
-	Birthday       string   `json:"birthday"`
-	Email          string   `json:"email"`
-	City           string   `json:"city"`
-	FavoriteShapes []string `json:"favoriteShapes"`
-	FavoriteColors []string `json:"favoriteColors"`
-	FavoriteStyles []string `json:"favoriteStyles"`
-	IsIntrovert    bool     `json:"isIntrovert"`
-	CustomerNote   string   `json:"customerNote"`
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Phone               string   `json:"phone"`
+	Birthday            string   `json:"birthday"`
+	Email               string   `json:"email"`
+	City                string   `json:"city"`
+	FavoriteShapes      []string `json:"favoriteShapes"`
+	FavoriteColors      []string `json:"favoriteColors"`
+	FavoriteStyles      []string `json:"favoriteStyles"`
+	IsIntrovert         bool     `json:"isIntrovert"`
+	CustomerNote        string   `json:"customerNote"`
+	InvoiceCarrierType  string   `json:"invoiceCarrierType"`
+	InvoiceCarrierValue string   `json:"invoiceCarrierValue"`
+	Points              int32    `json:"points"`
+	ReferralCode        string   `json:"referralCode"`
 }
@@ -11,25 +11,30 @@ type UpdateMeRequest struct {
 	FavoriteStyles *[]string `json:"favoriteStyles" binding:"omitempty,max=20"`
 	IsIntrovert    *bool     `json:"isIntrovert" binding:"omitempty"`
 	CustomerNote   *string   `json:"customerNote" binding:"omitempty,max=255"`
+	// InvoiceCarrierType NONE clears the saved carrier
+	InvoiceCarrierType  *string `json:"invoiceCarrierType" binding:"omitempty,oneof=MOBILE_BARCODE DONATION NONE"`
+	InvoiceCarrierValue *string `json:"invoiceCarrierValue" binding:"omitempty,max=20"`
 }
 
 type UpdateMeResponse struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	Phone          string   `json:"phone"`
-	Birthday       string   `json:"birthday"`
-	Email          string   `json:"email"`
-	City           string   `json:"city"`
-	FavoriteShapes []string `json:"favoriteShapes"`
-	FavoriteColors []string `json:"favoriteColors"`
-	FavoriteStyles []string `json:"favoriteStyles"`
-	IsIntrovert    bool     `json:"isIntrovert"`
-	CustomerNote   string   `json:"customerNote"`
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Phone               string   `json:"phone"`
+	Birthday            string   `json:"birthday"`
+	Email               string   `json:"email"`
+	City                string   `json:"city"`
+	FavoriteShapes      []string `json:"favoriteShapes"`
+	FavoriteColors      []string `json:"favoriteColors"`
+	FavoriteStyles      []string `json:"favoriteStyles"`
+	IsIntrovert         bool     `json:"isIntrovert"`
+	CustomerNote        string   `json:"customerNote"`
+	InvoiceCarrierType  string   `json:"invoiceCarrierType"`
+	InvoiceCarrierValue string   `json:"invoiceCarrierValue"`
 }
 
 // HasUpdates checks if at least one field is provided for update
 func (req *UpdateMeRequest) HasUpdates() bool {
 	return req.Name != nil || req.Phone != nil || req.Birthday != nil || req.City != nil || req.Email != nil ||
 		req.FavoriteShapes != nil || req.FavoriteColors != nil || req.FavoriteStyles != nil ||
-		req.IsIntrovert != nil || req.CustomerNote != nil
+		req.IsIntrovert != nil || req.CustomerNote != nil || req.InvoiceCarrierType != nil
 }
@@ -1,17 +1,19 @@
 package customerCoupon
 
 type GetAllRequest struct {
-	IsUsed *bool   `form:"isUsed" binding:"omitempty"`
-	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
-	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
-	Sort   *string `form:"sort" binding:"omitempty"`
+	IsUsed    *bool   `form:"isUsed" binding:"omitempty"`
+	BookingID *string `form:"bookingId" binding:"omitempty"`
+	Limit     *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset    *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort      *string `form:"sort" binding:"omitempty"`
 }
 
 type GetAllParsedRequest struct {
-	IsUsed *bool
-	Limit  int
-	Offset int
-	Sort   []string
+	IsUsed    *bool
+	BookingID *int64
+	Limit     int
+	Offset    int
+	Sort      []string
 }
 
 type GetAllResponse struct {
@@ -27,6 +29,8 @@ type GetAllCustomerCouponItem struct {
 	UsedAt    string              `json:"usedAt"`
 	CreatedAt string              `json:"createdAt"`
 	Coupon    GetAllItemCouponDTO `json:"coupon"`
+	// Applicability is only set when bookingId is provided
+	Applicability *GetAllItemApplicabilityDTO `json:"applicability"`
 }
 
 type GetAllItemCouponDTO struct {
@@ -36,3 +40,9 @@ type GetAllItemCouponDTO struct {
 	DiscountAmount int64   `json:"discountAmount"`
 	IsActive       bool    `json:"isActive"`
 }
+
+type GetAllItemApplicabilityDTO struct {
+	IsApplicable bool   `json:"isApplicable"`
+	ReasonCode   string `json:"reasonCode"`
+	Reason       string `json:"reason"`
+}
@@ -0,0 +1,22 @@
+package customerHealthQuestionnaire
+
+import "github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+
+type GetMeResponse struct {
+	Version              string                             `json:"version"`
+	AllergyOptions       []common.HealthQuestionnaireOption `json:"allergyOptions"`
+	SkinConditionOptions []common.HealthQuestionnaireOption `json:"skinConditionOptions"`
+	Answer               *GetMeAnswer                       `json:"answer"`
+	NeedsUpdate          bool                               `json:"needsUpdate"`
+}
+
+type GetMeAnswer struct {
+	Version           string   `json:"version"`
+	Allergies         []string `json:"allergies"`
+	AllergyNote       string   `json:"allergyNote"`
+	SkinConditions    []string `json:"skinConditions"`
+	SkinConditionNote string   `json:"skinConditionNote"`
+	IsPregnant        bool     `json:"isPregnant"`
+	OtherNote         string   `json:"otherNote"`
+	AnsweredAt        string   `json:"answeredAt"`
+}
@@ -0,0 +1,17 @@
+package customerHealthQuestionnaire
+
+type SubmitMeRequest struct {
+	Version           string   `json:"version" binding:"required,noBlank,max=20"`
+	Allergies         []string `json:"allergies" binding:"omitempty,max=10,dive,oneof=GEL ACRYLIC ACETONE ADHESIVE LATEX FRAGRANCE"`
+	AllergyNote       *string  `json:"allergyNote" binding:"omitempty,max=255"`
+	SkinConditions    []string `json:"skinConditions" binding:"omitempty,max=10,dive,oneof=ECZEMA PSORIASIS FUNGAL_INFECTION OPEN_WOUND"`
+	SkinConditionNote *string  `json:"skinConditionNote" binding:"omitempty,max=255"`
+	IsPregnant        *bool    `json:"isPregnant" binding:"required"`
+	OtherNote         *string  `json:"otherNote" binding:"omitempty,max=500"`
+}
+
+type SubmitMeResponse struct {
+	ID         string `json:"id"`
+	Version    string `json:"version"`
+	AnsweredAt string `json:"answeredAt"`
+}
@@ -0,0 +1,6 @@
+package customerWallet
+
+type GetMeResponse struct {
+	Balance   int64  `json:"balance"`
+	UpdatedAt string `json:"updatedAt"`
+}
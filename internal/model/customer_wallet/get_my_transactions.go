@@ -0,0 +1,31 @@
+package customerWallet
+
+type GetMyTransactionsRequest struct {
+	Type   *string `form:"type" binding:"omitempty,oneof=TOP_UP SPEND ADJUST REDEEM REFUND"`
+	Limit  *int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset *int    `form:"offset" binding:"omitempty,min=0,max=1000000"`
+	Sort   *string `form:"sort" binding:"omitempty"`
+}
+
+type GetMyTransactionsParsedRequest struct {
+	Type   *string
+	Limit  int
+	Offset int
+	Sort   []string
+}
+
+type GetMyTransactionsResponse struct {
+	Total int                     `json:"total"`
+	Items []GetMyTransactionsItem `json:"items"`
+}
+
+type GetMyTransactionsItem struct {
+	ID            string `json:"id"`
+	StoreName     string `json:"storeName"`
+	Type          string `json:"type"`
+	Amount        int64  `json:"amount"`
+	Balance       int64  `json:"balance"`
+	PaymentMethod string `json:"paymentMethod"`
+	Note          string `json:"note"`
+	CreatedAt     string `json:"createdAt"`
+}
@@ -0,0 +1,10 @@
+package customerWallet
+
+type RedeemRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+}
+
+type RedeemResponse struct {
+	Amount  int64 `json:"amount"`
+	Balance int64 `json:"balance"`
+}
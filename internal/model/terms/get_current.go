@@ -0,0 +1,9 @@
+package terms
+
+type GetCurrentResponse struct {
+	Version       string `json:"version"`
+	Title         string `json:"title"`
+	Content       string `json:"content"`
+	EffectiveDate string `json:"effectiveDate"`
+	IsRequired    bool   `json:"isRequired"`
+}
@@ -10,3 +10,10 @@ INSERT INTO accounts (
 
 -- name: GetAccountByID :one
 SELECT id, store_id, name, note, is_active FROM accounts WHERE id = $1;
+
+-- name: GetAccountByIDForUpdate :one
+SELECT id, store_id, name, note, is_active FROM accounts WHERE id = $1 FOR UPDATE;
+
+-- name: CountStoreAccountsByIDs :one
+SELECT COUNT(*) FROM accounts
+WHERE id = ANY($1::bigint[]) AND store_id = $2;
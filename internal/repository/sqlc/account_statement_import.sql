@@ -0,0 +1,11 @@
+-- name: CreateAccountStatementImport :exec
+INSERT INTO account_statement_imports (
+    id,
+    account_id,
+    file_name,
+    total_lines,
+    matched_lines,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
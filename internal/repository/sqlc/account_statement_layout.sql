@@ -0,0 +1,46 @@
+-- name: GetAccountStatementLayoutByAccountID :one
+SELECT
+    account_id,
+    encoding,
+    has_header,
+    skip_rows,
+    delimiter,
+    date_column,
+    date_format,
+    description_column,
+    amount_column,
+    deposit_column,
+    withdrawal_column,
+    created_at,
+    updated_at
+FROM account_statement_layouts
+WHERE account_id = $1;
+
+-- name: UpsertAccountStatementLayout :exec
+INSERT INTO account_statement_layouts (
+    account_id,
+    encoding,
+    has_header,
+    skip_rows,
+    delimiter,
+    date_column,
+    date_format,
+    description_column,
+    amount_column,
+    deposit_column,
+    withdrawal_column
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (account_id) DO UPDATE
+SET encoding = EXCLUDED.encoding,
+    has_header = EXCLUDED.has_header,
+    skip_rows = EXCLUDED.skip_rows,
+    delimiter = EXCLUDED.delimiter,
+    date_column = EXCLUDED.date_column,
+    date_format = EXCLUDED.date_format,
+    description_column = EXCLUDED.description_column,
+    amount_column = EXCLUDED.amount_column,
+    deposit_column = EXCLUDED.deposit_column,
+    withdrawal_column = EXCLUDED.withdrawal_column,
+    updated_at = NOW();
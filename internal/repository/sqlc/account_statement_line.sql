@@ -0,0 +1,59 @@
+-- name: CreateAccountStatementLines :copyfrom
+INSERT INTO account_statement_lines (
+    id,
+    import_id,
+    account_id,
+    line_number,
+    transaction_date,
+    description,
+    type,
+    amount,
+    status,
+    account_transaction_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: GetAccountStatementLineByIDForUpdate :one
+SELECT
+    id,
+    import_id,
+    account_id,
+    line_number,
+    transaction_date,
+    description,
+    type,
+    amount,
+    status,
+    account_transaction_id
+FROM account_statement_lines
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetUnreconciledAccountTransactionsByDateRange :many
+SELECT
+    t.id,
+    t.transaction_date,
+    t.type,
+    t.amount
+FROM account_transactions t
+WHERE t.account_id = $1
+  AND t.transaction_date BETWEEN @start_date::date AND @end_date::date
+  AND NOT EXISTS (
+    SELECT 1 FROM account_statement_lines l WHERE l.account_transaction_id = t.id
+  )
+ORDER BY t.transaction_date ASC, t.created_at ASC, t.id ASC;
+
+-- name: ResetAccountStatementLinesByTransactionID :exec
+UPDATE account_statement_lines
+SET status = 'UNMATCHED',
+    account_transaction_id = NULL,
+    updated_at = NOW()
+WHERE account_transaction_id = $1;
+
+-- name: UpdateAccountStatementLineStatus :exec
+UPDATE account_statement_lines
+SET status = $2,
+    account_transaction_id = $3,
+    updated_at = NOW()
+WHERE id = $1;
@@ -6,13 +6,15 @@ INSERT INTO account_transactions (
     type,
     amount,
     balance,
-    note
+    note,
+    source_type,
+    source_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id;
 
 -- name: GetAccountTransactionByID :one
-SELECT id, account_id, transaction_date, type, amount, balance, note
+SELECT id, account_id, transaction_date, type, amount, balance, note, source_type, source_id
 FROM account_transactions
 WHERE id = $1;
 
@@ -21,7 +23,7 @@ SELECT COALESCE(
 (SELECT balance
     FROM account_transactions
     WHERE account_id = $1
-    ORDER BY transaction_date DESC, created_at DESC
+    ORDER BY transaction_date DESC, created_at DESC, id DESC
     LIMIT 1),
     0
 )::int as balance;
@@ -32,7 +34,66 @@ WHERE id = (
     SELECT t.id
     FROM account_transactions t
     WHERE t.account_id = $1
-    ORDER BY t.created_at DESC
+    ORDER BY t.created_at DESC, t.id DESC
     LIMIT 1
 )
 RETURNING id;
+
+-- name: GetLatestAccountTransactionByAccountID :one
+SELECT id, source_type, source_id
+FROM account_transactions
+WHERE account_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: GetAccountTransactionsBySource :many
+SELECT id, account_id
+FROM account_transactions
+WHERE source_type = $1 AND source_id = $2;
+
+-- name: DeleteAccountTransactionByID :exec
+DELETE FROM account_transactions WHERE id = $1;
+
+-- name: GetAccountBalanceAsOfDate :one
+SELECT COALESCE(
+(SELECT balance
+    FROM account_transactions
+    WHERE account_id = $1 AND transaction_date <= $2
+    ORDER BY transaction_date DESC, created_at DESC, id DESC
+    LIMIT 1),
+    0
+)::bigint as balance;
+
+-- name: ShiftAccountTransactionBalancesAfterDate :exec
+UPDATE account_transactions
+SET balance = balance + @delta::numeric
+WHERE account_id = $1 AND transaction_date > $2;
+
+-- name: RecomputeAccountTransactionBalances :exec
+WITH running AS (
+    SELECT
+        id,
+        SUM(CASE WHEN type = 'INCOME' THEN amount ELSE -amount END)
+            OVER (ORDER BY transaction_date, created_at, id) AS balance
+    FROM account_transactions
+    WHERE account_id = $1
+)
+UPDATE account_transactions t
+SET balance = running.balance
+FROM running
+WHERE t.id = running.id AND t.balance <> running.balance;
+
+-- name: CheckAccountNegativeBalanceExists :one
+SELECT EXISTS (
+    SELECT 1 FROM account_transactions
+    WHERE account_id = $1 AND balance < 0
+);
+
+-- name: UpdateAccountTransactionEntry :exec
+UPDATE account_transactions
+SET transaction_date = $2,
+    type = $3,
+    amount = $4,
+    note = $5,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,12 @@
+-- name: CreateAccountTransactionAudit :exec
+INSERT INTO account_transaction_audits (
+    id,
+    account_id,
+    account_transaction_id,
+    action,
+    before_data,
+    after_data,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
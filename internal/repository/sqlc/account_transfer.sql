@@ -0,0 +1,24 @@
+-- name: CreateAccountTransfer :exec
+INSERT INTO account_transfers (
+    id,
+    store_id,
+    from_account_id,
+    to_account_id,
+    transaction_date,
+    amount,
+    note,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: DeleteAccountTransferByID :exec
+DELETE FROM account_transfers WHERE id = $1;
+
+-- name: UpdateAccountTransfer :exec
+UPDATE account_transfers
+SET transaction_date = $2,
+    amount = $3,
+    note = $4,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,31 @@
+-- name: GetBirthdayBenefitSetting :one
+SELECT
+  id,
+  is_enabled,
+  coupon_id,
+  coupon_valid_days,
+  issue_period,
+  line_message,
+  created_at,
+  updated_at
+FROM birthday_benefit_settings
+WHERE id = 1;
+
+-- name: UpsertBirthdayBenefitSetting :exec
+INSERT INTO birthday_benefit_settings (
+  id,
+  is_enabled,
+  coupon_id,
+  coupon_valid_days,
+  issue_period,
+  line_message
+) VALUES (
+  1, $1, $2, $3, $4, $5
+)
+ON CONFLICT (id) DO UPDATE
+SET is_enabled = EXCLUDED.is_enabled,
+  coupon_id = EXCLUDED.coupon_id,
+  coupon_valid_days = EXCLUDED.coupon_valid_days,
+  issue_period = EXCLUDED.issue_period,
+  line_message = EXCLUDED.line_message,
+  updated_at = NOW();
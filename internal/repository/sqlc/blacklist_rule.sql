@@ -0,0 +1,45 @@
+-- name: GetAllBlacklistRules :many
+SELECT
+  type,
+  is_active,
+  threshold_count,
+  period_months,
+  late_cancel_hours,
+  action,
+  created_at,
+  updated_at
+FROM blacklist_rules
+ORDER BY type;
+
+-- name: GetActiveBlacklistRules :many
+SELECT
+  type,
+  is_active,
+  threshold_count,
+  period_months,
+  late_cancel_hours,
+  action,
+  created_at,
+  updated_at
+FROM blacklist_rules
+WHERE is_active = true
+ORDER BY CASE action WHEN 'BLACKLIST' THEN 0 ELSE 1 END, type;
+
+-- name: UpsertBlacklistRule :exec
+INSERT INTO blacklist_rules (
+  type,
+  is_active,
+  threshold_count,
+  period_months,
+  late_cancel_hours,
+  action
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (type) DO UPDATE
+SET is_active = EXCLUDED.is_active,
+  threshold_count = EXCLUDED.threshold_count,
+  period_months = EXCLUDED.period_months,
+  late_cancel_hours = EXCLUDED.late_cancel_hours,
+  action = EXCLUDED.action,
+  updated_at = NOW();
@@ -65,7 +65,7 @@ WHERE id = ANY($1::bigint[]);
 
 -- name: CancelBooking :one
 UPDATE bookings
-SET status = $2, cancel_reason = $3, updated_at = NOW()
+SET status = $2, cancel_reason = $3, cancelled_at = NOW(), updated_at = NOW()
 WHERE id = $1
 RETURNING id;
 
@@ -131,4 +131,36 @@ SELECT EXISTS(
     SELECT 1 FROM bookings
     WHERE time_slot_id = $1
     AND status IN ('SCHEDULED', 'COMPLETED', 'CANCELLED', 'NO_SHOW')
-) as exists;
\ No newline at end of file
+) as exists;
+
+-- name: GetCustomerBookingsForExport :many
+SELECT
+  b.id,
+  st.name AS store_name,
+  sy.name AS stylist_name,
+  sc.work_date,
+  ts.start_time,
+  ts.end_time,
+  b.status,
+  b.note,
+  b.cancel_reason,
+  ARRAY(
+    SELECT s.name
+    FROM booking_details bd
+    JOIN services s ON s.id = bd.service_id
+    WHERE bd.booking_id = b.id
+    ORDER BY bd.id
+  )::text[] AS service_names,
+  b.created_at
+FROM bookings b
+JOIN stores st ON st.id = b.store_id
+JOIN stylists sy ON sy.id = b.stylist_id
+JOIN time_slots ts ON ts.id = b.time_slot_id
+JOIN schedules sc ON sc.id = ts.schedule_id
+WHERE b.customer_id = $1
+ORDER BY sc.work_date DESC, ts.start_time DESC;
+
+-- name: CheckCustomerHasScheduledBookings :one
+SELECT EXISTS (
+  SELECT 1 FROM bookings WHERE customer_id = $1 AND status = 'SCHEDULED'
+);
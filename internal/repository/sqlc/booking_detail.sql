@@ -16,6 +16,8 @@ SET
     price = $2,
     discount_rate = $3,
     discount_amount = $4,
+    promotion_id = $5,
+    promotion_discount_amount = $6,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -28,6 +30,7 @@ SELECT
     bd.price,
     bd.discount_rate,
     bd.discount_amount,
+    bd.promotion_discount_amount,
     bd.created_at,
     srv.is_addon
 FROM booking_details bd
@@ -48,10 +51,13 @@ ORDER BY bd.booking_id ASC, srv.is_addon ASC, srv.name;
 
 -- name: GetBookingDetailPriceInfoByBookingID :many
 SELECT
-    id,
-    price,
-    discount_rate,
-    discount_amount
-FROM booking_details
-WHERE booking_id = $1
-ORDER BY id ASC;
+    bd.id,
+    bd.service_id,
+    srv.is_addon,
+    bd.price,
+    bd.discount_rate,
+    bd.discount_amount
+FROM booking_details bd
+JOIN services srv ON bd.service_id = srv.id
+WHERE bd.booking_id = $1
+ORDER BY bd.id ASC;
@@ -0,0 +1,38 @@
+-- name: CreateCashDrawerClose :one
+INSERT INTO cash_drawer_closes (
+    id,
+    store_id,
+    close_date,
+    checkout_count,
+    expected_cash,
+    counted_cash,
+    difference,
+    account_id,
+    account_transaction_id,
+    note,
+    closed_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id;
+
+-- name: CheckCashDrawerCloseExists :one
+SELECT EXISTS(SELECT 1 FROM cash_drawer_closes WHERE store_id = $1 AND close_date = $2);
+
+-- name: GetStoreCashCheckoutSummaryByDate :one
+SELECT
+    COUNT(*) FILTER (WHERE (ck.created_at AT TIME ZONE 'Asia/Taipei')::date = $2::date) as checkout_count,
+    (
+        COALESCE(SUM(ck.paid_amount) FILTER (WHERE (ck.created_at AT TIME ZONE 'Asia/Taipei')::date = $2::date), 0)
+        - COALESCE(SUM(ck.paid_amount) FILTER (WHERE (ck.refunded_at AT TIME ZONE 'Asia/Taipei')::date = $2::date), 0)
+    )::numeric(12,2) as cash_amount
+FROM checkouts ck
+JOIN bookings b ON ck.booking_id = b.id
+WHERE b.store_id = $1
+    AND ck.payment_method = 'CASH'
+    AND (
+        (ck.created_at AT TIME ZONE 'Asia/Taipei')::date = $2::date
+        OR (ck.refunded_at AT TIME ZONE 'Asia/Taipei')::date = $2::date
+    );
+
+-- name: LockCashDrawerByDate :exec
+SELECT pg_advisory_xact_lock(hashtextextended('cash_drawer:' || @store_id::bigint || ':' || @close_date::date, 0));
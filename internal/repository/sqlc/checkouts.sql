@@ -7,11 +7,13 @@ INSERT INTO checkouts (
   paid_amount,
   payment_method,
   coupon_id,
+  points_redeemed,
+  points_discount_amount,
   checkout_user,
   created_at,
   updated_at
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 );
 
 -- name: GetCheckoutByBookingID :one
@@ -25,9 +27,114 @@ SELECT
   c.name as coupon_name,
   c.display_name as coupon_display_name,
   c.code as coupon_code,
+  ck.points_redeemed,
+  ck.points_discount_amount,
   su.username as checkout_user,
+  ck.refunded_at,
+  ck.refund_reason,
+  inv.invoice_number,
+  inv.status as invoice_status,
   ck.created_at
 FROM checkouts ck
 LEFT JOIN coupons c ON c.id = ck.coupon_id
 LEFT JOIN staff_users su ON su.id = ck.checkout_user
-WHERE ck.booking_id = $1;
\ No newline at end of file
+LEFT JOIN invoices inv ON inv.checkout_id = ck.id
+WHERE ck.booking_id = $1;
+
+-- name: GetCheckoutByIDForUpdate :one
+SELECT
+  ck.id,
+  ck.booking_id,
+  b.store_id,
+  b.customer_id,
+  ck.paid_amount,
+  ck.payment_method,
+  ck.refunded_at
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE ck.id = $1
+FOR UPDATE OF ck;
+
+-- name: GetCheckoutReceiptByID :one
+SELECT
+  ck.id,
+  ck.booking_id,
+  b.store_id,
+  s.name as store_name,
+  s.address as store_address,
+  s.phone as store_phone,
+  b.customer_id,
+  cu.name as customer_name,
+  cu.line_uid as customer_line_uid,
+  st.name as stylist_name,
+  sch.work_date,
+  ts.start_time,
+  ts.end_time,
+  ck.total_amount,
+  ck.final_amount,
+  ck.paid_amount,
+  ck.payment_method,
+  ck.coupon_id,
+  c.display_name as coupon_display_name,
+  c.code as coupon_code,
+  ck.points_redeemed,
+  ck.points_discount_amount,
+  su.username as checkout_user,
+  inv.invoice_number,
+  ck.refunded_at,
+  ck.created_at
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+JOIN stores s ON s.id = b.store_id
+JOIN customers cu ON cu.id = b.customer_id
+JOIN stylists st ON st.id = b.stylist_id
+JOIN time_slots ts ON ts.id = b.time_slot_id
+JOIN schedules sch ON sch.id = ts.schedule_id
+LEFT JOIN coupons c ON c.id = ck.coupon_id
+LEFT JOIN staff_users su ON su.id = ck.checkout_user
+LEFT JOIN invoices inv ON inv.checkout_id = ck.id
+WHERE ck.id = $1;
+
+-- name: UpdateCheckoutRefunded :exec
+UPDATE checkouts
+SET refunded_at = $2,
+  refund_reason = $3,
+  refunded_by = $4,
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetCustomerCheckoutStatsSince :one
+SELECT
+  COALESCE(SUM(ck.final_amount), 0)::numeric AS total_spend,
+  COUNT(DISTINCT (ck.created_at AT TIME ZONE 'Asia/Taipei')::date) AS visit_count
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE b.customer_id = $1
+  AND ck.refunded_at IS NULL
+  AND ck.created_at >= $2;
+
+-- name: GetCustomerIDsWithCheckoutsSince :many
+SELECT DISTINCT b.customer_id
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE ck.refunded_at IS NULL
+  AND ck.created_at >= $1
+  AND b.customer_id > $2
+ORDER BY b.customer_id
+LIMIT $3;
+
+-- name: GetCustomerCheckoutsForExport :many
+SELECT
+  c.id,
+  c.booking_id,
+  c.total_amount,
+  c.final_amount,
+  c.paid_amount,
+  c.payment_method,
+  c.points_redeemed,
+  c.refunded_at,
+  c.created_at
+FROM checkouts c
+JOIN bookings b ON b.id = c.booking_id
+WHERE b.customer_id = $1
+ORDER BY c.created_at DESC;
@@ -7,9 +7,16 @@ INSERT INTO coupons (
   discount_rate,
   discount_amount,
   is_active,
-  note
+  note,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 );
 
 -- name: GetCouponByIDs :many
@@ -19,7 +26,8 @@ SELECT
   code,
   COALESCE(discount_rate, 0) AS discount_rate,
   COALESCE(discount_amount, 0) AS discount_amount,
-  is_active
+  is_active,
+  is_repeatable
 FROM coupons
 WHERE id = ANY($1::bigint[]);
 
@@ -45,4 +53,33 @@ SELECT EXISTS(
 SELECT EXISTS(
   SELECT 1 FROM coupons
   WHERE code = $1
-);
\ No newline at end of file
+);
+
+-- name: GetCouponRuleByID :one
+SELECT
+  id,
+  is_active,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
+FROM coupons
+WHERE id = $1;
+
+-- name: GetCouponRuleByIDForUpdate :one
+SELECT
+  id,
+  is_active,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
+FROM coupons
+WHERE id = $1
+FOR UPDATE;
@@ -0,0 +1,138 @@
+-- name: CreateCouponCampaign :exec
+INSERT INTO coupon_campaigns (
+  id,
+  name,
+  coupon_id,
+  valid_months,
+  customer_levels,
+  last_visit_from,
+  last_visit_to,
+  birthday_month,
+  store_id,
+  send_line,
+  line_message,
+  status,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+);
+
+-- name: GetCouponCampaignByID :one
+SELECT
+  id,
+  name,
+  coupon_id,
+  valid_months,
+  customer_levels,
+  last_visit_from,
+  last_visit_to,
+  birthday_month,
+  store_id,
+  send_line,
+  line_message,
+  status,
+  target_count,
+  issued_count,
+  skipped_count,
+  line_sent_count,
+  line_failed_count,
+  last_customer_id,
+  error_message,
+  started_at,
+  finished_at,
+  created_by,
+  created_at,
+  updated_at
+FROM coupon_campaigns
+WHERE id = $1;
+
+-- name: CountCouponCampaignTargetCustomers :one
+SELECT COUNT(*)
+FROM customers c
+JOIN coupon_campaigns cc ON cc.id = $1
+WHERE COALESCE(c.is_blacklisted, false) = false
+  AND c.merged_into_customer_id IS NULL
+  AND c.erased_at IS NULL
+  AND (cc.customer_levels IS NULL OR c.level = ANY(cc.customer_levels))
+  AND (cc.last_visit_from IS NULL OR (c.last_visit_at AT TIME ZONE 'Asia/Taipei')::date >= cc.last_visit_from)
+  AND (cc.last_visit_to IS NULL OR (c.last_visit_at AT TIME ZONE 'Asia/Taipei')::date <= cc.last_visit_to)
+  AND (cc.birthday_month IS NULL OR EXTRACT(MONTH FROM c.birthday) = cc.birthday_month)
+  AND (cc.store_id IS NULL OR EXISTS (
+    SELECT 1 FROM bookings b
+    WHERE b.customer_id = c.id
+      AND b.store_id = cc.store_id
+      AND b.status = 'COMPLETED'
+  ));
+
+-- name: GetCouponCampaignTargetCustomers :many
+SELECT
+  c.id,
+  c.line_uid
+FROM customers c
+JOIN coupon_campaigns cc ON cc.id = $1
+WHERE COALESCE(c.is_blacklisted, false) = false
+  AND c.merged_into_customer_id IS NULL
+  AND c.erased_at IS NULL
+  AND (cc.customer_levels IS NULL OR c.level = ANY(cc.customer_levels))
+  AND (cc.last_visit_from IS NULL OR (c.last_visit_at AT TIME ZONE 'Asia/Taipei')::date >= cc.last_visit_from)
+  AND (cc.last_visit_to IS NULL OR (c.last_visit_at AT TIME ZONE 'Asia/Taipei')::date <= cc.last_visit_to)
+  AND (cc.birthday_month IS NULL OR EXTRACT(MONTH FROM c.birthday) = cc.birthday_month)
+  AND (cc.store_id IS NULL OR EXISTS (
+    SELECT 1 FROM bookings b
+    WHERE b.customer_id = c.id
+      AND b.store_id = cc.store_id
+      AND b.status = 'COMPLETED'
+  ))
+  AND c.id > $2
+ORDER BY c.id ASC
+LIMIT $3;
+
+-- name: UpdateCouponCampaignRunning :one
+UPDATE coupon_campaigns
+SET status = 'RUNNING',
+  target_count = $2,
+  started_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+RETURNING id;
+
+-- name: UpdateCouponCampaignProgress :exec
+UPDATE coupon_campaigns
+SET issued_count = issued_count + $2,
+  skipped_count = skipped_count + $3,
+  line_sent_count = line_sent_count + $4,
+  line_failed_count = line_failed_count + $5,
+  last_customer_id = $6,
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCouponCampaignCompleted :exec
+UPDATE coupon_campaigns
+SET status = 'COMPLETED',
+  finished_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCouponCampaignFailed :exec
+UPDATE coupon_campaigns
+SET status = 'FAILED',
+  error_message = $2,
+  finished_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetStaleCouponCampaignIDs :many
+SELECT id
+FROM coupon_campaigns
+WHERE status IN ('PENDING', 'RUNNING')
+  AND updated_at < $1
+ORDER BY created_at ASC;
+
+-- name: UpdateCouponCampaignResumed :one
+UPDATE coupon_campaigns
+SET updated_at = NOW()
+WHERE id = $1
+  AND status = 'RUNNING'
+  AND updated_at < $2
+RETURNING id;
@@ -0,0 +1,22 @@
+-- name: CreateCouponServices :exec
+INSERT INTO coupon_services (
+  coupon_id,
+  service_id
+)
+SELECT $1, UNNEST($2::bigint[]);
+
+-- name: DeleteCouponServicesByCouponID :exec
+DELETE FROM coupon_services
+WHERE coupon_id = $1;
+
+-- name: GetCouponServiceIDsByCouponID :many
+SELECT service_id
+FROM coupon_services
+WHERE coupon_id = $1;
+
+-- name: GetCouponServicesByCouponIDs :many
+SELECT
+  coupon_id,
+  service_id
+FROM coupon_services
+WHERE coupon_id = ANY($1::bigint[]);
@@ -1,12 +1,13 @@
 -- name: CreateCustomer :exec
 INSERT INTO customers (id, line_uid, line_name, email, name, phone, birthday, city, favorite_shapes, favorite_colors,
-      favorite_styles, is_introvert, referral_source, referrer, customer_note, level)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+      favorite_styles, is_introvert, referral_source, referrer, customer_note, level, referral_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
 
 -- name: GetCustomerByID :one
 SELECT id, name, line_uid, line_name, phone, birthday, email, city, favorite_shapes, favorite_colors,
       favorite_styles, is_introvert, referral_source, referrer, customer_note,
-      store_note, level, is_blacklisted, last_visit_at, created_at, updated_at
+      store_note, level, is_blacklisted, requires_deposit, last_visit_at, invoice_carrier_type, invoice_carrier_value,
+      referral_code, merged_into_customer_id, erased_at, tags, created_at, updated_at
 FROM customers
 WHERE id = $1;
 
@@ -16,9 +17,14 @@ FROM customers
 WHERE id = ANY($1::bigint[]);
 
 -- name: GetCustomerByLineUid :one
-SELECT id, line_name, name
+SELECT id, line_uid, line_name, name
 FROM customers
-WHERE line_uid = $1;
+WHERE id = (
+  SELECT COALESCE(mc.merged_into_customer_id, mc.id)
+  FROM customers mc
+  WHERE mc.line_uid = $1
+  LIMIT 1
+);
 
 -- name: UpdateCustomerLineName :exec
 UPDATE customers
@@ -34,4 +40,98 @@ WHERE id = $1;
 SELECT EXISTS (SELECT 1 FROM customers WHERE id = $1);
 
 -- name: CheckCustomerExistsByLineUid :one
-SELECT EXISTS (SELECT 1 FROM customers WHERE line_uid = $1);
\ No newline at end of file
+SELECT EXISTS (SELECT 1 FROM customers WHERE line_uid = $1);
+
+-- name: GetCustomerLevelByIDForUpdate :one
+SELECT level
+FROM customers
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateCustomerLevel :exec
+UPDATE customers
+SET level = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetCustomerIDByReferralCode :one
+SELECT COALESCE(merged_into_customer_id, id) AS id
+FROM customers
+WHERE referral_code = $1;
+
+-- name: CreateWalkInCustomer :exec
+INSERT INTO customers (id, name, phone, birthday, email, city, store_note, level, referral_code, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: CheckActiveCustomerExistsByPhone :one
+SELECT EXISTS (SELECT 1 FROM customers WHERE phone = $1 AND merged_into_customer_id IS NULL);
+
+-- name: GetLinkableCustomerByPhoneAndBirthday :one
+SELECT id
+FROM customers
+WHERE phone = $1
+  AND birthday = $2
+  AND line_uid = ''
+  AND merged_into_customer_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: GetCustomerForErasure :one
+SELECT id, name, merged_into_customer_id, erased_at
+FROM customers
+WHERE id = $1
+FOR UPDATE;
+
+-- name: AnonymizeCustomer :exec
+UPDATE customers
+SET name = $1,
+  phone = '',
+  birthday = NULL,
+  line_uid = '',
+  line_name = NULL,
+  email = NULL,
+  city = NULL,
+  favorite_shapes = NULL,
+  favorite_colors = NULL,
+  favorite_styles = NULL,
+  referral_source = NULL,
+  referrer = NULL,
+  referral_code = NULL,
+  customer_note = NULL,
+  store_note = NULL,
+  invoice_carrier_type = NULL,
+  invoice_carrier_value = NULL,
+  tags = '{}',
+  erased_at = NOW(),
+  updated_at = NOW()
+WHERE id = $2
+  OR merged_into_customer_id = $2;
+
+-- name: GetCustomerTagsByID :one
+SELECT tags
+FROM customers
+WHERE id = $1;
+
+-- name: GetCustomerBlacklistStateForUpdate :one
+SELECT is_blacklisted, requires_deposit
+FROM customers
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateCustomerIsBlacklisted :exec
+UPDATE customers
+SET is_blacklisted = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCustomerRequiresDeposit :exec
+UPDATE customers
+SET requires_deposit = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetCustomerIDsWithLevels :many
+SELECT id
+FROM customers
+WHERE level = ANY($1::text[])
+  AND merged_into_customer_id IS NULL
+  AND id > $2
+ORDER BY id
+LIMIT $3;
@@ -0,0 +1,34 @@
+-- name: CreateCustomerBirthdayBenefit :one
+INSERT INTO customer_birthday_benefits (
+  id,
+  customer_id,
+  year
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (customer_id, year) DO NOTHING
+RETURNING id;
+
+-- name: GetBirthdayBenefitTargetCustomers :many
+SELECT
+  c.id,
+  c.name,
+  c.line_uid
+FROM customers c
+WHERE COALESCE(c.is_blacklisted, false) = false
+  AND c.merged_into_customer_id IS NULL
+  AND EXTRACT(MONTH FROM c.birthday)::int = @birthday_month::int
+  AND (@birthday_days::int[] IS NULL OR EXTRACT(DAY FROM c.birthday)::int = ANY(@birthday_days::int[]))
+  AND NOT EXISTS (
+    SELECT 1 FROM customer_birthday_benefits cbb
+    WHERE cbb.customer_id = c.id
+      AND cbb.year = @year::int
+  )
+  AND c.id > @last_customer_id::bigint
+ORDER BY c.id ASC
+LIMIT @batch_size::int;
+
+-- name: UpdateCustomerBirthdayBenefitCustomerCoupon :exec
+UPDATE customer_birthday_benefits
+SET customer_coupon_id = $2
+WHERE id = $1;
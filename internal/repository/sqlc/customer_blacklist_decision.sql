@@ -0,0 +1,71 @@
+-- name: CreateCustomerBlacklistDecision :exec
+INSERT INTO customer_blacklist_decisions (
+  id,
+  customer_id,
+  rule_type,
+  action,
+  threshold_count,
+  period_months,
+  evidence
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetCustomerBlacklistDecisionByIDForUpdate :one
+SELECT
+  id,
+  customer_id,
+  rule_type,
+  action,
+  threshold_count,
+  period_months,
+  evidence,
+  status,
+  overturned_by,
+  overturn_reason,
+  overturned_at,
+  created_at
+FROM customer_blacklist_decisions
+WHERE id = $1
+FOR UPDATE;
+
+-- name: OverturnCustomerBlacklistDecision :exec
+UPDATE customer_blacklist_decisions
+SET status = 'OVERTURNED',
+  overturned_by = $2,
+  overturn_reason = $3,
+  overturned_at = NOW()
+WHERE id = $1;
+
+-- name: CountActiveCustomerBlacklistDecisionsByAction :one
+SELECT COUNT(*)
+FROM customer_blacklist_decisions
+WHERE customer_id = $1
+  AND action = $2
+  AND status = 'ACTIVE';
+
+-- name: GetLatestCustomerBlacklistDecidedAt :one
+SELECT MAX(COALESCE(overturned_at, created_at))::timestamptz AS decided_at
+FROM customer_blacklist_decisions
+WHERE customer_id = $1
+  AND rule_type = $2;
+
+-- name: GetCustomerBlacklistIncidents :many
+SELECT
+  b.id,
+  s.work_date,
+  ts.start_time,
+  b.status,
+  b.cancelled_at
+FROM bookings b
+JOIN time_slots ts ON ts.id = b.time_slot_id
+JOIN schedules s ON s.id = ts.schedule_id
+WHERE b.customer_id = @customer_id
+  AND b.status = @status
+  AND (s.work_date + ts.start_time) AT TIME ZONE 'Asia/Taipei' >= @since::timestamptz
+  AND (@recorded_after::timestamptz IS NULL OR b.cancelled_at > @recorded_after::timestamptz)
+  AND (
+    @late_cancel_hours::int IS NULL
+    OR b.cancelled_at > (s.work_date + ts.start_time) AT TIME ZONE 'Asia/Taipei' - make_interval(hours => @late_cancel_hours::int)
+  )
+ORDER BY s.work_date, ts.start_time;
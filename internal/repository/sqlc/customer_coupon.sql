@@ -16,22 +16,24 @@ SELECT
   c.discount_rate,
   c.discount_amount,
   c.is_active,
+  cc.valid_from,
   cc.valid_to,
   cc.is_used
 FROM customer_coupons cc
 JOIN coupons c ON cc.coupon_id = c.id
 WHERE cc.id = $1;
 
--- name: UpdateCustomerCouponUsed :exec
+-- name: UpdateCustomerCouponUsed :one
 UPDATE customer_coupons
 SET is_used = true,
   used_at = now()
-WHERE id = $1;
+WHERE id = $1 AND is_used = false
+RETURNING id;
 
 -- name: CheckCustomerCouponExists :one
 SELECT EXISTS(
   SELECT 1 FROM customer_coupons
-  WHERE customer_id = $1 AND coupon_id = $2
+  WHERE customer_id = $1 AND coupon_id = $2 AND source_type IS NULL
 );
 
 -- name: GetCustomerCouponForDelete :one
@@ -47,4 +49,45 @@ WHERE id = $1;
 
 -- name: DeleteCustomerCoupon :exec
 DELETE FROM customer_coupons
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: CreateCustomerCouponWithSource :exec
+INSERT INTO customer_coupons (
+  id,
+  customer_id,
+  coupon_id,
+  valid_from,
+  valid_to,
+  source_type,
+  source_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: CountCouponRedemptions :one
+SELECT COUNT(*)
+FROM customer_coupons
+WHERE coupon_id = $1
+  AND is_used = true;
+
+-- name: CountCustomerCouponRedemptions :one
+SELECT COUNT(*)
+FROM customer_coupons
+WHERE customer_id = $1
+  AND coupon_id = $2
+  AND is_used = true;
+
+-- name: GetCustomerCouponsForExport :many
+SELECT
+  cc.id,
+  c.display_name,
+  c.code,
+  cc.valid_from,
+  cc.valid_to,
+  cc.is_used,
+  cc.used_at,
+  cc.created_at
+FROM customer_coupons cc
+JOIN coupons c ON c.id = cc.coupon_id
+WHERE cc.customer_id = $1
+ORDER BY cc.created_at DESC;
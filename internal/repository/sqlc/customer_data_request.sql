@@ -0,0 +1,9 @@
+-- name: CreateCustomerDataRequest :exec
+INSERT INTO customer_data_requests (id, customer_id, request_type, requested_by, staff_user_id, note)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetCustomerDataRequestsByCustomerID :many
+SELECT id, request_type, requested_by, staff_user_id, note, created_at
+FROM customer_data_requests
+WHERE customer_id = $1
+ORDER BY created_at DESC;
@@ -0,0 +1,89 @@
+-- name: CreateCustomerHealthQuestionnaire :one
+INSERT INTO customer_health_questionnaires (
+  id,
+  customer_id,
+  version,
+  allergies,
+  allergy_note,
+  skin_conditions,
+  skin_condition_note,
+  is_pregnant,
+  other_note
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING created_at;
+
+-- name: GetLatestCustomerHealthQuestionnaire :one
+SELECT
+  id,
+  customer_id,
+  version,
+  allergies,
+  allergy_note,
+  skin_conditions,
+  skin_condition_note,
+  is_pregnant,
+  other_note,
+  created_at
+FROM customer_health_questionnaires
+WHERE customer_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: GetLatestCustomerHealthQuestionnairesByCustomerIDs :many
+SELECT DISTINCT ON (customer_id)
+  id,
+  customer_id,
+  version,
+  allergies,
+  allergy_note,
+  skin_conditions,
+  skin_condition_note,
+  is_pregnant,
+  other_note,
+  created_at
+FROM customer_health_questionnaires
+WHERE customer_id = ANY($1::bigint[])
+ORDER BY customer_id, created_at DESC, id DESC;
+
+-- name: CountCustomerHealthQuestionnairesByCustomerID :one
+SELECT COUNT(*) AS total
+FROM customer_health_questionnaires
+WHERE customer_id = $1;
+
+-- name: GetCustomerHealthQuestionnairesByCustomerID :many
+SELECT
+  id,
+  customer_id,
+  version,
+  allergies,
+  allergy_note,
+  skin_conditions,
+  skin_condition_note,
+  is_pregnant,
+  other_note,
+  created_at
+FROM customer_health_questionnaires
+WHERE customer_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: DeleteCustomerHealthQuestionnairesByCustomerID :exec
+DELETE FROM customer_health_questionnaires
+WHERE customer_id = $1;
+
+-- name: GetCustomerHealthQuestionnairesForExport :many
+SELECT
+  id,
+  customer_id,
+  version,
+  allergies,
+  allergy_note,
+  skin_conditions,
+  skin_condition_note,
+  is_pregnant,
+  other_note,
+  created_at
+FROM customer_health_questionnaires
+WHERE customer_id = $1
+ORDER BY created_at, id;
@@ -0,0 +1,19 @@
+-- name: CreateCustomerLevelHistory :exec
+INSERT INTO customer_level_histories (
+  id,
+  customer_id,
+  from_level,
+  to_level,
+  reason,
+  note,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetLatestCustomerLevelHistoryReason :one
+SELECT reason
+FROM customer_level_histories
+WHERE customer_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
@@ -0,0 +1,48 @@
+-- name: GetAllCustomerLevelRules :many
+SELECT
+  level,
+  is_active,
+  period_months,
+  min_spend_amount,
+  min_visit_count,
+  benefit_coupon_id,
+  benefit_coupon_valid_months,
+  created_at,
+  updated_at
+FROM customer_level_rules
+ORDER BY level;
+
+-- name: GetActiveCustomerLevelRules :many
+SELECT
+  level,
+  is_active,
+  period_months,
+  min_spend_amount,
+  min_visit_count,
+  benefit_coupon_id,
+  benefit_coupon_valid_months,
+  created_at,
+  updated_at
+FROM customer_level_rules
+WHERE is_active = true;
+
+-- name: UpsertCustomerLevelRule :exec
+INSERT INTO customer_level_rules (
+  level,
+  is_active,
+  period_months,
+  min_spend_amount,
+  min_visit_count,
+  benefit_coupon_id,
+  benefit_coupon_valid_months
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (level) DO UPDATE
+SET is_active = EXCLUDED.is_active,
+  period_months = EXCLUDED.period_months,
+  min_spend_amount = EXCLUDED.min_spend_amount,
+  min_visit_count = EXCLUDED.min_visit_count,
+  benefit_coupon_id = EXCLUDED.benefit_coupon_id,
+  benefit_coupon_valid_months = EXCLUDED.benefit_coupon_valid_months,
+  updated_at = NOW();
@@ -0,0 +1,53 @@
+-- name: CreateCustomerLinkRequest :exec
+INSERT INTO customer_link_requests (
+  id,
+  customer_id,
+  link_customer_id,
+  status
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: GetCustomerLinkRequestByIDForUpdate :one
+SELECT
+  id,
+  customer_id,
+  link_customer_id,
+  status,
+  customer_merge_id,
+  note,
+  reviewed_by,
+  reviewed_at,
+  created_at,
+  updated_at
+FROM customer_link_requests
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetPendingCustomerLinkRequests :many
+SELECT
+  r.id,
+  r.customer_id,
+  c.name AS customer_name,
+  c.line_name AS customer_line_name,
+  c.phone AS customer_phone,
+  r.link_customer_id,
+  lc.name AS link_customer_name,
+  lc.phone AS link_customer_phone,
+  lc.birthday AS link_customer_birthday,
+  r.created_at
+FROM customer_link_requests r
+JOIN customers c ON c.id = r.customer_id
+JOIN customers lc ON lc.id = r.link_customer_id
+WHERE r.status = 'PENDING'
+ORDER BY r.created_at ASC;
+
+-- name: UpdateCustomerLinkRequestReviewed :exec
+UPDATE customer_link_requests
+SET status = $2,
+  customer_merge_id = $3,
+  note = $4,
+  reviewed_by = $5,
+  reviewed_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,154 @@
+-- name: LockCustomersForMerge :exec
+SELECT id
+FROM customers
+WHERE id = ANY(@ids::bigint[])
+ORDER BY id ASC
+FOR UPDATE;
+
+-- name: MoveCustomerBookings :execrows
+UPDATE bookings
+SET customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE customer_id = @merged_customer_id::bigint;
+
+-- name: MoveCustomerInvoices :execrows
+UPDATE invoices
+SET customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE customer_id = @merged_customer_id::bigint;
+
+-- name: MoveCustomerCoupons :execrows
+UPDATE customer_coupons
+SET customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE customer_id = @merged_customer_id::bigint
+  AND (source_type IS NOT NULL OR NOT EXISTS (
+    SELECT 1 FROM customer_coupons sc
+    WHERE sc.customer_id = @customer_id::bigint
+      AND sc.coupon_id = customer_coupons.coupon_id
+      AND sc.source_type IS NULL
+  ));
+
+-- name: MoveCustomerTermsAcceptances :execrows
+UPDATE customer_terms_acceptance
+SET customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE customer_id = @merged_customer_id::bigint;
+
+-- name: MoveCustomerTokens :execrows
+UPDATE customer_tokens
+SET customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE customer_id = @merged_customer_id::bigint;
+
+-- name: MoveCustomerReferralsAsReferrer :execrows
+UPDATE customer_referrals
+SET referrer_customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE referrer_customer_id = @merged_customer_id::bigint
+  AND referee_customer_id <> @customer_id::bigint;
+
+-- name: MoveCustomerBirthdayBenefits :execrows
+UPDATE customer_birthday_benefits
+SET customer_id = @customer_id::bigint
+WHERE customer_id = @merged_customer_id::bigint
+  AND year NOT IN (
+    SELECT cbb.year FROM customer_birthday_benefits cbb
+    WHERE cbb.customer_id = @customer_id::bigint
+  );
+
+-- name: MoveWinBackContacts :execrows
+UPDATE win_back_contacts
+SET customer_id = @customer_id::bigint
+WHERE customer_id = @merged_customer_id::bigint
+  AND last_visit_at NOT IN (
+    SELECT wbc.last_visit_at FROM win_back_contacts wbc
+    WHERE wbc.customer_id = @customer_id::bigint
+  );
+
+-- name: GetCustomerLastCheckoutAt :one
+SELECT MAX(ck.created_at)::timestamptz AS last_checkout_at
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE b.customer_id = @customer_id::bigint
+  AND ck.refunded_at IS NULL;
+
+-- name: UpdateCustomerMergedProfile :exec
+UPDATE customers
+SET line_uid = @line_uid,
+  line_name = @line_name,
+  email = @email,
+  city = @city,
+  favorite_shapes = @favorite_shapes,
+  favorite_colors = @favorite_colors,
+  favorite_styles = @favorite_styles,
+  referral_source = @referral_source,
+  referrer = @referrer,
+  customer_note = @customer_note,
+  store_note = @store_note,
+  level = @level,
+  is_blacklisted = @is_blacklisted,
+  requires_deposit = @requires_deposit,
+  last_visit_at = @last_visit_at,
+  invoice_carrier_type = @invoice_carrier_type,
+  invoice_carrier_value = @invoice_carrier_value,
+  tags = @tags,
+  updated_at = NOW()
+WHERE id = @id;
+
+-- name: MarkCustomerMerged :exec
+UPDATE customers
+SET merged_into_customer_id = @merged_into_customer_id,
+  merged_at = NOW(),
+  line_uid = @line_uid,
+  line_name = @line_name,
+  updated_at = NOW()
+WHERE id = @id;
+
+-- name: UpdateCustomersMergedInto :exec
+UPDATE customers
+SET merged_into_customer_id = @customer_id::bigint, updated_at = NOW()
+WHERE merged_into_customer_id = @merged_customer_id::bigint;
+
+-- name: CreateCustomerMerge :exec
+INSERT INTO customer_merges (
+  id,
+  customer_id,
+  merged_customer_id,
+  customer_snapshot,
+  merged_customer_snapshot,
+  moved_counts,
+  note,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetCustomerMergesByCustomerID :many
+SELECT
+  id,
+  merged_customer_id,
+  merged_customer_snapshot,
+  moved_counts,
+  note,
+  created_by,
+  created_at
+FROM customer_merges
+WHERE customer_id = $1
+ORDER BY created_at DESC;
+
+-- name: ClearCustomerMergeSnapshots :exec
+UPDATE customer_merges
+SET customer_snapshot = customer_snapshot - $2::text[],
+  merged_customer_snapshot = merged_customer_snapshot - $2::text[]
+WHERE customer_id = $1
+  OR customer_id IN (SELECT id FROM customers WHERE merged_into_customer_id = $1);
+
+-- name: MoveCustomerNotes :execrows
+UPDATE customer_notes
+SET customer_id = $1::bigint, updated_at = NOW()
+WHERE customer_id = $2::bigint;
+
+-- name: MoveCustomerHealthQuestionnaires :execrows
+UPDATE customer_health_questionnaires
+SET customer_id = $1::bigint
+WHERE customer_id = $2::bigint;
+
+-- name: MoveCustomerBlacklistDecisions :execrows
+UPDATE customer_blacklist_decisions
+SET customer_id = $1::bigint
+WHERE customer_id = $2::bigint;
@@ -0,0 +1,81 @@
+-- name: GetCustomerMetricSources :many
+SELECT
+  c.id AS customer_id,
+  ck.lifetime_spend,
+  ck.checkout_count,
+  ck.visit_count,
+  ck.first_visit_at,
+  ck.last_visit_at,
+  bk.booking_count,
+  bk.no_show_count,
+  bk.cancelled_count
+FROM customers c
+CROSS JOIN LATERAL (
+  SELECT
+    COALESCE(SUM(checkouts.final_amount), 0)::numeric AS lifetime_spend,
+    COUNT(*) AS checkout_count,
+    COUNT(DISTINCT (checkouts.created_at AT TIME ZONE 'Asia/Taipei')::date) AS visit_count,
+    MIN(checkouts.created_at)::timestamptz AS first_visit_at,
+    MAX(checkouts.created_at)::timestamptz AS last_visit_at
+  FROM checkouts
+  JOIN bookings ON bookings.id = checkouts.booking_id
+  WHERE bookings.customer_id = c.id
+    AND checkouts.refunded_at IS NULL
+) ck
+CROSS JOIN LATERAL (
+  SELECT
+    COUNT(*) FILTER (WHERE bookings.status IN ('COMPLETED', 'NO_SHOW', 'CANCELLED')) AS booking_count,
+    COUNT(*) FILTER (WHERE bookings.status = 'NO_SHOW') AS no_show_count,
+    COUNT(*) FILTER (WHERE bookings.status = 'CANCELLED') AS cancelled_count
+  FROM bookings
+  WHERE bookings.customer_id = c.id
+) bk
+WHERE c.id > $1
+  AND c.merged_into_customer_id IS NULL
+  AND c.erased_at IS NULL
+ORDER BY c.id
+LIMIT $2;
+
+-- name: UpsertCustomerMetric :exec
+INSERT INTO customer_metrics (
+  customer_id,
+  lifetime_spend,
+  average_ticket,
+  visit_count,
+  visit_frequency_days,
+  days_since_last_visit,
+  no_show_rate,
+  cancellation_rate,
+  churn_risk_score,
+  churn_risk_level,
+  calculated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (customer_id) DO UPDATE
+SET lifetime_spend = EXCLUDED.lifetime_spend,
+  average_ticket = EXCLUDED.average_ticket,
+  visit_count = EXCLUDED.visit_count,
+  visit_frequency_days = EXCLUDED.visit_frequency_days,
+  days_since_last_visit = EXCLUDED.days_since_last_visit,
+  no_show_rate = EXCLUDED.no_show_rate,
+  cancellation_rate = EXCLUDED.cancellation_rate,
+  churn_risk_score = EXCLUDED.churn_risk_score,
+  churn_risk_level = EXCLUDED.churn_risk_level,
+  calculated_at = EXCLUDED.calculated_at;
+
+-- name: GetCustomerMetricByCustomerID :one
+SELECT
+  customer_id,
+  lifetime_spend,
+  average_ticket,
+  visit_count,
+  visit_frequency_days,
+  days_since_last_visit,
+  no_show_rate,
+  cancellation_rate,
+  churn_risk_score,
+  churn_risk_level,
+  calculated_at
+FROM customer_metrics
+WHERE customer_id = $1;
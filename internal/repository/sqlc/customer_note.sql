@@ -0,0 +1,68 @@
+-- name: CreateCustomerNote :exec
+INSERT INTO customer_notes (
+  id,
+  customer_id,
+  booking_id,
+  content,
+  is_pinned,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetCustomerNoteByID :one
+SELECT id, customer_id, booking_id, content, is_pinned, created_by
+FROM customer_notes
+WHERE id = $1;
+
+-- name: UpdateCustomerNote :exec
+UPDATE customer_notes
+SET content = COALESCE($2, content),
+  is_pinned = COALESCE($3, is_pinned),
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteCustomerNote :exec
+DELETE FROM customer_notes
+WHERE id = $1;
+
+-- name: CountCustomerNotesByCustomerID :one
+SELECT COUNT(*) AS total
+FROM customer_notes
+WHERE customer_id = $1;
+
+-- name: GetCustomerNotesByCustomerID :many
+SELECT
+  cn.id,
+  cn.booking_id,
+  cn.content,
+  cn.is_pinned,
+  cn.created_by,
+  su.username AS created_by_username,
+  cn.created_at,
+  cn.updated_at
+FROM customer_notes cn
+LEFT JOIN staff_users su ON su.id = cn.created_by
+WHERE cn.customer_id = $1
+ORDER BY cn.is_pinned DESC, cn.created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetCustomerNotesForBooking :many
+SELECT
+  cn.id,
+  cn.booking_id,
+  cn.content,
+  cn.is_pinned,
+  cn.created_by,
+  su.username AS created_by_username,
+  cn.created_at,
+  cn.updated_at
+FROM customer_notes cn
+LEFT JOIN staff_users su ON su.id = cn.created_by
+WHERE cn.customer_id = $1
+  AND (cn.is_pinned = true OR cn.booking_id = $2::bigint)
+ORDER BY cn.is_pinned DESC, cn.created_at DESC;
+
+-- name: DeleteCustomerNotesByCustomerID :exec
+DELETE FROM customer_notes
+WHERE customer_id = $1;
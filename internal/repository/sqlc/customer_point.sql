@@ -0,0 +1,33 @@
+-- name: CreateCustomerPointIfNotExists :exec
+INSERT INTO customer_points (
+    id,
+    customer_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (customer_id) DO NOTHING;
+
+-- name: GetCustomerPointByCustomerID :one
+SELECT
+    id,
+    customer_id,
+    balance,
+    created_at,
+    updated_at
+FROM customer_points
+WHERE customer_id = $1;
+
+-- name: GetCustomerPointByCustomerIDForUpdate :one
+SELECT
+    id,
+    customer_id,
+    balance
+FROM customer_points
+WHERE customer_id = $1
+FOR UPDATE;
+
+-- name: UpdateCustomerPointBalance :exec
+UPDATE customer_points
+SET balance = $2,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,63 @@
+-- name: CreateCustomerPointTransaction :exec
+INSERT INTO customer_point_transactions (
+    id,
+    customer_id,
+    store_id,
+    type,
+    points,
+    balance,
+    remaining_points,
+    expires_at,
+    source_type,
+    source_id,
+    note,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: GetCustomerPointRemainingLotsForUpdate :many
+SELECT
+    id,
+    remaining_points,
+    expires_at
+FROM customer_point_transactions
+WHERE customer_id = $1
+    AND remaining_points > 0
+ORDER BY expires_at ASC NULLS LAST, created_at ASC
+FOR UPDATE;
+
+-- name: GetExpiredCustomerPointLotsForUpdate :many
+SELECT
+    id,
+    remaining_points
+FROM customer_point_transactions
+WHERE customer_id = $1
+    AND remaining_points > 0
+    AND expires_at < $2
+ORDER BY expires_at ASC, created_at ASC
+FOR UPDATE;
+
+-- name: GetCustomerIDsWithExpiredPoints :many
+SELECT DISTINCT
+    customer_id
+FROM customer_point_transactions
+WHERE remaining_points > 0
+    AND expires_at < $1
+LIMIT $2;
+
+-- name: GetCustomerPointTransactionsBySource :many
+SELECT
+    id,
+    store_id,
+    type,
+    points
+FROM customer_point_transactions
+WHERE source_type = $1
+    AND source_id = $2
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateCustomerPointTransactionRemaining :exec
+UPDATE customer_point_transactions
+SET remaining_points = $2
+WHERE id = $1;
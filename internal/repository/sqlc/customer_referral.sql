@@ -0,0 +1,30 @@
+-- name: CreateCustomerReferral :exec
+INSERT INTO customer_referrals (
+  id,
+  referrer_customer_id,
+  referee_customer_id,
+  referral_code,
+  status
+) VALUES (
+  $1, $2, $3, $4, $5
+);
+
+-- name: GetPendingCustomerReferralByRefereeIDForUpdate :one
+SELECT
+  id,
+  referrer_customer_id,
+  referee_customer_id
+FROM customer_referrals
+WHERE referee_customer_id = $1
+  AND status = 'PENDING'
+FOR UPDATE;
+
+-- name: UpdateCustomerReferralCompleted :exec
+UPDATE customer_referrals
+SET status = 'COMPLETED',
+  first_checkout_id = $2,
+  referrer_customer_coupon_id = $3,
+  referee_customer_coupon_id = $4,
+  completed_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,36 @@
+-- name: CreateCustomerSegment :exec
+INSERT INTO customer_segments (
+  id,
+  name,
+  description,
+  level,
+  is_blacklisted,
+  min_past_days,
+  spend_period_months,
+  min_total_spend,
+  max_total_spend,
+  min_visit_count,
+  max_visit_count,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: GetCustomerSegmentByID :one
+SELECT
+  id,
+  name,
+  description,
+  level,
+  is_blacklisted,
+  min_past_days,
+  spend_period_months,
+  min_total_spend,
+  max_total_spend,
+  min_visit_count,
+  max_visit_count,
+  created_by,
+  created_at,
+  updated_at
+FROM customer_segments
+WHERE id = $1;
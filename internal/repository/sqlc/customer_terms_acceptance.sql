@@ -11,4 +11,22 @@ SELECT EXISTS(
 -- name: GetCustomerTermsAcceptanceByCustomerIDAndVersion :one
 SELECT id, customer_id, terms_version, accepted_at
 FROM customer_terms_acceptance
-WHERE customer_id = $1 AND terms_version = $2;
\ No newline at end of file
+WHERE customer_id = $1 AND terms_version = $2;
+
+-- name: GetCustomerTermsAcceptancesByCustomerID :many
+SELECT id, terms_version, accepted_at
+FROM customer_terms_acceptance
+WHERE customer_id = $1
+ORDER BY accepted_at DESC;
+
+-- name: GetCustomerLatestAcceptedTermsEffectiveDate :one
+SELECT MAX(t.effective_date)::date AS effective_date
+FROM customer_terms_acceptance a
+JOIN terms_documents t ON t.version = a.terms_version
+WHERE a.customer_id = $1;
+
+-- name: CheckCustomerTermsAcceptanceExistsByVersion :one
+SELECT EXISTS(
+  SELECT 1 FROM customer_terms_acceptance
+  WHERE terms_version = $1
+) as exists;
@@ -24,4 +24,8 @@ WHERE id IN (
   FROM customer_tokens
   WHERE is_revoked = true OR expired_at < NOW()
   LIMIT $1
-);
\ No newline at end of file
+);
+
+-- name: DeleteCustomerTokensByCustomerID :exec
+DELETE FROM customer_tokens
+WHERE customer_id = $1;
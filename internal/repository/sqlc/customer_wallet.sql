@@ -0,0 +1,33 @@
+-- name: CreateCustomerWalletIfNotExists :exec
+INSERT INTO customer_wallets (
+    id,
+    customer_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (customer_id) DO NOTHING;
+
+-- name: GetCustomerWalletByCustomerID :one
+SELECT
+    id,
+    customer_id,
+    balance,
+    created_at,
+    updated_at
+FROM customer_wallets
+WHERE customer_id = $1;
+
+-- name: GetCustomerWalletByCustomerIDForUpdate :one
+SELECT
+    id,
+    customer_id,
+    balance
+FROM customer_wallets
+WHERE customer_id = $1
+FOR UPDATE;
+
+-- name: UpdateCustomerWalletBalance :exec
+UPDATE customer_wallets
+SET balance = $2,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,26 @@
+-- name: CreateCustomerWalletTransaction :exec
+INSERT INTO customer_wallet_transactions (
+    id,
+    wallet_id,
+    customer_id,
+    store_id,
+    type,
+    amount,
+    balance,
+    payment_method,
+    source_type,
+    source_id,
+    note,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: GetStoreCashWalletTopUpAmountByDate :one
+SELECT
+    COALESCE(SUM(amount), 0)::numeric(12,2) as cash_amount
+FROM customer_wallet_transactions
+WHERE store_id = $1
+    AND type = 'TOP_UP'
+    AND payment_method = 'CASH'
+    AND (created_at AT TIME ZONE 'Asia/Taipei')::date = $2::date;
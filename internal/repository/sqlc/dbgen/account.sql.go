@@ -11,6 +11,23 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countStoreAccountsByIDs = `-- name: CountStoreAccountsByIDs :one
+SELECT COUNT(*) FROM accounts
+WHERE id = ANY($1::bigint[]) AND store_id = $2
+`
+
+type CountStoreAccountsByIDsParams struct {
+	Column1 []int64 `db:"column_1" json:"column_1"`
+	StoreID int64   `db:"store_id" json:"store_id"`
+}
+
+func (q *Queries) CountStoreAccountsByIDs(ctx context.Context, arg CountStoreAccountsByIDsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countStoreAccountsByIDs, arg.Column1, arg.StoreID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :exec
 INSERT INTO accounts (
     id,
@@ -63,3 +80,28 @@ func (q *Queries) GetAccountByID(ctx context.Context, id int64) (GetAccountByIDR
 	)
 	return i, err
 }
+
+const getAccountByIDForUpdate = `-- name: GetAccountByIDForUpdate :one
+SELECT id, store_id, name, note, is_active FROM accounts WHERE id = $1 FOR UPDATE
+`
+
+type GetAccountByIDForUpdateRow struct {
+	ID       int64       `db:"id" json:"id"`
+	StoreID  int64       `db:"store_id" json:"store_id"`
+	Name     string      `db:"name" json:"name"`
+	Note     pgtype.Text `db:"note" json:"note"`
+	IsActive pgtype.Bool `db:"is_active" json:"is_active"`
+}
+
+func (q *Queries) GetAccountByIDForUpdate(ctx context.Context, id int64) (GetAccountByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getAccountByIDForUpdate, id)
+	var i GetAccountByIDForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.StoreID,
+		&i.Name,
+		&i.Note,
+		&i.IsActive,
+	)
+	return i, err
+}
@@ -11,6 +11,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const checkAccountNegativeBalanceExists = `-- name: CheckAccountNegativeBalanceExists :one
+SELECT EXISTS (
+    SELECT 1 FROM account_transactions
+    WHERE account_id = $1 AND balance < 0
+)
+`
+
+func (q *Queries) CheckAccountNegativeBalanceExists(ctx context.Context, accountID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, checkAccountNegativeBalanceExists, accountID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const createAccountTransaction = `-- name: CreateAccountTransaction :one
 INSERT INTO account_transactions (
     id,
@@ -19,9 +33,11 @@ INSERT INTO account_transactions (
     type,
     amount,
     balance,
-    note
+    note,
+    source_type,
+    source_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id
 `
 
@@ -33,6 +49,8 @@ type CreateAccountTransactionParams struct {
 	Amount          pgtype.Numeric `db:"amount" json:"amount"`
 	Balance         pgtype.Numeric `db:"balance" json:"balance"`
 	Note            pgtype.Text    `db:"note" json:"note"`
+	SourceType      pgtype.Text    `db:"source_type" json:"source_type"`
+	SourceID        pgtype.Int8    `db:"source_id" json:"source_id"`
 }
 
 func (q *Queries) CreateAccountTransaction(ctx context.Context, arg CreateAccountTransactionParams) (int64, error) {
@@ -44,19 +62,30 @@ func (q *Queries) CreateAccountTransaction(ctx context.Context, arg CreateAccoun
 		arg.Amount,
 		arg.Balance,
 		arg.Note,
+		arg.SourceType,
+		arg.SourceID,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const deleteAccountTransactionByID = `-- name: DeleteAccountTransactionByID :exec
+DELETE FROM account_transactions WHERE id = $1
+`
+
+func (q *Queries) DeleteAccountTransactionByID(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteAccountTransactionByID, id)
+	return err
+}
+
 const deleteLatestAccountTransaction = `-- name: DeleteLatestAccountTransaction :one
 DELETE FROM account_transactions
 WHERE id = (
     SELECT t.id
     FROM account_transactions t
     WHERE t.account_id = $1
-    ORDER BY t.created_at DESC
+    ORDER BY t.created_at DESC, t.id DESC
     LIMIT 1
 )
 RETURNING id
@@ -69,8 +98,31 @@ func (q *Queries) DeleteLatestAccountTransaction(ctx context.Context, accountID
 	return id, err
 }
 
+const getAccountBalanceAsOfDate = `-- name: GetAccountBalanceAsOfDate :one
+SELECT COALESCE(
+(SELECT balance
+    FROM account_transactions
+    WHERE account_id = $1 AND transaction_date <= $2
+    ORDER BY transaction_date DESC, created_at DESC, id DESC
+    LIMIT 1),
+    0
+)::bigint as balance
+`
+
+type GetAccountBalanceAsOfDateParams struct {
+	AccountID       int64       `db:"account_id" json:"account_id"`
+	TransactionDate pgtype.Date `db:"transaction_date" json:"transaction_date"`
+}
+
+func (q *Queries) GetAccountBalanceAsOfDate(ctx context.Context, arg GetAccountBalanceAsOfDateParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getAccountBalanceAsOfDate, arg.AccountID, arg.TransactionDate)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getAccountTransactionByID = `-- name: GetAccountTransactionByID :one
-SELECT id, account_id, transaction_date, type, amount, balance, note
+SELECT id, account_id, transaction_date, type, amount, balance, note, source_type, source_id
 FROM account_transactions
 WHERE id = $1
 `
@@ -83,6 +135,8 @@ type GetAccountTransactionByIDRow struct {
 	Amount          pgtype.Numeric `db:"amount" json:"amount"`
 	Balance         pgtype.Numeric `db:"balance" json:"balance"`
 	Note            pgtype.Text    `db:"note" json:"note"`
+	SourceType      pgtype.Text    `db:"source_type" json:"source_type"`
+	SourceID        pgtype.Int8    `db:"source_id" json:"source_id"`
 }
 
 func (q *Queries) GetAccountTransactionByID(ctx context.Context, id int64) (GetAccountTransactionByIDRow, error) {
@@ -96,6 +150,8 @@ func (q *Queries) GetAccountTransactionByID(ctx context.Context, id int64) (GetA
 		&i.Amount,
 		&i.Balance,
 		&i.Note,
+		&i.SourceType,
+		&i.SourceID,
 	)
 	return i, err
 }
@@ -105,7 +161,7 @@ SELECT COALESCE(
 (SELECT balance
     FROM account_transactions
     WHERE account_id = $1
-    ORDER BY transaction_date DESC, created_at DESC
+    ORDER BY transaction_date DESC, created_at DESC, id DESC
     LIMIT 1),
     0
 )::int as balance
@@ -117,3 +173,126 @@ func (q *Queries) GetAccountTransactionCurrentBalance(ctx context.Context, accou
 	err := row.Scan(&balance)
 	return balance, err
 }
+
+const getAccountTransactionsBySource = `-- name: GetAccountTransactionsBySource :many
+SELECT id, account_id
+FROM account_transactions
+WHERE source_type = $1 AND source_id = $2
+`
+
+type GetAccountTransactionsBySourceParams struct {
+	SourceType pgtype.Text `db:"source_type" json:"source_type"`
+	SourceID   pgtype.Int8 `db:"source_id" json:"source_id"`
+}
+
+type GetAccountTransactionsBySourceRow struct {
+	ID        int64 `db:"id" json:"id"`
+	AccountID int64 `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) GetAccountTransactionsBySource(ctx context.Context, arg GetAccountTransactionsBySourceParams) ([]GetAccountTransactionsBySourceRow, error) {
+	rows, err := q.db.Query(ctx, getAccountTransactionsBySource, arg.SourceType, arg.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAccountTransactionsBySourceRow{}
+	for rows.Next() {
+		var i GetAccountTransactionsBySourceRow
+		if err := rows.Scan(&i.ID, &i.AccountID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestAccountTransactionByAccountID = `-- name: GetLatestAccountTransactionByAccountID :one
+SELECT id, source_type, source_id
+FROM account_transactions
+WHERE account_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1
+`
+
+type GetLatestAccountTransactionByAccountIDRow struct {
+	ID         int64       `db:"id" json:"id"`
+	SourceType pgtype.Text `db:"source_type" json:"source_type"`
+	SourceID   pgtype.Int8 `db:"source_id" json:"source_id"`
+}
+
+func (q *Queries) GetLatestAccountTransactionByAccountID(ctx context.Context, accountID int64) (GetLatestAccountTransactionByAccountIDRow, error) {
+	row := q.db.QueryRow(ctx, getLatestAccountTransactionByAccountID, accountID)
+	var i GetLatestAccountTransactionByAccountIDRow
+	err := row.Scan(&i.ID, &i.SourceType, &i.SourceID)
+	return i, err
+}
+
+const recomputeAccountTransactionBalances = `-- name: RecomputeAccountTransactionBalances :exec
+WITH running AS (
+    SELECT
+        id,
+        SUM(CASE WHEN type = 'INCOME' THEN amount ELSE -amount END)
+            OVER (ORDER BY transaction_date, created_at, id) AS balance
+    FROM account_transactions
+    WHERE account_id = $1
+)
+UPDATE account_transactions t
+SET balance = running.balance
+FROM running
+WHERE t.id = running.id AND t.balance <> running.balance
+`
+
+func (q *Queries) RecomputeAccountTransactionBalances(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, recomputeAccountTransactionBalances, accountID)
+	return err
+}
+
+const shiftAccountTransactionBalancesAfterDate = `-- name: ShiftAccountTransactionBalancesAfterDate :exec
+UPDATE account_transactions
+SET balance = balance + $3::numeric
+WHERE account_id = $1 AND transaction_date > $2
+`
+
+type ShiftAccountTransactionBalancesAfterDateParams struct {
+	AccountID       int64          `db:"account_id" json:"account_id"`
+	TransactionDate pgtype.Date    `db:"transaction_date" json:"transaction_date"`
+	Delta           pgtype.Numeric `db:"delta" json:"delta"`
+}
+
+func (q *Queries) ShiftAccountTransactionBalancesAfterDate(ctx context.Context, arg ShiftAccountTransactionBalancesAfterDateParams) error {
+	_, err := q.db.Exec(ctx, shiftAccountTransactionBalancesAfterDate, arg.AccountID, arg.TransactionDate, arg.Delta)
+	return err
+}
+
+const updateAccountTransactionEntry = `-- name: UpdateAccountTransactionEntry :exec
+UPDATE account_transactions
+SET transaction_date = $2,
+    type = $3,
+    amount = $4,
+    note = $5,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateAccountTransactionEntryParams struct {
+	ID              int64          `db:"id" json:"id"`
+	TransactionDate pgtype.Date    `db:"transaction_date" json:"transaction_date"`
+	Type            string         `db:"type" json:"type"`
+	Amount          pgtype.Numeric `db:"amount" json:"amount"`
+	Note            pgtype.Text    `db:"note" json:"note"`
+}
+
+func (q *Queries) UpdateAccountTransactionEntry(ctx context.Context, arg UpdateAccountTransactionEntryParams) error {
+	_, err := q.db.Exec(ctx, updateAccountTransactionEntry,
+		arg.ID,
+		arg.TransactionDate,
+		arg.Type,
+		arg.Amount,
+		arg.Note,
+	)
+	return err
+}
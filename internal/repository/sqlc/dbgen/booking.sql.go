@@ -13,7 +13,7 @@ import (
 
 const cancelBooking = `-- name: CancelBooking :one
 UPDATE bookings
-SET status = $2, cancel_reason = $3, updated_at = NOW()
+SET status = $2, cancel_reason = $3, cancelled_at = NOW(), updated_at = NOW()
 WHERE id = $1
 RETURNING id
 `
@@ -46,6 +46,19 @@ func (q *Queries) CheckAllBookingExistsByTimeSlotID(ctx context.Context, timeSlo
 	return exists, err
 }
 
+const checkCustomerHasScheduledBookings = `-- name: CheckCustomerHasScheduledBookings :one
+SELECT EXISTS (
+  SELECT 1 FROM bookings WHERE customer_id = $1 AND status = 'SCHEDULED'
+)
+`
+
+func (q *Queries) CheckCustomerHasScheduledBookings(ctx context.Context, customerID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, checkCustomerHasScheduledBookings, customerID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const checkValidBookingExistsByTimeSlotID = `-- name: CheckValidBookingExistsByTimeSlotID :one
 SELECT EXISTS(
     SELECT 1 FROM bookings
@@ -246,6 +259,80 @@ func (q *Queries) GetBookingInfoWithDateByID(ctx context.Context, id int64) (Get
 	return i, err
 }
 
+const getCustomerBookingsForExport = `-- name: GetCustomerBookingsForExport :many
+SELECT
+  b.id,
+  st.name AS store_name,
+  sy.name AS stylist_name,
+  sc.work_date,
+  ts.start_time,
+  ts.end_time,
+  b.status,
+  b.note,
+  b.cancel_reason,
+  ARRAY(
+    SELECT s.name
+    FROM booking_details bd
+    JOIN services s ON s.id = bd.service_id
+    WHERE bd.booking_id = b.id
+    ORDER BY bd.id
+  )::text[] AS service_names,
+  b.created_at
+FROM bookings b
+JOIN stores st ON st.id = b.store_id
+JOIN stylists sy ON sy.id = b.stylist_id
+JOIN time_slots ts ON ts.id = b.time_slot_id
+JOIN schedules sc ON sc.id = ts.schedule_id
+WHERE b.customer_id = $1
+ORDER BY sc.work_date DESC, ts.start_time DESC
+`
+
+type GetCustomerBookingsForExportRow struct {
+	ID           int64              `db:"id" json:"id"`
+	StoreName    string             `db:"store_name" json:"store_name"`
+	StylistName  pgtype.Text        `db:"stylist_name" json:"stylist_name"`
+	WorkDate     pgtype.Date        `db:"work_date" json:"work_date"`
+	StartTime    pgtype.Time        `db:"start_time" json:"start_time"`
+	EndTime      pgtype.Time        `db:"end_time" json:"end_time"`
+	Status       string             `db:"status" json:"status"`
+	Note         pgtype.Text        `db:"note" json:"note"`
+	CancelReason pgtype.Text        `db:"cancel_reason" json:"cancel_reason"`
+	ServiceNames []string           `db:"service_names" json:"service_names"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) GetCustomerBookingsForExport(ctx context.Context, customerID int64) ([]GetCustomerBookingsForExportRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerBookingsForExport, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCustomerBookingsForExportRow{}
+	for rows.Next() {
+		var i GetCustomerBookingsForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StoreName,
+			&i.StylistName,
+			&i.WorkDate,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Status,
+			&i.Note,
+			&i.CancelReason,
+			&i.ServiceNames,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStorePerformanceGroupByStylist = `-- name: GetStorePerformanceGroupByStylist :many
 SELECT
     b.stylist_id,
@@ -22,17 +22,22 @@ type CreateBookingDetailsParams struct {
 
 const getBookingDetailPriceInfoByBookingID = `-- name: GetBookingDetailPriceInfoByBookingID :many
 SELECT
-    id,
-    price,
-    discount_rate,
-    discount_amount
-FROM booking_details
-WHERE booking_id = $1
-ORDER BY id ASC
+    bd.id,
+    bd.service_id,
+    srv.is_addon,
+    bd.price,
+    bd.discount_rate,
+    bd.discount_amount
+FROM booking_details bd
+JOIN services srv ON bd.service_id = srv.id
+WHERE bd.booking_id = $1
+ORDER BY bd.id ASC
 `
 
 type GetBookingDetailPriceInfoByBookingIDRow struct {
 	ID             int64          `db:"id" json:"id"`
+	ServiceID      int64          `db:"service_id" json:"service_id"`
+	IsAddon        pgtype.Bool    `db:"is_addon" json:"is_addon"`
 	Price          pgtype.Numeric `db:"price" json:"price"`
 	DiscountRate   pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
 	DiscountAmount pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
@@ -49,6 +54,8 @@ func (q *Queries) GetBookingDetailPriceInfoByBookingID(ctx context.Context, book
 		var i GetBookingDetailPriceInfoByBookingIDRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.ServiceID,
+			&i.IsAddon,
 			&i.Price,
 			&i.DiscountRate,
 			&i.DiscountAmount,
@@ -72,6 +79,7 @@ SELECT
     bd.price,
     bd.discount_rate,
     bd.discount_amount,
+    bd.promotion_discount_amount,
     bd.created_at,
     srv.is_addon
 FROM booking_details bd
@@ -81,15 +89,16 @@ ORDER BY srv.is_addon ASC, srv.name ASC
 `
 
 type GetBookingDetailsByBookingIDRow struct {
-	ID             int64              `db:"id" json:"id"`
-	BookingID      int64              `db:"booking_id" json:"booking_id"`
-	ServiceID      int64              `db:"service_id" json:"service_id"`
-	ServiceName    string             `db:"service_name" json:"service_name"`
-	Price          pgtype.Numeric     `db:"price" json:"price"`
-	DiscountRate   pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
-	DiscountAmount pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	IsAddon        pgtype.Bool        `db:"is_addon" json:"is_addon"`
+	ID                      int64              `db:"id" json:"id"`
+	BookingID               int64              `db:"booking_id" json:"booking_id"`
+	ServiceID               int64              `db:"service_id" json:"service_id"`
+	ServiceName             string             `db:"service_name" json:"service_name"`
+	Price                   pgtype.Numeric     `db:"price" json:"price"`
+	DiscountRate            pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount          pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
+	PromotionDiscountAmount pgtype.Numeric     `db:"promotion_discount_amount" json:"promotion_discount_amount"`
+	CreatedAt               pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	IsAddon                 pgtype.Bool        `db:"is_addon" json:"is_addon"`
 }
 
 func (q *Queries) GetBookingDetailsByBookingID(ctx context.Context, bookingID int64) ([]GetBookingDetailsByBookingIDRow, error) {
@@ -109,6 +118,7 @@ func (q *Queries) GetBookingDetailsByBookingID(ctx context.Context, bookingID in
 			&i.Price,
 			&i.DiscountRate,
 			&i.DiscountAmount,
+			&i.PromotionDiscountAmount,
 			&i.CreatedAt,
 			&i.IsAddon,
 		); err != nil {
@@ -172,15 +182,19 @@ SET
     price = $2,
     discount_rate = $3,
     discount_amount = $4,
+    promotion_id = $5,
+    promotion_discount_amount = $6,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateBookingDetailPriceInfoParams struct {
-	ID             int64          `db:"id" json:"id"`
-	Price          pgtype.Numeric `db:"price" json:"price"`
-	DiscountRate   pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
-	DiscountAmount pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
+	ID                      int64          `db:"id" json:"id"`
+	Price                   pgtype.Numeric `db:"price" json:"price"`
+	DiscountRate            pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount          pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
+	PromotionID             pgtype.Int8    `db:"promotion_id" json:"promotion_id"`
+	PromotionDiscountAmount pgtype.Numeric `db:"promotion_discount_amount" json:"promotion_discount_amount"`
 }
 
 func (q *Queries) UpdateBookingDetailPriceInfo(ctx context.Context, arg UpdateBookingDetailPriceInfoParams) error {
@@ -189,6 +203,8 @@ func (q *Queries) UpdateBookingDetailPriceInfo(ctx context.Context, arg UpdateBo
 		arg.Price,
 		arg.DiscountRate,
 		arg.DiscountAmount,
+		arg.PromotionID,
+		arg.PromotionDiscountAmount,
 	)
 	return err
 }
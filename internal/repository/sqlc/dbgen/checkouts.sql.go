@@ -12,16 +12,18 @@ import (
 )
 
 type BulkCreateCheckoutParams struct {
-	ID            int64              `db:"id" json:"id"`
-	BookingID     int64              `db:"booking_id" json:"booking_id"`
-	TotalAmount   pgtype.Numeric     `db:"total_amount" json:"total_amount"`
-	FinalAmount   pgtype.Numeric     `db:"final_amount" json:"final_amount"`
-	PaidAmount    pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
-	PaymentMethod string             `db:"payment_method" json:"payment_method"`
-	CouponID      pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
-	CheckoutUser  pgtype.Int8        `db:"checkout_user" json:"checkout_user"`
-	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	ID                   int64              `db:"id" json:"id"`
+	BookingID            int64              `db:"booking_id" json:"booking_id"`
+	TotalAmount          pgtype.Numeric     `db:"total_amount" json:"total_amount"`
+	FinalAmount          pgtype.Numeric     `db:"final_amount" json:"final_amount"`
+	PaidAmount           pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod        string             `db:"payment_method" json:"payment_method"`
+	CouponID             pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	PointsRedeemed       int32              `db:"points_redeemed" json:"points_redeemed"`
+	PointsDiscountAmount pgtype.Numeric     `db:"points_discount_amount" json:"points_discount_amount"`
+	CheckoutUser         pgtype.Int8        `db:"checkout_user" json:"checkout_user"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
 const getCheckoutByBookingID = `-- name: GetCheckoutByBookingID :one
@@ -35,26 +37,39 @@ SELECT
   c.name as coupon_name,
   c.display_name as coupon_display_name,
   c.code as coupon_code,
+  ck.points_redeemed,
+  ck.points_discount_amount,
   su.username as checkout_user,
+  ck.refunded_at,
+  ck.refund_reason,
+  inv.invoice_number,
+  inv.status as invoice_status,
   ck.created_at
 FROM checkouts ck
 LEFT JOIN coupons c ON c.id = ck.coupon_id
 LEFT JOIN staff_users su ON su.id = ck.checkout_user
+LEFT JOIN invoices inv ON inv.checkout_id = ck.id
 WHERE ck.booking_id = $1
 `
 
 type GetCheckoutByBookingIDRow struct {
-	ID                int64              `db:"id" json:"id"`
-	TotalAmount       pgtype.Numeric     `db:"total_amount" json:"total_amount"`
-	FinalAmount       pgtype.Numeric     `db:"final_amount" json:"final_amount"`
-	PaidAmount        pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
-	PaymentMethod     string             `db:"payment_method" json:"payment_method"`
-	CouponID          pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
-	CouponName        pgtype.Text        `db:"coupon_name" json:"coupon_name"`
-	CouponDisplayName pgtype.Text        `db:"coupon_display_name" json:"coupon_display_name"`
-	CouponCode        pgtype.Text        `db:"coupon_code" json:"coupon_code"`
-	CheckoutUser      pgtype.Text        `db:"checkout_user" json:"checkout_user"`
-	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	ID                   int64              `db:"id" json:"id"`
+	TotalAmount          pgtype.Numeric     `db:"total_amount" json:"total_amount"`
+	FinalAmount          pgtype.Numeric     `db:"final_amount" json:"final_amount"`
+	PaidAmount           pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod        string             `db:"payment_method" json:"payment_method"`
+	CouponID             pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	CouponName           pgtype.Text        `db:"coupon_name" json:"coupon_name"`
+	CouponDisplayName    pgtype.Text        `db:"coupon_display_name" json:"coupon_display_name"`
+	CouponCode           pgtype.Text        `db:"coupon_code" json:"coupon_code"`
+	PointsRedeemed       int32              `db:"points_redeemed" json:"points_redeemed"`
+	PointsDiscountAmount pgtype.Numeric     `db:"points_discount_amount" json:"points_discount_amount"`
+	CheckoutUser         pgtype.Text        `db:"checkout_user" json:"checkout_user"`
+	RefundedAt           pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+	RefundReason         pgtype.Text        `db:"refund_reason" json:"refund_reason"`
+	InvoiceNumber        pgtype.Text        `db:"invoice_number" json:"invoice_number"`
+	InvoiceStatus        pgtype.Text        `db:"invoice_status" json:"invoice_status"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
 }
 
 func (q *Queries) GetCheckoutByBookingID(ctx context.Context, bookingID int64) (GetCheckoutByBookingIDRow, error) {
@@ -70,8 +85,312 @@ func (q *Queries) GetCheckoutByBookingID(ctx context.Context, bookingID int64) (
 		&i.CouponName,
 		&i.CouponDisplayName,
 		&i.CouponCode,
+		&i.PointsRedeemed,
+		&i.PointsDiscountAmount,
 		&i.CheckoutUser,
+		&i.RefundedAt,
+		&i.RefundReason,
+		&i.InvoiceNumber,
+		&i.InvoiceStatus,
 		&i.CreatedAt,
 	)
 	return i, err
 }
+
+const getCheckoutByIDForUpdate = `-- name: GetCheckoutByIDForUpdate :one
+SELECT
+  ck.id,
+  ck.booking_id,
+  b.store_id,
+  b.customer_id,
+  ck.paid_amount,
+  ck.payment_method,
+  ck.refunded_at
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE ck.id = $1
+FOR UPDATE OF ck
+`
+
+type GetCheckoutByIDForUpdateRow struct {
+	ID            int64              `db:"id" json:"id"`
+	BookingID     int64              `db:"booking_id" json:"booking_id"`
+	StoreID       int64              `db:"store_id" json:"store_id"`
+	CustomerID    int64              `db:"customer_id" json:"customer_id"`
+	PaidAmount    pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod string             `db:"payment_method" json:"payment_method"`
+	RefundedAt    pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+}
+
+func (q *Queries) GetCheckoutByIDForUpdate(ctx context.Context, id int64) (GetCheckoutByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getCheckoutByIDForUpdate, id)
+	var i GetCheckoutByIDForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.BookingID,
+		&i.StoreID,
+		&i.CustomerID,
+		&i.PaidAmount,
+		&i.PaymentMethod,
+		&i.RefundedAt,
+	)
+	return i, err
+}
+
+const getCheckoutReceiptByID = `-- name: GetCheckoutReceiptByID :one
+SELECT
+  ck.id,
+  ck.booking_id,
+  b.store_id,
+  s.name as store_name,
+  s.address as store_address,
+  s.phone as store_phone,
+  b.customer_id,
+  cu.name as customer_name,
+  cu.line_uid as customer_line_uid,
+  st.name as stylist_name,
+  sch.work_date,
+  ts.start_time,
+  ts.end_time,
+  ck.total_amount,
+  ck.final_amount,
+  ck.paid_amount,
+  ck.payment_method,
+  ck.coupon_id,
+  c.display_name as coupon_display_name,
+  c.code as coupon_code,
+  ck.points_redeemed,
+  ck.points_discount_amount,
+  su.username as checkout_user,
+  inv.invoice_number,
+  ck.refunded_at,
+  ck.created_at
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+JOIN stores s ON s.id = b.store_id
+JOIN customers cu ON cu.id = b.customer_id
+JOIN stylists st ON st.id = b.stylist_id
+JOIN time_slots ts ON ts.id = b.time_slot_id
+JOIN schedules sch ON sch.id = ts.schedule_id
+LEFT JOIN coupons c ON c.id = ck.coupon_id
+LEFT JOIN staff_users su ON su.id = ck.checkout_user
+LEFT JOIN invoices inv ON inv.checkout_id = ck.id
+WHERE ck.id = $1
+`
+
+type GetCheckoutReceiptByIDRow struct {
+	ID                   int64              `db:"id" json:"id"`
+	BookingID            int64              `db:"booking_id" json:"booking_id"`
+	StoreID              int64              `db:"store_id" json:"store_id"`
+	StoreName            string             `db:"store_name" json:"store_name"`
+	StoreAddress         pgtype.Text        `db:"store_address" json:"store_address"`
+	StorePhone           pgtype.Text        `db:"store_phone" json:"store_phone"`
+	CustomerID           int64              `db:"customer_id" json:"customer_id"`
+	CustomerName         string             `db:"customer_name" json:"customer_name"`
+	CustomerLineUid      string             `db:"customer_line_uid" json:"customer_line_uid"`
+	StylistName          pgtype.Text        `db:"stylist_name" json:"stylist_name"`
+	WorkDate             pgtype.Date        `db:"work_date" json:"work_date"`
+	StartTime            pgtype.Time        `db:"start_time" json:"start_time"`
+	EndTime              pgtype.Time        `db:"end_time" json:"end_time"`
+	TotalAmount          pgtype.Numeric     `db:"total_amount" json:"total_amount"`
+	FinalAmount          pgtype.Numeric     `db:"final_amount" json:"final_amount"`
+	PaidAmount           pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod        string             `db:"payment_method" json:"payment_method"`
+	CouponID             pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	CouponDisplayName    pgtype.Text        `db:"coupon_display_name" json:"coupon_display_name"`
+	CouponCode           pgtype.Text        `db:"coupon_code" json:"coupon_code"`
+	PointsRedeemed       int32              `db:"points_redeemed" json:"points_redeemed"`
+	PointsDiscountAmount pgtype.Numeric     `db:"points_discount_amount" json:"points_discount_amount"`
+	CheckoutUser         pgtype.Text        `db:"checkout_user" json:"checkout_user"`
+	InvoiceNumber        pgtype.Text        `db:"invoice_number" json:"invoice_number"`
+	RefundedAt           pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) GetCheckoutReceiptByID(ctx context.Context, id int64) (GetCheckoutReceiptByIDRow, error) {
+	row := q.db.QueryRow(ctx, getCheckoutReceiptByID, id)
+	var i GetCheckoutReceiptByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.BookingID,
+		&i.StoreID,
+		&i.StoreName,
+		&i.StoreAddress,
+		&i.StorePhone,
+		&i.CustomerID,
+		&i.CustomerName,
+		&i.CustomerLineUid,
+		&i.StylistName,
+		&i.WorkDate,
+		&i.StartTime,
+		&i.EndTime,
+		&i.TotalAmount,
+		&i.FinalAmount,
+		&i.PaidAmount,
+		&i.PaymentMethod,
+		&i.CouponID,
+		&i.CouponDisplayName,
+		&i.CouponCode,
+		&i.PointsRedeemed,
+		&i.PointsDiscountAmount,
+		&i.CheckoutUser,
+		&i.InvoiceNumber,
+		&i.RefundedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCustomerCheckoutStatsSince = `-- name: GetCustomerCheckoutStatsSince :one
+SELECT
+  COALESCE(SUM(ck.final_amount), 0)::numeric AS total_spend,
+  COUNT(DISTINCT (ck.created_at AT TIME ZONE 'Asia/Taipei')::date) AS visit_count
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE b.customer_id = $1
+  AND ck.refunded_at IS NULL
+  AND ck.created_at >= $2
+`
+
+type GetCustomerCheckoutStatsSinceParams struct {
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type GetCustomerCheckoutStatsSinceRow struct {
+	TotalSpend pgtype.Numeric `db:"total_spend" json:"total_spend"`
+	VisitCount int64          `db:"visit_count" json:"visit_count"`
+}
+
+func (q *Queries) GetCustomerCheckoutStatsSince(ctx context.Context, arg GetCustomerCheckoutStatsSinceParams) (GetCustomerCheckoutStatsSinceRow, error) {
+	row := q.db.QueryRow(ctx, getCustomerCheckoutStatsSince, arg.CustomerID, arg.CreatedAt)
+	var i GetCustomerCheckoutStatsSinceRow
+	err := row.Scan(&i.TotalSpend, &i.VisitCount)
+	return i, err
+}
+
+const getCustomerCheckoutsForExport = `-- name: GetCustomerCheckoutsForExport :many
+SELECT
+  c.id,
+  c.booking_id,
+  c.total_amount,
+  c.final_amount,
+  c.paid_amount,
+  c.payment_method,
+  c.points_redeemed,
+  c.refunded_at,
+  c.created_at
+FROM checkouts c
+JOIN bookings b ON b.id = c.booking_id
+WHERE b.customer_id = $1
+ORDER BY c.created_at DESC
+`
+
+type GetCustomerCheckoutsForExportRow struct {
+	ID             int64              `db:"id" json:"id"`
+	BookingID      int64              `db:"booking_id" json:"booking_id"`
+	TotalAmount    pgtype.Numeric     `db:"total_amount" json:"total_amount"`
+	FinalAmount    pgtype.Numeric     `db:"final_amount" json:"final_amount"`
+	PaidAmount     pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod  string             `db:"payment_method" json:"payment_method"`
+	PointsRedeemed int32              `db:"points_redeemed" json:"points_redeemed"`
+	RefundedAt     pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) GetCustomerCheckoutsForExport(ctx context.Context, customerID int64) ([]GetCustomerCheckoutsForExportRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerCheckoutsForExport, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCustomerCheckoutsForExportRow{}
+	for rows.Next() {
+		var i GetCustomerCheckoutsForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookingID,
+			&i.TotalAmount,
+			&i.FinalAmount,
+			&i.PaidAmount,
+			&i.PaymentMethod,
+			&i.PointsRedeemed,
+			&i.RefundedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerIDsWithCheckoutsSince = `-- name: GetCustomerIDsWithCheckoutsSince :many
+SELECT DISTINCT b.customer_id
+FROM checkouts ck
+JOIN bookings b ON b.id = ck.booking_id
+WHERE ck.refunded_at IS NULL
+  AND ck.created_at >= $1
+  AND b.customer_id > $2
+ORDER BY b.customer_id
+LIMIT $3
+`
+
+type GetCustomerIDsWithCheckoutsSinceParams struct {
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	Limit      int32              `db:"limit" json:"limit"`
+}
+
+func (q *Queries) GetCustomerIDsWithCheckoutsSince(ctx context.Context, arg GetCustomerIDsWithCheckoutsSinceParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getCustomerIDsWithCheckoutsSince,
+		arg.CreatedAt,
+		arg.CustomerID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var customerID int64
+		if err := rows.Scan(&customerID); err != nil {
+			return nil, err
+		}
+		items = append(items, customerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCheckoutRefunded = `-- name: UpdateCheckoutRefunded :exec
+UPDATE checkouts
+SET refunded_at = $2,
+  refund_reason = $3,
+  refunded_by = $4,
+  updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCheckoutRefundedParams struct {
+	ID           int64              `db:"id" json:"id"`
+	RefundedAt   pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+	RefundReason pgtype.Text        `db:"refund_reason" json:"refund_reason"`
+	RefundedBy   pgtype.Int8        `db:"refunded_by" json:"refunded_by"`
+}
+
+func (q *Queries) UpdateCheckoutRefunded(ctx context.Context, arg UpdateCheckoutRefundedParams) error {
+	_, err := q.db.Exec(ctx, updateCheckoutRefunded,
+		arg.ID,
+		arg.RefundedAt,
+		arg.RefundReason,
+		arg.RefundedBy,
+	)
+	return err
+}
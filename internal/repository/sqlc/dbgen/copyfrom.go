@@ -261,6 +261,8 @@ func (r iteratorForBulkCreateCheckout) Values() ([]interface{}, error) {
 		r.rows[0].PaidAmount,
 		r.rows[0].PaymentMethod,
 		r.rows[0].CouponID,
+		r.rows[0].PointsRedeemed,
+		r.rows[0].PointsDiscountAmount,
 		r.rows[0].CheckoutUser,
 		r.rows[0].CreatedAt,
 		r.rows[0].UpdatedAt,
@@ -272,7 +274,85 @@ func (r iteratorForBulkCreateCheckout) Err() error {
 }
 
 func (q *Queries) BulkCreateCheckout(ctx context.Context, arg []BulkCreateCheckoutParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"checkouts"}, []string{"id", "booking_id", "total_amount", "final_amount", "paid_amount", "payment_method", "coupon_id", "checkout_user", "created_at", "updated_at"}, &iteratorForBulkCreateCheckout{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"checkouts"}, []string{"id", "booking_id", "total_amount", "final_amount", "paid_amount", "payment_method", "coupon_id", "points_redeemed", "points_discount_amount", "checkout_user", "created_at", "updated_at"}, &iteratorForBulkCreateCheckout{rows: arg})
+}
+
+// iteratorForBulkCreateStoreAccountMappings implements pgx.CopyFromSource.
+type iteratorForBulkCreateStoreAccountMappings struct {
+	rows                 []BulkCreateStoreAccountMappingsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkCreateStoreAccountMappings) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkCreateStoreAccountMappings) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].StoreID,
+		r.rows[0].MappingType,
+		r.rows[0].PaymentMethod,
+		r.rows[0].PayerID,
+		r.rows[0].AccountID,
+	}, nil
+}
+
+func (r iteratorForBulkCreateStoreAccountMappings) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkCreateStoreAccountMappings(ctx context.Context, arg []BulkCreateStoreAccountMappingsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"store_account_mappings"}, []string{"id", "store_id", "mapping_type", "payment_method", "payer_id", "account_id"}, &iteratorForBulkCreateStoreAccountMappings{rows: arg})
+}
+
+// iteratorForCreateAccountStatementLines implements pgx.CopyFromSource.
+type iteratorForCreateAccountStatementLines struct {
+	rows                 []CreateAccountStatementLinesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateAccountStatementLines) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateAccountStatementLines) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].ImportID,
+		r.rows[0].AccountID,
+		r.rows[0].LineNumber,
+		r.rows[0].TransactionDate,
+		r.rows[0].Description,
+		r.rows[0].Type,
+		r.rows[0].Amount,
+		r.rows[0].Status,
+		r.rows[0].AccountTransactionID,
+	}, nil
+}
+
+func (r iteratorForCreateAccountStatementLines) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateAccountStatementLines(ctx context.Context, arg []CreateAccountStatementLinesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"account_statement_lines"}, []string{"id", "import_id", "account_id", "line_number", "transaction_date", "description", "type", "amount", "status", "account_transaction_id"}, &iteratorForCreateAccountStatementLines{rows: arg})
 }
 
 // iteratorForCreateBookingDetails implements pgx.CopyFromSource.
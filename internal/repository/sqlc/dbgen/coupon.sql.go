@@ -81,21 +81,35 @@ INSERT INTO coupons (
   discount_rate,
   discount_amount,
   is_active,
-  note
+  note,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
 `
 
 type CreateCouponParams struct {
-	ID             int64          `db:"id" json:"id"`
-	Name           string         `db:"name" json:"name"`
-	DisplayName    string         `db:"display_name" json:"display_name"`
-	Code           string         `db:"code" json:"code"`
-	DiscountRate   pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
-	DiscountAmount pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
-	IsActive       pgtype.Bool    `db:"is_active" json:"is_active"`
-	Note           pgtype.Text    `db:"note" json:"note"`
+	ID                    int64          `db:"id" json:"id"`
+	Name                  string         `db:"name" json:"name"`
+	DisplayName           string         `db:"display_name" json:"display_name"`
+	Code                  string         `db:"code" json:"code"`
+	DiscountRate          pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount        pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
+	IsActive              pgtype.Bool    `db:"is_active" json:"is_active"`
+	Note                  pgtype.Text    `db:"note" json:"note"`
+	StartDate             pgtype.Date    `db:"start_date" json:"start_date"`
+	EndDate               pgtype.Date    `db:"end_date" json:"end_date"`
+	MinSpendAmount        pgtype.Numeric `db:"min_spend_amount" json:"min_spend_amount"`
+	ServiceScope          string         `db:"service_scope" json:"service_scope"`
+	TotalUsageLimit       pgtype.Int4    `db:"total_usage_limit" json:"total_usage_limit"`
+	PerCustomerUsageLimit pgtype.Int4    `db:"per_customer_usage_limit" json:"per_customer_usage_limit"`
+	IsRepeatable          bool           `db:"is_repeatable" json:"is_repeatable"`
 }
 
 func (q *Queries) CreateCoupon(ctx context.Context, arg CreateCouponParams) error {
@@ -108,6 +122,13 @@ func (q *Queries) CreateCoupon(ctx context.Context, arg CreateCouponParams) erro
 		arg.DiscountAmount,
 		arg.IsActive,
 		arg.Note,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MinSpendAmount,
+		arg.ServiceScope,
+		arg.TotalUsageLimit,
+		arg.PerCustomerUsageLimit,
+		arg.IsRepeatable,
 	)
 	return err
 }
@@ -119,7 +140,8 @@ SELECT
   code,
   COALESCE(discount_rate, 0) AS discount_rate,
   COALESCE(discount_amount, 0) AS discount_amount,
-  is_active
+  is_active,
+  is_repeatable
 FROM coupons
 WHERE id = ANY($1::bigint[])
 `
@@ -131,6 +153,7 @@ type GetCouponByIDsRow struct {
 	DiscountRate   pgtype.Numeric `db:"discount_rate" json:"discount_rate"`
 	DiscountAmount pgtype.Numeric `db:"discount_amount" json:"discount_amount"`
 	IsActive       pgtype.Bool    `db:"is_active" json:"is_active"`
+	IsRepeatable   bool           `db:"is_repeatable" json:"is_repeatable"`
 }
 
 func (q *Queries) GetCouponByIDs(ctx context.Context, dollar_1 []int64) ([]GetCouponByIDsRow, error) {
@@ -149,6 +172,7 @@ func (q *Queries) GetCouponByIDs(ctx context.Context, dollar_1 []int64) ([]GetCo
 			&i.DiscountRate,
 			&i.DiscountAmount,
 			&i.IsActive,
+			&i.IsRepeatable,
 		); err != nil {
 			return nil, err
 		}
@@ -159,3 +183,92 @@ func (q *Queries) GetCouponByIDs(ctx context.Context, dollar_1 []int64) ([]GetCo
 	}
 	return items, nil
 }
+
+const getCouponRuleByID = `-- name: GetCouponRuleByID :one
+SELECT
+  id,
+  is_active,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
+FROM coupons
+WHERE id = $1
+`
+
+type GetCouponRuleByIDRow struct {
+	ID                    int64          `db:"id" json:"id"`
+	IsActive              pgtype.Bool    `db:"is_active" json:"is_active"`
+	StartDate             pgtype.Date    `db:"start_date" json:"start_date"`
+	EndDate               pgtype.Date    `db:"end_date" json:"end_date"`
+	MinSpendAmount        pgtype.Numeric `db:"min_spend_amount" json:"min_spend_amount"`
+	ServiceScope          string         `db:"service_scope" json:"service_scope"`
+	TotalUsageLimit       pgtype.Int4    `db:"total_usage_limit" json:"total_usage_limit"`
+	PerCustomerUsageLimit pgtype.Int4    `db:"per_customer_usage_limit" json:"per_customer_usage_limit"`
+	IsRepeatable          bool           `db:"is_repeatable" json:"is_repeatable"`
+}
+
+func (q *Queries) GetCouponRuleByID(ctx context.Context, id int64) (GetCouponRuleByIDRow, error) {
+	row := q.db.QueryRow(ctx, getCouponRuleByID, id)
+	var i GetCouponRuleByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.IsActive,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MinSpendAmount,
+		&i.ServiceScope,
+		&i.TotalUsageLimit,
+		&i.PerCustomerUsageLimit,
+		&i.IsRepeatable,
+	)
+	return i, err
+}
+
+const getCouponRuleByIDForUpdate = `-- name: GetCouponRuleByIDForUpdate :one
+SELECT
+  id,
+  is_active,
+  start_date,
+  end_date,
+  min_spend_amount,
+  service_scope,
+  total_usage_limit,
+  per_customer_usage_limit,
+  is_repeatable
+FROM coupons
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetCouponRuleByIDForUpdateRow struct {
+	ID                    int64          `db:"id" json:"id"`
+	IsActive              pgtype.Bool    `db:"is_active" json:"is_active"`
+	StartDate             pgtype.Date    `db:"start_date" json:"start_date"`
+	EndDate               pgtype.Date    `db:"end_date" json:"end_date"`
+	MinSpendAmount        pgtype.Numeric `db:"min_spend_amount" json:"min_spend_amount"`
+	ServiceScope          string         `db:"service_scope" json:"service_scope"`
+	TotalUsageLimit       pgtype.Int4    `db:"total_usage_limit" json:"total_usage_limit"`
+	PerCustomerUsageLimit pgtype.Int4    `db:"per_customer_usage_limit" json:"per_customer_usage_limit"`
+	IsRepeatable          bool           `db:"is_repeatable" json:"is_repeatable"`
+}
+
+func (q *Queries) GetCouponRuleByIDForUpdate(ctx context.Context, id int64) (GetCouponRuleByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getCouponRuleByIDForUpdate, id)
+	var i GetCouponRuleByIDForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.IsActive,
+		&i.StartDate,
+		&i.EndDate,
+		&i.MinSpendAmount,
+		&i.ServiceScope,
+		&i.TotalUsageLimit,
+		&i.PerCustomerUsageLimit,
+		&i.IsRepeatable,
+	)
+	return i, err
+}
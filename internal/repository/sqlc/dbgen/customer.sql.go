@@ -11,6 +11,53 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeCustomer = `-- name: AnonymizeCustomer :exec
+UPDATE customers
+SET name = $1,
+  phone = '',
+  birthday = NULL,
+  line_uid = '',
+  line_name = NULL,
+  email = NULL,
+  city = NULL,
+  favorite_shapes = NULL,
+  favorite_colors = NULL,
+  favorite_styles = NULL,
+  referral_source = NULL,
+  referrer = NULL,
+  referral_code = NULL,
+  customer_note = NULL,
+  store_note = NULL,
+  invoice_carrier_type = NULL,
+  invoice_carrier_value = NULL,
+  tags = '{}',
+  erased_at = NOW(),
+  updated_at = NOW()
+WHERE id = $2
+  OR merged_into_customer_id = $2
+`
+
+type AnonymizeCustomerParams struct {
+	Name string `db:"name" json:"name"`
+	ID   int64  `db:"id" json:"id"`
+}
+
+func (q *Queries) AnonymizeCustomer(ctx context.Context, arg AnonymizeCustomerParams) error {
+	_, err := q.db.Exec(ctx, anonymizeCustomer, arg.Name, arg.ID)
+	return err
+}
+
+const checkActiveCustomerExistsByPhone = `-- name: CheckActiveCustomerExistsByPhone :one
+SELECT EXISTS (SELECT 1 FROM customers WHERE phone = $1 AND merged_into_customer_id IS NULL)
+`
+
+func (q *Queries) CheckActiveCustomerExistsByPhone(ctx context.Context, phone string) (bool, error) {
+	row := q.db.QueryRow(ctx, checkActiveCustomerExistsByPhone, phone)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const checkCustomerExistsByID = `-- name: CheckCustomerExistsByID :one
 SELECT EXISTS (SELECT 1 FROM customers WHERE id = $1)
 `
@@ -35,8 +82,8 @@ func (q *Queries) CheckCustomerExistsByLineUid(ctx context.Context, lineUid stri
 
 const createCustomer = `-- name: CreateCustomer :exec
 INSERT INTO customers (id, line_uid, line_name, email, name, phone, birthday, city, favorite_shapes, favorite_colors,
-      favorite_styles, is_introvert, referral_source, referrer, customer_note, level)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+      favorite_styles, is_introvert, referral_source, referrer, customer_note, level, referral_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 `
 
 type CreateCustomerParams struct {
@@ -56,6 +103,7 @@ type CreateCustomerParams struct {
 	Referrer       pgtype.Text `db:"referrer" json:"referrer"`
 	CustomerNote   pgtype.Text `db:"customer_note" json:"customer_note"`
 	Level          pgtype.Text `db:"level" json:"level"`
+	ReferralCode   pgtype.Text `db:"referral_code" json:"referral_code"`
 }
 
 func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams) error {
@@ -76,40 +124,102 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		arg.Referrer,
 		arg.CustomerNote,
 		arg.Level,
+		arg.ReferralCode,
 	)
 	return err
 }
 
+const createWalkInCustomer = `-- name: CreateWalkInCustomer :exec
+INSERT INTO customers (id, name, phone, birthday, email, city, store_note, level, referral_code, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateWalkInCustomerParams struct {
+	ID           int64       `db:"id" json:"id"`
+	Name         string      `db:"name" json:"name"`
+	Phone        string      `db:"phone" json:"phone"`
+	Birthday     pgtype.Date `db:"birthday" json:"birthday"`
+	Email        pgtype.Text `db:"email" json:"email"`
+	City         pgtype.Text `db:"city" json:"city"`
+	StoreNote    pgtype.Text `db:"store_note" json:"store_note"`
+	Level        pgtype.Text `db:"level" json:"level"`
+	ReferralCode pgtype.Text `db:"referral_code" json:"referral_code"`
+	CreatedBy    pgtype.Int8 `db:"created_by" json:"created_by"`
+}
+
+func (q *Queries) CreateWalkInCustomer(ctx context.Context, arg CreateWalkInCustomerParams) error {
+	_, err := q.db.Exec(ctx, createWalkInCustomer,
+		arg.ID,
+		arg.Name,
+		arg.Phone,
+		arg.Birthday,
+		arg.Email,
+		arg.City,
+		arg.StoreNote,
+		arg.Level,
+		arg.ReferralCode,
+		arg.CreatedBy,
+	)
+	return err
+}
+
+const getCustomerBlacklistStateForUpdate = `-- name: GetCustomerBlacklistStateForUpdate :one
+SELECT is_blacklisted, requires_deposit
+FROM customers
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetCustomerBlacklistStateForUpdateRow struct {
+	IsBlacklisted   pgtype.Bool `db:"is_blacklisted" json:"is_blacklisted"`
+	RequiresDeposit bool        `db:"requires_deposit" json:"requires_deposit"`
+}
+
+func (q *Queries) GetCustomerBlacklistStateForUpdate(ctx context.Context, id int64) (GetCustomerBlacklistStateForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getCustomerBlacklistStateForUpdate, id)
+	var i GetCustomerBlacklistStateForUpdateRow
+	err := row.Scan(&i.IsBlacklisted, &i.RequiresDeposit)
+	return i, err
+}
+
 const getCustomerByID = `-- name: GetCustomerByID :one
 SELECT id, name, line_uid, line_name, phone, birthday, email, city, favorite_shapes, favorite_colors,
       favorite_styles, is_introvert, referral_source, referrer, customer_note,
-      store_note, level, is_blacklisted, last_visit_at, created_at, updated_at
+      store_note, level, is_blacklisted, requires_deposit, last_visit_at, invoice_carrier_type, invoice_carrier_value,
+      referral_code, merged_into_customer_id, erased_at, tags, created_at, updated_at
 FROM customers
 WHERE id = $1
 `
 
 type GetCustomerByIDRow struct {
-	ID             int64              `db:"id" json:"id"`
-	Name           string             `db:"name" json:"name"`
-	LineUid        string             `db:"line_uid" json:"line_uid"`
-	LineName       pgtype.Text        `db:"line_name" json:"line_name"`
-	Phone          string             `db:"phone" json:"phone"`
-	Birthday       pgtype.Date        `db:"birthday" json:"birthday"`
-	Email          pgtype.Text        `db:"email" json:"email"`
-	City           pgtype.Text        `db:"city" json:"city"`
-	FavoriteShapes []string           `db:"favorite_shapes" json:"favorite_shapes"`
-	FavoriteColors []string           `db:"favorite_colors" json:"favorite_colors"`
-	FavoriteStyles []string           `db:"favorite_styles" json:"favorite_styles"`
-	IsIntrovert    pgtype.Bool        `db:"is_introvert" json:"is_introvert"`
-	ReferralSource []string           `db:"referral_source" json:"referral_source"`
-	Referrer       pgtype.Text        `db:"referrer" json:"referrer"`
-	CustomerNote   pgtype.Text        `db:"customer_note" json:"customer_note"`
-	StoreNote      pgtype.Text        `db:"store_note" json:"store_note"`
-	Level          pgtype.Text        `db:"level" json:"level"`
-	IsBlacklisted  pgtype.Bool        `db:"is_blacklisted" json:"is_blacklisted"`
-	LastVisitAt    pgtype.Timestamptz `db:"last_visit_at" json:"last_visit_at"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	ID                   int64              `db:"id" json:"id"`
+	Name                 string             `db:"name" json:"name"`
+	LineUid              string             `db:"line_uid" json:"line_uid"`
+	LineName             pgtype.Text        `db:"line_name" json:"line_name"`
+	Phone                string             `db:"phone" json:"phone"`
+	Birthday             pgtype.Date        `db:"birthday" json:"birthday"`
+	Email                pgtype.Text        `db:"email" json:"email"`
+	City                 pgtype.Text        `db:"city" json:"city"`
+	FavoriteShapes       []string           `db:"favorite_shapes" json:"favorite_shapes"`
+	FavoriteColors       []string           `db:"favorite_colors" json:"favorite_colors"`
+	FavoriteStyles       []string           `db:"favorite_styles" json:"favorite_styles"`
+	IsIntrovert          pgtype.Bool        `db:"is_introvert" json:"is_introvert"`
+	ReferralSource       []string           `db:"referral_source" json:"referral_source"`
+	Referrer             pgtype.Text        `db:"referrer" json:"referrer"`
+	CustomerNote         pgtype.Text        `db:"customer_note" json:"customer_note"`
+	StoreNote            pgtype.Text        `db:"store_note" json:"store_note"`
+	Level                pgtype.Text        `db:"level" json:"level"`
+	IsBlacklisted        pgtype.Bool        `db:"is_blacklisted" json:"is_blacklisted"`
+	RequiresDeposit      bool               `db:"requires_deposit" json:"requires_deposit"`
+	LastVisitAt          pgtype.Timestamptz `db:"last_visit_at" json:"last_visit_at"`
+	InvoiceCarrierType   pgtype.Text        `db:"invoice_carrier_type" json:"invoice_carrier_type"`
+	InvoiceCarrierValue  pgtype.Text        `db:"invoice_carrier_value" json:"invoice_carrier_value"`
+	ReferralCode         pgtype.Text        `db:"referral_code" json:"referral_code"`
+	MergedIntoCustomerID pgtype.Int8        `db:"merged_into_customer_id" json:"merged_into_customer_id"`
+	ErasedAt             pgtype.Timestamptz `db:"erased_at" json:"erased_at"`
+	Tags                 []string           `db:"tags" json:"tags"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) GetCustomerByID(ctx context.Context, id int64) (GetCustomerByIDRow, error) {
@@ -134,7 +244,14 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id int64) (GetCustomerByI
 		&i.StoreNote,
 		&i.Level,
 		&i.IsBlacklisted,
+		&i.RequiresDeposit,
 		&i.LastVisitAt,
+		&i.InvoiceCarrierType,
+		&i.InvoiceCarrierValue,
+		&i.ReferralCode,
+		&i.MergedIntoCustomerID,
+		&i.ErasedAt,
+		&i.Tags,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -180,13 +297,19 @@ func (q *Queries) GetCustomerByIDs(ctx context.Context, dollar_1 []int64) ([]Get
 }
 
 const getCustomerByLineUid = `-- name: GetCustomerByLineUid :one
-SELECT id, line_name, name
+SELECT id, line_uid, line_name, name
 FROM customers
-WHERE line_uid = $1
+WHERE id = (
+  SELECT COALESCE(mc.merged_into_customer_id, mc.id)
+  FROM customers mc
+  WHERE mc.line_uid = $1
+  LIMIT 1
+)
 `
 
 type GetCustomerByLineUidRow struct {
 	ID       int64       `db:"id" json:"id"`
+	LineUid  string      `db:"line_uid" json:"line_uid"`
 	LineName pgtype.Text `db:"line_name" json:"line_name"`
 	Name     string      `db:"name" json:"name"`
 }
@@ -194,10 +317,160 @@ type GetCustomerByLineUidRow struct {
 func (q *Queries) GetCustomerByLineUid(ctx context.Context, lineUid string) (GetCustomerByLineUidRow, error) {
 	row := q.db.QueryRow(ctx, getCustomerByLineUid, lineUid)
 	var i GetCustomerByLineUidRow
-	err := row.Scan(&i.ID, &i.LineName, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.LineUid,
+		&i.LineName,
+		&i.Name,
+	)
 	return i, err
 }
 
+const getCustomerForErasure = `-- name: GetCustomerForErasure :one
+SELECT id, name, merged_into_customer_id, erased_at
+FROM customers
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetCustomerForErasureRow struct {
+	ID                   int64              `db:"id" json:"id"`
+	Name                 string             `db:"name" json:"name"`
+	MergedIntoCustomerID pgtype.Int8        `db:"merged_into_customer_id" json:"merged_into_customer_id"`
+	ErasedAt             pgtype.Timestamptz `db:"erased_at" json:"erased_at"`
+}
+
+func (q *Queries) GetCustomerForErasure(ctx context.Context, id int64) (GetCustomerForErasureRow, error) {
+	row := q.db.QueryRow(ctx, getCustomerForErasure, id)
+	var i GetCustomerForErasureRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.MergedIntoCustomerID,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
+const getCustomerIDByReferralCode = `-- name: GetCustomerIDByReferralCode :one
+SELECT COALESCE(merged_into_customer_id, id) AS id
+FROM customers
+WHERE referral_code = $1
+`
+
+func (q *Queries) GetCustomerIDByReferralCode(ctx context.Context, referralCode pgtype.Text) (int64, error) {
+	row := q.db.QueryRow(ctx, getCustomerIDByReferralCode, referralCode)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getCustomerIDsWithLevels = `-- name: GetCustomerIDsWithLevels :many
+SELECT id
+FROM customers
+WHERE level = ANY($1::text[])
+  AND merged_into_customer_id IS NULL
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type GetCustomerIDsWithLevelsParams struct {
+	Levels     []string `db:"levels" json:"levels"`
+	CustomerID int64    `db:"customer_id" json:"customer_id"`
+	Limit      int32    `db:"limit" json:"limit"`
+}
+
+func (q *Queries) GetCustomerIDsWithLevels(ctx context.Context, arg GetCustomerIDsWithLevelsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getCustomerIDsWithLevels,
+		arg.Levels,
+		arg.CustomerID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerLevelByIDForUpdate = `-- name: GetCustomerLevelByIDForUpdate :one
+SELECT level
+FROM customers
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCustomerLevelByIDForUpdate(ctx context.Context, id int64) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getCustomerLevelByIDForUpdate, id)
+	var level pgtype.Text
+	err := row.Scan(&level)
+	return level, err
+}
+
+const getCustomerTagsByID = `-- name: GetCustomerTagsByID :one
+SELECT tags
+FROM customers
+WHERE id = $1
+`
+
+func (q *Queries) GetCustomerTagsByID(ctx context.Context, id int64) ([]string, error) {
+	row := q.db.QueryRow(ctx, getCustomerTagsByID, id)
+	var tags []string
+	err := row.Scan(&tags)
+	return tags, err
+}
+
+const getLinkableCustomerByPhoneAndBirthday = `-- name: GetLinkableCustomerByPhoneAndBirthday :one
+SELECT id
+FROM customers
+WHERE phone = $1
+  AND birthday = $2
+  AND line_uid = ''
+  AND merged_into_customer_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+type GetLinkableCustomerByPhoneAndBirthdayParams struct {
+	Phone    string      `db:"phone" json:"phone"`
+	Birthday pgtype.Date `db:"birthday" json:"birthday"`
+}
+
+func (q *Queries) GetLinkableCustomerByPhoneAndBirthday(ctx context.Context, arg GetLinkableCustomerByPhoneAndBirthdayParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getLinkableCustomerByPhoneAndBirthday, arg.Phone, arg.Birthday)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateCustomerIsBlacklisted = `-- name: UpdateCustomerIsBlacklisted :exec
+UPDATE customers
+SET is_blacklisted = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCustomerIsBlacklistedParams struct {
+	ID            int64       `db:"id" json:"id"`
+	IsBlacklisted pgtype.Bool `db:"is_blacklisted" json:"is_blacklisted"`
+}
+
+func (q *Queries) UpdateCustomerIsBlacklisted(ctx context.Context, arg UpdateCustomerIsBlacklistedParams) error {
+	_, err := q.db.Exec(ctx, updateCustomerIsBlacklisted, arg.ID, arg.IsBlacklisted)
+	return err
+}
+
 const updateCustomerLastVisitAt = `-- name: UpdateCustomerLastVisitAt :exec
 UPDATE customers
 SET last_visit_at = NOW(), updated_at = NOW()
@@ -209,6 +482,22 @@ func (q *Queries) UpdateCustomerLastVisitAt(ctx context.Context, id int64) error
 	return err
 }
 
+const updateCustomerLevel = `-- name: UpdateCustomerLevel :exec
+UPDATE customers
+SET level = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCustomerLevelParams struct {
+	ID    int64       `db:"id" json:"id"`
+	Level pgtype.Text `db:"level" json:"level"`
+}
+
+func (q *Queries) UpdateCustomerLevel(ctx context.Context, arg UpdateCustomerLevelParams) error {
+	_, err := q.db.Exec(ctx, updateCustomerLevel, arg.ID, arg.Level)
+	return err
+}
+
 const updateCustomerLineName = `-- name: UpdateCustomerLineName :exec
 UPDATE customers
 SET line_name = $2
@@ -224,3 +513,19 @@ func (q *Queries) UpdateCustomerLineName(ctx context.Context, arg UpdateCustomer
 	_, err := q.db.Exec(ctx, updateCustomerLineName, arg.ID, arg.LineName)
 	return err
 }
+
+const updateCustomerRequiresDeposit = `-- name: UpdateCustomerRequiresDeposit :exec
+UPDATE customers
+SET requires_deposit = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCustomerRequiresDepositParams struct {
+	ID              int64 `db:"id" json:"id"`
+	RequiresDeposit bool  `db:"requires_deposit" json:"requires_deposit"`
+}
+
+func (q *Queries) UpdateCustomerRequiresDeposit(ctx context.Context, arg UpdateCustomerRequiresDepositParams) error {
+	_, err := q.db.Exec(ctx, updateCustomerRequiresDeposit, arg.ID, arg.RequiresDeposit)
+	return err
+}
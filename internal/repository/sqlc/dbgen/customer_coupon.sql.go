@@ -14,7 +14,7 @@ import (
 const checkCustomerCouponExists = `-- name: CheckCustomerCouponExists :one
 SELECT EXISTS(
   SELECT 1 FROM customer_coupons
-  WHERE customer_id = $1 AND coupon_id = $2
+  WHERE customer_id = $1 AND coupon_id = $2 AND source_type IS NULL
 )
 `
 
@@ -30,6 +30,40 @@ func (q *Queries) CheckCustomerCouponExists(ctx context.Context, arg CheckCustom
 	return exists, err
 }
 
+const countCouponRedemptions = `-- name: CountCouponRedemptions :one
+SELECT COUNT(*)
+FROM customer_coupons
+WHERE coupon_id = $1
+  AND is_used = true
+`
+
+func (q *Queries) CountCouponRedemptions(ctx context.Context, couponID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countCouponRedemptions, couponID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCustomerCouponRedemptions = `-- name: CountCustomerCouponRedemptions :one
+SELECT COUNT(*)
+FROM customer_coupons
+WHERE customer_id = $1
+  AND coupon_id = $2
+  AND is_used = true
+`
+
+type CountCustomerCouponRedemptionsParams struct {
+	CustomerID int64 `db:"customer_id" json:"customer_id"`
+	CouponID   int64 `db:"coupon_id" json:"coupon_id"`
+}
+
+func (q *Queries) CountCustomerCouponRedemptions(ctx context.Context, arg CountCustomerCouponRedemptionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCustomerCouponRedemptions, arg.CustomerID, arg.CouponID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCustomerCoupon = `-- name: CreateCustomerCoupon :exec
 INSERT INTO customer_coupons (
   id,
@@ -61,6 +95,43 @@ func (q *Queries) CreateCustomerCoupon(ctx context.Context, arg CreateCustomerCo
 	return err
 }
 
+const createCustomerCouponWithSource = `-- name: CreateCustomerCouponWithSource :exec
+INSERT INTO customer_coupons (
+  id,
+  customer_id,
+  coupon_id,
+  valid_from,
+  valid_to,
+  source_type,
+  source_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateCustomerCouponWithSourceParams struct {
+	ID         int64              `db:"id" json:"id"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	CouponID   int64              `db:"coupon_id" json:"coupon_id"`
+	ValidFrom  pgtype.Timestamptz `db:"valid_from" json:"valid_from"`
+	ValidTo    pgtype.Timestamptz `db:"valid_to" json:"valid_to"`
+	SourceType pgtype.Text        `db:"source_type" json:"source_type"`
+	SourceID   pgtype.Int8        `db:"source_id" json:"source_id"`
+}
+
+func (q *Queries) CreateCustomerCouponWithSource(ctx context.Context, arg CreateCustomerCouponWithSourceParams) error {
+	_, err := q.db.Exec(ctx, createCustomerCouponWithSource,
+		arg.ID,
+		arg.CustomerID,
+		arg.CouponID,
+		arg.ValidFrom,
+		arg.ValidTo,
+		arg.SourceType,
+		arg.SourceID,
+	)
+	return err
+}
+
 const deleteCustomerCoupon = `-- name: DeleteCustomerCoupon :exec
 DELETE FROM customer_coupons
 WHERE id = $1
@@ -113,6 +184,7 @@ SELECT
   c.discount_rate,
   c.discount_amount,
   c.is_active,
+  cc.valid_from,
   cc.valid_to,
   cc.is_used
 FROM customer_coupons cc
@@ -126,6 +198,7 @@ type GetCustomerCouponPriceInfoByIDRow struct {
 	DiscountRate   pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
 	DiscountAmount pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
 	IsActive       pgtype.Bool        `db:"is_active" json:"is_active"`
+	ValidFrom      pgtype.Timestamptz `db:"valid_from" json:"valid_from"`
 	ValidTo        pgtype.Timestamptz `db:"valid_to" json:"valid_to"`
 	IsUsed         pgtype.Bool        `db:"is_used" json:"is_used"`
 }
@@ -139,20 +212,79 @@ func (q *Queries) GetCustomerCouponPriceInfoByID(ctx context.Context, id int64)
 		&i.DiscountRate,
 		&i.DiscountAmount,
 		&i.IsActive,
+		&i.ValidFrom,
 		&i.ValidTo,
 		&i.IsUsed,
 	)
 	return i, err
 }
 
-const updateCustomerCouponUsed = `-- name: UpdateCustomerCouponUsed :exec
+const getCustomerCouponsForExport = `-- name: GetCustomerCouponsForExport :many
+SELECT
+  cc.id,
+  c.display_name,
+  c.code,
+  cc.valid_from,
+  cc.valid_to,
+  cc.is_used,
+  cc.used_at,
+  cc.created_at
+FROM customer_coupons cc
+JOIN coupons c ON c.id = cc.coupon_id
+WHERE cc.customer_id = $1
+ORDER BY cc.created_at DESC
+`
+
+type GetCustomerCouponsForExportRow struct {
+	ID          int64              `db:"id" json:"id"`
+	DisplayName string             `db:"display_name" json:"display_name"`
+	Code        string             `db:"code" json:"code"`
+	ValidFrom   pgtype.Timestamptz `db:"valid_from" json:"valid_from"`
+	ValidTo     pgtype.Timestamptz `db:"valid_to" json:"valid_to"`
+	IsUsed      pgtype.Bool        `db:"is_used" json:"is_used"`
+	UsedAt      pgtype.Timestamptz `db:"used_at" json:"used_at"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) GetCustomerCouponsForExport(ctx context.Context, customerID int64) ([]GetCustomerCouponsForExportRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerCouponsForExport, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCustomerCouponsForExportRow{}
+	for rows.Next() {
+		var i GetCustomerCouponsForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DisplayName,
+			&i.Code,
+			&i.ValidFrom,
+			&i.ValidTo,
+			&i.IsUsed,
+			&i.UsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCustomerCouponUsed = `-- name: UpdateCustomerCouponUsed :one
 UPDATE customer_coupons
 SET is_used = true,
   used_at = now()
-WHERE id = $1
+WHERE id = $1 AND is_used = false
+RETURNING id
 `
 
-func (q *Queries) UpdateCustomerCouponUsed(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, updateCustomerCouponUsed, id)
-	return err
+func (q *Queries) UpdateCustomerCouponUsed(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRow(ctx, updateCustomerCouponUsed, id)
+	err := row.Scan(&id)
+	return id, err
 }
@@ -11,6 +11,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const checkCustomerTermsAcceptanceExistsByVersion = `-- name: CheckCustomerTermsAcceptanceExistsByVersion :one
+SELECT EXISTS(
+  SELECT 1 FROM customer_terms_acceptance
+  WHERE terms_version = $1
+) as exists
+`
+
+func (q *Queries) CheckCustomerTermsAcceptanceExistsByVersion(ctx context.Context, termsVersion string) (bool, error) {
+	row := q.db.QueryRow(ctx, checkCustomerTermsAcceptanceExistsByVersion, termsVersion)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const checkCustomerTermsExistsByCustomerIDAndVersion = `-- name: CheckCustomerTermsExistsByCustomerIDAndVersion :one
 SELECT EXISTS(
   SELECT 1 FROM customer_terms_acceptance
@@ -52,6 +66,20 @@ func (q *Queries) CreateCustomerTermsAcceptance(ctx context.Context, arg CreateC
 	return err
 }
 
+const getCustomerLatestAcceptedTermsEffectiveDate = `-- name: GetCustomerLatestAcceptedTermsEffectiveDate :one
+SELECT MAX(t.effective_date)::date AS effective_date
+FROM customer_terms_acceptance a
+JOIN terms_documents t ON t.version = a.terms_version
+WHERE a.customer_id = $1
+`
+
+func (q *Queries) GetCustomerLatestAcceptedTermsEffectiveDate(ctx context.Context, customerID int64) (pgtype.Date, error) {
+	row := q.db.QueryRow(ctx, getCustomerLatestAcceptedTermsEffectiveDate, customerID)
+	var effectiveDate pgtype.Date
+	err := row.Scan(&effectiveDate)
+	return effectiveDate, err
+}
+
 const getCustomerTermsAcceptanceByCustomerIDAndVersion = `-- name: GetCustomerTermsAcceptanceByCustomerIDAndVersion :one
 SELECT id, customer_id, terms_version, accepted_at
 FROM customer_terms_acceptance
@@ -81,3 +109,40 @@ func (q *Queries) GetCustomerTermsAcceptanceByCustomerIDAndVersion(ctx context.C
 	)
 	return i, err
 }
+
+const getCustomerTermsAcceptancesByCustomerID = `-- name: GetCustomerTermsAcceptancesByCustomerID :many
+SELECT id, terms_version, accepted_at
+FROM customer_terms_acceptance
+WHERE customer_id = $1
+ORDER BY accepted_at DESC
+`
+
+type GetCustomerTermsAcceptancesByCustomerIDRow struct {
+	ID           int64              `db:"id" json:"id"`
+	TermsVersion string             `db:"terms_version" json:"terms_version"`
+	AcceptedAt   pgtype.Timestamptz `db:"accepted_at" json:"accepted_at"`
+}
+
+func (q *Queries) GetCustomerTermsAcceptancesByCustomerID(ctx context.Context, customerID int64) ([]GetCustomerTermsAcceptancesByCustomerIDRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerTermsAcceptancesByCustomerID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCustomerTermsAcceptancesByCustomerIDRow{}
+	for rows.Next() {
+		var i GetCustomerTermsAcceptancesByCustomerIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TermsVersion,
+			&i.AcceptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
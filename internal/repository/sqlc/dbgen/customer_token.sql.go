@@ -78,6 +78,16 @@ func (q *Queries) DeleteCustomerTokensBatch(ctx context.Context, limit int32) er
 	return err
 }
 
+const deleteCustomerTokensByCustomerID = `-- name: DeleteCustomerTokensByCustomerID :exec
+DELETE FROM customer_tokens
+WHERE customer_id = $1
+`
+
+func (q *Queries) DeleteCustomerTokensByCustomerID(ctx context.Context, customerID int64) error {
+	_, err := q.db.Exec(ctx, deleteCustomerTokensByCustomerID, customerID)
+	return err
+}
+
 const getValidCustomerToken = `-- name: GetValidCustomerToken :one
 SELECT id, customer_id
 FROM customer_tokens
@@ -332,6 +332,77 @@ func (q *Queries) GetStoreExpenseByID(ctx context.Context, arg GetStoreExpenseBy
 	return i, err
 }
 
+const getStoreExpenseByIDForUpdate = `-- name: GetStoreExpenseByIDForUpdate :one
+SELECT
+    e.id,
+    e.supplier_id,
+    COALESCE(s.name, '') AS supplier_name,
+    e.payer_id,
+    COALESCE(su.username, '') AS payer_name,
+    e.category,
+    e.amount,
+    e.other_fee,
+    e.expense_date,
+    e.note,
+    e.is_reimbursed,
+    e.reimbursed_at,
+    COALESCE(su2.username, '') AS updater,
+    e.created_at,
+    e.updated_at
+FROM expenses e
+LEFT JOIN suppliers s ON e.supplier_id = s.id
+LEFT JOIN staff_users su ON e.payer_id = su.id
+LEFT JOIN staff_users su2 ON e.updater = su2.id
+WHERE e.id = $1 AND e.store_id = $2
+FOR UPDATE OF e
+`
+
+type GetStoreExpenseByIDForUpdateParams struct {
+	ID      int64 `db:"id" json:"id"`
+	StoreID int64 `db:"store_id" json:"store_id"`
+}
+
+type GetStoreExpenseByIDForUpdateRow struct {
+	ID           int64              `db:"id" json:"id"`
+	SupplierID   pgtype.Int8        `db:"supplier_id" json:"supplier_id"`
+	SupplierName string             `db:"supplier_name" json:"supplier_name"`
+	PayerID      pgtype.Int8        `db:"payer_id" json:"payer_id"`
+	PayerName    string             `db:"payer_name" json:"payer_name"`
+	Category     pgtype.Text        `db:"category" json:"category"`
+	Amount       pgtype.Numeric     `db:"amount" json:"amount"`
+	OtherFee     pgtype.Numeric     `db:"other_fee" json:"other_fee"`
+	ExpenseDate  pgtype.Date        `db:"expense_date" json:"expense_date"`
+	Note         pgtype.Text        `db:"note" json:"note"`
+	IsReimbursed pgtype.Bool        `db:"is_reimbursed" json:"is_reimbursed"`
+	ReimbursedAt pgtype.Timestamptz `db:"reimbursed_at" json:"reimbursed_at"`
+	Updater      string             `db:"updater" json:"updater"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) GetStoreExpenseByIDForUpdate(ctx context.Context, arg GetStoreExpenseByIDForUpdateParams) (GetStoreExpenseByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getStoreExpenseByIDForUpdate, arg.ID, arg.StoreID)
+	var i GetStoreExpenseByIDForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.SupplierID,
+		&i.SupplierName,
+		&i.PayerID,
+		&i.PayerName,
+		&i.Category,
+		&i.Amount,
+		&i.OtherFee,
+		&i.ExpenseDate,
+		&i.Note,
+		&i.IsReimbursed,
+		&i.ReimbursedAt,
+		&i.Updater,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateStoreExpenseAmount = `-- name: UpdateStoreExpenseAmount :exec
 UPDATE expenses SET amount = $1, updater = $2, updated_at = NOW() WHERE id = $3
 `
@@ -20,6 +20,47 @@ type Account struct {
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type AccountStatementImport struct {
+	ID           int64              `db:"id" json:"id"`
+	AccountID    int64              `db:"account_id" json:"account_id"`
+	FileName     string             `db:"file_name" json:"file_name"`
+	TotalLines   int32              `db:"total_lines" json:"total_lines"`
+	MatchedLines int32              `db:"matched_lines" json:"matched_lines"`
+	CreatedBy    int64              `db:"created_by" json:"created_by"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type AccountStatementLayout struct {
+	AccountID         int64              `db:"account_id" json:"account_id"`
+	Encoding          string             `db:"encoding" json:"encoding"`
+	HasHeader         bool               `db:"has_header" json:"has_header"`
+	SkipRows          int32              `db:"skip_rows" json:"skip_rows"`
+	Delimiter         string             `db:"delimiter" json:"delimiter"`
+	DateColumn        int32              `db:"date_column" json:"date_column"`
+	DateFormat        string             `db:"date_format" json:"date_format"`
+	DescriptionColumn pgtype.Int4        `db:"description_column" json:"description_column"`
+	AmountColumn      pgtype.Int4        `db:"amount_column" json:"amount_column"`
+	DepositColumn     pgtype.Int4        `db:"deposit_column" json:"deposit_column"`
+	WithdrawalColumn  pgtype.Int4        `db:"withdrawal_column" json:"withdrawal_column"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type AccountStatementLine struct {
+	ID                   int64              `db:"id" json:"id"`
+	ImportID             int64              `db:"import_id" json:"import_id"`
+	AccountID            int64              `db:"account_id" json:"account_id"`
+	LineNumber           int32              `db:"line_number" json:"line_number"`
+	TransactionDate      pgtype.Date        `db:"transaction_date" json:"transaction_date"`
+	Description          pgtype.Text        `db:"description" json:"description"`
+	Type                 string             `db:"type" json:"type"`
+	Amount               pgtype.Numeric     `db:"amount" json:"amount"`
+	Status               string             `db:"status" json:"status"`
+	AccountTransactionID pgtype.Int8        `db:"account_transaction_id" json:"account_transaction_id"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type AccountTransaction struct {
 	ID              int64              `db:"id" json:"id"`
 	AccountID       int64              `db:"account_id" json:"account_id"`
@@ -30,6 +71,54 @@ type AccountTransaction struct {
 	Note            pgtype.Text        `db:"note" json:"note"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	SourceType      pgtype.Text        `db:"source_type" json:"source_type"`
+	SourceID        pgtype.Int8        `db:"source_id" json:"source_id"`
+}
+
+type AccountTransactionAudit struct {
+	ID                   int64              `db:"id" json:"id"`
+	AccountID            int64              `db:"account_id" json:"account_id"`
+	AccountTransactionID int64              `db:"account_transaction_id" json:"account_transaction_id"`
+	Action               string             `db:"action" json:"action"`
+	BeforeData           []byte             `db:"before_data" json:"before_data"`
+	AfterData            []byte             `db:"after_data" json:"after_data"`
+	CreatedBy            int64              `db:"created_by" json:"created_by"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type AccountTransfer struct {
+	ID              int64              `db:"id" json:"id"`
+	StoreID         int64              `db:"store_id" json:"store_id"`
+	FromAccountID   int64              `db:"from_account_id" json:"from_account_id"`
+	ToAccountID     int64              `db:"to_account_id" json:"to_account_id"`
+	TransactionDate pgtype.Date        `db:"transaction_date" json:"transaction_date"`
+	Amount          pgtype.Numeric     `db:"amount" json:"amount"`
+	Note            pgtype.Text        `db:"note" json:"note"`
+	CreatedBy       int64              `db:"created_by" json:"created_by"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type BirthdayBenefitSetting struct {
+	ID              int16              `db:"id" json:"id"`
+	IsEnabled       bool               `db:"is_enabled" json:"is_enabled"`
+	CouponID        pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	CouponValidDays int32              `db:"coupon_valid_days" json:"coupon_valid_days"`
+	IssuePeriod     string             `db:"issue_period" json:"issue_period"`
+	LineMessage     pgtype.Text        `db:"line_message" json:"line_message"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type BlacklistRule struct {
+	Type            string             `db:"type" json:"type"`
+	IsActive        bool               `db:"is_active" json:"is_active"`
+	ThresholdCount  int32              `db:"threshold_count" json:"threshold_count"`
+	PeriodMonths    int32              `db:"period_months" json:"period_months"`
+	LateCancelHours pgtype.Int4        `db:"late_cancel_hours" json:"late_cancel_hours"`
+	Action          string             `db:"action" json:"action"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
 type Booking struct {
@@ -47,17 +136,20 @@ type Booking struct {
 	CancelReason       pgtype.Text        `db:"cancel_reason" json:"cancel_reason"`
 	StoreNote          pgtype.Text        `db:"store_note" json:"store_note"`
 	PinterestImageUrls []string           `db:"pinterest_image_urls" json:"pinterest_image_urls"`
+	CancelledAt        pgtype.Timestamptz `db:"cancelled_at" json:"cancelled_at"`
 }
 
 type BookingDetail struct {
-	ID             int64              `db:"id" json:"id"`
-	BookingID      int64              `db:"booking_id" json:"booking_id"`
-	ServiceID      int64              `db:"service_id" json:"service_id"`
-	Price          pgtype.Numeric     `db:"price" json:"price"`
-	DiscountRate   pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
-	DiscountAmount pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	ID                      int64              `db:"id" json:"id"`
+	BookingID               int64              `db:"booking_id" json:"booking_id"`
+	ServiceID               int64              `db:"service_id" json:"service_id"`
+	Price                   pgtype.Numeric     `db:"price" json:"price"`
+	DiscountRate            pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount          pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
+	CreatedAt               pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	PromotionID             pgtype.Int8        `db:"promotion_id" json:"promotion_id"`
+	PromotionDiscountAmount pgtype.Numeric     `db:"promotion_discount_amount" json:"promotion_discount_amount"`
 }
 
 type BookingProduct struct {
@@ -74,54 +166,146 @@ type Brand struct {
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type CashDrawerClose struct {
+	ID                   int64              `db:"id" json:"id"`
+	StoreID              int64              `db:"store_id" json:"store_id"`
+	CloseDate            pgtype.Date        `db:"close_date" json:"close_date"`
+	CheckoutCount        int32              `db:"checkout_count" json:"checkout_count"`
+	ExpectedCash         pgtype.Numeric     `db:"expected_cash" json:"expected_cash"`
+	CountedCash          pgtype.Numeric     `db:"counted_cash" json:"counted_cash"`
+	Difference           pgtype.Numeric     `db:"difference" json:"difference"`
+	AccountID            int64              `db:"account_id" json:"account_id"`
+	AccountTransactionID pgtype.Int8        `db:"account_transaction_id" json:"account_transaction_id"`
+	Note                 pgtype.Text        `db:"note" json:"note"`
+	ClosedBy             int64              `db:"closed_by" json:"closed_by"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type Checkout struct {
-	ID            int64              `db:"id" json:"id"`
-	BookingID     int64              `db:"booking_id" json:"booking_id"`
-	TotalAmount   pgtype.Numeric     `db:"total_amount" json:"total_amount"`
-	FinalAmount   pgtype.Numeric     `db:"final_amount" json:"final_amount"`
-	PaidAmount    pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
-	PaymentMethod string             `db:"payment_method" json:"payment_method"`
-	CouponID      pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
-	CheckoutUser  pgtype.Int8        `db:"checkout_user" json:"checkout_user"`
-	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	ID                   int64              `db:"id" json:"id"`
+	BookingID            int64              `db:"booking_id" json:"booking_id"`
+	TotalAmount          pgtype.Numeric     `db:"total_amount" json:"total_amount"`
+	FinalAmount          pgtype.Numeric     `db:"final_amount" json:"final_amount"`
+	PaidAmount           pgtype.Numeric     `db:"paid_amount" json:"paid_amount"`
+	PaymentMethod        string             `db:"payment_method" json:"payment_method"`
+	CouponID             pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	CheckoutUser         pgtype.Int8        `db:"checkout_user" json:"checkout_user"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	RefundedAt           pgtype.Timestamptz `db:"refunded_at" json:"refunded_at"`
+	RefundReason         pgtype.Text        `db:"refund_reason" json:"refund_reason"`
+	RefundedBy           pgtype.Int8        `db:"refunded_by" json:"refunded_by"`
+	PointsRedeemed       int32              `db:"points_redeemed" json:"points_redeemed"`
+	PointsDiscountAmount pgtype.Numeric     `db:"points_discount_amount" json:"points_discount_amount"`
 }
 
 type Coupon struct {
-	ID             int64              `db:"id" json:"id"`
-	Name           string             `db:"name" json:"name"`
-	Code           string             `db:"code" json:"code"`
-	DiscountRate   pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
-	DiscountAmount pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
-	IsActive       pgtype.Bool        `db:"is_active" json:"is_active"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	Note           pgtype.Text        `db:"note" json:"note"`
-	DisplayName    string             `db:"display_name" json:"display_name"`
+	ID                    int64              `db:"id" json:"id"`
+	Name                  string             `db:"name" json:"name"`
+	Code                  string             `db:"code" json:"code"`
+	DiscountRate          pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount        pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
+	IsActive              pgtype.Bool        `db:"is_active" json:"is_active"`
+	CreatedAt             pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Note                  pgtype.Text        `db:"note" json:"note"`
+	DisplayName           string             `db:"display_name" json:"display_name"`
+	StartDate             pgtype.Date        `db:"start_date" json:"start_date"`
+	EndDate               pgtype.Date        `db:"end_date" json:"end_date"`
+	MinSpendAmount        pgtype.Numeric     `db:"min_spend_amount" json:"min_spend_amount"`
+	ServiceScope          string             `db:"service_scope" json:"service_scope"`
+	TotalUsageLimit       pgtype.Int4        `db:"total_usage_limit" json:"total_usage_limit"`
+	PerCustomerUsageLimit pgtype.Int4        `db:"per_customer_usage_limit" json:"per_customer_usage_limit"`
+	IsRepeatable          bool               `db:"is_repeatable" json:"is_repeatable"`
+}
+
+type CouponCampaign struct {
+	ID              int64              `db:"id" json:"id"`
+	Name            string             `db:"name" json:"name"`
+	CouponID        int64              `db:"coupon_id" json:"coupon_id"`
+	ValidMonths     pgtype.Int4        `db:"valid_months" json:"valid_months"`
+	CustomerLevels  []string           `db:"customer_levels" json:"customer_levels"`
+	LastVisitFrom   pgtype.Date        `db:"last_visit_from" json:"last_visit_from"`
+	LastVisitTo     pgtype.Date        `db:"last_visit_to" json:"last_visit_to"`
+	BirthdayMonth   pgtype.Int4        `db:"birthday_month" json:"birthday_month"`
+	StoreID         pgtype.Int8        `db:"store_id" json:"store_id"`
+	SendLine        bool               `db:"send_line" json:"send_line"`
+	LineMessage     pgtype.Text        `db:"line_message" json:"line_message"`
+	Status          string             `db:"status" json:"status"`
+	TargetCount     int32              `db:"target_count" json:"target_count"`
+	IssuedCount     int32              `db:"issued_count" json:"issued_count"`
+	SkippedCount    int32              `db:"skipped_count" json:"skipped_count"`
+	LineSentCount   int32              `db:"line_sent_count" json:"line_sent_count"`
+	LineFailedCount int32              `db:"line_failed_count" json:"line_failed_count"`
+	LastCustomerID  int64              `db:"last_customer_id" json:"last_customer_id"`
+	ErrorMessage    pgtype.Text        `db:"error_message" json:"error_message"`
+	StartedAt       pgtype.Timestamptz `db:"started_at" json:"started_at"`
+	FinishedAt      pgtype.Timestamptz `db:"finished_at" json:"finished_at"`
+	CreatedBy       int64              `db:"created_by" json:"created_by"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CouponService struct {
+	CouponID  int64 `db:"coupon_id" json:"coupon_id"`
+	ServiceID int64 `db:"service_id" json:"service_id"`
 }
 
 type Customer struct {
+	ID                   int64              `db:"id" json:"id"`
+	Name                 string             `db:"name" json:"name"`
+	Phone                string             `db:"phone" json:"phone"`
+	Birthday             pgtype.Date        `db:"birthday" json:"birthday"`
+	City                 pgtype.Text        `db:"city" json:"city"`
+	FavoriteShapes       []string           `db:"favorite_shapes" json:"favorite_shapes"`
+	FavoriteColors       []string           `db:"favorite_colors" json:"favorite_colors"`
+	FavoriteStyles       []string           `db:"favorite_styles" json:"favorite_styles"`
+	IsIntrovert          pgtype.Bool        `db:"is_introvert" json:"is_introvert"`
+	ReferralSource       []string           `db:"referral_source" json:"referral_source"`
+	Referrer             pgtype.Text        `db:"referrer" json:"referrer"`
+	CustomerNote         pgtype.Text        `db:"customer_note" json:"customer_note"`
+	StoreNote            pgtype.Text        `db:"store_note" json:"store_note"`
+	Level                pgtype.Text        `db:"level" json:"level"`
+	IsBlacklisted        pgtype.Bool        `db:"is_blacklisted" json:"is_blacklisted"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	LastVisitAt          pgtype.Timestamptz `db:"last_visit_at" json:"last_visit_at"`
+	LineUid              string             `db:"line_uid" json:"line_uid"`
+	LineName             pgtype.Text        `db:"line_name" json:"line_name"`
+	Email                pgtype.Text        `db:"email" json:"email"`
+	InvoiceCarrierType   pgtype.Text        `db:"invoice_carrier_type" json:"invoice_carrier_type"`
+	InvoiceCarrierValue  pgtype.Text        `db:"invoice_carrier_value" json:"invoice_carrier_value"`
+	ReferralCode         pgtype.Text        `db:"referral_code" json:"referral_code"`
+	MergedIntoCustomerID pgtype.Int8        `db:"merged_into_customer_id" json:"merged_into_customer_id"`
+	MergedAt             pgtype.Timestamptz `db:"merged_at" json:"merged_at"`
+	CreatedBy            pgtype.Int8        `db:"created_by" json:"created_by"`
+	ErasedAt             pgtype.Timestamptz `db:"erased_at" json:"erased_at"`
+	Tags                 []string           `db:"tags" json:"tags"`
+	RequiresDeposit      bool               `db:"requires_deposit" json:"requires_deposit"`
+}
+
+type CustomerBirthdayBenefit struct {
+	ID               int64              `db:"id" json:"id"`
+	CustomerID       int64              `db:"customer_id" json:"customer_id"`
+	Year             int32              `db:"year" json:"year"`
+	CustomerCouponID pgtype.Int8        `db:"customer_coupon_id" json:"customer_coupon_id"`
+	CreatedAt        pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerBlacklistDecision struct {
 	ID             int64              `db:"id" json:"id"`
-	Name           string             `db:"name" json:"name"`
-	Phone          string             `db:"phone" json:"phone"`
-	Birthday       pgtype.Date        `db:"birthday" json:"birthday"`
-	City           pgtype.Text        `db:"city" json:"city"`
-	FavoriteShapes []string           `db:"favorite_shapes" json:"favorite_shapes"`
-	FavoriteColors []string           `db:"favorite_colors" json:"favorite_colors"`
-	FavoriteStyles []string           `db:"favorite_styles" json:"favorite_styles"`
-	IsIntrovert    pgtype.Bool        `db:"is_introvert" json:"is_introvert"`
-	ReferralSource []string           `db:"referral_source" json:"referral_source"`
-	Referrer       pgtype.Text        `db:"referrer" json:"referrer"`
-	CustomerNote   pgtype.Text        `db:"customer_note" json:"customer_note"`
-	StoreNote      pgtype.Text        `db:"store_note" json:"store_note"`
-	Level          pgtype.Text        `db:"level" json:"level"`
-	IsBlacklisted  pgtype.Bool        `db:"is_blacklisted" json:"is_blacklisted"`
+	CustomerID     int64              `db:"customer_id" json:"customer_id"`
+	RuleType       string             `db:"rule_type" json:"rule_type"`
+	Action         string             `db:"action" json:"action"`
+	ThresholdCount int32              `db:"threshold_count" json:"threshold_count"`
+	PeriodMonths   int32              `db:"period_months" json:"period_months"`
+	Evidence       []byte             `db:"evidence" json:"evidence"`
+	Status         string             `db:"status" json:"status"`
+	OverturnedBy   pgtype.Int8        `db:"overturned_by" json:"overturned_by"`
+	OverturnReason pgtype.Text        `db:"overturn_reason" json:"overturn_reason"`
+	OverturnedAt   pgtype.Timestamptz `db:"overturned_at" json:"overturned_at"`
 	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	LastVisitAt    pgtype.Timestamptz `db:"last_visit_at" json:"last_visit_at"`
-	LineUid        string             `db:"line_uid" json:"line_uid"`
-	LineName       pgtype.Text        `db:"line_name" json:"line_name"`
-	Email          pgtype.Text        `db:"email" json:"email"`
 }
 
 type CustomerCoupon struct {
@@ -134,6 +318,159 @@ type CustomerCoupon struct {
 	UsedAt     pgtype.Timestamptz `db:"used_at" json:"used_at"`
 	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	SourceType pgtype.Text        `db:"source_type" json:"source_type"`
+	SourceID   pgtype.Int8        `db:"source_id" json:"source_id"`
+}
+
+type CustomerDataRequest struct {
+	ID          int64              `db:"id" json:"id"`
+	CustomerID  int64              `db:"customer_id" json:"customer_id"`
+	RequestType string             `db:"request_type" json:"request_type"`
+	RequestedBy string             `db:"requested_by" json:"requested_by"`
+	StaffUserID pgtype.Int8        `db:"staff_user_id" json:"staff_user_id"`
+	Note        pgtype.Text        `db:"note" json:"note"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerHealthQuestionnaire struct {
+	ID                int64              `db:"id" json:"id"`
+	CustomerID        int64              `db:"customer_id" json:"customer_id"`
+	Version           string             `db:"version" json:"version"`
+	Allergies         []string           `db:"allergies" json:"allergies"`
+	AllergyNote       pgtype.Text        `db:"allergy_note" json:"allergy_note"`
+	SkinConditions    []string           `db:"skin_conditions" json:"skin_conditions"`
+	SkinConditionNote pgtype.Text        `db:"skin_condition_note" json:"skin_condition_note"`
+	IsPregnant        bool               `db:"is_pregnant" json:"is_pregnant"`
+	OtherNote         pgtype.Text        `db:"other_note" json:"other_note"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerLevelHistory struct {
+	ID         int64              `db:"id" json:"id"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	FromLevel  pgtype.Text        `db:"from_level" json:"from_level"`
+	ToLevel    string             `db:"to_level" json:"to_level"`
+	Reason     string             `db:"reason" json:"reason"`
+	Note       pgtype.Text        `db:"note" json:"note"`
+	CreatedBy  pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerLevelRule struct {
+	Level                    string             `db:"level" json:"level"`
+	IsActive                 bool               `db:"is_active" json:"is_active"`
+	PeriodMonths             int32              `db:"period_months" json:"period_months"`
+	MinSpendAmount           pgtype.Numeric     `db:"min_spend_amount" json:"min_spend_amount"`
+	MinVisitCount            int32              `db:"min_visit_count" json:"min_visit_count"`
+	BenefitCouponID          pgtype.Int8        `db:"benefit_coupon_id" json:"benefit_coupon_id"`
+	BenefitCouponValidMonths pgtype.Int4        `db:"benefit_coupon_valid_months" json:"benefit_coupon_valid_months"`
+	CreatedAt                pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerLinkRequest struct {
+	ID              int64              `db:"id" json:"id"`
+	CustomerID      int64              `db:"customer_id" json:"customer_id"`
+	LinkCustomerID  int64              `db:"link_customer_id" json:"link_customer_id"`
+	Status          string             `db:"status" json:"status"`
+	CustomerMergeID pgtype.Int8        `db:"customer_merge_id" json:"customer_merge_id"`
+	Note            pgtype.Text        `db:"note" json:"note"`
+	ReviewedBy      pgtype.Int8        `db:"reviewed_by" json:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz `db:"reviewed_at" json:"reviewed_at"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerMerge struct {
+	ID                     int64              `db:"id" json:"id"`
+	CustomerID             int64              `db:"customer_id" json:"customer_id"`
+	MergedCustomerID       int64              `db:"merged_customer_id" json:"merged_customer_id"`
+	CustomerSnapshot       []byte             `db:"customer_snapshot" json:"customer_snapshot"`
+	MergedCustomerSnapshot []byte             `db:"merged_customer_snapshot" json:"merged_customer_snapshot"`
+	MovedCounts            []byte             `db:"moved_counts" json:"moved_counts"`
+	Note                   pgtype.Text        `db:"note" json:"note"`
+	CreatedBy              pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt              pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerMetric struct {
+	CustomerID         int64              `db:"customer_id" json:"customer_id"`
+	LifetimeSpend      pgtype.Numeric     `db:"lifetime_spend" json:"lifetime_spend"`
+	AverageTicket      pgtype.Numeric     `db:"average_ticket" json:"average_ticket"`
+	VisitCount         int32              `db:"visit_count" json:"visit_count"`
+	VisitFrequencyDays pgtype.Numeric     `db:"visit_frequency_days" json:"visit_frequency_days"`
+	DaysSinceLastVisit pgtype.Int4        `db:"days_since_last_visit" json:"days_since_last_visit"`
+	NoShowRate         pgtype.Numeric     `db:"no_show_rate" json:"no_show_rate"`
+	CancellationRate   pgtype.Numeric     `db:"cancellation_rate" json:"cancellation_rate"`
+	ChurnRiskScore     pgtype.Int4        `db:"churn_risk_score" json:"churn_risk_score"`
+	ChurnRiskLevel     pgtype.Text        `db:"churn_risk_level" json:"churn_risk_level"`
+	CalculatedAt       pgtype.Timestamptz `db:"calculated_at" json:"calculated_at"`
+}
+
+type CustomerNote struct {
+	ID         int64              `db:"id" json:"id"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	BookingID  pgtype.Int8        `db:"booking_id" json:"booking_id"`
+	Content    string             `db:"content" json:"content"`
+	IsPinned   bool               `db:"is_pinned" json:"is_pinned"`
+	CreatedBy  pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerPoint struct {
+	ID         int64              `db:"id" json:"id"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	Balance    int32              `db:"balance" json:"balance"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerPointTransaction struct {
+	ID              int64              `db:"id" json:"id"`
+	CustomerID      int64              `db:"customer_id" json:"customer_id"`
+	StoreID         pgtype.Int8        `db:"store_id" json:"store_id"`
+	Type            string             `db:"type" json:"type"`
+	Points          int32              `db:"points" json:"points"`
+	Balance         int32              `db:"balance" json:"balance"`
+	RemainingPoints int32              `db:"remaining_points" json:"remaining_points"`
+	ExpiresAt       pgtype.Date        `db:"expires_at" json:"expires_at"`
+	SourceType      pgtype.Text        `db:"source_type" json:"source_type"`
+	SourceID        pgtype.Int8        `db:"source_id" json:"source_id"`
+	Note            pgtype.Text        `db:"note" json:"note"`
+	CreatedBy       pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type CustomerReferral struct {
+	ID                       int64              `db:"id" json:"id"`
+	ReferrerCustomerID       int64              `db:"referrer_customer_id" json:"referrer_customer_id"`
+	RefereeCustomerID        int64              `db:"referee_customer_id" json:"referee_customer_id"`
+	ReferralCode             string             `db:"referral_code" json:"referral_code"`
+	Status                   string             `db:"status" json:"status"`
+	FirstCheckoutID          pgtype.Int8        `db:"first_checkout_id" json:"first_checkout_id"`
+	ReferrerCustomerCouponID pgtype.Int8        `db:"referrer_customer_coupon_id" json:"referrer_customer_coupon_id"`
+	RefereeCustomerCouponID  pgtype.Int8        `db:"referee_customer_coupon_id" json:"referee_customer_coupon_id"`
+	CompletedAt              pgtype.Timestamptz `db:"completed_at" json:"completed_at"`
+	CreatedAt                pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerSegment struct {
+	ID                int64              `db:"id" json:"id"`
+	Name              string             `db:"name" json:"name"`
+	Description       pgtype.Text        `db:"description" json:"description"`
+	Level             pgtype.Text        `db:"level" json:"level"`
+	IsBlacklisted     pgtype.Bool        `db:"is_blacklisted" json:"is_blacklisted"`
+	MinPastDays       pgtype.Int4        `db:"min_past_days" json:"min_past_days"`
+	SpendPeriodMonths pgtype.Int4        `db:"spend_period_months" json:"spend_period_months"`
+	MinTotalSpend     pgtype.Numeric     `db:"min_total_spend" json:"min_total_spend"`
+	MaxTotalSpend     pgtype.Numeric     `db:"max_total_spend" json:"max_total_spend"`
+	MinVisitCount     pgtype.Int4        `db:"min_visit_count" json:"min_visit_count"`
+	MaxVisitCount     pgtype.Int4        `db:"max_visit_count" json:"max_visit_count"`
+	CreatedBy         int64              `db:"created_by" json:"created_by"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
 type CustomerTermsAcceptance struct {
@@ -157,6 +494,30 @@ type CustomerToken struct {
 	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type CustomerWallet struct {
+	ID         int64              `db:"id" json:"id"`
+	CustomerID int64              `db:"customer_id" json:"customer_id"`
+	Balance    pgtype.Numeric     `db:"balance" json:"balance"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type CustomerWalletTransaction struct {
+	ID            int64              `db:"id" json:"id"`
+	WalletID      int64              `db:"wallet_id" json:"wallet_id"`
+	CustomerID    int64              `db:"customer_id" json:"customer_id"`
+	StoreID       pgtype.Int8        `db:"store_id" json:"store_id"`
+	Type          string             `db:"type" json:"type"`
+	Amount        pgtype.Numeric     `db:"amount" json:"amount"`
+	Balance       pgtype.Numeric     `db:"balance" json:"balance"`
+	PaymentMethod pgtype.Text        `db:"payment_method" json:"payment_method"`
+	SourceType    pgtype.Text        `db:"source_type" json:"source_type"`
+	SourceID      pgtype.Int8        `db:"source_id" json:"source_id"`
+	Note          pgtype.Text        `db:"note" json:"note"`
+	CreatedBy     pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
 type Expense struct {
 	ID           int64              `db:"id" json:"id"`
 	StoreID      int64              `db:"store_id" json:"store_id"`
@@ -189,6 +550,71 @@ type ExpenseItem struct {
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type GiftCard struct {
+	ID                 int64              `db:"id" json:"id"`
+	StoreID            int64              `db:"store_id" json:"store_id"`
+	Code               string             `db:"code" json:"code"`
+	Amount             pgtype.Numeric     `db:"amount" json:"amount"`
+	Status             string             `db:"status" json:"status"`
+	ExpiresAt          pgtype.Date        `db:"expires_at" json:"expires_at"`
+	Note               pgtype.Text        `db:"note" json:"note"`
+	RedeemedCustomerID pgtype.Int8        `db:"redeemed_customer_id" json:"redeemed_customer_id"`
+	RedeemedAt         pgtype.Timestamptz `db:"redeemed_at" json:"redeemed_at"`
+	CreatedBy          int64              `db:"created_by" json:"created_by"`
+	CreatedAt          pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type Invoice struct {
+	ID            int64              `db:"id" json:"id"`
+	StoreID       int64              `db:"store_id" json:"store_id"`
+	CheckoutID    int64              `db:"checkout_id" json:"checkout_id"`
+	CustomerID    int64              `db:"customer_id" json:"customer_id"`
+	Amount        pgtype.Numeric     `db:"amount" json:"amount"`
+	CarrierType   pgtype.Text        `db:"carrier_type" json:"carrier_type"`
+	CarrierValue  pgtype.Text        `db:"carrier_value" json:"carrier_value"`
+	InvoiceNumber pgtype.Text        `db:"invoice_number" json:"invoice_number"`
+	RandomCode    pgtype.Text        `db:"random_code" json:"random_code"`
+	Status        string             `db:"status" json:"status"`
+	ErrorMessage  pgtype.Text        `db:"error_message" json:"error_message"`
+	IssuedAt      pgtype.Timestamptz `db:"issued_at" json:"issued_at"`
+	VoidedAt      pgtype.Timestamptz `db:"voided_at" json:"voided_at"`
+	VoidReason    pgtype.Text        `db:"void_reason" json:"void_reason"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type LineCampaign struct {
+	ID           int64              `db:"id" json:"id"`
+	Name         string             `db:"name" json:"name"`
+	SegmentID    int64              `db:"segment_id" json:"segment_id"`
+	MessageType  string             `db:"message_type" json:"message_type"`
+	TextContent  pgtype.Text        `db:"text_content" json:"text_content"`
+	AltText      pgtype.Text        `db:"alt_text" json:"alt_text"`
+	FlexContents []byte             `db:"flex_contents" json:"flex_contents"`
+	Status       string             `db:"status" json:"status"`
+	TargetCount  int32              `db:"target_count" json:"target_count"`
+	SentCount    int32              `db:"sent_count" json:"sent_count"`
+	FailedCount  int32              `db:"failed_count" json:"failed_count"`
+	ErrorMessage pgtype.Text        `db:"error_message" json:"error_message"`
+	StartedAt    pgtype.Timestamptz `db:"started_at" json:"started_at"`
+	FinishedAt   pgtype.Timestamptz `db:"finished_at" json:"finished_at"`
+	CreatedBy    int64              `db:"created_by" json:"created_by"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type LineCampaignRecipient struct {
+	CampaignID   int64              `db:"campaign_id" json:"campaign_id"`
+	CustomerID   int64              `db:"customer_id" json:"customer_id"`
+	LineUid      string             `db:"line_uid" json:"line_uid"`
+	Status       string             `db:"status" json:"status"`
+	ErrorMessage pgtype.Text        `db:"error_message" json:"error_message"`
+	SentAt       pgtype.Timestamptz `db:"sent_at" json:"sent_at"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type Product struct {
 	ID              int64              `db:"id" json:"id"`
 	StoreID         int64              `db:"store_id" json:"store_id"`
@@ -213,6 +639,41 @@ type ProductCategory struct {
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type Promotion struct {
+	ID              int64              `db:"id" json:"id"`
+	Name            string             `db:"name" json:"name"`
+	StoreID         pgtype.Int8        `db:"store_id" json:"store_id"`
+	DiscountRate    pgtype.Numeric     `db:"discount_rate" json:"discount_rate"`
+	DiscountAmount  pgtype.Numeric     `db:"discount_amount" json:"discount_amount"`
+	StartDate       pgtype.Date        `db:"start_date" json:"start_date"`
+	EndDate         pgtype.Date        `db:"end_date" json:"end_date"`
+	Weekdays        []int32            `db:"weekdays" json:"weekdays"`
+	StartTime       pgtype.Time        `db:"start_time" json:"start_time"`
+	EndTime         pgtype.Time        `db:"end_time" json:"end_time"`
+	ServiceScope    string             `db:"service_scope" json:"service_scope"`
+	StackWithCoupon bool               `db:"stack_with_coupon" json:"stack_with_coupon"`
+	IsActive        bool               `db:"is_active" json:"is_active"`
+	Note            pgtype.Text        `db:"note" json:"note"`
+	CreatedBy       int64              `db:"created_by" json:"created_by"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type PromotionService struct {
+	PromotionID int64 `db:"promotion_id" json:"promotion_id"`
+	ServiceID   int64 `db:"service_id" json:"service_id"`
+}
+
+type ReferralSetting struct {
+	ID                int16              `db:"id" json:"id"`
+	IsEnabled         bool               `db:"is_enabled" json:"is_enabled"`
+	ReferrerCouponID  pgtype.Int8        `db:"referrer_coupon_id" json:"referrer_coupon_id"`
+	RefereeCouponID   pgtype.Int8        `db:"referee_coupon_id" json:"referee_coupon_id"`
+	CouponValidMonths pgtype.Int4        `db:"coupon_valid_months" json:"coupon_valid_months"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type Schedule struct {
 	ID        int64              `db:"id" json:"id"`
 	StoreID   int64              `db:"store_id" json:"store_id"`
@@ -235,6 +696,7 @@ type Service struct {
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 	SortOrder       pgtype.Int4        `db:"sort_order" json:"sort_order"`
+	Allergens       []string           `db:"allergens" json:"allergens"`
 }
 
 type StaffUser struct {
@@ -289,6 +751,40 @@ type Store struct {
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type StoreAccountMapping struct {
+	ID            int64              `db:"id" json:"id"`
+	StoreID       int64              `db:"store_id" json:"store_id"`
+	MappingType   string             `db:"mapping_type" json:"mapping_type"`
+	PaymentMethod pgtype.Text        `db:"payment_method" json:"payment_method"`
+	PayerID       pgtype.Int8        `db:"payer_id" json:"payer_id"`
+	AccountID     int64              `db:"account_id" json:"account_id"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type StoreLoyaltySetting struct {
+	StoreID          int64              `db:"store_id" json:"store_id"`
+	IsEnabled        bool               `db:"is_enabled" json:"is_enabled"`
+	EarnAmountUnit   int32              `db:"earn_amount_unit" json:"earn_amount_unit"`
+	EarnPoints       int32              `db:"earn_points" json:"earn_points"`
+	RedeemPointsUnit int32              `db:"redeem_points_unit" json:"redeem_points_unit"`
+	RedeemAmount     int32              `db:"redeem_amount" json:"redeem_amount"`
+	ExpiryMonths     pgtype.Int4        `db:"expiry_months" json:"expiry_months"`
+	CreatedAt        pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type StoreWinBackSetting struct {
+	StoreID         int64              `db:"store_id" json:"store_id"`
+	IsEnabled       bool               `db:"is_enabled" json:"is_enabled"`
+	InactiveDays    int32              `db:"inactive_days" json:"inactive_days"`
+	CouponID        pgtype.Int8        `db:"coupon_id" json:"coupon_id"`
+	CouponValidDays int32              `db:"coupon_valid_days" json:"coupon_valid_days"`
+	LineMessage     pgtype.Text        `db:"line_message" json:"line_message"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type Stylist struct {
 	ID           int64              `db:"id" json:"id"`
 	StaffUserID  int64              `db:"staff_user_id" json:"staff_user_id"`
@@ -309,6 +805,18 @@ type Supplier struct {
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type TermsDocument struct {
+	ID            int64              `db:"id" json:"id"`
+	Version       string             `db:"version" json:"version"`
+	Title         string             `db:"title" json:"title"`
+	Content       string             `db:"content" json:"content"`
+	EffectiveDate pgtype.Date        `db:"effective_date" json:"effective_date"`
+	IsRequired    bool               `db:"is_required" json:"is_required"`
+	CreatedBy     pgtype.Int8        `db:"created_by" json:"created_by"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type TimeSlot struct {
 	ID          int64              `db:"id" json:"id"`
 	ScheduleID  int64              `db:"schedule_id" json:"schedule_id"`
@@ -336,3 +844,13 @@ type TimeSlotTemplateItem struct {
 	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
+
+type WinBackContact struct {
+	ID               int64              `db:"id" json:"id"`
+	StoreID          int64              `db:"store_id" json:"store_id"`
+	CustomerID       int64              `db:"customer_id" json:"customer_id"`
+	LastVisitAt      pgtype.Timestamptz `db:"last_visit_at" json:"last_visit_at"`
+	CustomerCouponID pgtype.Int8        `db:"customer_coupon_id" json:"customer_coupon_id"`
+	ContactedAt      pgtype.Timestamptz `db:"contacted_at" json:"contacted_at"`
+	ReturnedAt       pgtype.Timestamptz `db:"returned_at" json:"returned_at"`
+}
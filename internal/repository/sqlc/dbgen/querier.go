@@ -11,6 +11,7 @@ import (
 )
 
 type Querier interface {
+	AnonymizeCustomer(ctx context.Context, arg AnonymizeCustomerParams) error
 	BatchCreateExpenseItems(ctx context.Context, arg []BatchCreateExpenseItemsParams) (int64, error)
 	BatchCreateSchedules(ctx context.Context, arg []BatchCreateSchedulesParams) (int64, error)
 	BatchCreateStaffUserStoreAccess(ctx context.Context, arg []BatchCreateStaffUserStoreAccessParams) (int64, error)
@@ -18,13 +19,17 @@ type Querier interface {
 	BatchCreateTimeSlots(ctx context.Context, arg []BatchCreateTimeSlotsParams) (int64, error)
 	BulkCreateBookingProducts(ctx context.Context, arg []BulkCreateBookingProductsParams) (int64, error)
 	BulkCreateCheckout(ctx context.Context, arg []BulkCreateCheckoutParams) (int64, error)
+	BulkCreateStoreAccountMappings(ctx context.Context, arg []BulkCreateStoreAccountMappingsParams) (int64, error)
 	BulkDeleteBookingProducts(ctx context.Context, arg BulkDeleteBookingProductsParams) error
 	CancelBooking(ctx context.Context, arg CancelBookingParams) (int64, error)
+	CheckAccountNegativeBalanceExists(ctx context.Context, accountID int64) (bool, error)
+	CheckActiveCustomerExistsByPhone(ctx context.Context, phone string) (bool, error)
 	CheckAllBookingExistsByTimeSlotID(ctx context.Context, timeSlotID int64) (bool, error)
 	CheckAllExpenseItemsAreArrived(ctx context.Context, expenseID int64) (bool, error)
 	CheckBrandExistByID(ctx context.Context, id int64) (bool, error)
 	CheckBrandNameExists(ctx context.Context, name string) (bool, error)
 	CheckBrandNameExistsExcludeSelf(ctx context.Context, arg CheckBrandNameExistsExcludeSelfParams) (bool, error)
+	CheckCashDrawerCloseExists(ctx context.Context, arg CheckCashDrawerCloseExistsParams) (bool, error)
 	CheckCouponCodeExists(ctx context.Context, code string) (bool, error)
 	CheckCouponExists(ctx context.Context, id int64) (bool, error)
 	CheckCouponNameExists(ctx context.Context, name string) (bool, error)
@@ -32,6 +37,8 @@ type Querier interface {
 	CheckCustomerCouponExists(ctx context.Context, arg CheckCustomerCouponExistsParams) (bool, error)
 	CheckCustomerExistsByID(ctx context.Context, id int64) (bool, error)
 	CheckCustomerExistsByLineUid(ctx context.Context, lineUid string) (bool, error)
+	CheckCustomerHasScheduledBookings(ctx context.Context, customerID int64) (bool, error)
+	CheckCustomerTermsAcceptanceExistsByVersion(ctx context.Context, termsVersion string) (bool, error)
 	CheckCustomerTermsExistsByCustomerIDAndVersion(ctx context.Context, arg CheckCustomerTermsExistsByCustomerIDAndVersionParams) (bool, error)
 	CheckExpenseItemsExistsByExpenseID(ctx context.Context, expenseID int64) (bool, error)
 	CheckProductCategoryExistByID(ctx context.Context, id int64) (bool, error)
@@ -58,28 +65,66 @@ type Querier interface {
 	CheckSupplierExistsByID(ctx context.Context, id int64) (bool, error)
 	CheckSupplierNameExists(ctx context.Context, name string) (bool, error)
 	CheckSupplierNameExistsExcluding(ctx context.Context, arg CheckSupplierNameExistsExcludingParams) (bool, error)
+	CheckTermsDocumentVersionExists(ctx context.Context, version string) (bool, error)
 	CheckTimeSlotOverlap(ctx context.Context, arg CheckTimeSlotOverlapParams) (bool, error)
 	CheckTimeSlotOverlapExcluding(ctx context.Context, arg CheckTimeSlotOverlapExcludingParams) (bool, error)
 	CheckTimeSlotTemplateExists(ctx context.Context, id int64) (bool, error)
 	CheckTimeSlotTemplateItemExistsByIDAndTemplateID(ctx context.Context, arg CheckTimeSlotTemplateItemExistsByIDAndTemplateIDParams) (bool, error)
 	CheckTimeSlotTemplateItemOverlap(ctx context.Context, arg CheckTimeSlotTemplateItemOverlapParams) (bool, error)
 	CheckValidBookingExistsByTimeSlotID(ctx context.Context, timeSlotID int64) (bool, error)
+	ClearCustomerMergeSnapshots(ctx context.Context, arg ClearCustomerMergeSnapshotsParams) error
+	ClearLineCampaignRecipientsLineUid(ctx context.Context, customerID int64) error
+	CountActiveCustomerBlacklistDecisionsByAction(ctx context.Context, arg CountActiveCustomerBlacklistDecisionsByActionParams) (int64, error)
+	CountCouponCampaignTargetCustomers(ctx context.Context, id int64) (int64, error)
+	CountCouponRedemptions(ctx context.Context, couponID int64) (int64, error)
+	CountCustomerCouponRedemptions(ctx context.Context, arg CountCustomerCouponRedemptionsParams) (int64, error)
+	CountCustomerHealthQuestionnairesByCustomerID(ctx context.Context, customerID int64) (int64, error)
+	CountCustomerNotesByCustomerID(ctx context.Context, customerID int64) (int64, error)
 	CountExpiredOrRevokedCustomerTokens(ctx context.Context) (int64, error)
 	CountExpiredOrRevokedStaffUserTokens(ctx context.Context) (int64, error)
+	CountLineCampaignRecipients(ctx context.Context, campaignID int64) (int64, error)
 	CountProductsByIDs(ctx context.Context, arg CountProductsByIDsParams) (int64, error)
+	CountStoreAccountsByIDs(ctx context.Context, arg CountStoreAccountsByIDsParams) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) error
+	CreateAccountStatementImport(ctx context.Context, arg CreateAccountStatementImportParams) error
+	CreateAccountStatementLines(ctx context.Context, arg []CreateAccountStatementLinesParams) (int64, error)
 	CreateAccountTransaction(ctx context.Context, arg CreateAccountTransactionParams) (int64, error)
+	CreateAccountTransactionAudit(ctx context.Context, arg CreateAccountTransactionAuditParams) error
+	CreateAccountTransfer(ctx context.Context, arg CreateAccountTransferParams) error
 	CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error)
 	CreateBookingDetails(ctx context.Context, arg []CreateBookingDetailsParams) (int64, error)
 	CreateBrand(ctx context.Context, arg CreateBrandParams) (int64, error)
+	CreateCashDrawerClose(ctx context.Context, arg CreateCashDrawerCloseParams) (int64, error)
 	CreateCoupon(ctx context.Context, arg CreateCouponParams) error
+	CreateCouponCampaign(ctx context.Context, arg CreateCouponCampaignParams) error
+	CreateCouponServices(ctx context.Context, arg CreateCouponServicesParams) error
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) error
+	CreateCustomerBirthdayBenefit(ctx context.Context, arg CreateCustomerBirthdayBenefitParams) (int64, error)
+	CreateCustomerBlacklistDecision(ctx context.Context, arg CreateCustomerBlacklistDecisionParams) error
 	CreateCustomerCoupon(ctx context.Context, arg CreateCustomerCouponParams) error
+	CreateCustomerCouponWithSource(ctx context.Context, arg CreateCustomerCouponWithSourceParams) error
+	CreateCustomerDataRequest(ctx context.Context, arg CreateCustomerDataRequestParams) error
+	CreateCustomerHealthQuestionnaire(ctx context.Context, arg CreateCustomerHealthQuestionnaireParams) (pgtype.Timestamptz, error)
+	CreateCustomerLevelHistory(ctx context.Context, arg CreateCustomerLevelHistoryParams) error
+	CreateCustomerLinkRequest(ctx context.Context, arg CreateCustomerLinkRequestParams) error
+	CreateCustomerMerge(ctx context.Context, arg CreateCustomerMergeParams) error
+	CreateCustomerNote(ctx context.Context, arg CreateCustomerNoteParams) error
+	CreateCustomerPointIfNotExists(ctx context.Context, arg CreateCustomerPointIfNotExistsParams) error
+	CreateCustomerPointTransaction(ctx context.Context, arg CreateCustomerPointTransactionParams) error
+	CreateCustomerReferral(ctx context.Context, arg CreateCustomerReferralParams) error
+	CreateCustomerSegment(ctx context.Context, arg CreateCustomerSegmentParams) error
 	CreateCustomerTermsAcceptance(ctx context.Context, arg CreateCustomerTermsAcceptanceParams) error
 	CreateCustomerToken(ctx context.Context, arg CreateCustomerTokenParams) (CustomerToken, error)
+	CreateCustomerWalletIfNotExists(ctx context.Context, arg CreateCustomerWalletIfNotExistsParams) error
+	CreateCustomerWalletTransaction(ctx context.Context, arg CreateCustomerWalletTransactionParams) error
 	CreateExpense(ctx context.Context, arg CreateExpenseParams) (int64, error)
+	CreateGiftCard(ctx context.Context, arg CreateGiftCardParams) error
+	CreateInvoice(ctx context.Context, arg CreateInvoiceParams) error
+	CreateLineCampaign(ctx context.Context, arg CreateLineCampaignParams) error
 	CreateProduct(ctx context.Context, arg CreateProductParams) error
 	CreateProductCategory(ctx context.Context, arg CreateProductCategoryParams) (int64, error)
+	CreatePromotion(ctx context.Context, arg CreatePromotionParams) error
+	CreatePromotionServices(ctx context.Context, arg CreatePromotionServicesParams) error
 	CreateService(ctx context.Context, arg CreateServiceParams) (CreateServiceRow, error)
 	CreateStaffUser(ctx context.Context, arg CreateStaffUserParams) (CreateStaffUserRow, error)
 	CreateStaffUserStoreAccess(ctx context.Context, arg CreateStaffUserStoreAccessParams) error
@@ -89,85 +134,252 @@ type Querier interface {
 	CreateStoreExpenseItem(ctx context.Context, arg CreateStoreExpenseItemParams) error
 	CreateStylist(ctx context.Context, arg CreateStylistParams) (Stylist, error)
 	CreateSupplier(ctx context.Context, arg CreateSupplierParams) (int64, error)
+	CreateTermsDocument(ctx context.Context, arg CreateTermsDocumentParams) error
 	CreateTimeSlot(ctx context.Context, arg CreateTimeSlotParams) (TimeSlot, error)
 	CreateTimeSlotTemplate(ctx context.Context, arg CreateTimeSlotTemplateParams) (TimeSlotTemplate, error)
 	CreateTimeSlotTemplateItem(ctx context.Context, arg CreateTimeSlotTemplateItemParams) (CreateTimeSlotTemplateItemRow, error)
+	CreateWalkInCustomer(ctx context.Context, arg CreateWalkInCustomerParams) error
+	CreateWinBackContact(ctx context.Context, arg CreateWinBackContactParams) (int64, error)
+	DeleteAccountTransactionByID(ctx context.Context, id int64) error
+	DeleteAccountTransferByID(ctx context.Context, id int64) error
+	DeleteCouponServicesByCouponID(ctx context.Context, couponID int64) error
 	DeleteCustomerCoupon(ctx context.Context, id int64) error
+	DeleteCustomerHealthQuestionnairesByCustomerID(ctx context.Context, customerID int64) error
+	DeleteCustomerNote(ctx context.Context, id int64) error
+	DeleteCustomerNotesByCustomerID(ctx context.Context, customerID int64) error
 	DeleteCustomerTokensBatch(ctx context.Context, limit int32) error
+	DeleteCustomerTokensByCustomerID(ctx context.Context, customerID int64) error
 	DeleteLatestAccountTransaction(ctx context.Context, accountID int64) (int64, error)
 	DeleteSchedulesByIDs(ctx context.Context, dollar_1 []int64) error
 	DeleteStaffUserStoreAccess(ctx context.Context, arg DeleteStaffUserStoreAccessParams) error
 	DeleteStaffUserTokensBatch(ctx context.Context, limit int32) error
+	DeleteStoreAccountMappingsByStoreID(ctx context.Context, storeID int64) error
 	DeleteStoreExpenseItem(ctx context.Context, arg DeleteStoreExpenseItemParams) error
 	DeleteTimeSlotByID(ctx context.Context, id int64) error
 	DeleteTimeSlotTemplate(ctx context.Context, id int64) error
 	DeleteTimeSlotTemplateItem(ctx context.Context, id int64) error
+	GetAccountBalanceAsOfDate(ctx context.Context, arg GetAccountBalanceAsOfDateParams) (int64, error)
 	GetAccountByID(ctx context.Context, id int64) (GetAccountByIDRow, error)
+	GetAccountByIDForUpdate(ctx context.Context, id int64) (GetAccountByIDForUpdateRow, error)
+	GetAccountStatementLayoutByAccountID(ctx context.Context, accountID int64) (AccountStatementLayout, error)
+	GetAccountStatementLineByIDForUpdate(ctx context.Context, id int64) (GetAccountStatementLineByIDForUpdateRow, error)
 	GetAccountTransactionByID(ctx context.Context, id int64) (GetAccountTransactionByIDRow, error)
 	GetAccountTransactionCurrentBalance(ctx context.Context, accountID int64) (int32, error)
+	GetAccountTransactionsBySource(ctx context.Context, arg GetAccountTransactionsBySourceParams) ([]GetAccountTransactionsBySourceRow, error)
+	GetActiveBlacklistRules(ctx context.Context) ([]BlacklistRule, error)
+	GetActiveCustomerLevelRules(ctx context.Context) ([]CustomerLevelRule, error)
+	GetActivePromotionsByStoreAndDate(ctx context.Context, arg GetActivePromotionsByStoreAndDateParams) ([]GetActivePromotionsByStoreAndDateRow, error)
 	GetActiveStaffUserByUsername(ctx context.Context, username string) (StaffUser, error)
 	GetActiveStylistNameByID(ctx context.Context, id int64) (pgtype.Text, error)
 	GetAllActiveStoreAccessByStaffId(ctx context.Context, staffUserID int64) ([]GetAllActiveStoreAccessByStaffIdRow, error)
 	GetAllActiveStoresName(ctx context.Context) ([]GetAllActiveStoresNameRow, error)
+	GetAllBlacklistRules(ctx context.Context) ([]BlacklistRule, error)
 	GetAllBookingProductIdsByBookingID(ctx context.Context, bookingID int64) ([]int64, error)
+	GetAllCustomerLevelRules(ctx context.Context) ([]CustomerLevelRule, error)
+	GetAllTermsDocuments(ctx context.Context) ([]GetAllTermsDocumentsRow, error)
 	GetAvailableSchedules(ctx context.Context, arg GetAvailableSchedulesParams) ([]GetAvailableSchedulesRow, error)
 	GetAvailableTimeSlotsByScheduleID(ctx context.Context, scheduleID int64) ([]TimeSlot, error)
+	GetBirthdayBenefitSetting(ctx context.Context) (BirthdayBenefitSetting, error)
+	GetBirthdayBenefitTargetCustomers(ctx context.Context, arg GetBirthdayBenefitTargetCustomersParams) ([]GetBirthdayBenefitTargetCustomersRow, error)
 	GetBookingDetailByID(ctx context.Context, id int64) (GetBookingDetailByIDRow, error)
 	GetBookingDetailPriceInfoByBookingID(ctx context.Context, bookingID int64) ([]GetBookingDetailPriceInfoByBookingIDRow, error)
 	GetBookingDetailsByBookingID(ctx context.Context, bookingID int64) ([]GetBookingDetailsByBookingIDRow, error)
 	GetBookingDetailsByBookingIDs(ctx context.Context, dollar_1 []int64) ([]GetBookingDetailsByBookingIDsRow, error)
 	GetBookingInfoWithDateByID(ctx context.Context, id int64) (GetBookingInfoWithDateByIDRow, error)
 	GetCheckoutByBookingID(ctx context.Context, bookingID int64) (GetCheckoutByBookingIDRow, error)
+	GetCheckoutByIDForUpdate(ctx context.Context, id int64) (GetCheckoutByIDForUpdateRow, error)
+	GetCheckoutReceiptByID(ctx context.Context, id int64) (GetCheckoutReceiptByIDRow, error)
 	GetCouponByIDs(ctx context.Context, dollar_1 []int64) ([]GetCouponByIDsRow, error)
+	GetCouponCampaignByID(ctx context.Context, id int64) (CouponCampaign, error)
+	GetCouponCampaignTargetCustomers(ctx context.Context, arg GetCouponCampaignTargetCustomersParams) ([]GetCouponCampaignTargetCustomersRow, error)
+	GetCouponRuleByID(ctx context.Context, id int64) (GetCouponRuleByIDRow, error)
+	GetCouponRuleByIDForUpdate(ctx context.Context, id int64) (GetCouponRuleByIDForUpdateRow, error)
+	GetCouponServiceIDsByCouponID(ctx context.Context, couponID int64) ([]int64, error)
+	GetCouponServicesByCouponIDs(ctx context.Context, couponIds []int64) ([]CouponService, error)
+	GetCurrentRequiredTermsDocument(ctx context.Context) (GetCurrentRequiredTermsDocumentRow, error)
+	GetCurrentTermsDocument(ctx context.Context) (GetCurrentTermsDocumentRow, error)
+	GetCustomerBlacklistDecisionByIDForUpdate(ctx context.Context, id int64) (CustomerBlacklistDecision, error)
+	GetCustomerBlacklistIncidents(ctx context.Context, arg GetCustomerBlacklistIncidentsParams) ([]GetCustomerBlacklistIncidentsRow, error)
+	GetCustomerBlacklistStateForUpdate(ctx context.Context, id int64) (GetCustomerBlacklistStateForUpdateRow, error)
+	GetCustomerBookingsForExport(ctx context.Context, customerID int64) ([]GetCustomerBookingsForExportRow, error)
 	GetCustomerByID(ctx context.Context, id int64) (GetCustomerByIDRow, error)
 	GetCustomerByIDs(ctx context.Context, dollar_1 []int64) ([]GetCustomerByIDsRow, error)
 	GetCustomerByLineUid(ctx context.Context, lineUid string) (GetCustomerByLineUidRow, error)
+	GetCustomerCheckoutStatsSince(ctx context.Context, arg GetCustomerCheckoutStatsSinceParams) (GetCustomerCheckoutStatsSinceRow, error)
+	GetCustomerCheckoutsForExport(ctx context.Context, customerID int64) ([]GetCustomerCheckoutsForExportRow, error)
 	GetCustomerCouponForDelete(ctx context.Context, id int64) (GetCustomerCouponForDeleteRow, error)
 	GetCustomerCouponPriceInfoByID(ctx context.Context, id int64) (GetCustomerCouponPriceInfoByIDRow, error)
+	GetCustomerCouponsForExport(ctx context.Context, customerID int64) ([]GetCustomerCouponsForExportRow, error)
+	GetCustomerDataRequestsByCustomerID(ctx context.Context, customerID int64) ([]GetCustomerDataRequestsByCustomerIDRow, error)
+	GetCustomerForErasure(ctx context.Context, id int64) (GetCustomerForErasureRow, error)
+	GetCustomerHealthQuestionnairesByCustomerID(ctx context.Context, arg GetCustomerHealthQuestionnairesByCustomerIDParams) ([]CustomerHealthQuestionnaire, error)
+	GetCustomerHealthQuestionnairesForExport(ctx context.Context, customerID int64) ([]CustomerHealthQuestionnaire, error)
+	GetCustomerIDByReferralCode(ctx context.Context, referralCode pgtype.Text) (int64, error)
+	GetCustomerIDsWithCheckoutsSince(ctx context.Context, arg GetCustomerIDsWithCheckoutsSinceParams) ([]int64, error)
+	GetCustomerIDsWithExpiredPoints(ctx context.Context, arg GetCustomerIDsWithExpiredPointsParams) ([]int64, error)
+	GetCustomerIDsWithLevels(ctx context.Context, arg GetCustomerIDsWithLevelsParams) ([]int64, error)
+	GetCustomerLastCheckoutAt(ctx context.Context, customerID int64) (pgtype.Timestamptz, error)
+	GetCustomerLatestAcceptedTermsEffectiveDate(ctx context.Context, customerID int64) (pgtype.Date, error)
+	GetCustomerLevelByIDForUpdate(ctx context.Context, id int64) (pgtype.Text, error)
+	GetCustomerLinkRequestByIDForUpdate(ctx context.Context, id int64) (CustomerLinkRequest, error)
+	GetCustomerMergesByCustomerID(ctx context.Context, customerID int64) ([]GetCustomerMergesByCustomerIDRow, error)
+	GetCustomerMetricByCustomerID(ctx context.Context, customerID int64) (CustomerMetric, error)
+	GetCustomerMetricSources(ctx context.Context, arg GetCustomerMetricSourcesParams) ([]GetCustomerMetricSourcesRow, error)
+	GetCustomerNoteByID(ctx context.Context, id int64) (GetCustomerNoteByIDRow, error)
+	GetCustomerNotesByCustomerID(ctx context.Context, arg GetCustomerNotesByCustomerIDParams) ([]GetCustomerNotesByCustomerIDRow, error)
+	GetCustomerNotesForBooking(ctx context.Context, arg GetCustomerNotesForBookingParams) ([]GetCustomerNotesForBookingRow, error)
+	GetCustomerPointByCustomerID(ctx context.Context, customerID int64) (CustomerPoint, error)
+	GetCustomerPointByCustomerIDForUpdate(ctx context.Context, customerID int64) (GetCustomerPointByCustomerIDForUpdateRow, error)
+	GetCustomerPointRemainingLotsForUpdate(ctx context.Context, customerID int64) ([]GetCustomerPointRemainingLotsForUpdateRow, error)
+	GetCustomerPointTransactionsBySource(ctx context.Context, arg GetCustomerPointTransactionsBySourceParams) ([]GetCustomerPointTransactionsBySourceRow, error)
+	GetCustomerSegmentByID(ctx context.Context, id int64) (CustomerSegment, error)
+	GetCustomerTagsByID(ctx context.Context, id int64) ([]string, error)
 	GetCustomerTermsAcceptanceByCustomerIDAndVersion(ctx context.Context, arg GetCustomerTermsAcceptanceByCustomerIDAndVersionParams) (GetCustomerTermsAcceptanceByCustomerIDAndVersionRow, error)
+	GetCustomerTermsAcceptancesByCustomerID(ctx context.Context, customerID int64) ([]GetCustomerTermsAcceptancesByCustomerIDRow, error)
+	GetCustomerWalletByCustomerID(ctx context.Context, customerID int64) (CustomerWallet, error)
+	GetCustomerWalletByCustomerIDForUpdate(ctx context.Context, customerID int64) (GetCustomerWalletByCustomerIDForUpdateRow, error)
+	GetEnabledStoreWinBackSettings(ctx context.Context) ([]StoreWinBackSetting, error)
 	GetExpenseReportByCategory(ctx context.Context, arg GetExpenseReportByCategoryParams) ([]GetExpenseReportByCategoryRow, error)
 	GetExpenseReportByPayer(ctx context.Context, arg GetExpenseReportByPayerParams) ([]GetExpenseReportByPayerRow, error)
 	GetExpenseReportBySupplier(ctx context.Context, arg GetExpenseReportBySupplierParams) ([]GetExpenseReportBySupplierRow, error)
 	GetExpenseReportSummary(ctx context.Context, arg GetExpenseReportSummaryParams) (GetExpenseReportSummaryRow, error)
+	GetExpiredCustomerPointLotsForUpdate(ctx context.Context, arg GetExpiredCustomerPointLotsForUpdateParams) ([]GetExpiredCustomerPointLotsForUpdateRow, error)
+	GetGiftCardByCodeForUpdate(ctx context.Context, code string) (GetGiftCardByCodeForUpdateRow, error)
+	GetInvoiceByCheckoutIDForUpdate(ctx context.Context, checkoutID int64) (GetInvoiceByCheckoutIDForUpdateRow, error)
+	GetInvoiceByID(ctx context.Context, id int64) (Invoice, error)
+	GetInvoiceByIDForUpdate(ctx context.Context, id int64) (Invoice, error)
+	GetLatestAccountTransactionByAccountID(ctx context.Context, accountID int64) (GetLatestAccountTransactionByAccountIDRow, error)
+	GetLatestCustomerBlacklistDecidedAt(ctx context.Context, arg GetLatestCustomerBlacklistDecidedAtParams) (pgtype.Timestamptz, error)
+	GetLatestCustomerHealthQuestionnaire(ctx context.Context, customerID int64) (CustomerHealthQuestionnaire, error)
+	GetLatestCustomerHealthQuestionnairesByCustomerIDs(ctx context.Context, customerIds []int64) ([]CustomerHealthQuestionnaire, error)
+	GetLatestCustomerLevelHistoryReason(ctx context.Context, customerID int64) (string, error)
+	GetLineCampaignByID(ctx context.Context, id int64) (LineCampaign, error)
+	GetLinkableCustomerByPhoneAndBirthday(ctx context.Context, arg GetLinkableCustomerByPhoneAndBirthdayParams) (int64, error)
+	GetPendingCustomerLinkRequests(ctx context.Context) ([]GetPendingCustomerLinkRequestsRow, error)
+	GetPendingCustomerReferralByRefereeIDForUpdate(ctx context.Context, refereeCustomerID int64) (GetPendingCustomerReferralByRefereeIDForUpdateRow, error)
+	GetPendingLineCampaignRecipients(ctx context.Context, arg GetPendingLineCampaignRecipientsParams) ([]GetPendingLineCampaignRecipientsRow, error)
 	GetProductByID(ctx context.Context, id int64) (GetProductByIDRow, error)
 	GetProductWithDetailsByID(ctx context.Context, id int64) (GetProductWithDetailsByIDRow, error)
 	GetProductsStockInfoByIDs(ctx context.Context, dollar_1 []int64) ([]GetProductsStockInfoByIDsRow, error)
+	GetPromotionByID(ctx context.Context, id int64) (Promotion, error)
+	GetPromotionServicesByPromotionIDs(ctx context.Context, column1 []int64) ([]GetPromotionServicesByPromotionIDsRow, error)
+	GetReferralSetting(ctx context.Context) (ReferralSetting, error)
 	GetScheduleByID(ctx context.Context, id int64) (GetScheduleByIDRow, error)
 	GetScheduleWithTimeSlotsByID(ctx context.Context, id int64) ([]GetScheduleWithTimeSlotsByIDRow, error)
+	GetServiceAllergensByIDs(ctx context.Context, ids []int64) ([]GetServiceAllergensByIDsRow, error)
 	GetServiceByID(ctx context.Context, id int64) (GetServiceByIDRow, error)
 	GetServiceByIds(ctx context.Context, dollar_1 []int64) ([]GetServiceByIdsRow, error)
 	GetStaffUserByID(ctx context.Context, id int64) (StaffUser, error)
+	GetStaleCouponCampaignIDs(ctx context.Context, updatedAt pgtype.Timestamptz) ([]int64, error)
+	GetStaleLineCampaignIDs(ctx context.Context, updatedAt pgtype.Timestamptz) ([]int64, error)
 	GetStockUsageByID(ctx context.Context, id int64) (StockUsage, error)
+	GetStoreAccountMappingsByStoreID(ctx context.Context, storeID int64) ([]GetStoreAccountMappingsByStoreIDRow, error)
 	GetStoreByID(ctx context.Context, id int64) (GetStoreByIDRow, error)
+	GetStoreCashCheckoutSummaryByDate(ctx context.Context, arg GetStoreCashCheckoutSummaryByDateParams) (GetStoreCashCheckoutSummaryByDateRow, error)
+	GetStoreCashWalletTopUpAmountByDate(ctx context.Context, arg GetStoreCashWalletTopUpAmountByDateParams) (pgtype.Numeric, error)
 	GetStoreDetailByID(ctx context.Context, id int64) (Store, error)
 	GetStoreExpenseByID(ctx context.Context, arg GetStoreExpenseByIDParams) (GetStoreExpenseByIDRow, error)
+	GetStoreExpenseByIDForUpdate(ctx context.Context, arg GetStoreExpenseByIDForUpdateParams) (GetStoreExpenseByIDForUpdateRow, error)
 	GetStoreExpenseItemByID(ctx context.Context, arg GetStoreExpenseItemByIDParams) (GetStoreExpenseItemByIDRow, error)
 	GetStoreExpenseItemsByExpenseID(ctx context.Context, expenseID int64) ([]GetStoreExpenseItemsByExpenseIDRow, error)
+	GetStoreExpensePayerAccountID(ctx context.Context, arg GetStoreExpensePayerAccountIDParams) (int64, error)
+	GetStoreLoyaltySettingByStoreID(ctx context.Context, storeID int64) (StoreLoyaltySetting, error)
+	GetStorePaymentMethodAccountID(ctx context.Context, arg GetStorePaymentMethodAccountIDParams) (int64, error)
 	GetStorePerformanceGroupByStylist(ctx context.Context, arg GetStorePerformanceGroupByStylistParams) ([]GetStorePerformanceGroupByStylistRow, error)
+	GetStoreWinBackSettingByStoreID(ctx context.Context, storeID int64) (StoreWinBackSetting, error)
 	GetStylistByID(ctx context.Context, id int64) (Stylist, error)
 	GetStylistByStaffUserID(ctx context.Context, staffUserID int64) (Stylist, error)
 	GetStylistIDByStaffUserID(ctx context.Context, staffUserID int64) (int64, error)
 	GetStylistPerformanceGroupByStore(ctx context.Context, arg GetStylistPerformanceGroupByStoreParams) ([]GetStylistPerformanceGroupByStoreRow, error)
+	GetTermsDocumentByID(ctx context.Context, id int64) (GetTermsDocumentByIDRow, error)
+	GetTermsDocumentByVersion(ctx context.Context, version string) (GetTermsDocumentByVersionRow, error)
 	GetTimeSlotByID(ctx context.Context, id int64) (TimeSlot, error)
 	GetTimeSlotTemplateItemsByTemplateID(ctx context.Context, templateID int64) ([]GetTimeSlotTemplateItemsByTemplateIDRow, error)
 	GetTimeSlotTemplateWithItemsByID(ctx context.Context, id int64) ([]GetTimeSlotTemplateWithItemsByIDRow, error)
 	GetTimeSlotWithScheduleByID(ctx context.Context, id int64) (GetTimeSlotWithScheduleByIDRow, error)
+	GetUnreconciledAccountTransactionsByDateRange(ctx context.Context, arg GetUnreconciledAccountTransactionsByDateRangeParams) ([]GetUnreconciledAccountTransactionsByDateRangeRow, error)
 	GetValidCustomerToken(ctx context.Context, refreshToken string) (GetValidCustomerTokenRow, error)
 	GetValidStaffUserToken(ctx context.Context, refreshToken string) (GetValidStaffUserTokenRow, error)
+	GetWinBackContactStatsByStoreID(ctx context.Context, arg GetWinBackContactStatsByStoreIDParams) (GetWinBackContactStatsByStoreIDRow, error)
+	GetWinBackTargetCustomers(ctx context.Context, arg GetWinBackTargetCustomersParams) ([]GetWinBackTargetCustomersRow, error)
+	LockCashDrawerByDate(ctx context.Context, arg LockCashDrawerByDateParams) error
+	LockCustomersForMerge(ctx context.Context, ids []int64) error
+	MarkCustomerMerged(ctx context.Context, arg MarkCustomerMergedParams) error
+	MoveCustomerBirthdayBenefits(ctx context.Context, arg MoveCustomerBirthdayBenefitsParams) (int64, error)
+	MoveCustomerBlacklistDecisions(ctx context.Context, arg MoveCustomerBlacklistDecisionsParams) (int64, error)
+	MoveCustomerBookings(ctx context.Context, arg MoveCustomerBookingsParams) (int64, error)
+	MoveCustomerCoupons(ctx context.Context, arg MoveCustomerCouponsParams) (int64, error)
+	MoveCustomerHealthQuestionnaires(ctx context.Context, arg MoveCustomerHealthQuestionnairesParams) (int64, error)
+	MoveCustomerInvoices(ctx context.Context, arg MoveCustomerInvoicesParams) (int64, error)
+	MoveCustomerNotes(ctx context.Context, arg MoveCustomerNotesParams) (int64, error)
+	MoveCustomerReferralsAsReferrer(ctx context.Context, arg MoveCustomerReferralsAsReferrerParams) (int64, error)
+	MoveCustomerTermsAcceptances(ctx context.Context, arg MoveCustomerTermsAcceptancesParams) (int64, error)
+	MoveCustomerTokens(ctx context.Context, arg MoveCustomerTokensParams) (int64, error)
+	MoveWinBackContacts(ctx context.Context, arg MoveWinBackContactsParams) (int64, error)
+	OverturnCustomerBlacklistDecision(ctx context.Context, arg OverturnCustomerBlacklistDecisionParams) error
+	RecomputeAccountTransactionBalances(ctx context.Context, accountID int64) error
+	ResetAccountStatementLinesByTransactionID(ctx context.Context, accountTransactionID pgtype.Int8) error
 	RevokeCustomerToken(ctx context.Context, refreshToken string) error
 	RevokeStaffUserToken(ctx context.Context, refreshToken string) error
+	ShiftAccountTransactionBalancesAfterDate(ctx context.Context, arg ShiftAccountTransactionBalancesAfterDateParams) error
+	UpdateAccountStatementLineStatus(ctx context.Context, arg UpdateAccountStatementLineStatusParams) error
+	UpdateAccountTransactionEntry(ctx context.Context, arg UpdateAccountTransactionEntryParams) error
+	UpdateAccountTransfer(ctx context.Context, arg UpdateAccountTransferParams) error
 	UpdateBookingDetailPriceInfo(ctx context.Context, arg UpdateBookingDetailPriceInfoParams) error
 	UpdateBookingsStatus(ctx context.Context, arg UpdateBookingsStatusParams) error
-	UpdateCustomerCouponUsed(ctx context.Context, id int64) error
+	UpdateCheckoutRefunded(ctx context.Context, arg UpdateCheckoutRefundedParams) error
+	UpdateCouponCampaignCompleted(ctx context.Context, id int64) error
+	UpdateCouponCampaignFailed(ctx context.Context, arg UpdateCouponCampaignFailedParams) error
+	UpdateCouponCampaignProgress(ctx context.Context, arg UpdateCouponCampaignProgressParams) error
+	UpdateCouponCampaignResumed(ctx context.Context, arg UpdateCouponCampaignResumedParams) (int64, error)
+	UpdateCouponCampaignRunning(ctx context.Context, arg UpdateCouponCampaignRunningParams) (int64, error)
+	UpdateCustomerBirthdayBenefitCustomerCoupon(ctx context.Context, arg UpdateCustomerBirthdayBenefitCustomerCouponParams) error
+	UpdateCustomerCouponUsed(ctx context.Context, id int64) (int64, error)
+	UpdateCustomerIsBlacklisted(ctx context.Context, arg UpdateCustomerIsBlacklistedParams) error
 	UpdateCustomerLastVisitAt(ctx context.Context, id int64) error
+	UpdateCustomerLevel(ctx context.Context, arg UpdateCustomerLevelParams) error
 	UpdateCustomerLineName(ctx context.Context, arg UpdateCustomerLineNameParams) error
+	UpdateCustomerLinkRequestReviewed(ctx context.Context, arg UpdateCustomerLinkRequestReviewedParams) error
+	UpdateCustomerMergedProfile(ctx context.Context, arg UpdateCustomerMergedProfileParams) error
+	UpdateCustomerNote(ctx context.Context, arg UpdateCustomerNoteParams) error
+	UpdateCustomerPointBalance(ctx context.Context, arg UpdateCustomerPointBalanceParams) error
+	UpdateCustomerPointTransactionRemaining(ctx context.Context, arg UpdateCustomerPointTransactionRemainingParams) error
+	UpdateCustomerReferralCompleted(ctx context.Context, arg UpdateCustomerReferralCompletedParams) error
+	UpdateCustomerRequiresDeposit(ctx context.Context, arg UpdateCustomerRequiresDepositParams) error
+	UpdateCustomerWalletBalance(ctx context.Context, arg UpdateCustomerWalletBalanceParams) error
+	UpdateCustomersMergedInto(ctx context.Context, arg UpdateCustomersMergedIntoParams) error
+	UpdateGiftCardRedeemed(ctx context.Context, arg UpdateGiftCardRedeemedParams) error
+	UpdateInvoiceIssueFailed(ctx context.Context, arg UpdateInvoiceIssueFailedParams) error
+	UpdateInvoiceIssued(ctx context.Context, arg UpdateInvoiceIssuedParams) error
+	UpdateInvoiceVoidFailed(ctx context.Context, arg UpdateInvoiceVoidFailedParams) error
+	UpdateInvoiceVoidPending(ctx context.Context, arg UpdateInvoiceVoidPendingParams) error
+	UpdateInvoiceVoided(ctx context.Context, arg UpdateInvoiceVoidedParams) error
+	UpdateLineCampaignCompleted(ctx context.Context, id int64) error
+	UpdateLineCampaignFailed(ctx context.Context, arg UpdateLineCampaignFailedParams) error
+	UpdateLineCampaignProgress(ctx context.Context, arg UpdateLineCampaignProgressParams) error
+	UpdateLineCampaignRecipientsFailed(ctx context.Context, arg UpdateLineCampaignRecipientsFailedParams) error
+	UpdateLineCampaignRecipientsSent(ctx context.Context, arg UpdateLineCampaignRecipientsSentParams) error
+	UpdateLineCampaignResumed(ctx context.Context, arg UpdateLineCampaignResumedParams) (int64, error)
+	UpdateLineCampaignRunning(ctx context.Context, id int64) (int64, error)
+	UpdateLineCampaignTargetCount(ctx context.Context, arg UpdateLineCampaignTargetCountParams) error
 	UpdateProductCurrentStock(ctx context.Context, arg UpdateProductCurrentStockParams) error
 	UpdateStaffUserPassword(ctx context.Context, arg UpdateStaffUserPasswordParams) (int64, error)
 	UpdateStockUsageFinish(ctx context.Context, arg UpdateStockUsageFinishParams) error
 	UpdateStoreExpenseAmount(ctx context.Context, arg UpdateStoreExpenseAmountParams) error
+	UpdateTermsDocument(ctx context.Context, arg UpdateTermsDocumentParams) error
 	UpdateTimeSlot(ctx context.Context, arg UpdateTimeSlotParams) (int64, error)
 	UpdateTimeSlotIsAvailable(ctx context.Context, arg UpdateTimeSlotIsAvailableParams) (int64, error)
 	UpdateTimeSlotTemplateItem(ctx context.Context, arg UpdateTimeSlotTemplateItemParams) (UpdateTimeSlotTemplateItemRow, error)
+	UpdateWinBackContactCustomerCoupon(ctx context.Context, arg UpdateWinBackContactCustomerCouponParams) error
+	UpdateWinBackContactsReturned(ctx context.Context, customerID int64) error
+	UpsertAccountStatementLayout(ctx context.Context, arg UpsertAccountStatementLayoutParams) error
+	UpsertBirthdayBenefitSetting(ctx context.Context, arg UpsertBirthdayBenefitSettingParams) error
+	UpsertBlacklistRule(ctx context.Context, arg UpsertBlacklistRuleParams) error
+	UpsertCustomerLevelRule(ctx context.Context, arg UpsertCustomerLevelRuleParams) error
+	UpsertCustomerMetric(ctx context.Context, arg UpsertCustomerMetricParams) error
+	UpsertReferralSetting(ctx context.Context, arg UpsertReferralSettingParams) error
+	UpsertStoreLoyaltySetting(ctx context.Context, arg UpsertStoreLoyaltySettingParams) error
+	UpsertStoreWinBackSetting(ctx context.Context, arg UpsertStoreWinBackSettingParams) error
 }
 
 var _ Querier = (*Queries)(nil)
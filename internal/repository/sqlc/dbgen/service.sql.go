@@ -52,9 +52,10 @@ INSERT INTO services (
     duration_minutes,
     is_addon,
     is_visible,
-    note
+    note,
+    allergens
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING
     id,
     name,
@@ -64,6 +65,7 @@ INSERT INTO services (
     is_visible,
     is_active,
     note,
+    allergens,
     created_at,
     updated_at
 `
@@ -76,6 +78,7 @@ type CreateServiceParams struct {
 	IsAddon         pgtype.Bool    `db:"is_addon" json:"is_addon"`
 	IsVisible       pgtype.Bool    `db:"is_visible" json:"is_visible"`
 	Note            pgtype.Text    `db:"note" json:"note"`
+	Allergens       []string       `db:"allergens" json:"allergens"`
 }
 
 type CreateServiceRow struct {
@@ -87,6 +90,7 @@ type CreateServiceRow struct {
 	IsVisible       pgtype.Bool        `db:"is_visible" json:"is_visible"`
 	IsActive        pgtype.Bool        `db:"is_active" json:"is_active"`
 	Note            pgtype.Text        `db:"note" json:"note"`
+	Allergens       []string           `db:"allergens" json:"allergens"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
@@ -100,6 +104,7 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (C
 		arg.IsAddon,
 		arg.IsVisible,
 		arg.Note,
+		arg.Allergens,
 	)
 	var i CreateServiceRow
 	err := row.Scan(
@@ -111,12 +116,52 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (C
 		&i.IsVisible,
 		&i.IsActive,
 		&i.Note,
+		&i.Allergens,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getServiceAllergensByIDs = `-- name: GetServiceAllergensByIDs :many
+SELECT
+    id,
+    name,
+    allergens
+FROM services
+WHERE id = ANY($1::bigint[])
+`
+
+type GetServiceAllergensByIDsRow struct {
+	ID        int64    `db:"id" json:"id"`
+	Name      string   `db:"name" json:"name"`
+	Allergens []string `db:"allergens" json:"allergens"`
+}
+
+func (q *Queries) GetServiceAllergensByIDs(ctx context.Context, ids []int64) ([]GetServiceAllergensByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getServiceAllergensByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetServiceAllergensByIDsRow{}
+	for rows.Next() {
+		var i GetServiceAllergensByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Allergens,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getServiceByID = `-- name: GetServiceByID :one
 SELECT
     id,
@@ -128,6 +173,7 @@ SELECT
     is_visible,
     is_active,
     note,
+    allergens,
     created_at,
     updated_at
 FROM services
@@ -144,6 +190,7 @@ type GetServiceByIDRow struct {
 	IsVisible       pgtype.Bool        `db:"is_visible" json:"is_visible"`
 	IsActive        pgtype.Bool        `db:"is_active" json:"is_active"`
 	Note            pgtype.Text        `db:"note" json:"note"`
+	Allergens       []string           `db:"allergens" json:"allergens"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
@@ -161,6 +208,7 @@ func (q *Queries) GetServiceByID(ctx context.Context, id int64) (GetServiceByIDR
 		&i.IsVisible,
 		&i.IsActive,
 		&i.Note,
+		&i.Allergens,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -92,4 +92,28 @@ WHERE e.store_id = $1
     AND e.expense_date BETWEEN $2 AND $3
     AND e.payer_id IS NOT NULL
 GROUP BY e.payer_id, su.username
-ORDER BY advance_amount DESC;
\ No newline at end of file
+ORDER BY advance_amount DESC;
+
+-- name: GetStoreExpenseByIDForUpdate :one
+SELECT
+    e.id,
+    e.supplier_id,
+    COALESCE(s.name, '') AS supplier_name,
+    e.payer_id,
+    COALESCE(su.username, '') AS payer_name,
+    e.category,
+    e.amount,
+    e.other_fee,
+    e.expense_date,
+    e.note,
+    e.is_reimbursed,
+    e.reimbursed_at,
+    COALESCE(su2.username, '') AS updater,
+    e.created_at,
+    e.updated_at
+FROM expenses e
+LEFT JOIN suppliers s ON e.supplier_id = s.id
+LEFT JOIN staff_users su ON e.payer_id = su.id
+LEFT JOIN staff_users su2 ON e.updater = su2.id
+WHERE e.id = $1 AND e.store_id = $2
+FOR UPDATE OF e;
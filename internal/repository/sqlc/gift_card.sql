@@ -0,0 +1,33 @@
+-- name: CreateGiftCard :exec
+INSERT INTO gift_cards (
+    id,
+    store_id,
+    code,
+    amount,
+    status,
+    expires_at,
+    note,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetGiftCardByCodeForUpdate :one
+SELECT
+    id,
+    store_id,
+    code,
+    amount,
+    status,
+    expires_at
+FROM gift_cards
+WHERE code = $1
+FOR UPDATE;
+
+-- name: UpdateGiftCardRedeemed :exec
+UPDATE gift_cards
+SET status = 'REDEEMED',
+    redeemed_customer_id = $2,
+    redeemed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1;
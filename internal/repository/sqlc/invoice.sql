@@ -0,0 +1,101 @@
+-- name: CreateInvoice :exec
+INSERT INTO invoices (
+    id,
+    store_id,
+    checkout_id,
+    customer_id,
+    amount,
+    carrier_type,
+    carrier_value,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetInvoiceByID :one
+SELECT
+    id,
+    store_id,
+    checkout_id,
+    customer_id,
+    amount,
+    carrier_type,
+    carrier_value,
+    invoice_number,
+    random_code,
+    status,
+    error_message,
+    issued_at,
+    voided_at,
+    void_reason,
+    created_at,
+    updated_at
+FROM invoices
+WHERE id = $1;
+
+-- name: GetInvoiceByIDForUpdate :one
+SELECT
+    id,
+    store_id,
+    checkout_id,
+    customer_id,
+    amount,
+    carrier_type,
+    carrier_value,
+    invoice_number,
+    random_code,
+    status,
+    error_message,
+    issued_at,
+    voided_at,
+    void_reason,
+    created_at,
+    updated_at
+FROM invoices
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetInvoiceByCheckoutIDForUpdate :one
+SELECT id, invoice_number, status
+FROM invoices
+WHERE checkout_id = $1
+FOR UPDATE;
+
+-- name: UpdateInvoiceIssued :exec
+UPDATE invoices
+SET invoice_number = $2,
+    random_code = $3,
+    issued_at = $4,
+    status = 'ISSUED',
+    error_message = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateInvoiceIssueFailed :exec
+UPDATE invoices
+SET status = 'FAILED',
+    error_message = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateInvoiceVoidPending :exec
+UPDATE invoices
+SET status = 'VOID_PENDING',
+    void_reason = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateInvoiceVoided :exec
+UPDATE invoices
+SET status = 'VOIDED',
+    voided_at = $2,
+    error_message = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateInvoiceVoidFailed :exec
+UPDATE invoices
+SET status = 'VOID_FAILED',
+    error_message = $2,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,96 @@
+-- name: CreateLineCampaign :exec
+INSERT INTO line_campaigns (
+  id,
+  name,
+  segment_id,
+  message_type,
+  text_content,
+  alt_text,
+  flex_contents,
+  status,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: GetLineCampaignByID :one
+SELECT
+  id,
+  name,
+  segment_id,
+  message_type,
+  text_content,
+  alt_text,
+  flex_contents,
+  status,
+  target_count,
+  sent_count,
+  failed_count,
+  error_message,
+  started_at,
+  finished_at,
+  created_by,
+  created_at,
+  updated_at
+FROM line_campaigns
+WHERE id = $1;
+
+-- name: UpdateLineCampaignRunning :one
+UPDATE line_campaigns
+SET status = 'RUNNING',
+  started_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+RETURNING id;
+
+-- name: UpdateLineCampaignTargetCount :exec
+UPDATE line_campaigns
+SET target_count = $2,
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateLineCampaignProgress :exec
+UPDATE line_campaigns
+SET sent_count = sent_count + $2,
+  failed_count = failed_count + $3,
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateLineCampaignCompleted :exec
+UPDATE line_campaigns
+SET status = 'COMPLETED',
+  finished_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateLineCampaignFailed :exec
+UPDATE line_campaigns
+SET status = 'FAILED',
+  error_message = $2,
+  finished_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetStaleLineCampaignIDs :many
+SELECT id
+FROM line_campaigns
+WHERE status IN ('PENDING', 'RUNNING')
+  AND updated_at < $1
+ORDER BY created_at ASC;
+
+-- name: UpdateLineCampaignResumed :one
+UPDATE line_campaigns
+SET sent_count = (
+    SELECT COUNT(*) FROM line_campaign_recipients
+    WHERE campaign_id = $1 AND status = 'SENT'
+  ),
+  failed_count = (
+    SELECT COUNT(*) FROM line_campaign_recipients
+    WHERE campaign_id = $1 AND status = 'FAILED'
+  ),
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'RUNNING'
+  AND updated_at < $2
+RETURNING id;
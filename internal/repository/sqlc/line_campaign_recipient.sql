@@ -0,0 +1,37 @@
+-- name: GetPendingLineCampaignRecipients :many
+SELECT
+  customer_id,
+  line_uid
+FROM line_campaign_recipients
+WHERE campaign_id = $1
+  AND status = 'PENDING'
+  AND customer_id > $2
+ORDER BY customer_id ASC
+LIMIT $3;
+
+-- name: UpdateLineCampaignRecipientsSent :exec
+UPDATE line_campaign_recipients
+SET status = 'SENT',
+  sent_at = NOW(),
+  updated_at = NOW()
+WHERE campaign_id = $1
+  AND customer_id = ANY($2::bigint[]);
+
+-- name: UpdateLineCampaignRecipientsFailed :exec
+UPDATE line_campaign_recipients
+SET status = 'FAILED',
+  error_message = $3,
+  updated_at = NOW()
+WHERE campaign_id = $1
+  AND customer_id = ANY($2::bigint[]);
+
+-- name: ClearLineCampaignRecipientsLineUid :exec
+UPDATE line_campaign_recipients
+SET line_uid = '', updated_at = NOW()
+WHERE customer_id = $1
+  OR customer_id IN (SELECT id FROM customers WHERE merged_into_customer_id = $1);
+
+-- name: CountLineCampaignRecipients :one
+SELECT COUNT(*)
+FROM line_campaign_recipients
+WHERE campaign_id = $1;
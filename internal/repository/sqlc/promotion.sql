@@ -0,0 +1,58 @@
+-- name: CreatePromotion :exec
+INSERT INTO promotions (
+    id,
+    name,
+    store_id,
+    discount_rate,
+    discount_amount,
+    start_date,
+    end_date,
+    weekdays,
+    start_time,
+    end_time,
+    service_scope,
+    stack_with_coupon,
+    note,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+);
+
+-- name: GetPromotionByID :one
+SELECT
+    id,
+    name,
+    store_id,
+    discount_rate,
+    discount_amount,
+    start_date,
+    end_date,
+    weekdays,
+    start_time,
+    end_time,
+    service_scope,
+    stack_with_coupon,
+    is_active,
+    note,
+    created_by,
+    created_at,
+    updated_at
+FROM promotions
+WHERE id = $1;
+
+-- name: GetActivePromotionsByStoreAndDate :many
+SELECT
+    id,
+    discount_rate,
+    discount_amount,
+    weekdays,
+    start_time,
+    end_time,
+    service_scope,
+    stack_with_coupon
+FROM promotions
+WHERE is_active = true
+    AND (store_id IS NULL OR store_id = @store_id)
+    AND start_date <= @work_date::date
+    AND end_date >= @work_date::date
+ORDER BY created_at ASC, id ASC;
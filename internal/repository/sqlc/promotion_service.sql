@@ -0,0 +1,13 @@
+-- name: CreatePromotionServices :exec
+INSERT INTO promotion_services (
+  promotion_id,
+  service_id
+)
+SELECT $1, UNNEST($2::bigint[]);
+
+-- name: GetPromotionServicesByPromotionIDs :many
+SELECT
+  promotion_id,
+  service_id
+FROM promotion_services
+WHERE promotion_id = ANY($1::bigint[]);
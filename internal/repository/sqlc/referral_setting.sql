@@ -0,0 +1,28 @@
+-- name: GetReferralSetting :one
+SELECT
+  id,
+  is_enabled,
+  referrer_coupon_id,
+  referee_coupon_id,
+  coupon_valid_months,
+  created_at,
+  updated_at
+FROM referral_settings
+WHERE id = 1;
+
+-- name: UpsertReferralSetting :exec
+INSERT INTO referral_settings (
+  id,
+  is_enabled,
+  referrer_coupon_id,
+  referee_coupon_id,
+  coupon_valid_months
+) VALUES (
+  1, $1, $2, $3, $4
+)
+ON CONFLICT (id) DO UPDATE
+SET is_enabled = EXCLUDED.is_enabled,
+  referrer_coupon_id = EXCLUDED.referrer_coupon_id,
+  referee_coupon_id = EXCLUDED.referee_coupon_id,
+  coupon_valid_months = EXCLUDED.coupon_valid_months,
+  updated_at = NOW();
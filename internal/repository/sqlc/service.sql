@@ -6,9 +6,10 @@ INSERT INTO services (
     duration_minutes,
     is_addon,
     is_visible,
-    note
+    note,
+    allergens
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING
     id,
     name,
@@ -18,6 +19,7 @@ INSERT INTO services (
     is_visible,
     is_active,
     note,
+    allergens,
     created_at,
     updated_at;
 
@@ -32,6 +34,7 @@ SELECT
     is_visible,
     is_active,
     note,
+    allergens,
     created_at,
     updated_at
 FROM services
@@ -61,3 +64,11 @@ SELECT EXISTS(
     SELECT 1 FROM services
     WHERE name = $1 AND id != $2
 ) AS exists;
+
+-- name: GetServiceAllergensByIDs :many
+SELECT
+    id,
+    name,
+    allergens
+FROM services
+WHERE id = ANY($1::bigint[]);
@@ -0,0 +1,44 @@
+-- name: BulkCreateStoreAccountMappings :copyfrom
+INSERT INTO store_account_mappings (
+    id,
+    store_id,
+    mapping_type,
+    payment_method,
+    payer_id,
+    account_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: DeleteStoreAccountMappingsByStoreID :exec
+DELETE FROM store_account_mappings WHERE store_id = $1;
+
+-- name: GetStoreAccountMappingsByStoreID :many
+SELECT
+    m.id,
+    m.mapping_type,
+    m.payment_method,
+    m.payer_id,
+    COALESCE(su.username, '') AS payer_name,
+    m.account_id,
+    a.name AS account_name,
+    m.updated_at
+FROM store_account_mappings m
+JOIN accounts a ON m.account_id = a.id
+LEFT JOIN staff_users su ON m.payer_id = su.id
+WHERE m.store_id = $1
+ORDER BY m.mapping_type, m.payment_method, m.payer_id NULLS FIRST;
+
+-- name: GetStoreExpensePayerAccountID :one
+SELECT account_id FROM store_account_mappings
+WHERE store_id = $1
+    AND mapping_type = 'EXPENSE_PAYER'
+    AND (payer_id = $2 OR payer_id IS NULL)
+ORDER BY payer_id NULLS LAST
+LIMIT 1;
+
+-- name: GetStorePaymentMethodAccountID :one
+SELECT account_id FROM store_account_mappings
+WHERE store_id = $1
+    AND mapping_type = 'PAYMENT_METHOD'
+    AND payment_method = $2;
@@ -0,0 +1,34 @@
+-- name: GetStoreLoyaltySettingByStoreID :one
+SELECT
+    store_id,
+    is_enabled,
+    earn_amount_unit,
+    earn_points,
+    redeem_points_unit,
+    redeem_amount,
+    expiry_months,
+    created_at,
+    updated_at
+FROM store_loyalty_settings
+WHERE store_id = $1;
+
+-- name: UpsertStoreLoyaltySetting :exec
+INSERT INTO store_loyalty_settings (
+    store_id,
+    is_enabled,
+    earn_amount_unit,
+    earn_points,
+    redeem_points_unit,
+    redeem_amount,
+    expiry_months
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (store_id) DO UPDATE
+SET is_enabled = EXCLUDED.is_enabled,
+    earn_amount_unit = EXCLUDED.earn_amount_unit,
+    earn_points = EXCLUDED.earn_points,
+    redeem_points_unit = EXCLUDED.redeem_points_unit,
+    redeem_amount = EXCLUDED.redeem_amount,
+    expiry_months = EXCLUDED.expiry_months,
+    updated_at = NOW();
@@ -0,0 +1,45 @@
+-- name: GetStoreWinBackSettingByStoreID :one
+SELECT
+  store_id,
+  is_enabled,
+  inactive_days,
+  coupon_id,
+  coupon_valid_days,
+  line_message,
+  created_at,
+  updated_at
+FROM store_win_back_settings
+WHERE store_id = $1;
+
+-- name: GetEnabledStoreWinBackSettings :many
+SELECT
+  store_id,
+  is_enabled,
+  inactive_days,
+  coupon_id,
+  coupon_valid_days,
+  line_message,
+  created_at,
+  updated_at
+FROM store_win_back_settings
+WHERE is_enabled = true
+ORDER BY store_id ASC;
+
+-- name: UpsertStoreWinBackSetting :exec
+INSERT INTO store_win_back_settings (
+  store_id,
+  is_enabled,
+  inactive_days,
+  coupon_id,
+  coupon_valid_days,
+  line_message
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (store_id) DO UPDATE
+SET is_enabled = EXCLUDED.is_enabled,
+  inactive_days = EXCLUDED.inactive_days,
+  coupon_id = EXCLUDED.coupon_id,
+  coupon_valid_days = EXCLUDED.coupon_valid_days,
+  line_message = EXCLUDED.line_message,
+  updated_at = NOW();
@@ -0,0 +1,43 @@
+-- name: CreateTermsDocument :exec
+INSERT INTO terms_documents (id, version, title, content, effective_date, is_required, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CheckTermsDocumentVersionExists :one
+SELECT EXISTS (SELECT 1 FROM terms_documents WHERE version = $1);
+
+-- name: GetTermsDocumentByID :one
+SELECT id, version, title, content, effective_date, is_required
+FROM terms_documents
+WHERE id = $1;
+
+-- name: GetTermsDocumentByVersion :one
+SELECT id, version, title, content, effective_date, is_required
+FROM terms_documents
+WHERE version = $1;
+
+-- name: GetCurrentTermsDocument :one
+SELECT id, version, title, content, effective_date, is_required
+FROM terms_documents
+WHERE effective_date <= (NOW() AT TIME ZONE 'Asia/Taipei')::date
+ORDER BY effective_date DESC, created_at DESC
+LIMIT 1;
+
+-- name: GetCurrentRequiredTermsDocument :one
+SELECT version, effective_date
+FROM terms_documents
+WHERE is_required = true
+  AND effective_date <= (NOW() AT TIME ZONE 'Asia/Taipei')::date
+ORDER BY effective_date DESC, created_at DESC
+LIMIT 1;
+
+-- name: GetAllTermsDocuments :many
+SELECT id, version, title, effective_date, is_required, created_by, created_at, updated_at
+FROM terms_documents
+ORDER BY effective_date DESC, created_at DESC;
+
+-- name: UpdateTermsDocument :exec
+UPDATE terms_documents
+SET title = COALESCE($2, title),
+  content = COALESCE($3, content),
+  updated_at = NOW()
+WHERE id = $1;
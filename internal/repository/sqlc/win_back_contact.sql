@@ -0,0 +1,60 @@
+-- name: CreateWinBackContact :one
+INSERT INTO win_back_contacts (
+  id,
+  store_id,
+  customer_id,
+  last_visit_at
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (customer_id, last_visit_at) DO NOTHING
+RETURNING id;
+
+-- name: GetWinBackTargetCustomers :many
+SELECT
+  c.id,
+  c.name,
+  c.line_uid,
+  c.last_visit_at
+FROM customers c
+JOIN LATERAL (
+  SELECT b.store_id
+  FROM checkouts ck
+  JOIN bookings b ON b.id = ck.booking_id
+  WHERE b.customer_id = c.id
+    AND ck.refunded_at IS NULL
+  ORDER BY ck.created_at DESC
+  LIMIT 1
+) lv ON true
+WHERE lv.store_id = @store_id::bigint
+  AND COALESCE(c.is_blacklisted, false) = false
+  AND c.line_uid <> ''
+  AND c.last_visit_at < @inactive_before::timestamptz
+  AND NOT EXISTS (
+    SELECT 1 FROM win_back_contacts wbc
+    WHERE wbc.customer_id = c.id
+      AND wbc.last_visit_at = c.last_visit_at
+  )
+  AND c.id > @last_customer_id::bigint
+ORDER BY c.id ASC
+LIMIT @batch_size::int;
+
+-- name: UpdateWinBackContactCustomerCoupon :exec
+UPDATE win_back_contacts
+SET customer_coupon_id = $2
+WHERE id = $1;
+
+-- name: UpdateWinBackContactsReturned :exec
+UPDATE win_back_contacts
+SET returned_at = NOW()
+WHERE customer_id = $1
+  AND returned_at IS NULL;
+
+-- name: GetWinBackContactStatsByStoreID :one
+SELECT
+  COUNT(*)::int AS contacted_count,
+  COUNT(customer_coupon_id)::int AS coupon_issued_count,
+  COUNT(returned_at)::int AS returned_count
+FROM win_back_contacts
+WHERE store_id = @store_id::bigint
+  AND (contacted_at AT TIME ZONE 'Asia/Taipei')::date BETWEEN @start_date::date AND @end_date::date;
@@ -0,0 +1,87 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type AccountStatementImportRepository struct {
+	db *sqlx.DB
+}
+
+func NewAccountStatementImportRepository(db *sqlx.DB) *AccountStatementImportRepository {
+	return &AccountStatementImportRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllAccountStatementImportsByFilterParams struct {
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllAccountStatementImportsByFilterItem struct {
+	ID             int64              `db:"id"`
+	FileName       string             `db:"file_name"`
+	TotalLines     int32              `db:"total_lines"`
+	MatchedLines   int32              `db:"matched_lines"`
+	UnmatchedLines int32              `db:"unmatched_lines"`
+	CreatedBy      int64              `db:"created_by"`
+	CreatedByName  string             `db:"created_by_name"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *AccountStatementImportRepository) GetAllAccountStatementImportsByFilter(ctx context.Context, accountID int64, params GetAllAccountStatementImportsByFilterParams) (int, []GetAllAccountStatementImportsByFilterItem, error) {
+	// Count query
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM account_statement_imports WHERE account_id = $1`, accountID); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllAccountStatementImportsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"i.created_at DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "i.created_at",
+	}, defaultSortArr, params.Sort)
+
+	// Data query, unmatched lines are counted on the fly since staff resolve them after the import
+	query := fmt.Sprintf(`
+		SELECT
+			i.id,
+			i.file_name,
+			i.total_lines,
+			i.matched_lines,
+			(
+				SELECT COUNT(*)
+				FROM account_statement_lines l
+				WHERE l.import_id = i.id AND l.status = 'UNMATCHED'
+			) AS unmatched_lines,
+			i.created_by,
+			COALESCE(su.username, '') AS created_by_name,
+			i.created_at
+		FROM account_statement_imports i
+		LEFT JOIN staff_users su ON i.created_by = su.id
+		WHERE i.account_id = $1
+		ORDER BY %s
+		LIMIT $2 OFFSET $3
+	`, sort)
+
+	var results []GetAllAccountStatementImportsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, accountID, limit, offset); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
@@ -0,0 +1,118 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type AccountStatementLineRepository struct {
+	db *sqlx.DB
+}
+
+func NewAccountStatementLineRepository(db *sqlx.DB) *AccountStatementLineRepository {
+	return &AccountStatementLineRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllAccountStatementLinesByFilterParams struct {
+	ImportID *int64
+	Status   *string
+	Limit    *int
+	Offset   *int
+	Sort     *[]string
+}
+
+type GetAllAccountStatementLinesByFilterItem struct {
+	ID                   int64              `db:"id"`
+	ImportID             int64              `db:"import_id"`
+	LineNumber           int32              `db:"line_number"`
+	TransactionDate      pgtype.Date        `db:"transaction_date"`
+	Description          pgtype.Text        `db:"description"`
+	Type                 string             `db:"type"`
+	Amount               pgtype.Numeric     `db:"amount"`
+	Status               string             `db:"status"`
+	AccountTransactionID pgtype.Int8        `db:"account_transaction_id"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (r *AccountStatementLineRepository) GetAllAccountStatementLinesByFilter(ctx context.Context, accountID int64, params GetAllAccountStatementLinesByFilterParams) (int, []GetAllAccountStatementLinesByFilterItem, error) {
+	whereConditions := []string{"account_id = $1"}
+	args := []interface{}{accountID}
+
+	if params.ImportID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("import_id = $%d", len(args)+1))
+		args = append(args, *params.ImportID)
+	}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM account_statement_lines
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllAccountStatementLinesByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"transaction_date ASC", "import_id ASC", "line_number ASC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"transactionDate": "transaction_date",
+		"amount":          "amount",
+		"status":          "status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			import_id,
+			line_number,
+			transaction_date,
+			description,
+			type,
+			amount,
+			status,
+			account_transaction_id,
+			created_at,
+			updated_at
+		FROM account_statement_lines
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllAccountStatementLinesByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
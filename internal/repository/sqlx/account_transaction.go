@@ -36,6 +36,8 @@ type GetAllAccountTransactionsByFilterItem struct {
 	Amount          pgtype.Numeric     `db:"amount"`
 	Balance         pgtype.Numeric     `db:"balance"`
 	Note            pgtype.Text        `db:"note"`
+	SourceType      pgtype.Text        `db:"source_type"`
+	SourceID        pgtype.Int8        `db:"source_id"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
 }
@@ -61,7 +63,7 @@ func (r *AccountTransactionRepository) GetAllAccountTransactionsByFilter(ctx con
 
 	// Pagination + Sorting
 	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
-	defaultSortArr := []string{"created_at DESC"}
+	defaultSortArr := []string{"transaction_date DESC", "created_at DESC", "id DESC"}
 	sort := utils.HandleSortByMap(map[string]string{}, defaultSortArr, params.Sort)
 
 	args = append(args, limit, offset)
@@ -70,7 +72,7 @@ func (r *AccountTransactionRepository) GetAllAccountTransactionsByFilter(ctx con
 
 	// Data query
 	query := fmt.Sprintf(`
-		SELECT id, transaction_date, type, amount, balance, note, created_at, updated_at
+		SELECT id, transaction_date, type, amount, balance, note, source_type, source_id, created_at, updated_at
 		FROM account_transactions
 		WHERE account_id = $1
 		ORDER BY %s
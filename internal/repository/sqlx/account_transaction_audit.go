@@ -0,0 +1,111 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type AccountTransactionAuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAccountTransactionAuditRepository(db *sqlx.DB) *AccountTransactionAuditRepository {
+	return &AccountTransactionAuditRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllAccountTransactionAuditsByFilterParams struct {
+	AccountTransactionID *int64
+	Action               *string
+	Limit                *int
+	Offset               *int
+	Sort                 *[]string
+}
+
+type GetAllAccountTransactionAuditsByFilterItem struct {
+	ID                   int64              `db:"id"`
+	AccountTransactionID int64              `db:"account_transaction_id"`
+	Action               string             `db:"action"`
+	BeforeData           []byte             `db:"before_data"`
+	AfterData            []byte             `db:"after_data"`
+	CreatedBy            int64              `db:"created_by"`
+	CreatedByName        string             `db:"created_by_name"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *AccountTransactionAuditRepository) GetAllAccountTransactionAuditsByFilter(ctx context.Context, accountID int64, params GetAllAccountTransactionAuditsByFilterParams) (int, []GetAllAccountTransactionAuditsByFilterItem, error) {
+	whereConditions := []string{"a.account_id = $1"}
+	args := []interface{}{accountID}
+
+	if params.AccountTransactionID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("a.account_transaction_id = $%d", len(args)+1))
+		args = append(args, *params.AccountTransactionID)
+	}
+
+	if params.Action != nil && *params.Action != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("a.action = $%d", len(args)+1))
+		args = append(args, *params.Action)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM account_transaction_audits a
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllAccountTransactionAuditsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"a.created_at DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "a.created_at",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			a.id,
+			a.account_transaction_id,
+			a.action,
+			a.before_data,
+			a.after_data,
+			a.created_by,
+			COALESCE(su.username, '') AS created_by_name,
+			a.created_at
+		FROM account_transaction_audits a
+		LEFT JOIN staff_users su ON a.created_by = su.id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllAccountTransactionAuditsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
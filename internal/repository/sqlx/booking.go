@@ -367,13 +367,15 @@ func (r *BookingRepository) UpdateBookingCompletedInfo(ctx context.Context, book
 
 // ---------------------------------------------------------------------------------------------------------------------
 
-// CancelBookingTx cancels a booking with transaction support
+// CancelBookingTx cancels a booking with transaction support.
+// cancelled_at is only recorded for a no-show, a cancellation by staff is not counted by the late cancellation blacklist rule.
 func (r *BookingRepository) CancelBookingTx(ctx context.Context, tx *sqlx.Tx, bookingID int64, status string, cancelReason *string) (int64, error) {
 	// Data query
 	query := `
 		UPDATE bookings
 		SET status = $1,
 			cancel_reason = $2,
+			cancelled_at = CASE WHEN $1 = 'NO_SHOW' THEN NOW() END,
 			updated_at = NOW()
 		WHERE id = $3
 		RETURNING id
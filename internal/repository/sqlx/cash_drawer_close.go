@@ -0,0 +1,125 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CashDrawerCloseRepository struct {
+	db *sqlx.DB
+}
+
+func NewCashDrawerCloseRepository(db *sqlx.DB) *CashDrawerCloseRepository {
+	return &CashDrawerCloseRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCashDrawerClosesByFilterParams struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Limit     *int
+	Offset    *int
+	Sort      *[]string
+}
+
+type GetAllCashDrawerClosesByFilterItem struct {
+	ID                   int64              `db:"id"`
+	CloseDate            pgtype.Date        `db:"close_date"`
+	CheckoutCount        int32              `db:"checkout_count"`
+	ExpectedCash         pgtype.Numeric     `db:"expected_cash"`
+	CountedCash          pgtype.Numeric     `db:"counted_cash"`
+	Difference           pgtype.Numeric     `db:"difference"`
+	AccountID            int64              `db:"account_id"`
+	AccountName          string             `db:"account_name"`
+	AccountTransactionID pgtype.Int8        `db:"account_transaction_id"`
+	Note                 pgtype.Text        `db:"note"`
+	ClosedBy             int64              `db:"closed_by"`
+	ClosedByName         string             `db:"closed_by_name"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *CashDrawerCloseRepository) GetAllCashDrawerClosesByFilter(ctx context.Context, storeID int64, params GetAllCashDrawerClosesByFilterParams) (int, []GetAllCashDrawerClosesByFilterItem, error) {
+	whereConditions := []string{"c.store_id = $1"}
+	args := []interface{}{storeID}
+
+	if params.StartDate != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("c.close_date >= $%d", len(args)+1))
+		args = append(args, *params.StartDate)
+	}
+
+	if params.EndDate != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("c.close_date <= $%d", len(args)+1))
+		args = append(args, *params.EndDate)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM cash_drawer_closes c
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCashDrawerClosesByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"c.close_date DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"closeDate":  "c.close_date",
+		"difference": "c.difference",
+		"createdAt":  "c.created_at",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			c.id,
+			c.close_date,
+			c.checkout_count,
+			c.expected_cash,
+			c.counted_cash,
+			c.difference,
+			c.account_id,
+			COALESCE(a.name, '') AS account_name,
+			c.account_transaction_id,
+			c.note,
+			c.closed_by,
+			COALESCE(su.username, '') AS closed_by_name,
+			c.created_at
+		FROM cash_drawer_closes c
+		LEFT JOIN accounts a ON c.account_id = a.id
+		LEFT JOIN staff_users su ON c.closed_by = su.id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCashDrawerClosesByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jmoiron/sqlx"
@@ -32,16 +33,24 @@ type GetAllCouponsByFilterParams struct {
 }
 
 type GetAllCouponsByFilterItem struct {
-	ID             int64              `db:"id"`
-	Name           string             `db:"name"`
-	DisplayName    string             `db:"display_name"`
-	Code           string             `db:"code"`
-	DiscountRate   pgtype.Numeric     `db:"discount_rate"`
-	DiscountAmount pgtype.Numeric     `db:"discount_amount"`
-	IsActive       pgtype.Bool        `db:"is_active"`
-	Note           pgtype.Text        `db:"note"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at"`
+	ID                    int64              `db:"id"`
+	Name                  string             `db:"name"`
+	DisplayName           string             `db:"display_name"`
+	Code                  string             `db:"code"`
+	DiscountRate          pgtype.Numeric     `db:"discount_rate"`
+	DiscountAmount        pgtype.Numeric     `db:"discount_amount"`
+	IsActive              pgtype.Bool        `db:"is_active"`
+	StartDate             pgtype.Date        `db:"start_date"`
+	EndDate               pgtype.Date        `db:"end_date"`
+	MinSpendAmount        pgtype.Numeric     `db:"min_spend_amount"`
+	ServiceScope          string             `db:"service_scope"`
+	TotalUsageLimit       pgtype.Int4        `db:"total_usage_limit"`
+	PerCustomerUsageLimit pgtype.Int4        `db:"per_customer_usage_limit"`
+	IsRepeatable          bool               `db:"is_repeatable"`
+	UsedCount             int64              `db:"used_count"`
+	Note                  pgtype.Text        `db:"note"`
+	CreatedAt             pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `db:"updated_at"`
 }
 
 // GetAllCouponsByFilter retrieves coupons with filtering, pagination and sorting
@@ -103,6 +112,18 @@ func (r *CouponRepository) GetAllCouponsByFilter(ctx context.Context, params Get
 			COALESCE(discount_rate, 0) AS discount_rate,
 			COALESCE(discount_amount, 0) AS discount_amount,
 			is_active,
+			start_date,
+			end_date,
+			COALESCE(min_spend_amount, 0) AS min_spend_amount,
+			service_scope,
+			total_usage_limit,
+			per_customer_usage_limit,
+			is_repeatable,
+			(
+				SELECT COUNT(*)
+				FROM customer_coupons cc
+				WHERE cc.coupon_id = coupons.id AND cc.is_used = true
+			) AS used_count,
 			COALESCE(note, '') as note,
 			created_at,
 			updated_at
@@ -122,14 +143,21 @@ func (r *CouponRepository) GetAllCouponsByFilter(ctx context.Context, params Get
 
 // ---------------------------------------------------------------------------------------------------------------------
 
-type UpdateCouponParams struct {
-	Name     *string
-	IsActive *bool
-	Note     *string
+type UpdateCouponTxParams struct {
+	Name                  *string
+	IsActive              *bool
+	StartDate             *time.Time
+	EndDate               *time.Time
+	MinSpendAmount        *int64
+	ServiceScope          *string
+	TotalUsageLimit       *int32
+	PerCustomerUsageLimit *int32
+	IsRepeatable          *bool
+	Note                  *string
 }
 
-// UpdateCoupon updates coupon fields that are provided
-func (r *CouponRepository) UpdateCoupon(ctx context.Context, couponID int64, params UpdateCouponParams) error {
+// UpdateCouponTx updates coupon fields that are provided within a transaction, zero dates and zero limits clear the rule
+func (r *CouponRepository) UpdateCouponTx(ctx context.Context, tx *sqlx.Tx, couponID int64, params UpdateCouponTxParams) error {
 	setParts := []string{"updated_at = NOW()"}
 	args := []interface{}{}
 
@@ -143,15 +171,66 @@ func (r *CouponRepository) UpdateCoupon(ctx context.Context, couponID int64, par
 		args = append(args, utils.BoolPtrToPgBool(params.IsActive))
 	}
 
+	if params.StartDate != nil {
+		setParts = append(setParts, fmt.Sprintf("start_date = $%d", len(args)+1))
+		if params.StartDate.IsZero() {
+			args = append(args, nil)
+		} else {
+			args = append(args, utils.TimePtrToPgDate(params.StartDate))
+		}
+	}
+
+	if params.EndDate != nil {
+		setParts = append(setParts, fmt.Sprintf("end_date = $%d", len(args)+1))
+		if params.EndDate.IsZero() {
+			args = append(args, nil)
+		} else {
+			args = append(args, utils.TimePtrToPgDate(params.EndDate))
+		}
+	}
+
+	if params.MinSpendAmount != nil {
+		setParts = append(setParts, fmt.Sprintf("min_spend_amount = $%d", len(args)+1))
+		if *params.MinSpendAmount == 0 {
+			args = append(args, nil)
+		} else {
+			args = append(args, *params.MinSpendAmount)
+		}
+	}
+
+	if params.ServiceScope != nil {
+		setParts = append(setParts, fmt.Sprintf("service_scope = $%d", len(args)+1))
+		args = append(args, *params.ServiceScope)
+	}
+
+	if params.TotalUsageLimit != nil {
+		setParts = append(setParts, fmt.Sprintf("total_usage_limit = $%d", len(args)+1))
+		if *params.TotalUsageLimit == 0 {
+			args = append(args, nil)
+		} else {
+			args = append(args, *params.TotalUsageLimit)
+		}
+	}
+
+	if params.PerCustomerUsageLimit != nil {
+		setParts = append(setParts, fmt.Sprintf("per_customer_usage_limit = $%d", len(args)+1))
+		if *params.PerCustomerUsageLimit == 0 {
+			args = append(args, nil)
+		} else {
+			args = append(args, *params.PerCustomerUsageLimit)
+		}
+	}
+
+	if params.IsRepeatable != nil {
+		setParts = append(setParts, fmt.Sprintf("is_repeatable = $%d", len(args)+1))
+		args = append(args, *params.IsRepeatable)
+	}
+
 	if params.Note != nil {
 		setParts = append(setParts, fmt.Sprintf("note = $%d", len(args)+1))
 		args = append(args, utils.StringPtrToPgText(params.Note, false))
 	}
 
-	if len(setParts) == 1 {
-		return fmt.Errorf("no fields to update")
-	}
-
 	args = append(args, couponID)
 	query := fmt.Sprintf(`
 		UPDATE coupons
@@ -159,9 +238,40 @@ func (r *CouponRepository) UpdateCoupon(ctx context.Context, couponID int64, par
 		WHERE id = $%d
 	`, strings.Join(setParts, ", "), len(args))
 
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		return fmt.Errorf("failed to execute coupon update: %w", err)
 	}
 
 	return nil
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// ReplaceCouponServicesTx replaces the eligible services of the coupon within a transaction
+func (r *CouponRepository) ReplaceCouponServicesTx(ctx context.Context, tx *sqlx.Tx, couponID int64, serviceIDs []int64) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM coupon_services WHERE coupon_id = $1`, couponID); err != nil {
+		return fmt.Errorf("failed to delete coupon services: %w", err)
+	}
+
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	valueParts := make([]string, len(serviceIDs))
+	args := []interface{}{couponID}
+	for i, serviceID := range serviceIDs {
+		valueParts[i] = fmt.Sprintf("($1, $%d)", len(args)+1)
+		args = append(args, serviceID)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO coupon_services (coupon_id, service_id)
+		VALUES %s
+	`, strings.Join(valueParts, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create coupon services: %w", err)
+	}
+
+	return nil
+}
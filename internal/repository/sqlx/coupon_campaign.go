@@ -0,0 +1,122 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CouponCampaignRepository struct {
+	db *sqlx.DB
+}
+
+func NewCouponCampaignRepository(db *sqlx.DB) *CouponCampaignRepository {
+	return &CouponCampaignRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCouponCampaignsByFilterParams struct {
+	Status *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllCouponCampaignsByFilterItem struct {
+	ID              int64              `db:"id"`
+	Name            string             `db:"name"`
+	CouponID        int64              `db:"coupon_id"`
+	CouponName      string             `db:"coupon_name"`
+	Status          string             `db:"status"`
+	SendLine        bool               `db:"send_line"`
+	TargetCount     int32              `db:"target_count"`
+	IssuedCount     int32              `db:"issued_count"`
+	SkippedCount    int32              `db:"skipped_count"`
+	LineSentCount   int32              `db:"line_sent_count"`
+	LineFailedCount int32              `db:"line_failed_count"`
+	StartedAt       pgtype.Timestamptz `db:"started_at"`
+	FinishedAt      pgtype.Timestamptz `db:"finished_at"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *CouponCampaignRepository) GetAllCouponCampaignsByFilter(ctx context.Context, params GetAllCouponCampaignsByFilterParams) (int, []GetAllCouponCampaignsByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("cc.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM coupon_campaigns cc
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCouponCampaignsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"cc.created_at DESC", "cc.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt":  "cc.created_at",
+		"finishedAt": "cc.finished_at",
+		"status":     "cc.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			cc.id,
+			cc.name,
+			cc.coupon_id,
+			c.display_name AS coupon_name,
+			cc.status,
+			cc.send_line,
+			cc.target_count,
+			cc.issued_count,
+			cc.skipped_count,
+			cc.line_sent_count,
+			cc.line_failed_count,
+			cc.started_at,
+			cc.finished_at,
+			cc.created_at
+		FROM coupon_campaigns cc
+		JOIN coupons c ON c.id = cc.coupon_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCouponCampaignsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
@@ -27,60 +27,51 @@ type GetAllCustomersByFilterParams struct {
 	Phone         *string
 	Level         *string
 	IsBlacklisted *bool
-	MinPastDays   *int
-	Limit         *int
-	Offset        *int
-	Sort          *[]string
+	// RequiresDeposit filters customers restricted to deposit bookings
+	RequiresDeposit *bool
+	MinPastDays     *int
+	// Tags filters customers having all of the tags
+	Tags *[]string
+	// SpendPeriodMonths limits the spend and visit metrics to the recent months, nil means all checkouts
+	SpendPeriodMonths *int
+	MinTotalSpend     *float64
+	MaxTotalSpend     *float64
+	MinVisitCount     *int
+	MaxVisitCount     *int
+	// churn risk filters use the metrics of the nightly job, customers not calculated yet never match
+	ChurnRiskLevel      *string
+	MinChurnRiskScore   *int
+	MaxChurnRiskScore   *int
+	MinNoShowRate       *float64
+	MinCancellationRate *float64
+	Limit               *int
+	Offset              *int
+	Sort                *[]string
 }
 
 type GetAllCustomersByFilterItem struct {
-	ID            int64              `db:"id"`
-	Name          string             `db:"name"`
-	LineName      pgtype.Text        `db:"line_name"`
-	Phone         string             `db:"phone"`
-	Birthday      pgtype.Date        `db:"birthday"`
-	City          pgtype.Text        `db:"city"`
-	Level         pgtype.Text        `db:"level"`
-	IsBlacklisted pgtype.Bool        `db:"is_blacklisted"`
-	LastVisitAt   pgtype.Timestamptz `db:"last_visit_at"`
-	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
+	ID              int64              `db:"id"`
+	Name            string             `db:"name"`
+	LineName        pgtype.Text        `db:"line_name"`
+	Phone           string             `db:"phone"`
+	Birthday        pgtype.Date        `db:"birthday"`
+	City            pgtype.Text        `db:"city"`
+	Level           pgtype.Text        `db:"level"`
+	IsBlacklisted   pgtype.Bool        `db:"is_blacklisted"`
+	RequiresDeposit bool               `db:"requires_deposit"`
+	LastVisitAt     pgtype.Timestamptz `db:"last_visit_at"`
+	Tags            []string           `db:"tags"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
+	// metrics are null before the nightly job calculates the customer
+	LifetimeSpend  pgtype.Numeric `db:"lifetime_spend"`
+	ChurnRiskScore pgtype.Int4    `db:"churn_risk_score"`
+	ChurnRiskLevel pgtype.Text    `db:"churn_risk_level"`
 }
 
 // GetAllCustomersByFilter retrieves all customers with filtering, pagination and sorting
 func (r *CustomerRepository) GetAllCustomersByFilter(ctx context.Context, params GetAllCustomersByFilterParams) (int, []GetAllCustomersByFilterItem, error) {
 	// where conditions
-	whereConditions := []string{}
-	args := []interface{}{}
-
-	if params.Name != nil && *params.Name != "" {
-		whereConditions = append(whereConditions, fmt.Sprintf("name ILIKE $%d", len(args)+1))
-		args = append(args, "%"+*params.Name+"%")
-	}
-
-	if params.LineName != nil && *params.LineName != "" {
-		whereConditions = append(whereConditions, fmt.Sprintf("line_name ILIKE $%d", len(args)+1))
-		args = append(args, "%"+*params.LineName+"%")
-	}
-
-	if params.Phone != nil && *params.Phone != "" {
-		whereConditions = append(whereConditions, fmt.Sprintf("phone ILIKE $%d", len(args)+1))
-		args = append(args, "%"+*params.Phone+"%")
-	}
-
-	if params.Level != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("level = $%d", len(args)+1))
-		args = append(args, *params.Level)
-	}
-
-	if params.IsBlacklisted != nil {
-		whereConditions = append(whereConditions, fmt.Sprintf("is_blacklisted = $%d", len(args)+1))
-		args = append(args, *params.IsBlacklisted)
-	}
-
-	if params.MinPastDays != nil && *params.MinPastDays > 0 {
-		whereConditions = append(whereConditions, fmt.Sprintf("(last_visit_at IS NOT NULL AND last_visit_at < NOW() - ($%d * INTERVAL '1 day'))", len(args)+1))
-		args = append(args, *params.MinPastDays)
-	}
+	whereConditions, args := customerFilterConditions(params)
 
 	whereClause := ""
 	if len(whereConditions) > 0 {
@@ -107,14 +98,30 @@ func (r *CustomerRepository) GetAllCustomersByFilter(ctx context.Context, params
 	defaultSortArr := []string{"updated_at DESC"}
 	sort := utils.HandleSortByMapWithNulls(
 		map[string]string{
-			"level":         "level",
-			"isBlacklisted": "is_blacklisted",
-			"lastVisitAt":   "last_visit_at",
-			"createdAt":     "created_at",
-			"updatedAt":     "updated_at",
+			"level":           "level",
+			"isBlacklisted":   "is_blacklisted",
+			"requiresDeposit": "requires_deposit",
+			"lastVisitAt":     "last_visit_at",
+			"createdAt":       "created_at",
+			"updatedAt":       "updated_at",
+			// metrics of the nightly job
+			"lifetimeSpend":      "cm.lifetime_spend",
+			"averageTicket":      "cm.average_ticket",
+			"visitFrequencyDays": "cm.visit_frequency_days",
+			"daysSinceLastVisit": "cm.days_since_last_visit",
+			"noShowRate":         "cm.no_show_rate",
+			"cancellationRate":   "cm.cancellation_rate",
+			"churnRiskScore":     "cm.churn_risk_score",
 		},
 		map[string]bool{
-			"lastVisitAt": true,
+			"lastVisitAt":        true,
+			"lifetimeSpend":      true,
+			"averageTicket":      true,
+			"visitFrequencyDays": true,
+			"daysSinceLastVisit": true,
+			"noShowRate":         true,
+			"cancellationRate":   true,
+			"churnRiskScore":     true,
 		},
 		defaultSortArr,
 		params.Sort)
@@ -126,22 +133,190 @@ func (r *CustomerRepository) GetAllCustomersByFilter(ctx context.Context, params
 	// Data query
 	dataQuery := fmt.Sprintf(`
 		SELECT
-			id, name, line_name, phone, birthday, city,
-			level, is_blacklisted, last_visit_at, updated_at
+			customers.id, name, line_name, phone, birthday, city,
+			level, is_blacklisted, requires_deposit, last_visit_at, tags, updated_at,
+			cm.lifetime_spend, cm.churn_risk_score, cm.churn_risk_level
 		FROM customers
+		LEFT JOIN customer_metrics cm ON cm.customer_id = customers.id
 		%s
 		ORDER BY %s
 		LIMIT $%d OFFSET $%d`,
 		whereClause, sort, limitIndex, offsetIndex)
 
-	var results []GetAllCustomersByFilterItem
-	if err := r.db.SelectContext(ctx, &results, dataQuery, args...); err != nil {
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
 		return 0, nil, fmt.Errorf("failed to execute data query: %w", err)
 	}
+	defer rows.Close()
+
+	m := pgtype.NewMap()
+	var results []GetAllCustomersByFilterItem
+	for rows.Next() {
+		var result GetAllCustomersByFilterItem
+		if err := rows.Scan(
+			&result.ID,
+			&result.Name,
+			&result.LineName,
+			&result.Phone,
+			&result.Birthday,
+			&result.City,
+			&result.Level,
+			&result.IsBlacklisted,
+			&result.RequiresDeposit,
+			&result.LastVisitAt,
+			m.SQLScanner(&result.Tags),
+			&result.UpdatedAt,
+			&result.LifetimeSpend,
+			&result.ChurnRiskScore,
+			&result.ChurnRiskLevel,
+		); err != nil {
+			return 0, nil, fmt.Errorf("scan customer failed: %w", err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to iterate customers: %w", err)
+	}
 
 	return total, results, nil
 }
 
+// customerFilterConditions builds the where conditions of the customer filter on the customers table,
+// the spend and visit metrics are calculated from the non-refunded checkouts of the customer
+func customerFilterConditions(params GetAllCustomersByFilterParams) ([]string, []interface{}) {
+	// merged customers are kept as tombstones and never listed
+	whereConditions := []string{"merged_into_customer_id IS NULL"}
+	args := []interface{}{}
+
+	if params.Name != nil && *params.Name != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("name ILIKE $%d", len(args)+1))
+		args = append(args, "%"+*params.Name+"%")
+	}
+
+	if params.LineName != nil && *params.LineName != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("line_name ILIKE $%d", len(args)+1))
+		args = append(args, "%"+*params.LineName+"%")
+	}
+
+	if params.Phone != nil && *params.Phone != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("phone ILIKE $%d", len(args)+1))
+		args = append(args, "%"+*params.Phone+"%")
+	}
+
+	if params.Level != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("level = $%d", len(args)+1))
+		args = append(args, *params.Level)
+	}
+
+	if params.IsBlacklisted != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("is_blacklisted = $%d", len(args)+1))
+		args = append(args, *params.IsBlacklisted)
+	}
+
+	if params.RequiresDeposit != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("requires_deposit = $%d", len(args)+1))
+		args = append(args, *params.RequiresDeposit)
+	}
+
+	if params.MinPastDays != nil && *params.MinPastDays > 0 {
+		whereConditions = append(whereConditions, fmt.Sprintf("(last_visit_at IS NOT NULL AND last_visit_at < NOW() - ($%d * INTERVAL '1 day'))", len(args)+1))
+		args = append(args, *params.MinPastDays)
+	}
+
+	if params.Tags != nil && len(*params.Tags) > 0 {
+		whereConditions = append(whereConditions, fmt.Sprintf("tags @> $%d", len(args)+1))
+		args = append(args, *params.Tags)
+	}
+
+	whereConditions, args = customerMetricConditions(params, whereConditions, args)
+
+	if params.MinTotalSpend == nil && params.MaxTotalSpend == nil && params.MinVisitCount == nil && params.MaxVisitCount == nil {
+		return whereConditions, args
+	}
+
+	checkoutConditions := "b.customer_id = customers.id AND ck.refunded_at IS NULL"
+	if params.SpendPeriodMonths != nil && *params.SpendPeriodMonths > 0 {
+		checkoutConditions += fmt.Sprintf(" AND ck.created_at >= NOW() - ($%d * INTERVAL '1 month')", len(args)+1)
+		args = append(args, *params.SpendPeriodMonths)
+	}
+	totalSpend := fmt.Sprintf(`(
+		SELECT COALESCE(SUM(ck.final_amount), 0)
+		FROM checkouts ck
+		JOIN bookings b ON b.id = ck.booking_id
+		WHERE %s
+	)`, checkoutConditions)
+	visitCount := fmt.Sprintf(`(
+		SELECT COUNT(DISTINCT (ck.created_at AT TIME ZONE 'Asia/Taipei')::date)
+		FROM checkouts ck
+		JOIN bookings b ON b.id = ck.booking_id
+		WHERE %s
+	)`, checkoutConditions)
+
+	if params.MinTotalSpend != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("%s >= $%d", totalSpend, len(args)+1))
+		args = append(args, *params.MinTotalSpend)
+	}
+
+	if params.MaxTotalSpend != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("%s <= $%d", totalSpend, len(args)+1))
+		args = append(args, *params.MaxTotalSpend)
+	}
+
+	if params.MinVisitCount != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("%s >= $%d", visitCount, len(args)+1))
+		args = append(args, *params.MinVisitCount)
+	}
+
+	if params.MaxVisitCount != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("%s <= $%d", visitCount, len(args)+1))
+		args = append(args, *params.MaxVisitCount)
+	}
+
+	return whereConditions, args
+}
+
+// customerMetricConditions adds the conditions on the customer metrics as one subquery, so the customer filter
+// can still be used without joining the customer_metrics table
+func customerMetricConditions(params GetAllCustomersByFilterParams, whereConditions []string, args []interface{}) ([]string, []interface{}) {
+	var metricConditions []string
+
+	if params.ChurnRiskLevel != nil {
+		metricConditions = append(metricConditions, fmt.Sprintf("cm.churn_risk_level = $%d", len(args)+1))
+		args = append(args, *params.ChurnRiskLevel)
+	}
+
+	if params.MinChurnRiskScore != nil {
+		metricConditions = append(metricConditions, fmt.Sprintf("cm.churn_risk_score >= $%d", len(args)+1))
+		args = append(args, *params.MinChurnRiskScore)
+	}
+
+	if params.MaxChurnRiskScore != nil {
+		metricConditions = append(metricConditions, fmt.Sprintf("cm.churn_risk_score <= $%d", len(args)+1))
+		args = append(args, *params.MaxChurnRiskScore)
+	}
+
+	if params.MinNoShowRate != nil {
+		metricConditions = append(metricConditions, fmt.Sprintf("cm.no_show_rate >= $%d", len(args)+1))
+		args = append(args, *params.MinNoShowRate)
+	}
+
+	if params.MinCancellationRate != nil {
+		metricConditions = append(metricConditions, fmt.Sprintf("cm.cancellation_rate >= $%d", len(args)+1))
+		args = append(args, *params.MinCancellationRate)
+	}
+
+	if len(metricConditions) == 0 {
+		return whereConditions, args
+	}
+
+	whereConditions = append(whereConditions, fmt.Sprintf(`EXISTS (
+		SELECT 1
+		FROM customer_metrics cm
+		WHERE cm.customer_id = customers.id AND %s
+	)`, strings.Join(metricConditions, " AND ")))
+	return whereConditions, args
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 
 type UpdateCustomerParams struct {
@@ -158,29 +333,39 @@ type UpdateCustomerParams struct {
 	StoreNote      *string
 	Level          *string
 	IsBlacklisted  *bool
+	// RequiresDeposit restricts the customer to deposit bookings
+	RequiresDeposit *bool
+	Tags            *[]string
+	// InvoiceCarrierType and InvoiceCarrierValue are set together, empty string clears the carrier
+	InvoiceCarrierType  *string
+	InvoiceCarrierValue *string
 }
 
 type UpdateCustomerResponse struct {
-	ID             int64              `db:"id"`
-	Name           string             `db:"name"`
-	LineName       pgtype.Text        `db:"line_name"`
-	Phone          string             `db:"phone"`
-	Birthday       pgtype.Date        `db:"birthday"`
-	Email          pgtype.Text        `db:"email"`
-	City           pgtype.Text        `db:"city"`
-	FavoriteShapes []string           `db:"favorite_shapes"`
-	FavoriteColors []string           `db:"favorite_colors"`
-	FavoriteStyles []string           `db:"favorite_styles"`
-	IsIntrovert    pgtype.Bool        `db:"is_introvert"`
-	ReferralSource []string           `db:"referral_source"`
-	Referrer       pgtype.Text        `db:"referrer"`
-	CustomerNote   pgtype.Text        `db:"customer_note"`
-	StoreNote      pgtype.Text        `db:"store_note"`
-	Level          pgtype.Text        `db:"level"`
-	IsBlacklisted  pgtype.Bool        `db:"is_blacklisted"`
-	LastVisitAt    pgtype.Timestamptz `db:"last_visit_at"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at"`
+	ID                  int64              `db:"id"`
+	Name                string             `db:"name"`
+	LineName            pgtype.Text        `db:"line_name"`
+	Phone               string             `db:"phone"`
+	Birthday            pgtype.Date        `db:"birthday"`
+	Email               pgtype.Text        `db:"email"`
+	City                pgtype.Text        `db:"city"`
+	FavoriteShapes      []string           `db:"favorite_shapes"`
+	FavoriteColors      []string           `db:"favorite_colors"`
+	FavoriteStyles      []string           `db:"favorite_styles"`
+	IsIntrovert         pgtype.Bool        `db:"is_introvert"`
+	ReferralSource      []string           `db:"referral_source"`
+	Referrer            pgtype.Text        `db:"referrer"`
+	CustomerNote        pgtype.Text        `db:"customer_note"`
+	StoreNote           pgtype.Text        `db:"store_note"`
+	Level               pgtype.Text        `db:"level"`
+	IsBlacklisted       pgtype.Bool        `db:"is_blacklisted"`
+	RequiresDeposit     bool               `db:"requires_deposit"`
+	LastVisitAt         pgtype.Timestamptz `db:"last_visit_at"`
+	InvoiceCarrierType  pgtype.Text        `db:"invoice_carrier_type"`
+	InvoiceCarrierValue pgtype.Text        `db:"invoice_carrier_value"`
+	Tags                []string           `db:"tags"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at"`
 }
 
 func (r *CustomerRepository) UpdateCustomer(ctx context.Context, customerID int64, params UpdateCustomerParams) (UpdateCustomerResponse, error) {
@@ -253,6 +438,28 @@ func (r *CustomerRepository) UpdateCustomer(ctx context.Context, customerID int6
 		args = append(args, *params.IsBlacklisted)
 	}
 
+	if params.RequiresDeposit != nil {
+		setParts = append(setParts, fmt.Sprintf("requires_deposit = $%d", len(args)+1))
+		args = append(args, *params.RequiresDeposit)
+	}
+
+	if params.Tags != nil {
+		setParts = append(setParts, fmt.Sprintf("tags = $%d", len(args)+1))
+		args = append(args, *params.Tags)
+	}
+
+	if params.InvoiceCarrierType != nil {
+		setParts = append(setParts, fmt.Sprintf("invoice_carrier_type = NULLIF($%d, '')", len(args)+1))
+		args = append(args, *params.InvoiceCarrierType)
+
+		carrierValue := ""
+		if params.InvoiceCarrierValue != nil {
+			carrierValue = *params.InvoiceCarrierValue
+		}
+		setParts = append(setParts, fmt.Sprintf("invoice_carrier_value = NULLIF($%d, '')", len(args)+1))
+		args = append(args, carrierValue)
+	}
+
 	// Check if there are any fields to update
 	if len(setParts) == 1 {
 		return UpdateCustomerResponse{}, fmt.Errorf("no fields to update")
@@ -283,7 +490,11 @@ func (r *CustomerRepository) UpdateCustomer(ctx context.Context, customerID int6
 			store_note,
 			level,
 			is_blacklisted,
+			requires_deposit,
 			last_visit_at,
+			invoice_carrier_type,
+			invoice_carrier_value,
+			tags,
 			created_at,
 			updated_at
 		`,
@@ -311,7 +522,11 @@ func (r *CustomerRepository) UpdateCustomer(ctx context.Context, customerID int6
 		&result.StoreNote,
 		&result.Level,
 		&result.IsBlacklisted,
+		&result.RequiresDeposit,
 		&result.LastVisitAt,
+		&result.InvoiceCarrierType,
+		&result.InvoiceCarrierValue,
+		m.SQLScanner(&result.Tags),
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
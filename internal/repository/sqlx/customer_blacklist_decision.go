@@ -0,0 +1,139 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerBlacklistDecisionRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomerBlacklistDecisionRepository(db *sqlx.DB) *CustomerBlacklistDecisionRepository {
+	return &CustomerBlacklistDecisionRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCustomerBlacklistDecisionsByFilterParams struct {
+	CustomerID *int64
+	Status     *string
+	RuleType   *string
+	Action     *string
+	Limit      *int
+	Offset     *int
+	Sort       *[]string
+}
+
+type GetAllCustomerBlacklistDecisionsByFilterItem struct {
+	ID             int64              `db:"id"`
+	CustomerID     int64              `db:"customer_id"`
+	CustomerName   string             `db:"customer_name"`
+	CustomerPhone  string             `db:"customer_phone"`
+	RuleType       string             `db:"rule_type"`
+	Action         string             `db:"action"`
+	ThresholdCount int32              `db:"threshold_count"`
+	PeriodMonths   int32              `db:"period_months"`
+	Evidence       []byte             `db:"evidence"`
+	Status         string             `db:"status"`
+	OverturnedBy   pgtype.Int8        `db:"overturned_by"`
+	OverturnReason pgtype.Text        `db:"overturn_reason"`
+	OverturnedAt   pgtype.Timestamptz `db:"overturned_at"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *CustomerBlacklistDecisionRepository) GetAllCustomerBlacklistDecisionsByFilter(ctx context.Context, params GetAllCustomerBlacklistDecisionsByFilterParams) (int, []GetAllCustomerBlacklistDecisionsByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.CustomerID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("d.customer_id = $%d", len(args)+1))
+		args = append(args, *params.CustomerID)
+	}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("d.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	if params.RuleType != nil && *params.RuleType != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("d.rule_type = $%d", len(args)+1))
+		args = append(args, *params.RuleType)
+	}
+
+	if params.Action != nil && *params.Action != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("d.action = $%d", len(args)+1))
+		args = append(args, *params.Action)
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM customer_blacklist_decisions d
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCustomerBlacklistDecisionsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"d.created_at DESC", "d.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "d.created_at",
+		"status":    "d.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			d.id,
+			d.customer_id,
+			c.name AS customer_name,
+			c.phone AS customer_phone,
+			d.rule_type,
+			d.action,
+			d.threshold_count,
+			d.period_months,
+			d.evidence,
+			d.status,
+			d.overturned_by,
+			d.overturn_reason,
+			d.overturned_at,
+			d.created_at
+		FROM customer_blacklist_decisions d
+		JOIN customers c ON c.id = d.customer_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCustomerBlacklistDecisionsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
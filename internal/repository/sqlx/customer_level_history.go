@@ -0,0 +1,102 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerLevelHistoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomerLevelHistoryRepository(db *sqlx.DB) *CustomerLevelHistoryRepository {
+	return &CustomerLevelHistoryRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCustomerLevelHistoriesByFilterParams struct {
+	Reason *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllCustomerLevelHistoriesByFilterItem struct {
+	ID        int64              `db:"id"`
+	FromLevel pgtype.Text        `db:"from_level"`
+	ToLevel   string             `db:"to_level"`
+	Reason    string             `db:"reason"`
+	Note      pgtype.Text        `db:"note"`
+	CreatedBy pgtype.Int8        `db:"created_by"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *CustomerLevelHistoryRepository) GetAllCustomerLevelHistoriesByFilter(ctx context.Context, customerID int64, params GetAllCustomerLevelHistoriesByFilterParams) (int, []GetAllCustomerLevelHistoriesByFilterItem, error) {
+	whereConditions := []string{"h.customer_id = $1"}
+	args := []interface{}{customerID}
+
+	if params.Reason != nil && *params.Reason != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("h.reason = $%d", len(args)+1))
+		args = append(args, *params.Reason)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM customer_level_histories h
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCustomerLevelHistoriesByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"h.created_at DESC", "h.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "h.created_at",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			h.id,
+			h.from_level,
+			h.to_level,
+			h.reason,
+			h.note,
+			h.created_by,
+			h.created_at
+		FROM customer_level_histories h
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCustomerLevelHistoriesByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
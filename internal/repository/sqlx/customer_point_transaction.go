@@ -0,0 +1,117 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerPointTransactionRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomerPointTransactionRepository(db *sqlx.DB) *CustomerPointTransactionRepository {
+	return &CustomerPointTransactionRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCustomerPointTransactionsByFilterParams struct {
+	Type   *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllCustomerPointTransactionsByFilterItem struct {
+	ID              int64              `db:"id"`
+	StoreID         pgtype.Int8        `db:"store_id"`
+	StoreName       pgtype.Text        `db:"store_name"`
+	Type            string             `db:"type"`
+	Points          int32              `db:"points"`
+	Balance         int32              `db:"balance"`
+	RemainingPoints int32              `db:"remaining_points"`
+	ExpiresAt       pgtype.Date        `db:"expires_at"`
+	SourceType      pgtype.Text        `db:"source_type"`
+	SourceID        pgtype.Int8        `db:"source_id"`
+	Note            pgtype.Text        `db:"note"`
+	CreatedBy       pgtype.Int8        `db:"created_by"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at"`
+}
+
+func (r *CustomerPointTransactionRepository) GetAllCustomerPointTransactionsByFilter(ctx context.Context, customerID int64, params GetAllCustomerPointTransactionsByFilterParams) (int, []GetAllCustomerPointTransactionsByFilterItem, error) {
+	whereConditions := []string{"t.customer_id = $1"}
+	args := []interface{}{customerID}
+
+	if params.Type != nil && *params.Type != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("t.type = $%d", len(args)+1))
+		args = append(args, *params.Type)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM customer_point_transactions t
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCustomerPointTransactionsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"t.created_at DESC", "t.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "t.created_at",
+		"points":    "t.points",
+		"type":      "t.type",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			t.id,
+			t.store_id,
+			s.name AS store_name,
+			t.type,
+			t.points,
+			t.balance,
+			t.remaining_points,
+			t.expires_at,
+			t.source_type,
+			t.source_id,
+			t.note,
+			t.created_by,
+			t.created_at
+		FROM customer_point_transactions t
+		LEFT JOIN stores s ON s.id = t.store_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCustomerPointTransactionsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
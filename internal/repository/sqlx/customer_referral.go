@@ -0,0 +1,208 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerReferralRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomerReferralRepository(db *sqlx.DB) *CustomerReferralRepository {
+	return &CustomerReferralRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCustomerReferralsByFilterParams struct {
+	Status *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllCustomerReferralsByFilterItem struct {
+	ID                       int64              `db:"id"`
+	RefereeCustomerID        int64              `db:"referee_customer_id"`
+	RefereeName              string             `db:"referee_name"`
+	RefereePhone             string             `db:"referee_phone"`
+	Status                   string             `db:"status"`
+	FirstCheckoutID          pgtype.Int8        `db:"first_checkout_id"`
+	ReferrerCustomerCouponID pgtype.Int8        `db:"referrer_customer_coupon_id"`
+	RefereeCustomerCouponID  pgtype.Int8        `db:"referee_customer_coupon_id"`
+	CompletedAt              pgtype.Timestamptz `db:"completed_at"`
+	CreatedAt                pgtype.Timestamptz `db:"created_at"`
+}
+
+// GetAllCustomerReferralsByFilter returns the customers referred by the referrer
+func (r *CustomerReferralRepository) GetAllCustomerReferralsByFilter(ctx context.Context, referrerCustomerID int64, params GetAllCustomerReferralsByFilterParams) (int, []GetAllCustomerReferralsByFilterItem, error) {
+	whereConditions := []string{"r.referrer_customer_id = $1"}
+	args := []interface{}{referrerCustomerID}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("r.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM customer_referrals r
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCustomerReferralsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"r.created_at DESC", "r.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt":   "r.created_at",
+		"completedAt": "r.completed_at",
+		"status":      "r.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			r.id,
+			r.referee_customer_id,
+			c.name AS referee_name,
+			c.phone AS referee_phone,
+			r.status,
+			r.first_checkout_id,
+			r.referrer_customer_coupon_id,
+			r.referee_customer_coupon_id,
+			r.completed_at,
+			r.created_at
+		FROM customer_referrals r
+		JOIN customers c ON c.id = r.referee_customer_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCustomerReferralsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetReferralReportByFilterParams struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Limit     *int
+	Offset    *int
+	Sort      *[]string
+}
+
+type GetReferralReportByFilterItem struct {
+	CustomerID     int64              `db:"customer_id"`
+	CustomerName   string             `db:"customer_name"`
+	CustomerPhone  string             `db:"customer_phone"`
+	ReferralCode   pgtype.Text        `db:"referral_code"`
+	ReferredCount  int                `db:"referred_count"`
+	CompletedCount int                `db:"completed_count"`
+	LastReferredAt pgtype.Timestamptz `db:"last_referred_at"`
+}
+
+// GetReferralReportByFilter returns the referral counts grouped by referrer,
+// the date range filters the referral created date in Asia/Taipei
+func (r *CustomerReferralRepository) GetReferralReportByFilter(ctx context.Context, params GetReferralReportByFilterParams) (int, []GetReferralReportByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.StartDate != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("(r.created_at AT TIME ZONE 'Asia/Taipei')::date >= $%d::date", len(args)+1))
+		args = append(args, *params.StartDate)
+	}
+
+	if params.EndDate != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("(r.created_at AT TIME ZONE 'Asia/Taipei')::date <= $%d::date", len(args)+1))
+		args = append(args, *params.EndDate)
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(DISTINCT r.referrer_customer_id)
+		FROM customer_referrals r
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetReferralReportByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"referred_count DESC", "customer_id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"referredCount":  "referred_count",
+		"completedCount": "completed_count",
+		"lastReferredAt": "last_referred_at",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			c.id AS customer_id,
+			c.name AS customer_name,
+			c.phone AS customer_phone,
+			c.referral_code,
+			COUNT(*) AS referred_count,
+			COUNT(*) FILTER (WHERE r.status = 'COMPLETED') AS completed_count,
+			MAX(r.created_at) AS last_referred_at
+		FROM customer_referrals r
+		JOIN customers c ON c.id = r.referrer_customer_id
+		%s
+		GROUP BY c.id, c.name, c.phone, c.referral_code
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetReferralReportByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
@@ -0,0 +1,120 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerSegmentRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomerSegmentRepository(db *sqlx.DB) *CustomerSegmentRepository {
+	return &CustomerSegmentRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllCustomerSegmentsByFilterParams struct {
+	Name   *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllCustomerSegmentsByFilterItem struct {
+	ID                int64              `db:"id"`
+	Name              string             `db:"name"`
+	Description       pgtype.Text        `db:"description"`
+	Level             pgtype.Text        `db:"level"`
+	IsBlacklisted     pgtype.Bool        `db:"is_blacklisted"`
+	MinPastDays       pgtype.Int4        `db:"min_past_days"`
+	SpendPeriodMonths pgtype.Int4        `db:"spend_period_months"`
+	MinTotalSpend     pgtype.Numeric     `db:"min_total_spend"`
+	MaxTotalSpend     pgtype.Numeric     `db:"max_total_spend"`
+	MinVisitCount     pgtype.Int4        `db:"min_visit_count"`
+	MaxVisitCount     pgtype.Int4        `db:"max_visit_count"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `db:"updated_at"`
+}
+
+// GetAllCustomerSegmentsByFilter retrieves customer segments with filtering, pagination and sorting
+func (r *CustomerSegmentRepository) GetAllCustomerSegmentsByFilter(ctx context.Context, params GetAllCustomerSegmentsByFilterParams) (int, []GetAllCustomerSegmentsByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.Name != nil && *params.Name != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("name ILIKE $%d", len(args)+1))
+		args = append(args, "%"+*params.Name+"%")
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM customer_segments
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllCustomerSegmentsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"created_at DESC", "id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"name":      "name",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			description,
+			level,
+			is_blacklisted,
+			min_past_days,
+			spend_period_months,
+			min_total_spend,
+			max_total_spend,
+			min_visit_count,
+			max_visit_count,
+			created_at,
+			updated_at
+		FROM customer_segments
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllCustomerSegmentsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
@@ -0,0 +1,121 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GiftCardRepository struct {
+	db *sqlx.DB
+}
+
+func NewGiftCardRepository(db *sqlx.DB) *GiftCardRepository {
+	return &GiftCardRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllGiftCardsByFilterParams struct {
+	Code   *string
+	Status *string
+	Limit  *int
+	Offset *int
+	Sort   *[]string
+}
+
+type GetAllGiftCardsByFilterItem struct {
+	ID                   int64              `db:"id"`
+	Code                 string             `db:"code"`
+	Amount               pgtype.Numeric     `db:"amount"`
+	Status               string             `db:"status"`
+	ExpiresAt            pgtype.Date        `db:"expires_at"`
+	Note                 pgtype.Text        `db:"note"`
+	RedeemedCustomerID   pgtype.Int8        `db:"redeemed_customer_id"`
+	RedeemedCustomerName pgtype.Text        `db:"redeemed_customer_name"`
+	RedeemedAt           pgtype.Timestamptz `db:"redeemed_at"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (r *GiftCardRepository) GetAllGiftCardsByFilter(ctx context.Context, storeID int64, params GetAllGiftCardsByFilterParams) (int, []GetAllGiftCardsByFilterItem, error) {
+	whereConditions := []string{"g.store_id = $1"}
+	args := []interface{}{storeID}
+
+	if params.Code != nil && *params.Code != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("g.code = $%d", len(args)+1))
+		args = append(args, *params.Code)
+	}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("g.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM gift_cards g
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllGiftCardsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"g.created_at DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt":  "g.created_at",
+		"expiresAt":  "g.expires_at",
+		"redeemedAt": "g.redeemed_at",
+		"amount":     "g.amount",
+		"status":     "g.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			g.id,
+			g.code,
+			g.amount,
+			g.status,
+			g.expires_at,
+			g.note,
+			g.redeemed_customer_id,
+			c.name AS redeemed_customer_name,
+			g.redeemed_at,
+			g.created_at,
+			g.updated_at
+		FROM gift_cards g
+		LEFT JOIN customers c ON c.id = g.redeemed_customer_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllGiftCardsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
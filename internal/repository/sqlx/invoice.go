@@ -0,0 +1,134 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type InvoiceRepository struct {
+	db *sqlx.DB
+}
+
+func NewInvoiceRepository(db *sqlx.DB) *InvoiceRepository {
+	return &InvoiceRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllInvoicesByFilterParams struct {
+	Status        *string
+	InvoiceNumber *string
+	CheckoutID    *int64
+	Limit         *int
+	Offset        *int
+	Sort          *[]string
+}
+
+type GetAllInvoicesByFilterItem struct {
+	ID            int64              `db:"id"`
+	CheckoutID    int64              `db:"checkout_id"`
+	CustomerID    int64              `db:"customer_id"`
+	CustomerName  string             `db:"customer_name"`
+	Amount        pgtype.Numeric     `db:"amount"`
+	CarrierType   pgtype.Text        `db:"carrier_type"`
+	CarrierValue  pgtype.Text        `db:"carrier_value"`
+	InvoiceNumber pgtype.Text        `db:"invoice_number"`
+	Status        string             `db:"status"`
+	ErrorMessage  pgtype.Text        `db:"error_message"`
+	IssuedAt      pgtype.Timestamptz `db:"issued_at"`
+	VoidedAt      pgtype.Timestamptz `db:"voided_at"`
+	VoidReason    pgtype.Text        `db:"void_reason"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (r *InvoiceRepository) GetAllInvoicesByFilter(ctx context.Context, storeID int64, params GetAllInvoicesByFilterParams) (int, []GetAllInvoicesByFilterItem, error) {
+	whereConditions := []string{"i.store_id = $1"}
+	args := []interface{}{storeID}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("i.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	if params.InvoiceNumber != nil && *params.InvoiceNumber != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("i.invoice_number = $%d", len(args)+1))
+		args = append(args, *params.InvoiceNumber)
+	}
+
+	if params.CheckoutID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("i.checkout_id = $%d", len(args)+1))
+		args = append(args, *params.CheckoutID)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM invoices i
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllInvoicesByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"i.created_at DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "i.created_at",
+		"issuedAt":  "i.issued_at",
+		"amount":    "i.amount",
+		"status":    "i.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			i.id,
+			i.checkout_id,
+			i.customer_id,
+			c.name AS customer_name,
+			i.amount,
+			i.carrier_type,
+			i.carrier_value,
+			i.invoice_number,
+			i.status,
+			i.error_message,
+			i.issued_at,
+			i.voided_at,
+			i.void_reason,
+			i.created_at,
+			i.updated_at
+		FROM invoices i
+		JOIN customers c ON c.id = i.customer_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllInvoicesByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
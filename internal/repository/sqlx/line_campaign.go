@@ -0,0 +1,227 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type LineCampaignRepository struct {
+	db *sqlx.DB
+}
+
+func NewLineCampaignRepository(db *sqlx.DB) *LineCampaignRepository {
+	return &LineCampaignRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllLineCampaignsByFilterParams struct {
+	SegmentID *int64
+	Status    *string
+	Limit     *int
+	Offset    *int
+	Sort      *[]string
+}
+
+type GetAllLineCampaignsByFilterItem struct {
+	ID          int64              `db:"id"`
+	Name        string             `db:"name"`
+	SegmentID   int64              `db:"segment_id"`
+	SegmentName string             `db:"segment_name"`
+	MessageType string             `db:"message_type"`
+	Status      string             `db:"status"`
+	TargetCount int32              `db:"target_count"`
+	SentCount   int32              `db:"sent_count"`
+	FailedCount int32              `db:"failed_count"`
+	StartedAt   pgtype.Timestamptz `db:"started_at"`
+	FinishedAt  pgtype.Timestamptz `db:"finished_at"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+}
+
+// GetAllLineCampaignsByFilter retrieves LINE campaigns with filtering, pagination and sorting
+func (r *LineCampaignRepository) GetAllLineCampaignsByFilter(ctx context.Context, params GetAllLineCampaignsByFilterParams) (int, []GetAllLineCampaignsByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.SegmentID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("lc.segment_id = $%d", len(args)+1))
+		args = append(args, *params.SegmentID)
+	}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("lc.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM line_campaigns lc
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllLineCampaignsByFilterItem{}, nil
+	}
+
+	// Pagination + Sorting
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"lc.created_at DESC", "lc.id DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt":  "lc.created_at",
+		"finishedAt": "lc.finished_at",
+		"status":     "lc.status",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			lc.id,
+			lc.name,
+			lc.segment_id,
+			cs.name AS segment_name,
+			lc.message_type,
+			lc.status,
+			lc.target_count,
+			lc.sent_count,
+			lc.failed_count,
+			lc.started_at,
+			lc.finished_at,
+			lc.created_at
+		FROM line_campaigns lc
+		JOIN customer_segments cs ON cs.id = lc.segment_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllLineCampaignsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllLineCampaignRecipientsByFilterParams struct {
+	Status *string
+	Limit  *int
+	Offset *int
+}
+
+type GetAllLineCampaignRecipientsByFilterItem struct {
+	CustomerID   int64              `db:"customer_id"`
+	CustomerName string             `db:"customer_name"`
+	Status       string             `db:"status"`
+	ErrorMessage pgtype.Text        `db:"error_message"`
+	SentAt       pgtype.Timestamptz `db:"sent_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
+}
+
+// GetAllLineCampaignRecipientsByFilter retrieves the recipients and their delivery status of the LINE campaign, ordered by customer id
+func (r *LineCampaignRepository) GetAllLineCampaignRecipientsByFilter(ctx context.Context, campaignID int64, params GetAllLineCampaignRecipientsByFilterParams) (int, []GetAllLineCampaignRecipientsByFilterItem, error) {
+	whereConditions := []string{"lcr.campaign_id = $1"}
+	args := []interface{}{campaignID}
+
+	if params.Status != nil && *params.Status != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("lcr.status = $%d", len(args)+1))
+		args = append(args, *params.Status)
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM line_campaign_recipients lcr
+		%s
+	`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllLineCampaignRecipientsByFilterItem{}, nil
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	// Data query
+	query := fmt.Sprintf(`
+		SELECT
+			lcr.customer_id,
+			c.name AS customer_name,
+			lcr.status,
+			lcr.error_message,
+			lcr.sent_at,
+			lcr.updated_at
+		FROM line_campaign_recipients lcr
+		JOIN customers c ON c.id = lcr.customer_id
+		%s
+		ORDER BY lcr.customer_id ASC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, limitIndex, offsetIndex)
+
+	var results []GetAllLineCampaignRecipientsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return total, results, nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// CreateLineCampaignRecipientsByCustomerFilter snapshots the customers matching the filter with a LINE account as the
+// PENDING recipients of the campaign, existing recipients are kept. It returns the total recipient count of the campaign.
+func (r *LineCampaignRepository) CreateLineCampaignRecipientsByCustomerFilter(ctx context.Context, campaignID int64, filter GetAllCustomersByFilterParams) (int64, error) {
+	whereConditions, args := customerFilterConditions(filter)
+	whereConditions = append(whereConditions, "line_uid <> ''")
+
+	args = append(args, campaignID)
+	query := fmt.Sprintf(`
+		INSERT INTO line_campaign_recipients (campaign_id, customer_id, line_uid)
+		SELECT $%d, id, line_uid
+		FROM customers
+		WHERE %s
+		ON CONFLICT (campaign_id, customer_id) DO NOTHING
+	`, len(args), strings.Join(whereConditions, " AND "))
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to create line campaign recipients: %w", err)
+	}
+
+	var total int64
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM line_campaign_recipients WHERE campaign_id = $1`, campaignID); err != nil {
+		return 0, fmt.Errorf("failed to count line campaign recipients: %w", err)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,276 @@
+package sqlx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type PromotionRepository struct {
+	db *sqlx.DB
+}
+
+func NewPromotionRepository(db *sqlx.DB) *PromotionRepository {
+	return &PromotionRepository{
+		db: db,
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type GetAllPromotionsByFilterParams struct {
+	Name     *string
+	StoreID  *int64
+	IsActive *bool
+	Limit    *int
+	Offset   *int
+	Sort     *[]string
+}
+
+type GetAllPromotionsByFilterItem struct {
+	ID                  int64              `db:"id"`
+	Name                string             `db:"name"`
+	StoreID             pgtype.Int8        `db:"store_id"`
+	StoreName           string             `db:"store_name"`
+	DiscountRate        pgtype.Numeric     `db:"discount_rate"`
+	DiscountAmount      pgtype.Numeric     `db:"discount_amount"`
+	StartDate           pgtype.Date        `db:"start_date"`
+	EndDate             pgtype.Date        `db:"end_date"`
+	Weekdays            string             `db:"weekdays"`
+	StartTime           pgtype.Time        `db:"start_time"`
+	EndTime             pgtype.Time        `db:"end_time"`
+	ServiceScope        string             `db:"service_scope"`
+	StackWithCoupon     bool               `db:"stack_with_coupon"`
+	IsActive            bool               `db:"is_active"`
+	AppliedCount        int64              `db:"applied_count"`
+	TotalDiscountAmount pgtype.Numeric     `db:"total_discount_amount"`
+	Note                pgtype.Text        `db:"note"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at"`
+}
+
+// GetAllPromotionsByFilter retrieves promotions with filtering, pagination and sorting.
+// The store filter also matches promotions for all stores, weekdays are returned as a comma separated string.
+func (r *PromotionRepository) GetAllPromotionsByFilter(ctx context.Context, params GetAllPromotionsByFilterParams) (int, []GetAllPromotionsByFilterItem, error) {
+	whereConditions := []string{}
+	args := []interface{}{}
+
+	if params.Name != nil && *params.Name != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("p.name ILIKE $%d", len(args)+1))
+		args = append(args, "%"+*params.Name+"%")
+	}
+
+	if params.StoreID != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("(p.store_id IS NULL OR p.store_id = $%d)", len(args)+1))
+		args = append(args, *params.StoreID)
+	}
+
+	if params.IsActive != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("p.is_active = $%d", len(args)+1))
+		args = append(args, *params.IsActive)
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM promotions p
+		%s`, whereClause)
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if total == 0 {
+		return 0, []GetAllPromotionsByFilterItem{}, nil
+	}
+
+	limit, offset := utils.SetDefaultValuesOfPagination(params.Limit, params.Offset, 20, 0)
+	defaultSortArr := []string{"p.created_at DESC"}
+	sort := utils.HandleSortByMap(map[string]string{
+		"createdAt": "p.created_at",
+		"updatedAt": "p.updated_at",
+		"startDate": "p.start_date",
+		"isActive":  "p.is_active",
+	}, defaultSortArr, params.Sort)
+
+	args = append(args, limit, offset)
+	limitIndex := len(args) - 1
+	offsetIndex := len(args)
+
+	query := fmt.Sprintf(`
+		SELECT
+			p.id,
+			p.name,
+			p.store_id,
+			COALESCE(s.name, '') AS store_name,
+			COALESCE(p.discount_rate, 0) AS discount_rate,
+			COALESCE(p.discount_amount, 0) AS discount_amount,
+			p.start_date,
+			p.end_date,
+			COALESCE(array_to_string(p.weekdays, ','), '') AS weekdays,
+			p.start_time,
+			p.end_time,
+			p.service_scope,
+			p.stack_with_coupon,
+			p.is_active,
+			COALESCE(stats.applied_count, 0) AS applied_count,
+			ROUND(COALESCE(stats.total_discount_amount, 0)) AS total_discount_amount,
+			COALESCE(p.note, '') AS note,
+			p.created_at,
+			p.updated_at
+		FROM promotions p
+		LEFT JOIN stores s ON s.id = p.store_id
+		LEFT JOIN LATERAL (
+			SELECT
+				COUNT(*) AS applied_count,
+				SUM(bd.promotion_discount_amount) AS total_discount_amount
+			FROM booking_details bd
+			WHERE bd.promotion_id = p.id
+		) stats ON true
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	  `, whereClause, sort, limitIndex, offsetIndex)
+
+	var results []GetAllPromotionsByFilterItem
+	if err := r.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return 0, nil, fmt.Errorf("failed to execute data query: %w", err)
+	}
+
+	return total, results, nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type UpdatePromotionTxParams struct {
+	Name            *string
+	StoreID         *int64
+	StartDate       *time.Time
+	EndDate         *time.Time
+	Weekdays        *[]int32
+	StartTime       *time.Time
+	EndTime         *time.Time
+	ServiceScope    *string
+	StackWithCoupon *bool
+	IsActive        *bool
+	Note            *string
+}
+
+// UpdatePromotionTx updates promotion fields that are provided within a transaction,
+// a zero store id, an empty weekdays or a zero time clears the limit
+func (r *PromotionRepository) UpdatePromotionTx(ctx context.Context, tx *sqlx.Tx, promotionID int64, params UpdatePromotionTxParams) error {
+	setParts := []string{"updated_at = NOW()"}
+	args := []interface{}{}
+
+	if params.Name != nil && *params.Name != "" {
+		setParts = append(setParts, fmt.Sprintf("name = $%d", len(args)+1))
+		args = append(args, *params.Name)
+	}
+
+	if params.StoreID != nil {
+		setParts = append(setParts, fmt.Sprintf("store_id = $%d", len(args)+1))
+		args = append(args, utils.Int64PtrToPgInt8(params.StoreID))
+	}
+
+	if params.StartDate != nil {
+		setParts = append(setParts, fmt.Sprintf("start_date = $%d", len(args)+1))
+		args = append(args, utils.TimePtrToPgDate(params.StartDate))
+	}
+
+	if params.EndDate != nil {
+		setParts = append(setParts, fmt.Sprintf("end_date = $%d", len(args)+1))
+		args = append(args, utils.TimePtrToPgDate(params.EndDate))
+	}
+
+	if params.Weekdays != nil {
+		setParts = append(setParts, fmt.Sprintf("weekdays = $%d", len(args)+1))
+		if len(*params.Weekdays) == 0 {
+			args = append(args, nil)
+		} else {
+			args = append(args, *params.Weekdays)
+		}
+	}
+
+	if params.StartTime != nil {
+		setParts = append(setParts, fmt.Sprintf("start_time = $%d", len(args)+1))
+		args = append(args, utils.TimePtrToPgTime(params.StartTime))
+	}
+
+	if params.EndTime != nil {
+		setParts = append(setParts, fmt.Sprintf("end_time = $%d", len(args)+1))
+		args = append(args, utils.TimePtrToPgTime(params.EndTime))
+	}
+
+	if params.ServiceScope != nil {
+		setParts = append(setParts, fmt.Sprintf("service_scope = $%d", len(args)+1))
+		args = append(args, *params.ServiceScope)
+	}
+
+	if params.StackWithCoupon != nil {
+		setParts = append(setParts, fmt.Sprintf("stack_with_coupon = $%d", len(args)+1))
+		args = append(args, *params.StackWithCoupon)
+	}
+
+	if params.IsActive != nil {
+		setParts = append(setParts, fmt.Sprintf("is_active = $%d", len(args)+1))
+		args = append(args, *params.IsActive)
+	}
+
+	if params.Note != nil {
+		setParts = append(setParts, fmt.Sprintf("note = $%d", len(args)+1))
+		args = append(args, utils.StringPtrToPgText(params.Note, false))
+	}
+
+	args = append(args, promotionID)
+	query := fmt.Sprintf(`
+		UPDATE promotions
+		SET %s
+		WHERE id = $%d
+	`, strings.Join(setParts, ", "), len(args))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute promotion update: %w", err)
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// ReplacePromotionServicesTx replaces the eligible services of the promotion within a transaction
+func (r *PromotionRepository) ReplacePromotionServicesTx(ctx context.Context, tx *sqlx.Tx, promotionID int64, serviceIDs []int64) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM promotion_services WHERE promotion_id = $1`, promotionID); err != nil {
+		return fmt.Errorf("failed to delete promotion services: %w", err)
+	}
+
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	valueParts := make([]string, len(serviceIDs))
+	args := []interface{}{promotionID}
+	for i, serviceID := range serviceIDs {
+		valueParts[i] = fmt.Sprintf("($1, $%d)", len(args)+1)
+		args = append(args, serviceID)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO promotion_services (promotion_id, service_id)
+		VALUES %s
+	`, strings.Join(valueParts, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create promotion services: %w", err)
+	}
+
+	return nil
+}
@@ -6,54 +6,84 @@ import (
 
 // Repositories consolidates all SQLX repositories into a single interface
 type Repositories struct {
-	Account            *AccountRepository
-	AccountTransaction *AccountTransactionRepository
-	Booking            *BookingRepository
-	BookingDetail      *BookingDetailRepository
-	BookingProduct     *BookingProductRepository
-	Brand              *BrandRepository
-	Customer           *CustomerRepository
-	Coupon             *CouponRepository
-	CustomerCoupon     *CustomerCouponRepository
-	Expense            *ExpenseRepository
-	ExpenseItem        *ExpenseItemRepository
-	Product            *ProductRepository
-	ProductCategory    *ProductCategoryRepository
-	Schedule           *ScheduleRepository
-	Service            *ServiceRepository
-	Staff              *StaffUserRepository
-	StockUsage         *StockUsageRepository
-	Store              *StoreRepository
-	Stylist            *StylistRepository
-	Supplier           *SupplierRepository
-	TimeSlot           *TimeSlotRepository
-	Template           *TimeSlotTemplateRepository
+	Account                   *AccountRepository
+	AccountTransaction        *AccountTransactionRepository
+	AccountTransactionAudit   *AccountTransactionAuditRepository
+	AccountStatementImport    *AccountStatementImportRepository
+	AccountStatementLine      *AccountStatementLineRepository
+	Booking                   *BookingRepository
+	BookingDetail             *BookingDetailRepository
+	BookingProduct            *BookingProductRepository
+	Brand                     *BrandRepository
+	CashDrawerClose           *CashDrawerCloseRepository
+	Customer                  *CustomerRepository
+	Coupon                    *CouponRepository
+	CouponCampaign            *CouponCampaignRepository
+	CustomerBlacklistDecision *CustomerBlacklistDecisionRepository
+	CustomerCoupon            *CustomerCouponRepository
+	CustomerLevelHistory      *CustomerLevelHistoryRepository
+	CustomerPointTransaction  *CustomerPointTransactionRepository
+	CustomerReferral          *CustomerReferralRepository
+	CustomerSegment           *CustomerSegmentRepository
+	CustomerWalletTransaction *CustomerWalletTransactionRepository
+	Expense                   *ExpenseRepository
+	ExpenseItem               *ExpenseItemRepository
+	GiftCard                  *GiftCardRepository
+	Invoice                   *InvoiceRepository
+	LineCampaign              *LineCampaignRepository
+	Product                   *ProductRepository
+	ProductCategory           *ProductCategoryRepository
+	Promotion                 *PromotionRepository
+	Schedule                  *ScheduleRepository
+	Service                   *ServiceRepository
+	Staff                     *StaffUserRepository
+	StockUsage                *StockUsageRepository
+	Store                     *StoreRepository
+	Stylist                   *StylistRepository
+	Supplier                  *SupplierRepository
+	TimeSlot                  *TimeSlotRepository
+	Template                  *TimeSlotTemplateRepository
 }
 
 // NewRepositories creates a new instance of Repositories with all repository instances
 func NewRepositories(db *sqlx.DB) *Repositories {
 	return &Repositories{
-		Account:            NewAccountRepository(db),
-		AccountTransaction: NewAccountTransactionRepository(db),
-		Booking:            NewBookingRepository(db),
-		BookingDetail:      NewBookingDetailRepository(db),
-		BookingProduct:     NewBookingProductRepository(db),
-		Brand:              NewBrandRepository(db),
-		Customer:           NewCustomerRepository(db),
-		Coupon:             NewCouponRepository(db),
-		CustomerCoupon:     NewCustomerCouponRepository(db),
-		Expense:            NewExpenseRepository(db),
-		ExpenseItem:        NewExpenseItemRepository(db),
-		Product:            NewProductRepository(db),
-		ProductCategory:    NewProductCategoryRepository(db),
-		Schedule:           NewScheduleRepository(db),
-		Service:            NewServiceRepository(db),
-		Staff:              NewStaffUserRepository(db),
-		StockUsage:         NewStockUsageRepository(db),
-		Store:              NewStoreRepository(db),
-		Stylist:            NewStylistRepository(db),
-		Supplier:           NewSupplierRepository(db),
-		TimeSlot:           NewTimeSlotRepository(db),
-		Template:           NewTimeSlotTemplateRepository(db),
+		Account:                   NewAccountRepository(db),
+		AccountTransaction:        NewAccountTransactionRepository(db),
+		AccountTransactionAudit:   NewAccountTransactionAuditRepository(db),
+		AccountStatementImport:    NewAccountStatementImportRepository(db),
+		AccountStatementLine:      NewAccountStatementLineRepository(db),
+		Booking:                   NewBookingRepository(db),
+		BookingDetail:             NewBookingDetailRepository(db),
+		BookingProduct:            NewBookingProductRepository(db),
+		Brand:                     NewBrandRepository(db),
+		CashDrawerClose:           NewCashDrawerCloseRepository(db),
+		Customer:                  NewCustomerRepository(db),
+		Coupon:                    NewCouponRepository(db),
+		CouponCampaign:            NewCouponCampaignRepository(db),
+		CustomerBlacklistDecision: NewCustomerBlacklistDecisionRepository(db),
+		CustomerCoupon:            NewCustomerCouponRepository(db),
+		CustomerLevelHistory:      NewCustomerLevelHistoryRepository(db),
+		CustomerPointTransaction:  NewCustomerPointTransactionRepository(db),
+		CustomerReferral:          NewCustomerReferralRepository(db),
+		CustomerSegment:           NewCustomerSegmentRepository(db),
+		CustomerWalletTransaction: NewCustomerWalletTransactionRepository(db),
+		Expense:                   NewExpenseRepository(db),
+		ExpenseItem:               NewExpenseItemRepository(db),
+		GiftCard:                  NewGiftCardRepository(db),
+		Invoice:                   NewInvoiceRepository(db),
+		LineCampaign:              NewLineCampaignRepository(db),
+		Product:                   NewProductRepository(db),
+		ProductCategory:           NewProductCategoryRepository(db),
+		Promotion:                 NewPromotionRepository(db),
+		Schedule:                  NewScheduleRepository(db),
+		Service:                   NewServiceRepository(db),
+		Staff:                     NewStaffUserRepository(db),
+		StockUsage:                NewStockUsageRepository(db),
+		Store:                     NewStoreRepository(db),
+		Stylist:                   NewStylistRepository(db),
+		Supplier:                  NewSupplierRepository(db),
+		TimeSlot:                  NewTimeSlotRepository(db),
+		Template:                  NewTimeSlotTemplateRepository(db),
 	}
 }
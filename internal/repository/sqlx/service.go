@@ -148,6 +148,7 @@ type UpdateServiceParams struct {
 	IsVisible       *bool
 	IsActive        *bool
 	Note            *string
+	Allergens       *[]string
 }
 
 type UpdateServiceResponse struct {
@@ -160,6 +161,7 @@ type UpdateServiceResponse struct {
 	IsVisible       pgtype.Bool        `db:"is_visible"`
 	IsActive        pgtype.Bool        `db:"is_active"`
 	Note            pgtype.Text        `db:"note"`
+	Allergens       []string           `db:"allergens"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at"`
 }
@@ -209,6 +211,11 @@ func (r *ServiceRepository) UpdateService(ctx context.Context, serviceID int64,
 		args = append(args, *params.Note)
 	}
 
+	if params.Allergens != nil {
+		setParts = append(setParts, fmt.Sprintf("allergens = $%d", len(args)+1))
+		args = append(args, *params.Allergens)
+	}
+
 	// Check if there are any fields to update
 	if len(setParts) == 1 {
 		return UpdateServiceResponse{}, fmt.Errorf("no fields to update")
@@ -221,11 +228,27 @@ func (r *ServiceRepository) UpdateService(ctx context.Context, serviceID int64,
 		UPDATE services
 		SET %s
 		WHERE id = $%d
-		RETURNING id, name, price, duration_minutes, is_addon, is_visible, is_active, note, created_at, updated_at
+		RETURNING id, name, price, duration_minutes, is_addon, is_visible, is_active, note, allergens, created_at, updated_at
 	`, strings.Join(setParts, ", "), len(args))
 
+	row := r.db.QueryRowxContext(ctx, query, args...)
+	m := pgtype.NewMap()
+
 	var result UpdateServiceResponse
-	if err := r.db.GetContext(ctx, &result, query, args...); err != nil {
+	err := row.Scan(
+		&result.ID,
+		&result.Name,
+		&result.Price,
+		&result.DurationMinutes,
+		&result.IsAddon,
+		&result.IsVisible,
+		&result.IsActive,
+		&result.Note,
+		m.SQLScanner(&result.Allergens),
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
 		return UpdateServiceResponse{}, fmt.Errorf("failed to update service: %w", err)
 	}
 
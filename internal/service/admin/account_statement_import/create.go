@@ -0,0 +1,187 @@
+package adminAccountStatementImport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementImportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_import"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool) CreateInterface {
+	return &Create{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, storeID, accountID int64, req adminAccountStatementImportModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementImportModel.CreateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// lock the account so concurrent imports cannot match the same transaction
+	account, err := qtx.GetAccountByIDForUpdate(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	layout, err := qtx.GetAccountStatementLayoutByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLayoutNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account statement layout", err)
+	}
+
+	lines, err := parseStatement(req.File, layout)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.AccountStatementFileInvalid, "failed to parse account statement", err)
+	}
+	if len(lines) == 0 {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementFileEmpty)
+	}
+
+	matches, err := matchStatementLines(ctx, qtx, accountID, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	importID := utils.GenerateID()
+	linesParams := make([]dbgen.CreateAccountStatementLinesParams, len(lines))
+	for i, line := range lines {
+		amount, err := utils.Int64PtrToPgNumeric(&line.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+		}
+
+		status := common.AccountStatementLineStatusUnmatched
+		if matches[i].Valid {
+			status = common.AccountStatementLineStatusMatched
+		}
+
+		linesParams[i] = dbgen.CreateAccountStatementLinesParams{
+			ID:                   utils.GenerateID(),
+			ImportID:             importID,
+			AccountID:            accountID,
+			LineNumber:           line.LineNumber,
+			TransactionDate:      utils.TimePtrToPgDate(&line.TransactionDate),
+			Description:          utils.StringPtrToPgText(&line.Description, true),
+			Type:                 line.Type,
+			Amount:               amount,
+			Status:               status,
+			AccountTransactionID: matches[i],
+		}
+	}
+
+	matchedLines := 0
+	for _, match := range matches {
+		if match.Valid {
+			matchedLines++
+		}
+	}
+
+	if err := qtx.CreateAccountStatementImport(ctx, dbgen.CreateAccountStatementImportParams{
+		ID:           importID,
+		AccountID:    accountID,
+		FileName:     req.FileName,
+		TotalLines:   int32(len(lines)),
+		MatchedLines: int32(matchedLines),
+		CreatedBy:    creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account statement import", err)
+	}
+
+	if _, err := qtx.CreateAccountStatementLines(ctx, linesParams); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account statement lines", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountStatementImportModel.CreateResponse{
+		ID:             utils.FormatID(importID),
+		TotalLines:     len(lines),
+		MatchedLines:   matchedLines,
+		UnmatchedLines: len(lines) - matchedLines,
+	}, nil
+}
+
+// matchStatementLines pairs each statement line with an unreconciled transaction of the same date, type and amount.
+// Transactions are consumed in ledger order so repeated identical amounts on one day are matched one to one.
+func matchStatementLines(ctx context.Context, qtx *dbgen.Queries, accountID int64, lines []statementLine) ([]pgtype.Int8, error) {
+	startDate, endDate := lines[0].TransactionDate, lines[0].TransactionDate
+	for _, line := range lines {
+		if line.TransactionDate.Before(startDate) {
+			startDate = line.TransactionDate
+		}
+		if line.TransactionDate.After(endDate) {
+			endDate = line.TransactionDate
+		}
+	}
+
+	candidates, err := qtx.GetUnreconciledAccountTransactionsByDateRange(ctx, dbgen.GetUnreconciledAccountTransactionsByDateRangeParams{
+		AccountID: accountID,
+		StartDate: utils.TimePtrToPgDate(&startDate),
+		EndDate:   utils.TimePtrToPgDate(&endDate),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get unreconciled account transactions", err)
+	}
+
+	pending := make(map[string][]int64)
+	for _, candidate := range candidates {
+		amount, err := utils.PgNumericToInt64(candidate.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+		key := matchKey(utils.PgDateToDateString(candidate.TransactionDate), candidate.Type, amount)
+		pending[key] = append(pending[key], candidate.ID)
+	}
+
+	matches := make([]pgtype.Int8, len(lines))
+	for i, line := range lines {
+		key := matchKey(line.TransactionDate.Format("2006-01-02"), line.Type, line.Amount)
+		ids := pending[key]
+		if len(ids) == 0 {
+			continue
+		}
+
+		matches[i] = pgtype.Int8{Int64: ids[0], Valid: true}
+		pending[key] = ids[1:]
+	}
+
+	return matches, nil
+}
+
+func matchKey(date, transactionType string, amount int64) string {
+	return fmt.Sprintf("%s|%s|%d", date, transactionType, amount)
+}
@@ -0,0 +1,73 @@
+package adminAccountStatementImport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementImportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_import"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID, accountID int64, req adminAccountStatementImportModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementImportModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	account, err := s.queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	total, items, err := s.repo.AccountStatementImport.GetAllAccountStatementImportsByFilter(ctx, accountID, sqlxRepo.GetAllAccountStatementImportsByFilterParams{
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account statement imports", err)
+	}
+
+	responseItems := make([]adminAccountStatementImportModel.GetAllItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminAccountStatementImportModel.GetAllItem{
+			ID:             utils.FormatID(item.ID),
+			FileName:       item.FileName,
+			TotalLines:     item.TotalLines,
+			MatchedLines:   item.MatchedLines,
+			UnmatchedLines: item.UnmatchedLines,
+			CreatedBy: adminAccountStatementImportModel.CreatedBy{
+				ID:   utils.FormatID(item.CreatedBy),
+				Name: item.CreatedByName,
+			},
+			CreatedAt: utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminAccountStatementImportModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
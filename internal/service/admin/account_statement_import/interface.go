@@ -0,0 +1,15 @@
+package adminAccountStatementImport
+
+import (
+	"context"
+
+	adminAccountStatementImportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_import"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, storeID, accountID int64, req adminAccountStatementImportModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementImportModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID, accountID int64, req adminAccountStatementImportModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementImportModel.GetAllResponse, error)
+}
@@ -0,0 +1,222 @@
+package adminAccountStatementImport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/traditionalchinese"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+const maxStatementLines = 5000
+
+type statementLine struct {
+	LineNumber      int32
+	TransactionDate time.Time
+	Description     string
+	Type            string
+	Amount          int64
+}
+
+// parseStatement reads the bank statement csv according to the layout of the account.
+// Rows without a date (blank rows, footers) are skipped, any other malformed row fails the whole file.
+func parseStatement(file io.Reader, layout dbgen.AccountStatementLayout) ([]statementLine, error) {
+	reader := bufio.NewReader(file)
+	if layout.Encoding == common.AccountStatementEncodingBig5 {
+		reader = bufio.NewReader(traditionalchinese.Big5.NewDecoder().Reader(reader))
+	}
+
+	// skip utf-8 BOM exported by excel
+	if bom, err := reader.Peek(3); err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		if _, err := reader.Discard(3); err != nil {
+			return nil, err
+		}
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = []rune(layout.Delimiter)[0]
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	csvReader.TrimLeadingSpace = true
+
+	skip := int(layout.SkipRows)
+	if layout.HasHeader {
+		skip++
+	}
+
+	lines := []statementLine{}
+	for rowIndex := 0; ; rowIndex++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rowIndex < skip {
+			continue
+		}
+
+		lineNumber, _ := csvReader.FieldPos(0)
+
+		dateValue := cellOf(record, layout.DateColumn)
+		if dateValue == "" {
+			continue
+		}
+		transactionDate, err := parseStatementDate(dateValue, layout.DateFormat)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q: %w", lineNumber, dateValue, err)
+		}
+
+		transactionType, amount, err := amountOf(record, layout)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		if amount == 0 {
+			continue
+		}
+
+		description := ""
+		if layout.DescriptionColumn.Valid {
+			description = cellOf(record, layout.DescriptionColumn.Int32)
+		}
+
+		lines = append(lines, statementLine{
+			LineNumber:      int32(lineNumber),
+			TransactionDate: transactionDate,
+			Description:     description,
+			Type:            transactionType,
+			Amount:          amount,
+		})
+		if len(lines) > maxStatementLines {
+			return nil, fmt.Errorf("statement exceeds %d lines", maxStatementLines)
+		}
+	}
+
+	return lines, nil
+}
+
+// cellOf returns the trimmed value of the 1-based column, or empty string when the row is shorter
+func cellOf(record []string, column int32) string {
+	index := int(column) - 1
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[index])
+}
+
+// amountOf resolves the transaction type and amount from either the signed amount column or the deposit / withdrawal columns
+func amountOf(record []string, layout dbgen.AccountStatementLayout) (string, int64, error) {
+	if layout.AmountColumn.Valid {
+		amount, err := parseStatementAmount(cellOf(record, layout.AmountColumn.Int32))
+		if err != nil {
+			return "", 0, err
+		}
+		if amount < 0 {
+			return common.AccountTransactionTypeExpense, -amount, nil
+		}
+		return common.AccountTransactionTypeIncome, amount, nil
+	}
+
+	deposit, err := parseStatementAmount(cellOf(record, layout.DepositColumn.Int32))
+	if err != nil {
+		return "", 0, err
+	}
+	withdrawal, err := parseStatementAmount(cellOf(record, layout.WithdrawalColumn.Int32))
+	if err != nil {
+		return "", 0, err
+	}
+
+	if deposit != 0 && withdrawal != 0 {
+		return "", 0, fmt.Errorf("both deposit and withdrawal are filled")
+	}
+	if withdrawal != 0 {
+		return common.AccountTransactionTypeExpense, absAmount(withdrawal), nil
+	}
+
+	return common.AccountTransactionTypeIncome, absAmount(deposit), nil
+}
+
+// parseStatementAmount parses amounts like "1,200", "NT$1,200.00", "-300" or "(300)", rounding to the dollar
+func parseStatementAmount(value string) (int64, error) {
+	value = strings.NewReplacer(",", "", " ", "", "NT$", "", "$", "", "+", "").Replace(value)
+	if value == "" || value == "-" {
+		return 0, nil
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.Trim(value, "()")
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return int64(math.Round(amount)), nil
+}
+
+// parseStatementDate parses the date by the layout format, ROC dates are like 114/01/02, 114-01-02 or 1140102
+func parseStatementDate(value, format string) (time.Time, error) {
+	switch format {
+	case common.AccountStatementDateFormatDash:
+		return time.Parse("2006-01-02", value)
+	case common.AccountStatementDateFormatSlash:
+		return time.Parse("2006/01/02", value)
+	case common.AccountStatementDateFormatCompact:
+		return time.Parse("20060102", value)
+	case common.AccountStatementDateFormatROC:
+		parts := strings.FieldsFunc(value, func(r rune) bool { return r == '/' || r == '-' || r == '.' })
+		if len(parts) == 1 && len(value) == 7 {
+			parts = []string{value[:3], value[3:5], value[5:]}
+		}
+		if len(parts) != 3 {
+			return time.Time{}, fmt.Errorf("unsupported ROC date")
+		}
+
+		year, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return time.Time{}, err
+		}
+		month, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		day, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		date := time.Date(year+1911, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if date.Month() != time.Month(month) || date.Day() != day {
+			return time.Time{}, fmt.Errorf("day out of range")
+		}
+		return date, nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported date format %s", format)
+	}
+}
+
+func absAmount(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+
+	return amount
+}
@@ -0,0 +1,226 @@
+package adminAccountStatementImport
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func column(index int32) pgtype.Int4 {
+	return pgtype.Int4{Int32: index, Valid: true}
+}
+
+// cathayLayout is the deposit / withdrawal layout of the Big5 export with two title rows before the header
+var cathayLayout = dbgen.AccountStatementLayout{
+	Encoding:          common.AccountStatementEncodingBig5,
+	HasHeader:         true,
+	SkipRows:          2,
+	Delimiter:         ",",
+	DateColumn:        1,
+	DateFormat:        common.AccountStatementDateFormatROC,
+	DescriptionColumn: column(2),
+	WithdrawalColumn:  column(3),
+	DepositColumn:     column(4),
+}
+
+// esunLayout is the signed amount layout of the UTF-8 export saved by excel with a BOM
+var esunLayout = dbgen.AccountStatementLayout{
+	Encoding:          common.AccountStatementEncodingUTF8,
+	HasHeader:         true,
+	Delimiter:         ",",
+	DateColumn:        1,
+	DateFormat:        common.AccountStatementDateFormatSlash,
+	DescriptionColumn: column(2),
+	AmountColumn:      column(3),
+}
+
+func TestParseStatementFixtures(t *testing.T) {
+	cases := []struct {
+		name    string
+		fixture string
+		layout  dbgen.AccountStatementLayout
+		want    []statementLine
+	}{
+		{
+			name:    "big5 with roc date and deposit withdrawal columns",
+			fixture: "testdata/cathay_big5.csv",
+			layout:  cathayLayout,
+			want: []statementLine{
+				{LineNumber: 4, TransactionDate: date(2024, time.January, 5), Description: "跨行轉入", Type: common.AccountTransactionTypeIncome, Amount: 1200},
+				{LineNumber: 5, TransactionDate: date(2024, time.January, 6), Description: "跨行手續費", Type: common.AccountTransactionTypeExpense, Amount: 15},
+				{LineNumber: 6, TransactionDate: date(2024, time.January, 8), Description: "ATM提款", Type: common.AccountTransactionTypeExpense, Amount: 3000},
+			},
+		},
+		{
+			name:    "utf-8 bom with signed amount column",
+			fixture: "testdata/esun_utf8_bom.csv",
+			layout:  esunLayout,
+			want: []statementLine{
+				{LineNumber: 2, TransactionDate: date(2024, time.January, 5), Description: "信用卡收單撥款", Type: common.AccountTransactionTypeIncome, Amount: 2350},
+				{LineNumber: 3, TransactionDate: date(2024, time.January, 5), Description: "轉帳支出", Type: common.AccountTransactionTypeExpense, Amount: 800},
+				{LineNumber: 4, TransactionDate: date(2024, time.January, 7), Description: "退款沖正", Type: common.AccountTransactionTypeExpense, Amount: 120},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := os.Open(tc.fixture)
+			require.NoError(t, err)
+			defer file.Close()
+
+			lines, err := parseStatement(file, tc.layout)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, lines)
+		})
+	}
+}
+
+func TestParseStatementInvalidRows(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		layout  dbgen.AccountStatementLayout
+		wantErr string
+	}{
+		{
+			name:    "invalid date",
+			content: "交易日,摘要,金額\n2024/13/01,轉帳,100\n",
+			layout:  esunLayout,
+			wantErr: "line 2: invalid date",
+		},
+		{
+			name:    "invalid amount",
+			content: "交易日,摘要,金額\n2024/01/05,轉帳,abc\n",
+			layout:  esunLayout,
+			wantErr: "line 2: invalid amount",
+		},
+		{
+			name:    "both deposit and withdrawal filled",
+			content: "帳號：123-45-678901\n查詢期間：113/01/01~113/01/31\n交易日期,摘要,提款金額,存款金額\n113/01/05,轉帳,100,200\n",
+			layout: func() dbgen.AccountStatementLayout {
+				layout := cathayLayout
+				layout.Encoding = common.AccountStatementEncodingUTF8
+				return layout
+			}(),
+			wantErr: "both deposit and withdrawal are filled",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseStatement(strings.NewReader(tc.content), tc.layout)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestParseStatementDate(t *testing.T) {
+	cases := []struct {
+		value   string
+		format  string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "113/01/05", format: common.AccountStatementDateFormatROC, want: date(2024, time.January, 5)},
+		{value: "113-01-05", format: common.AccountStatementDateFormatROC, want: date(2024, time.January, 5)},
+		{value: "113.1.5", format: common.AccountStatementDateFormatROC, want: date(2024, time.January, 5)},
+		{value: "1130105", format: common.AccountStatementDateFormatROC, want: date(2024, time.January, 5)},
+		{value: "99/12/31", format: common.AccountStatementDateFormatROC, want: date(2010, time.December, 31)},
+		{value: "113/02/29", format: common.AccountStatementDateFormatROC, want: date(2024, time.February, 29)},
+		{value: "114/02/29", format: common.AccountStatementDateFormatROC, wantErr: true},
+		{value: "113/01", format: common.AccountStatementDateFormatROC, wantErr: true},
+		{value: "2024-01-05", format: common.AccountStatementDateFormatDash, want: date(2024, time.January, 5)},
+		{value: "2024/01/05", format: common.AccountStatementDateFormatSlash, want: date(2024, time.January, 5)},
+		{value: "20240105", format: common.AccountStatementDateFormatCompact, want: date(2024, time.January, 5)},
+		{value: "2024/01/05", format: common.AccountStatementDateFormatDash, wantErr: true},
+		{value: "2024-01-05", format: "DD/MM/YYYY", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		got, err := parseStatementDate(tc.value, tc.format)
+		if tc.wantErr {
+			assert.Error(t, err, "%s (%s)", tc.value, tc.format)
+			continue
+		}
+		if assert.NoError(t, err, "%s (%s)", tc.value, tc.format) {
+			assert.True(t, tc.want.Equal(got), "%s (%s) → %s, want %s", tc.value, tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestParseStatementAmount(t *testing.T) {
+	cases := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "1,200", want: 1200},
+		{value: "NT$1,200.00", want: 1200},
+		{value: "$ 350", want: 350},
+		{value: "+500", want: 500},
+		{value: "-300", want: -300},
+		{value: "(300)", want: -300},
+		{value: "12.5", want: 13},
+		{value: "12.4", want: 12},
+		{value: "", want: 0},
+		{value: "-", want: 0},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		got, err := parseStatementAmount(tc.value)
+		if tc.wantErr {
+			assert.Error(t, err, tc.value)
+			continue
+		}
+		if assert.NoError(t, err, tc.value) {
+			assert.Equal(t, tc.want, got, tc.value)
+		}
+	}
+}
+
+func TestMatchKey(t *testing.T) {
+	file, err := os.Open("testdata/cathay_big5.csv")
+	require.NoError(t, err)
+	defer file.Close()
+
+	lines, err := parseStatement(file, cathayLayout)
+	require.NoError(t, err)
+	require.NotEmpty(t, lines)
+
+	line := lines[0]
+	statementKey := matchKey(line.TransactionDate.Format("2006-01-02"), line.Type, line.Amount)
+	assert.Equal(t, "2024-01-05|INCOME|1200", statementKey)
+
+	// the key of the ledger side is built from the pg date, both sides must agree
+	transactionDate := pgtype.Date{Time: date(2024, time.January, 5), Valid: true}
+	cases := []struct {
+		name      string
+		key       string
+		wantMatch bool
+	}{
+		{name: "same date type and amount", key: matchKey(utils.PgDateToDateString(transactionDate), common.AccountTransactionTypeIncome, 1200), wantMatch: true},
+		{name: "different type", key: matchKey(utils.PgDateToDateString(transactionDate), common.AccountTransactionTypeExpense, 1200)},
+		{name: "different amount", key: matchKey(utils.PgDateToDateString(transactionDate), common.AccountTransactionTypeIncome, 1201)},
+		{name: "different date", key: matchKey("2024-01-06", common.AccountTransactionTypeIncome, 1200)},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.wantMatch, tc.key == statementKey, tc.name)
+	}
+}
@@ -0,0 +1,72 @@
+package adminAccountStatementLayout
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementLayoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_layout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, storeID, accountID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLayoutModel.GetResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if err := checkStoreAccount(ctx, s.queries, storeID, accountID); err != nil {
+		return nil, err
+	}
+
+	layout, err := s.queries.GetAccountStatementLayoutByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLayoutNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account statement layout", err)
+	}
+
+	return &adminAccountStatementLayoutModel.GetResponse{
+		AccountID:         utils.FormatID(layout.AccountID),
+		Encoding:          layout.Encoding,
+		HasHeader:         layout.HasHeader,
+		SkipRows:          layout.SkipRows,
+		Delimiter:         layout.Delimiter,
+		DateColumn:        layout.DateColumn,
+		DateFormat:        layout.DateFormat,
+		DescriptionColumn: utils.PgInt4ToInt32Ptr(layout.DescriptionColumn),
+		AmountColumn:      utils.PgInt4ToInt32Ptr(layout.AmountColumn),
+		DepositColumn:     utils.PgInt4ToInt32Ptr(layout.DepositColumn),
+		WithdrawalColumn:  utils.PgInt4ToInt32Ptr(layout.WithdrawalColumn),
+		UpdatedAt:         utils.PgTimestamptzToTimeString(layout.UpdatedAt),
+	}, nil
+}
+
+// checkStoreAccount validates the account exists and belongs to the store
+func checkStoreAccount(ctx context.Context, queries *dbgen.Queries, storeID, accountID int64) error {
+	account, err := queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	return nil
+}
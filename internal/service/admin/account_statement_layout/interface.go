@@ -0,0 +1,15 @@
+package adminAccountStatementLayout
+
+import (
+	"context"
+
+	adminAccountStatementLayoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_layout"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context, storeID, accountID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLayoutModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, storeID, accountID int64, req adminAccountStatementLayoutModel.UpdateRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementLayoutModel.UpdateResponse, error)
+}
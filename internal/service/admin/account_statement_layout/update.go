@@ -0,0 +1,75 @@
+package adminAccountStatementLayout
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementLayoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_layout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, storeID, accountID int64, req adminAccountStatementLayoutModel.UpdateRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementLayoutModel.UpdateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	// amounts are either a single signed column or a pair of deposit / withdrawal columns
+	hasSplitColumns := req.DepositColumn != nil && req.WithdrawalColumn != nil
+	hasAnySplitColumn := req.DepositColumn != nil || req.WithdrawalColumn != nil
+	if req.AmountColumn != nil && hasAnySplitColumn || req.AmountColumn == nil && !hasSplitColumns {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLayoutAmountColumnInvalid)
+	}
+
+	if err := checkStoreAccount(ctx, s.queries, storeID, accountID); err != nil {
+		return nil, err
+	}
+
+	encoding := common.AccountStatementEncodingUTF8
+	if req.Encoding != nil {
+		encoding = *req.Encoding
+	}
+	hasHeader := true
+	if req.HasHeader != nil {
+		hasHeader = *req.HasHeader
+	}
+	var skipRows int32
+	if req.SkipRows != nil {
+		skipRows = *req.SkipRows
+	}
+	delimiter := ","
+	if req.Delimiter != nil {
+		delimiter = *req.Delimiter
+	}
+
+	if err := s.queries.UpsertAccountStatementLayout(ctx, dbgen.UpsertAccountStatementLayoutParams{
+		AccountID:         accountID,
+		Encoding:          encoding,
+		HasHeader:         hasHeader,
+		SkipRows:          skipRows,
+		Delimiter:         delimiter,
+		DateColumn:        req.DateColumn,
+		DateFormat:        req.DateFormat,
+		DescriptionColumn: utils.Int32PtrToPgInt4(req.DescriptionColumn),
+		AmountColumn:      utils.Int32PtrToPgInt4(req.AmountColumn),
+		DepositColumn:     utils.Int32PtrToPgInt4(req.DepositColumn),
+		WithdrawalColumn:  utils.Int32PtrToPgInt4(req.WithdrawalColumn),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account statement layout", err)
+	}
+
+	return &adminAccountStatementLayoutModel.UpdateResponse{
+		AccountID: utils.FormatID(accountID),
+	}, nil
+}
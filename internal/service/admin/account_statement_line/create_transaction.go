@@ -0,0 +1,124 @@
+package adminAccountStatementLine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CreateTransaction struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewCreateTransaction(queries *dbgen.Queries, db *pgxpool.Pool) CreateTransactionInterface {
+	return &CreateTransaction{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *CreateTransaction) CreateTransaction(ctx context.Context, storeID, accountID, lineID int64, req adminAccountStatementLineModel.CreateTransactionRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.CreateTransactionResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	line, err := getUnmatchedLine(ctx, qtx, accountID, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := utils.PgNumericToInt64(line.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+	}
+
+	// the bank description is kept as note unless staff provide one
+	note := utils.PgTextToString(line.Description)
+	if req.Note != nil {
+		note = *req.Note
+	}
+	transactionDate := time.Date(line.TransactionDate.Time.Year(), line.TransactionDate.Time.Month(), line.TransactionDate.Time.Day(), 0, 0, 0, 0, time.UTC)
+
+	accountTransactionID, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+		StoreID:         storeID,
+		AccountID:       accountID,
+		TransactionDate: transactionDate,
+		Type:            line.Type,
+		Amount:          amount,
+		Note:            &note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ledger.CreateAudit(ctx, qtx, ledger.CreateAuditParams{
+		AccountID:     accountID,
+		TransactionID: accountTransactionID,
+		Action:        common.AccountTransactionAuditActionCreate,
+		After: &ledger.TransactionSnapshot{
+			TransactionDate: transactionDate.Format("2006-01-02"),
+			Type:            line.Type,
+			Amount:          amount,
+			Note:            note,
+		},
+		StaffID: creatorID,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := qtx.UpdateAccountStatementLineStatus(ctx, dbgen.UpdateAccountStatementLineStatusParams{
+		ID:                   line.ID,
+		Status:               common.AccountStatementLineStatusCreated,
+		AccountTransactionID: pgtype.Int8{Int64: accountTransactionID, Valid: true},
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account statement line", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountStatementLineModel.CreateTransactionResponse{
+		ID:            utils.FormatID(line.ID),
+		TransactionID: utils.FormatID(accountTransactionID),
+	}, nil
+}
+
+// getUnmatchedLine locks the statement line of the account, only unmatched lines can be resolved by staff
+func getUnmatchedLine(ctx context.Context, qtx *dbgen.Queries, accountID, lineID int64) (dbgen.GetAccountStatementLineByIDForUpdateRow, error) {
+	line, err := qtx.GetAccountStatementLineByIDForUpdate(ctx, lineID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbgen.GetAccountStatementLineByIDForUpdateRow{}, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLineNotFound)
+		}
+		return dbgen.GetAccountStatementLineByIDForUpdateRow{}, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account statement line", err)
+	}
+	if line.AccountID != accountID {
+		return dbgen.GetAccountStatementLineByIDForUpdateRow{}, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLineNotFound)
+	}
+	if line.Status != common.AccountStatementLineStatusUnmatched {
+		return dbgen.GetAccountStatementLineByIDForUpdateRow{}, errorCodes.NewServiceErrorWithCode(errorCodes.AccountStatementLineNotUnmatched)
+	}
+
+	return line, nil
+}
@@ -0,0 +1,80 @@
+package adminAccountStatementLine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID, accountID int64, req adminAccountStatementLineModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	account, err := s.queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	total, items, err := s.repo.AccountStatementLine.GetAllAccountStatementLinesByFilter(ctx, accountID, sqlxRepo.GetAllAccountStatementLinesByFilterParams{
+		ImportID: req.ImportID,
+		Status:   req.Status,
+		Limit:    &req.Limit,
+		Offset:   &req.Offset,
+		Sort:     &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account statement lines", err)
+	}
+
+	responseItems := make([]adminAccountStatementLineModel.GetAllItem, len(items))
+	for i, item := range items {
+		amount, err := utils.PgNumericToInt64(item.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+
+		responseItems[i] = adminAccountStatementLineModel.GetAllItem{
+			ID:              utils.FormatID(item.ID),
+			ImportID:        utils.FormatID(item.ImportID),
+			LineNumber:      item.LineNumber,
+			TransactionDate: utils.PgDateToDateString(item.TransactionDate),
+			Description:     utils.PgTextToString(item.Description),
+			Type:            item.Type,
+			Amount:          amount,
+			Status:          item.Status,
+			TransactionID:   utils.PgInt8ToIDString(item.AccountTransactionID),
+			UpdatedAt:       utils.PgTimestamptzToTimeString(item.UpdatedAt),
+		}
+	}
+
+	return &adminAccountStatementLineModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
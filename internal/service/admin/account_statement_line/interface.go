@@ -0,0 +1,19 @@
+package adminAccountStatementLine
+
+import (
+	"context"
+
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID, accountID int64, req adminAccountStatementLineModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.GetAllResponse, error)
+}
+
+type CreateTransactionInterface interface {
+	CreateTransaction(ctx context.Context, storeID, accountID, lineID int64, req adminAccountStatementLineModel.CreateTransactionRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.CreateTransactionResponse, error)
+}
+
+type UpdateIgnoreInterface interface {
+	UpdateIgnore(ctx context.Context, storeID, accountID, lineID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.UpdateIgnoreResponse, error)
+}
@@ -0,0 +1,74 @@
+package adminAccountStatementLine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountStatementLineModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_statement_line"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type UpdateIgnore struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewUpdateIgnore(queries *dbgen.Queries, db *pgxpool.Pool) UpdateIgnoreInterface {
+	return &UpdateIgnore{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *UpdateIgnore) UpdateIgnore(ctx context.Context, storeID, accountID, lineID int64, role string, creatorStoreIDs []int64) (*adminAccountStatementLineModel.UpdateIgnoreResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	account, err := s.queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	line, err := getUnmatchedLine(ctx, qtx, accountID, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := qtx.UpdateAccountStatementLineStatus(ctx, dbgen.UpdateAccountStatementLineStatusParams{
+		ID:                   line.ID,
+		Status:               common.AccountStatementLineStatusIgnored,
+		AccountTransactionID: pgtype.Int8{Valid: false},
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account statement line", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountStatementLineModel.UpdateIgnoreResponse{
+		ID: utils.FormatID(line.ID),
+	}, nil
+}
@@ -2,86 +2,80 @@ package adminAccountTransaction
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminAccountTransactionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type Create struct {
 	queries *dbgen.Queries
+	db      *pgxpool.Pool
 }
 
-func NewCreate(queries *dbgen.Queries) CreateInterface {
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool) CreateInterface {
 	return &Create{
 		queries: queries,
+		db:      db,
 	}
 }
 
-func (s *Create) Create(ctx context.Context, storeID, accountID int64, req adminAccountTransactionModel.CreateParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.CreateResponse, error) {
+func (s *Create) Create(ctx context.Context, storeID, accountID int64, req adminAccountTransactionModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.CreateResponse, error) {
 	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
 		return nil, err
 	}
 
-	account, err := s.queries.GetAccountByID(ctx, accountID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
-		}
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
-	}
-	if account.StoreID != storeID {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
-	}
-
-	balance, err := s.queries.GetAccountTransactionCurrentBalance(ctx, accountID)
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction current balance", err)
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
 	}
+	defer tx.Rollback(ctx)
 
-	if req.Type == common.AccountTransactionTypeIncome {
-		balance += int32(req.Amount)
-	} else {
-		balance -= int32(req.Amount)
-	}
-
-	// check if balance is less than 0
-	if balance < 0 {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountBalanceNotEnough)
-	}
+	qtx := dbgen.New(tx)
 
-	int64Balance := int64(balance)
-	balanceNumeric, err := utils.Int64PtrToPgNumeric(&int64Balance)
+	// a back-dated transaction shifts the balances of later transactions
+	amount := int64(req.Amount)
+	accountTransactionID, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+		StoreID:         storeID,
+		AccountID:       accountID,
+		TransactionDate: req.TransactionDate,
+		Type:            req.Type,
+		Amount:          amount,
+		Note:            req.Note,
+	})
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance", err)
+		return nil, err
 	}
 
-	int64Amount := int64(req.Amount)
-	amountNumeric, err := utils.Int64PtrToPgNumeric(&int64Amount)
-	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	note := ""
+	if req.Note != nil {
+		note = *req.Note
+	}
+	if err := ledger.CreateAudit(ctx, qtx, ledger.CreateAuditParams{
+		AccountID:     accountID,
+		TransactionID: accountTransactionID,
+		Action:        common.AccountTransactionAuditActionCreate,
+		After: &ledger.TransactionSnapshot{
+			TransactionDate: req.TransactionDate.Format("2006-01-02"),
+			Type:            req.Type,
+			Amount:          amount,
+			Note:            note,
+		},
+		StaffID: creatorID,
+	}); err != nil {
+		return nil, err
 	}
 
-	// Create account
-	accountTransactionID := utils.GenerateID()
-	_, err = s.queries.CreateAccountTransaction(ctx, dbgen.CreateAccountTransactionParams{
-		ID:              accountTransactionID,
-		AccountID:       accountID,
-		TransactionDate: utils.TimePtrToPgDate(&req.TransactionDate),
-		Type:            req.Type,
-		Amount:          amountNumeric,
-		Balance:         balanceNumeric,
-		Note:            utils.StringPtrToPgText(req.Note, true),
-	})
-	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account transaction", err)
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
 	}
 
 	return &adminAccountTransactionModel.CreateResponse{
-		ID: utils.FormatID(accountID),
+		ID: utils.FormatID(accountTransactionID),
 	}, nil
 }
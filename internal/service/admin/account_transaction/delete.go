@@ -5,50 +5,213 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminAccountTransactionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type Delete struct {
 	queries *dbgen.Queries
+	db      *pgxpool.Pool
 }
 
-func NewDelete(queries *dbgen.Queries) DeleteInterface {
+func NewDelete(queries *dbgen.Queries, db *pgxpool.Pool) DeleteInterface {
 	return &Delete{
 		queries: queries,
+		db:      db,
 	}
 }
 
-func (s *Delete) Delete(ctx context.Context, storeID, accountID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error) {
+func (s *Delete) Delete(ctx context.Context, storeID, accountID int64, deleterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error) {
 	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
 		return nil, err
 	}
 
-	// validate account exists
-	account, err := s.queries.GetAccountByID(ctx, accountID)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := checkStoreAccount(ctx, qtx, storeID, accountID); err != nil {
+		return nil, err
+	}
+
+	latestID, err := getLatestAccountTransactionID(ctx, qtx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountTransaction, err := getAccountTransaction(ctx, qtx, accountID, latestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lockTransactionAccounts(ctx, qtx, accountTransaction); err != nil {
+		return nil, err
+	}
+
+	// the latest transaction may have changed before the accounts were locked
+	lockedLatestID, err := getLatestAccountTransactionID(ctx, qtx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if lockedLatestID != latestID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionChanged)
+	}
+
+	accountTransaction, err = getAccountTransaction(ctx, qtx, accountID, latestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteAccountTransaction(ctx, qtx, accountTransaction, deleterID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountTransactionModel.DeleteResponse{
+		Deleted: utils.FormatID(accountTransaction.ID),
+	}, nil
+}
+
+// checkStoreAccount checks the account exists and belongs to the store
+func checkStoreAccount(ctx context.Context, qtx *dbgen.Queries, storeID, accountID int64) error {
+	account, err := qtx.GetAccountByID(ctx, accountID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+			return errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
 		}
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
 	}
 	if account.StoreID != storeID {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+		return errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
 	}
 
-	// delete latest account_transactions data
-	deletedID, err := s.queries.DeleteLatestAccountTransaction(ctx, accountID)
+	return nil
+}
+
+// getAccountTransaction gets the transaction and checks it belongs to the account
+func getAccountTransaction(ctx context.Context, qtx *dbgen.Queries, accountID, transactionID int64) (dbgen.GetAccountTransactionByIDRow, error) {
+	accountTransaction, err := qtx.GetAccountTransactionByID(ctx, transactionID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotFound)
+			return dbgen.GetAccountTransactionByIDRow{}, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotFound)
 		}
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete latest account transaction", err)
+		return dbgen.GetAccountTransactionByIDRow{}, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+	}
+	if accountTransaction.AccountID != accountID {
+		return dbgen.GetAccountTransactionByIDRow{}, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotBelongToAccount)
 	}
 
-	return &adminAccountTransactionModel.DeleteResponse{
-		Deleted: utils.FormatID(deletedID),
-	}, nil
+	return accountTransaction, nil
+}
+
+// getLatestAccountTransactionID gets the id of the latest transaction of the account
+func getLatestAccountTransactionID(ctx context.Context, qtx *dbgen.Queries, accountID int64) (int64, error) {
+	latest, err := qtx.GetLatestAccountTransactionByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotFound)
+		}
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get latest account transaction", err)
+	}
+
+	return latest.ID, nil
+}
+
+// lockTransactionAccounts locks the account of the transaction, and the other accounts of a transfer, in ascending id order.
+// The accounts of a transfer never change, so they can be collected before the locks are taken.
+func lockTransactionAccounts(ctx context.Context, qtx *dbgen.Queries, accountTransaction dbgen.GetAccountTransactionByIDRow) error {
+	accountIDs := []int64{accountTransaction.AccountID}
+	if isTransfer(accountTransaction) {
+		pairs, err := getTransferPairs(ctx, qtx, accountTransaction)
+		if err != nil {
+			return err
+		}
+		for _, pair := range pairs {
+			accountIDs = append(accountIDs, pair.AccountID)
+		}
+	}
+
+	return ledger.LockAccounts(ctx, qtx, accountIDs)
+}
+
+// deleteAccountTransaction deletes the transaction and recomputes the balances of the account.
+// A transfer is deleted together with the transaction on the other account and the transfer record.
+// The caller must have locked the accounts through lockTransactionAccounts.
+func deleteAccountTransaction(ctx context.Context, qtx *dbgen.Queries, accountTransaction dbgen.GetAccountTransactionByIDRow, deleterID int64) error {
+	if err := checkManuallyChangeable(accountTransaction); err != nil {
+		return err
+	}
+
+	if isTransfer(accountTransaction) {
+		pairs, err := getTransferPairs(ctx, qtx, accountTransaction)
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range pairs {
+			pairTransaction, err := qtx.GetAccountTransactionByID(ctx, pair.ID)
+			if err != nil {
+				return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+			}
+
+			if err := ledger.DeleteTransaction(ctx, qtx, pairTransaction, deleterID); err != nil {
+				return err
+			}
+		}
+
+		if err := qtx.DeleteAccountTransferByID(ctx, accountTransaction.SourceID.Int64); err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete account transfer", err)
+		}
+	}
+
+	return ledger.DeleteTransaction(ctx, qtx, accountTransaction, deleterID)
+}
+
+// checkManuallyChangeable checks the transaction is not posted from a source document other than a transfer.
+// Those postings follow their source (checkout, refund, expense, cash drawer close, wallet top-up) and are changed through it,
+// otherwise the ledger goes out of sync and the posting can not be found when the source changes.
+func checkManuallyChangeable(accountTransaction dbgen.GetAccountTransactionByIDRow) error {
+	if accountTransaction.SourceType.Valid && accountTransaction.SourceType.String != common.AccountTransactionSourceTransfer {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionPostedBySource)
+	}
+
+	return nil
+}
+
+// isTransfer checks if the transaction is one side of an account transfer
+func isTransfer(accountTransaction dbgen.GetAccountTransactionByIDRow) bool {
+	return accountTransaction.SourceType.Valid && accountTransaction.SourceType.String == common.AccountTransactionSourceTransfer && accountTransaction.SourceID.Valid
+}
+
+// getTransferPairs gets the other transactions of the same transfer
+func getTransferPairs(ctx context.Context, qtx *dbgen.Queries, accountTransaction dbgen.GetAccountTransactionByIDRow) ([]dbgen.GetAccountTransactionsBySourceRow, error) {
+	rows, err := qtx.GetAccountTransactionsBySource(ctx, dbgen.GetAccountTransactionsBySourceParams{
+		SourceType: accountTransaction.SourceType,
+		SourceID:   accountTransaction.SourceID,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get transfer transactions", err)
+	}
+
+	pairs := make([]dbgen.GetAccountTransactionsBySourceRow, 0, len(rows))
+	for _, row := range rows {
+		if row.ID != accountTransaction.ID {
+			pairs = append(pairs, row)
+		}
+	}
+
+	return pairs, nil
 }
@@ -0,0 +1,70 @@
+package adminAccountTransaction
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountTransactionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type DeleteByID struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewDeleteByID(queries *dbgen.Queries, db *pgxpool.Pool) DeleteByIDInterface {
+	return &DeleteByID{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *DeleteByID) DeleteByID(ctx context.Context, storeID, accountID, transactionID int64, deleterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := checkStoreAccount(ctx, qtx, storeID, accountID); err != nil {
+		return nil, err
+	}
+
+	accountTransaction, err := getAccountTransaction(ctx, qtx, accountID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock every account the change touches before any write, later balances are recomputed under the locks
+	if err := lockTransactionAccounts(ctx, qtx, accountTransaction); err != nil {
+		return nil, err
+	}
+
+	// read again under the locks
+	accountTransaction, err = getAccountTransaction(ctx, qtx, accountID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteAccountTransaction(ctx, qtx, accountTransaction, deleterID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountTransactionModel.DeleteResponse{
+		Deleted: utils.FormatID(accountTransaction.ID),
+	}, nil
+}
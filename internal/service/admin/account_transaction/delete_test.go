@@ -0,0 +1,20 @@
+package adminAccountTransaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+)
+
+func TestDeleteAccountTransactionRejectsPostedBySource(t *testing.T) {
+	// rejected before any query, so no transaction queries are needed
+	for _, sourceType := range postedSourceTypes {
+		err := deleteAccountTransaction(context.Background(), nil, postedTransaction(sourceType), 4)
+		code, ok := errorCodes.IsServiceError(err)
+		assert.True(t, ok, sourceType)
+		assert.Equal(t, errorCodes.AccountTransactionPostedBySource, code, sourceType)
+	}
+}
@@ -49,6 +49,11 @@ func (s *GetAll) GetAll(ctx context.Context, storeID, accountID int64, req admin
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance to int64", err)
 		}
 
+		sourceID := ""
+		if item.SourceID.Valid {
+			sourceID = utils.FormatID(item.SourceID.Int64)
+		}
+
 		responseItems[i] = adminAccountTransactionModel.GetAllItem{
 			ID:              utils.FormatID(item.ID),
 			TransactionDate: utils.PgDateToDateString(item.TransactionDate),
@@ -56,6 +61,8 @@ func (s *GetAll) GetAll(ctx context.Context, storeID, accountID int64, req admin
 			Amount:          amount,
 			Balance:         balance,
 			Note:            utils.PgTextToString(item.Note),
+			SourceType:      utils.PgTextToString(item.SourceType),
+			SourceID:        sourceID,
 			CreatedAt:       utils.PgTimestamptzToTimeString(item.CreatedAt),
 			UpdatedAt:       utils.PgTimestamptzToTimeString(item.UpdatedAt),
 		}
@@ -11,13 +11,17 @@ type GetAllInterface interface {
 }
 
 type CreateInterface interface {
-	Create(ctx context.Context, storeID, accountID int64, req adminAccountTransactionModel.CreateParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.CreateResponse, error)
+	Create(ctx context.Context, storeID, accountID int64, req adminAccountTransactionModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.CreateResponse, error)
 }
 
 type UpdateInterface interface {
-	Update(ctx context.Context, storeID, accountID, transactionID int64, req adminAccountTransactionModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.UpdateResponse, error)
+	Update(ctx context.Context, storeID, accountID, transactionID int64, req adminAccountTransactionModel.UpdateParsedRequest, updaterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.UpdateResponse, error)
 }
 
 type DeleteInterface interface {
-	Delete(ctx context.Context, storeID, accountID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error)
+	Delete(ctx context.Context, storeID, accountID int64, deleterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error)
+}
+
+type DeleteByIDInterface interface {
+	DeleteByID(ctx context.Context, storeID, accountID, transactionID int64, deleterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.DeleteResponse, error)
 }
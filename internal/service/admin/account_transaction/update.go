@@ -2,53 +2,172 @@ package adminAccountTransaction
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminAccountTransactionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
-	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type Update struct {
 	queries *dbgen.Queries
-	repo    *sqlxRepo.Repositories
+	db      *pgxpool.Pool
 }
 
-func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories) UpdateInterface {
+func NewUpdate(queries *dbgen.Queries, db *pgxpool.Pool) UpdateInterface {
 	return &Update{
 		queries: queries,
-		repo:    repo,
+		db:      db,
 	}
 }
 
-func (s *Update) Update(ctx context.Context, storeID, accountID, transactionID int64, req adminAccountTransactionModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.UpdateResponse, error) {
+func (s *Update) Update(ctx context.Context, storeID, accountID, transactionID int64, req adminAccountTransactionModel.UpdateParsedRequest, updaterID int64, role string, creatorStoreIDs []int64) (*adminAccountTransactionModel.UpdateResponse, error) {
 	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
 		return nil, err
 	}
 
-	accountTransaction, err := s.queries.GetAccountTransactionByID(ctx, transactionID)
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotFound)
-		}
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := checkStoreAccount(ctx, qtx, storeID, accountID); err != nil {
+		return nil, err
+	}
+
+	accountTransaction, err := getAccountTransaction(ctx, qtx, accountID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock every account the change touches before any write, later balances are recomputed under the locks
+	if err := lockTransactionAccounts(ctx, qtx, accountTransaction); err != nil {
+		return nil, err
+	}
+
+	// read again under the locks
+	accountTransaction, err = getAccountTransaction(ctx, qtx, accountID, transactionID)
+	if err != nil {
+		return nil, err
 	}
-	if accountTransaction.AccountID != accountID {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransactionNotBelongToAccount)
+
+	if isTransfer(accountTransaction) {
+		// the direction of a transfer is fixed, the other fields are corrected on both sides together
+		if req.Type != nil && *req.Type != accountTransaction.Type {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransferTypeNotUpdatable)
+		}
+
+		pairs, err := getTransferPairs(ctx, qtx, accountTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pair := range pairs {
+			pairTransaction, err := qtx.GetAccountTransactionByID(ctx, pair.ID)
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+			}
+
+			if _, err := updateAccountTransaction(ctx, qtx, pairTransaction, req, updaterID); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	updateResponse, err := s.repo.AccountTransaction.UpdateAccountTransaction(ctx, transactionID, sqlxRepo.UpdateAccountTransactionParams{
-		Note: req.Note,
-	})
+	after, err := updateAccountTransaction(ctx, qtx, accountTransaction, req, updaterID)
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account transaction", err)
+		return nil, err
+	}
+
+	if isTransfer(accountTransaction) {
+		if err := qtx.UpdateAccountTransfer(ctx, dbgen.UpdateAccountTransferParams{
+			ID:              accountTransaction.SourceID.Int64,
+			TransactionDate: after.TransactionDate,
+			Amount:          after.Amount,
+			Note:            after.Note,
+		}); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account transfer", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
 	}
 
 	return &adminAccountTransactionModel.UpdateResponse{
-		ID: utils.FormatID(updateResponse.ID),
+		ID: utils.FormatID(accountTransaction.ID),
 	}, nil
 }
+
+// updateAccountTransaction applies the request to the transaction, records the audit and recomputes the balances of the account
+func updateAccountTransaction(ctx context.Context, qtx *dbgen.Queries, accountTransaction dbgen.GetAccountTransactionByIDRow, req adminAccountTransactionModel.UpdateParsedRequest, updaterID int64) (dbgen.UpdateAccountTransactionEntryParams, error) {
+	if err := checkManuallyChangeable(accountTransaction); err != nil {
+		return dbgen.UpdateAccountTransactionEntryParams{}, err
+	}
+
+	before, err := ledger.SnapshotOf(accountTransaction)
+	if err != nil {
+		return dbgen.UpdateAccountTransactionEntryParams{}, err
+	}
+
+	params := dbgen.UpdateAccountTransactionEntryParams{
+		ID:              accountTransaction.ID,
+		TransactionDate: accountTransaction.TransactionDate,
+		Type:            accountTransaction.Type,
+		Amount:          accountTransaction.Amount,
+		Note:            accountTransaction.Note,
+	}
+	after := *before
+
+	if req.TransactionDate != nil {
+		params.TransactionDate = utils.TimePtrToPgDate(req.TransactionDate)
+		after.TransactionDate = req.TransactionDate.Format("2006-01-02")
+	}
+	if req.Type != nil {
+		params.Type = *req.Type
+		after.Type = *req.Type
+	}
+	if req.Amount != nil {
+		amountNumeric, err := utils.Int64PtrToPgNumeric(req.Amount)
+		if err != nil {
+			return dbgen.UpdateAccountTransactionEntryParams{}, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+		}
+		params.Amount = amountNumeric
+		after.Amount = *req.Amount
+	}
+	if req.Note != nil {
+		params.Note = utils.StringPtrToPgText(req.Note, true)
+		after.Note = *req.Note
+	}
+
+	if err := qtx.UpdateAccountTransactionEntry(ctx, params); err != nil {
+		return dbgen.UpdateAccountTransactionEntryParams{}, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account transaction", err)
+	}
+
+	if err := ledger.CreateAudit(ctx, qtx, ledger.CreateAuditParams{
+		AccountID:     accountTransaction.AccountID,
+		TransactionID: accountTransaction.ID,
+		Action:        common.AccountTransactionAuditActionUpdate,
+		Before:        before,
+		After:         &after,
+		StaffID:       updaterID,
+	}); err != nil {
+		return dbgen.UpdateAccountTransactionEntryParams{}, err
+	}
+
+	// only the note changes won't affect the balances
+	if req.TransactionDate != nil || req.Type != nil || req.Amount != nil {
+		if err := ledger.RecomputeBalances(ctx, qtx, accountTransaction.AccountID); err != nil {
+			return dbgen.UpdateAccountTransactionEntryParams{}, err
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,55 @@
+package adminAccountTransaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountTransactionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+// postedTransaction returns a transaction posted from the given source document
+func postedTransaction(sourceType string) dbgen.GetAccountTransactionByIDRow {
+	return dbgen.GetAccountTransactionByIDRow{
+		ID:         1,
+		AccountID:  2,
+		Type:       common.AccountTransactionTypeIncome,
+		SourceType: pgtype.Text{String: sourceType, Valid: true},
+		SourceID:   pgtype.Int8{Int64: 3, Valid: true},
+	}
+}
+
+var postedSourceTypes = []string{
+	common.AccountTransactionSourceCheckout,
+	common.AccountTransactionSourceCheckoutRefund,
+	common.AccountTransactionSourceExpense,
+	common.AccountTransactionSourceCashDrawerClose,
+	common.AccountTransactionSourceWalletTopUp,
+}
+
+func TestUpdateAccountTransactionRejectsPostedBySource(t *testing.T) {
+	note := "修正備註"
+	req := adminAccountTransactionModel.UpdateParsedRequest{Note: &note}
+
+	// rejected before any query, so no transaction queries are needed
+	for _, sourceType := range postedSourceTypes {
+		_, err := updateAccountTransaction(context.Background(), nil, postedTransaction(sourceType), req, 4)
+		code, ok := errorCodes.IsServiceError(err)
+		assert.True(t, ok, sourceType)
+		assert.Equal(t, errorCodes.AccountTransactionPostedBySource, code, sourceType)
+	}
+}
+
+func TestCheckManuallyChangeable(t *testing.T) {
+	assert.NoError(t, checkManuallyChangeable(dbgen.GetAccountTransactionByIDRow{ID: 1, AccountID: 2}), "manual")
+	assert.NoError(t, checkManuallyChangeable(postedTransaction(common.AccountTransactionSourceTransfer)), "transfer")
+
+	for _, sourceType := range postedSourceTypes {
+		assert.Error(t, checkManuallyChangeable(postedTransaction(sourceType)), sourceType)
+	}
+}
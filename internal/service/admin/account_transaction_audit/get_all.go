@@ -0,0 +1,98 @@
+package adminAccountTransactionAudit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountTransactionAuditModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction_audit"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID, accountID int64, req adminAccountTransactionAuditModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionAuditModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	account, err := s.queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	total, items, err := s.repo.AccountTransactionAudit.GetAllAccountTransactionAuditsByFilter(ctx, accountID, sqlxRepo.GetAllAccountTransactionAuditsByFilterParams{
+		AccountTransactionID: req.TransactionID,
+		Action:               req.Action,
+		Limit:                &req.Limit,
+		Offset:               &req.Offset,
+		Sort:                 &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction audits", err)
+	}
+
+	responseItems := make([]adminAccountTransactionAuditModel.GetAllItem, len(items))
+	for i, item := range items {
+		beforeData, err := unmarshalContent(item.BeforeData)
+		if err != nil {
+			return nil, err
+		}
+		afterData, err := unmarshalContent(item.AfterData)
+		if err != nil {
+			return nil, err
+		}
+
+		responseItems[i] = adminAccountTransactionAuditModel.GetAllItem{
+			ID:            utils.FormatID(item.ID),
+			TransactionID: utils.FormatID(item.AccountTransactionID),
+			Action:        item.Action,
+			BeforeData:    beforeData,
+			AfterData:     afterData,
+			CreatedBy: adminAccountTransactionAuditModel.CreatedBy{
+				ID:   utils.FormatID(item.CreatedBy),
+				Name: item.CreatedByName,
+			},
+			CreatedAt: utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminAccountTransactionAuditModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
+
+func unmarshalContent(data []byte) (*adminAccountTransactionAuditModel.TransactionContent, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var content adminAccountTransactionAuditModel.TransactionContent
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to unmarshal audit data", err)
+	}
+
+	return &content, nil
+}
@@ -0,0 +1,11 @@
+package adminAccountTransactionAudit
+
+import (
+	"context"
+
+	adminAccountTransactionAuditModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transaction_audit"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID, accountID int64, req adminAccountTransactionAuditModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminAccountTransactionAuditModel.GetAllResponse, error)
+}
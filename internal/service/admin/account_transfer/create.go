@@ -0,0 +1,134 @@
+package adminAccountTransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminAccountTransferModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transfer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool) CreateInterface {
+	return &Create{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, storeID int64, req adminAccountTransferModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountTransferModel.CreateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if req.FromAccountID == req.ToAccountID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountTransferSameAccount)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// lock both accounts in id order, so concurrent transfers in opposite directions won't deadlock
+	accountIDs := []int64{req.FromAccountID, req.ToAccountID}
+	if err := ledger.LockAccounts(ctx, qtx, accountIDs); err != nil {
+		return nil, err
+	}
+	accountNames := make(map[int64]string, len(accountIDs))
+	for _, accountID := range accountIDs {
+		account, err := qtx.GetAccountByID(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+		}
+		if account.StoreID != storeID {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+		}
+		accountNames[accountID] = account.Name
+	}
+
+	amountNumeric, err := utils.Int64PtrToPgNumeric(&req.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	transferID := utils.GenerateID()
+	if err := qtx.CreateAccountTransfer(ctx, dbgen.CreateAccountTransferParams{
+		ID:              transferID,
+		StoreID:         storeID,
+		FromAccountID:   req.FromAccountID,
+		ToAccountID:     req.ToAccountID,
+		TransactionDate: utils.TimePtrToPgDate(&req.TransactionDate),
+		Amount:          amountNumeric,
+		Note:            utils.StringPtrToPgText(req.Note, true),
+		CreatedBy:       creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account transfer", err)
+	}
+
+	sourceType := common.AccountTransactionSourceTransfer
+
+	expenseNote := fmt.Sprintf("轉出至 %s", accountNames[req.ToAccountID])
+	if req.Note != nil && *req.Note != "" {
+		expenseNote = *req.Note
+	}
+	expenseTransactionID, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+		StoreID:         storeID,
+		AccountID:       req.FromAccountID,
+		TransactionDate: req.TransactionDate,
+		Type:            common.AccountTransactionTypeExpense,
+		Amount:          req.Amount,
+		Note:            &expenseNote,
+		SourceType:      &sourceType,
+		SourceID:        &transferID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	incomeNote := fmt.Sprintf("由 %s 轉入", accountNames[req.FromAccountID])
+	if req.Note != nil && *req.Note != "" {
+		incomeNote = *req.Note
+	}
+	incomeTransactionID, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+		StoreID:         storeID,
+		AccountID:       req.ToAccountID,
+		TransactionDate: req.TransactionDate,
+		Type:            common.AccountTransactionTypeIncome,
+		Amount:          req.Amount,
+		Note:            &incomeNote,
+		SourceType:      &sourceType,
+		SourceID:        &transferID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminAccountTransferModel.CreateResponse{
+		ID:                   utils.FormatID(transferID),
+		ExpenseTransactionID: utils.FormatID(expenseTransactionID),
+		IncomeTransactionID:  utils.FormatID(incomeTransactionID),
+	}, nil
+}
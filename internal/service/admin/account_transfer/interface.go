@@ -0,0 +1,11 @@
+package adminAccountTransfer
+
+import (
+	"context"
+
+	adminAccountTransferModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/account_transfer"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, storeID int64, req adminAccountTransferModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminAccountTransferModel.CreateResponse, error)
+}
@@ -0,0 +1,54 @@
+package adminBirthdayBenefitSetting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBirthdayBenefitSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/birthday_benefit_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context) (*adminBirthdayBenefitSettingModel.GetResponse, error) {
+	setting, err := s.queries.GetBirthdayBenefitSetting(ctx)
+	if err != nil {
+		// the birthday benefit is disabled until the setting is saved
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &adminBirthdayBenefitSettingModel.GetResponse{
+				IsEnabled:       false,
+				CouponValidDays: 30,
+				IssuePeriod:     common.BirthdayBenefitIssuePeriodMonth,
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get birthday benefit setting", err)
+	}
+
+	var couponID *string
+	if setting.CouponID.Valid {
+		id := utils.FormatID(setting.CouponID.Int64)
+		couponID = &id
+	}
+
+	return &adminBirthdayBenefitSettingModel.GetResponse{
+		IsEnabled:       setting.IsEnabled,
+		CouponID:        couponID,
+		CouponValidDays: setting.CouponValidDays,
+		IssuePeriod:     setting.IssuePeriod,
+		LineMessage:     utils.PgTextToString(setting.LineMessage),
+		UpdatedAt:       utils.PgTimestamptzToTimeString(setting.UpdatedAt),
+	}, nil
+}
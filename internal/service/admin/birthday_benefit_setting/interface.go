@@ -0,0 +1,15 @@
+package adminBirthdayBenefitSetting
+
+import (
+	"context"
+
+	adminBirthdayBenefitSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/birthday_benefit_setting"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context) (*adminBirthdayBenefitSettingModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, req adminBirthdayBenefitSettingModel.UpdateParsedRequest) (*adminBirthdayBenefitSettingModel.UpdateResponse, error)
+}
@@ -0,0 +1,51 @@
+package adminBirthdayBenefitSetting
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBirthdayBenefitSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/birthday_benefit_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, req adminBirthdayBenefitSettingModel.UpdateParsedRequest) (*adminBirthdayBenefitSettingModel.UpdateResponse, error) {
+	if req.IsEnabled && req.CouponID == nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BirthdayBenefitCouponRequired)
+	}
+
+	if req.CouponID != nil {
+		exists, err := s.queries.CheckCouponExists(ctx, *req.CouponID)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		}
+		if !exists {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+		}
+	}
+
+	// the setting applies to the next job run, issued benefits are not changed
+	if err := s.queries.UpsertBirthdayBenefitSetting(ctx, dbgen.UpsertBirthdayBenefitSettingParams{
+		IsEnabled:       req.IsEnabled,
+		CouponID:        utils.Int64PtrToPgInt8(req.CouponID),
+		CouponValidDays: req.CouponValidDays,
+		IssuePeriod:     req.IssuePeriod,
+		LineMessage:     utils.StringPtrToPgText(req.LineMessage, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update birthday benefit setting", err)
+	}
+
+	return &adminBirthdayBenefitSettingModel.UpdateResponse{
+		IsEnabled: req.IsEnabled,
+	}, nil
+}
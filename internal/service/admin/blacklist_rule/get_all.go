@@ -0,0 +1,44 @@
+package adminBlacklistRule
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBlacklistRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/blacklist_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context) (*adminBlacklistRuleModel.GetAllResponse, error) {
+	rules, err := s.queries.GetAllBlacklistRules(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get blacklist rules", err)
+	}
+
+	items := make([]adminBlacklistRuleModel.GetAllItem, len(rules))
+	for i, rule := range rules {
+		items[i] = adminBlacklistRuleModel.GetAllItem{
+			Type:            rule.Type,
+			IsActive:        rule.IsActive,
+			ThresholdCount:  rule.ThresholdCount,
+			PeriodMonths:    rule.PeriodMonths,
+			LateCancelHours: utils.PgInt4ToInt32Ptr(rule.LateCancelHours),
+			Action:          rule.Action,
+			UpdatedAt:       utils.PgTimestamptzToTimeString(rule.UpdatedAt),
+		}
+	}
+
+	return &adminBlacklistRuleModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminBlacklistRule
+
+import (
+	"context"
+
+	adminBlacklistRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/blacklist_rule"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context) (*adminBlacklistRuleModel.GetAllResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, req adminBlacklistRuleModel.UpdateRequest) (*adminBlacklistRuleModel.UpdateResponse, error)
+}
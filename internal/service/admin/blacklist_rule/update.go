@@ -0,0 +1,49 @@
+package adminBlacklistRule
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminBlacklistRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/blacklist_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, req adminBlacklistRuleModel.UpdateRequest) (*adminBlacklistRuleModel.UpdateResponse, error) {
+	// a cancellation is late when it is within the hours before the booking, no-shows have no such limit
+	lateCancelHours := req.LateCancelHours
+	if req.Type == common.BlacklistRuleTypeLateCancel {
+		if lateCancelHours == nil {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BlacklistRuleLateCancelHoursRequired)
+		}
+	} else {
+		lateCancelHours = nil
+	}
+
+	// the rule applies to the next evaluation, customers already flagged keep their flags
+	if err := s.queries.UpsertBlacklistRule(ctx, dbgen.UpsertBlacklistRuleParams{
+		Type:            req.Type,
+		IsActive:        *req.IsActive,
+		ThresholdCount:  req.ThresholdCount,
+		PeriodMonths:    req.PeriodMonths,
+		LateCancelHours: utils.Int32PtrToPgInt4(lateCancelHours),
+		Action:          req.Action,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update blacklist rule", err)
+	}
+
+	return &adminBlacklistRuleModel.UpdateResponse{
+		Type: req.Type,
+	}, nil
+}
@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jmoiron/sqlx"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminBookingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/booking"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/blacklist"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
@@ -21,20 +23,26 @@ type Cancel struct {
 	queries     *dbgen.Queries
 	db          *sqlx.DB
 	repo        *sqlxRepo.Repositories
+	pgxPool     *pgxpool.Pool
 	activityLog cache.ActivityLogCacheInterface
+	authCache   cache.AuthCacheInterface
 }
 
 func NewCancel(
 	queries *dbgen.Queries,
 	db *sqlx.DB,
 	repo *sqlxRepo.Repositories,
+	pgxPool *pgxpool.Pool,
 	activityLog cache.ActivityLogCacheInterface,
+	authCache cache.AuthCacheInterface,
 ) CancelInterface {
 	return &Cancel{
 		queries:     queries,
 		db:          db,
 		repo:        repo,
+		pgxPool:     pgxPool,
 		activityLog: activityLog,
+		authCache:   authCache,
 	}
 }
 
@@ -80,6 +88,23 @@ func (s *Cancel) Cancel(ctx context.Context, storeID, bookingID int64, req admin
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
 	}
 
+	// only a no-show is a customer incident, a cancellation by staff never reaches the blacklist rules
+	if req.Status == common.BookingStatusNoShow {
+		loc, err := time.LoadLocation("Asia/Taipei")
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+		}
+		escalated, err := blacklist.EscalateInNewTx(ctx, s.pgxPool, booking.CustomerID, time.Now().In(loc))
+		if err != nil {
+			return nil, err
+		}
+		if len(escalated) > 0 {
+			if cacheErr := s.authCache.DeleteCustomerContext(ctx, booking.CustomerID); cacheErr != nil {
+				log.Println("failed to delete customer context from cache", cacheErr)
+			}
+		}
+	}
+
 	// Log activity
 	go func() {
 		logCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -15,6 +15,7 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/health"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -120,6 +121,17 @@ func (s *Create) Create(ctx context.Context, storeID int64, req adminBookingMode
 		}
 	}
 
+	// warn the staff when the services contain allergens the customer is allergic to, the booking is still created
+	healthFlags, err := health.GetCustomerFlags(ctx, s.queries, req.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customer health questionnaire", err)
+	}
+	serviceIDs := append([]int64{req.MainServiceID}, req.SubServiceIDs...)
+	healthWarnings, err := health.GetAllergyWarnings(ctx, s.queries, healthFlags, serviceIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get service allergens", err)
+	}
+
 	bookingId := utils.GenerateID()
 	bookingDetails, err := s.parseBookingDetails(bookingId, services)
 	if err != nil {
@@ -173,7 +185,8 @@ func (s *Create) Create(ctx context.Context, storeID int64, req adminBookingMode
 
 	// Build response
 	response := &adminBookingModel.CreateResponse{
-		ID: utils.FormatID(booking.ID),
+		ID:             utils.FormatID(booking.ID),
+		HealthWarnings: healthWarnings,
 	}
 
 	// Log activity
@@ -9,6 +9,7 @@ import (
 	adminBookingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/booking"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/health"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -67,33 +68,80 @@ func (s *Get) Get(ctx context.Context, storeID, bookingID int64, role string, st
 		Checkout:           nil, // default is nil
 	}
 
+	// customer tags and notes for the stylist, pinned notes and the notes of this booking
+	customerTags, err := s.queries.GetCustomerTagsByID(ctx, booking.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customer tags", err)
+	}
+	response.Customer.Tags = customerTags
+
+	customerNotes, err := s.queries.GetCustomerNotesForBooking(ctx, dbgen.GetCustomerNotesForBookingParams{
+		CustomerID: booking.CustomerID,
+		BookingID:  bookingID,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customer notes", err)
+	}
+
+	response.Customer.Notes = make([]adminBookingModel.GetCustomerNote, len(customerNotes))
+	for i, note := range customerNotes {
+		response.Customer.Notes[i] = adminBookingModel.GetCustomerNote{
+			ID:                utils.FormatID(note.ID),
+			BookingID:         utils.PgInt8ToIDString(note.BookingID),
+			Content:           note.Content,
+			IsPinned:          note.IsPinned,
+			CreatedByUsername: utils.PgTextToString(note.CreatedByUsername),
+			CreatedAt:         utils.PgTimestamptzToTimeString(note.CreatedAt),
+		}
+	}
+
+	// the latest health questionnaire flags of the customer
+	healthFlags, err := health.GetCustomerFlags(ctx, s.queries, booking.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customer health questionnaire", err)
+	}
+	response.Customer.HealthFlags = healthFlags
+
 	bookingDetails, err := s.queries.GetBookingDetailsByBookingID(ctx, bookingID)
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get booking details", err)
 	}
 
 	response.BookingDetails = make([]adminBookingModel.GetBookingDetailItem, len(bookingDetails))
+	serviceIDs := make([]int64, len(bookingDetails))
 	for i, detail := range bookingDetails {
+		serviceIDs[i] = detail.ServiceID
+
 		rawPrice, err := utils.PgNumericToFloat64(detail.Price)
 		if err != nil {
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert price to float64", err)
 		}
 		price := rawPrice
 
+		// promotion discount is applied before the coupon discount
+		if detail.PromotionDiscountAmount.Valid {
+			promotionDiscountAmount, err := utils.PgNumericToFloat64(detail.PromotionDiscountAmount)
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert promotion discount amount to float64", err)
+			}
+
+			price = rawPrice - promotionDiscountAmount
+		}
+
 		if detail.DiscountRate.Valid {
 			discountRate, err := utils.PgNumericToFloat64(detail.DiscountRate)
 			if err != nil {
 				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
 			}
 
-			price = rawPrice * discountRate
+			price = price * discountRate
 		} else if detail.DiscountAmount.Valid {
 			discountAmount, err := utils.PgNumericToFloat64(detail.DiscountAmount)
 			if err != nil {
 				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to float64", err)
 			}
 
-			price = rawPrice - discountAmount
+			price = price - discountAmount
 		}
 
 		response.BookingDetails[i] = adminBookingModel.GetBookingDetailItem{
@@ -108,6 +156,11 @@ func (s *Get) Get(ctx context.Context, storeID, bookingID int64, role string, st
 		}
 	}
 
+	response.HealthWarnings, err = health.GetAllergyWarnings(ctx, s.queries, healthFlags, serviceIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get service allergens", err)
+	}
+
 	if booking.Status == common.BookingStatusCompleted {
 		checkout, err := s.queries.GetCheckoutByBookingID(ctx, bookingID)
 		if err != nil {
@@ -126,15 +179,25 @@ func (s *Get) Get(ctx context.Context, storeID, bookingID int64, role string, st
 		if err != nil {
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert paid amount to int64", err)
 		}
+		pointsDiscountAmount, err := utils.PgNumericToInt64(checkout.PointsDiscountAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert points discount amount to int64", err)
+		}
 
 		response.Checkout = &adminBookingModel.GetCheckout{
-			ID:            utils.FormatID(checkout.ID),
-			PaymentMethod: checkout.PaymentMethod,
-			TotalAmount:   totalAmount,
-			FinalAmount:   finalAmount,
-			PaidAmount:    paidAmount,
-			CheckoutUser:  utils.PgTextToString(checkout.CheckoutUser),
-			Coupon:        nil, // default is nil
+			ID:                   utils.FormatID(checkout.ID),
+			PaymentMethod:        checkout.PaymentMethod,
+			TotalAmount:          totalAmount,
+			FinalAmount:          finalAmount,
+			PaidAmount:           paidAmount,
+			CheckoutUser:         utils.PgTextToString(checkout.CheckoutUser),
+			Coupon:               nil, // default is nil
+			PointsRedeemed:       checkout.PointsRedeemed,
+			PointsDiscountAmount: pointsDiscountAmount,
+			RefundedAt:           utils.PgTimestamptzToTimeString(checkout.RefundedAt),
+			RefundReason:         utils.PgTextToString(checkout.RefundReason),
+			InvoiceNumber:        utils.PgTextToString(checkout.InvoiceNumber),
+			InvoiceStatus:        utils.PgTextToString(checkout.InvoiceStatus),
 		}
 
 		if checkout.CouponID.Valid {
@@ -2,11 +2,13 @@ package adminBooking
 
 import (
 	"context"
+	"slices"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminBookingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/booking"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/health"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -65,6 +67,18 @@ func (s *GetAll) GetAll(ctx context.Context, storeID int64, req adminBookingMode
 		detailsByBookingID[detail.BookingID] = append(detailsByBookingID[detail.BookingID], detail)
 	}
 
+	// the latest health questionnaire flags of the customers, for the stylist to check before the service
+	customerIDs := make([]int64, 0, len(bookings))
+	for _, booking := range bookings {
+		if !slices.Contains(customerIDs, booking.CustomerID) {
+			customerIDs = append(customerIDs, booking.CustomerID)
+		}
+	}
+	healthFlags, err := health.GetCustomersFlags(ctx, s.queries, customerIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customer health questionnaires", err)
+	}
+
 	// Build response items by assembling data in service layer
 	items := make([]adminBookingModel.GetAllItem, len(bookings))
 	for i, booking := range bookings {
@@ -99,9 +113,10 @@ func (s *GetAll) GetAll(ctx context.Context, storeID int64, req adminBookingMode
 		item := adminBookingModel.GetAllItem{
 			ID: utils.FormatID(booking.ID),
 			Customer: adminBookingModel.GetAllCustomer{
-				ID:       utils.FormatID(booking.CustomerID),
-				Name:     booking.CustomerName,
-				LineName: booking.CustomerLineName,
+				ID:          utils.FormatID(booking.CustomerID),
+				Name:        booking.CustomerName,
+				LineName:    booking.CustomerLineName,
+				HealthFlags: healthFlags[booking.CustomerID],
 			},
 			Stylist: adminBookingModel.GetAllStylist{
 				ID:   utils.FormatID(booking.StylistID),
@@ -0,0 +1,214 @@
+package adminCashDrawerClose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCashDrawerCloseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/cash_drawer_close"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool) CreateInterface {
+	return &Create{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, storeID int64, req adminCashDrawerCloseModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminCashDrawerCloseModel.CreateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	// close date can not be in the future (Asia/Taipei)
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+	if req.CloseDate.Format("2006-01-02") > today {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CashDrawerCloseDateInFuture)
+	}
+
+	closeDatePg := utils.TimePtrToPgDate(&req.CloseDate)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// checkouts and cash refunds of the day hold the same lock, so none of them can commit unseen by this close
+	if err := qtx.LockCashDrawerByDate(ctx, dbgen.LockCashDrawerByDateParams{
+		StoreID:   storeID,
+		CloseDate: closeDatePg,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to lock cash drawer", err)
+	}
+
+	exists, err := qtx.CheckCashDrawerCloseExists(ctx, dbgen.CheckCashDrawerCloseExistsParams{
+		StoreID:   storeID,
+		CloseDate: closeDatePg,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check cash drawer close exists", err)
+	}
+	if exists {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CashDrawerCloseAlreadyExists)
+	}
+
+	// the account is recorded on the close even when nothing is posted, so it must be an active account of the store
+	account, err := qtx.GetAccountByID(ctx, req.AccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+	if !utils.PgBoolToBool(account.IsActive) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotActive)
+	}
+
+	summary, err := qtx.GetStoreCashCheckoutSummaryByDate(ctx, dbgen.GetStoreCashCheckoutSummaryByDateParams{
+		StoreID: storeID,
+		Column2: closeDatePg,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store cash checkout summary", err)
+	}
+
+	expectedCash, err := utils.PgNumericToInt64(summary.CashAmount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert cash amount to int64", err)
+	}
+
+	// cash wallet top-ups are also kept in the drawer
+	walletTopUpCash, err := qtx.GetStoreCashWalletTopUpAmountByDate(ctx, dbgen.GetStoreCashWalletTopUpAmountByDateParams{
+		StoreID: utils.Int64PtrToPgInt8(&storeID),
+		Column2: closeDatePg,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store cash wallet top-up amount", err)
+	}
+	walletTopUpCashAmount, err := utils.PgNumericToInt64(walletTopUpCash)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert wallet top-up cash amount to int64", err)
+	}
+	expectedCash += walletTopUpCashAmount
+	difference := req.CountedCash - expectedCash
+
+	// when CASH checkouts and top-ups are already posted automatically, only the difference needs to be posted
+	cashPostedByCheckout := true
+	cashPaymentMethod := common.PaymentMethodCash
+	_, err = qtx.GetStorePaymentMethodAccountID(ctx, dbgen.GetStorePaymentMethodAccountIDParams{
+		StoreID:       storeID,
+		PaymentMethod: utils.StringPtrToPgText(&cashPaymentMethod, false),
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store payment method account", err)
+		}
+		cashPostedByCheckout = false
+	}
+
+	postType := common.AccountTransactionTypeIncome
+	postAmount := req.CountedCash
+	if cashPostedByCheckout {
+		postAmount = difference
+		if difference < 0 {
+			postType = common.AccountTransactionTypeExpense
+			postAmount = -difference
+		}
+	}
+
+	// post the net cash into the chosen account
+	closeID := utils.GenerateID()
+	accountTransactionID := pgtype.Int8{Valid: false}
+	if postAmount > 0 {
+		note := fmt.Sprintf("%s 現金關帳", req.CloseDate.Format("2006-01-02"))
+		sourceType := common.AccountTransactionSourceCashDrawerClose
+		transactionID, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+			StoreID:         storeID,
+			AccountID:       req.AccountID,
+			TransactionDate: req.CloseDate,
+			Type:            postType,
+			Amount:          postAmount,
+			Note:            &note,
+			SourceType:      &sourceType,
+			SourceID:        &closeID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		accountTransactionID = utils.Int64PtrToPgInt8(&transactionID)
+	}
+
+	expectedCashPg, err := utils.Int64PtrToPgNumeric(&expectedCash)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert expected cash", err)
+	}
+	countedCashPg, err := utils.Int64PtrToPgNumeric(&req.CountedCash)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert counted cash", err)
+	}
+	differencePg, err := utils.Int64PtrToPgNumeric(&difference)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert difference", err)
+	}
+
+	_, err = qtx.CreateCashDrawerClose(ctx, dbgen.CreateCashDrawerCloseParams{
+		ID:                   closeID,
+		StoreID:              storeID,
+		CloseDate:            closeDatePg,
+		CheckoutCount:        int32(summary.CheckoutCount),
+		ExpectedCash:         expectedCashPg,
+		CountedCash:          countedCashPg,
+		Difference:           differencePg,
+		AccountID:            req.AccountID,
+		AccountTransactionID: accountTransactionID,
+		Note:                 utils.StringPtrToPgText(req.Note, true),
+		ClosedBy:             creatorID,
+	})
+	if err != nil {
+		// unique_violation of (store_id, close_date)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CashDrawerCloseAlreadyExists)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create cash drawer close", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCashDrawerCloseModel.CreateResponse{
+		ID:            utils.FormatID(closeID),
+		CloseDate:     utils.PgDateToDateString(closeDatePg),
+		CheckoutCount: int32(summary.CheckoutCount),
+		ExpectedCash:  expectedCash,
+		CountedCash:   req.CountedCash,
+		Difference:    difference,
+	}, nil
+}
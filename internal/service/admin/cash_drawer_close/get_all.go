@@ -0,0 +1,83 @@
+package adminCashDrawerClose
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCashDrawerCloseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/cash_drawer_close"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID int64, req adminCashDrawerCloseModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminCashDrawerCloseModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	total, items, err := s.repo.CashDrawerClose.GetAllCashDrawerClosesByFilter(ctx, storeID, sqlxRepo.GetAllCashDrawerClosesByFilterParams{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Limit:     &req.Limit,
+		Offset:    &req.Offset,
+		Sort:      &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get cash drawer closes", err)
+	}
+
+	responseItems := make([]adminCashDrawerCloseModel.GetAllItem, len(items))
+	for i, item := range items {
+		expectedCash, err := utils.PgNumericToInt64(item.ExpectedCash)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert expected cash to int64", err)
+		}
+		countedCash, err := utils.PgNumericToInt64(item.CountedCash)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert counted cash to int64", err)
+		}
+		difference, err := utils.PgNumericToInt64(item.Difference)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert difference to int64", err)
+		}
+
+		accountTransactionID := ""
+		if item.AccountTransactionID.Valid {
+			accountTransactionID = utils.FormatID(item.AccountTransactionID.Int64)
+		}
+
+		responseItems[i] = adminCashDrawerCloseModel.GetAllItem{
+			ID:            utils.FormatID(item.ID),
+			CloseDate:     utils.PgDateToDateString(item.CloseDate),
+			CheckoutCount: item.CheckoutCount,
+			ExpectedCash:  expectedCash,
+			CountedCash:   countedCash,
+			Difference:    difference,
+			Account: adminCashDrawerCloseModel.GetAllAccount{
+				ID:   utils.FormatID(item.AccountID),
+				Name: item.AccountName,
+			},
+			AccountTransactionID: accountTransactionID,
+			Note:                 utils.PgTextToString(item.Note),
+			ClosedBy: adminCashDrawerCloseModel.GetAllStaff{
+				ID:   utils.FormatID(item.ClosedBy),
+				Name: item.ClosedByName,
+			},
+			CreatedAt: utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCashDrawerCloseModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminCashDrawerClose
+
+import (
+	"context"
+
+	adminCashDrawerCloseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/cash_drawer_close"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, storeID int64, req adminCashDrawerCloseModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminCashDrawerCloseModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID int64, req adminCashDrawerCloseModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminCashDrawerCloseModel.GetAllResponse, error)
+}
@@ -2,10 +2,13 @@ package adminCheckout
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"math"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -15,14 +18,25 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cashdrawer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/level"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/points"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/referral"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type CreateBulk struct {
-	queries     *dbgen.Queries
-	db          *pgxpool.Pool
-	repo        *sqlxRepo.Repositories
-	activityLog cache.ActivityLogCacheInterface
+	queries       *dbgen.Queries
+	db            *pgxpool.Pool
+	repo          *sqlxRepo.Repositories
+	activityLog   cache.ActivityLogCacheInterface
+	authCache     cache.AuthCacheInterface
+	invoiceIssuer invoice.IssuerInterface
 }
 
 type CouponInfo struct {
@@ -32,12 +46,14 @@ type CouponInfo struct {
 	ApplyCount     int64
 }
 
-func NewCreateBulk(queries *dbgen.Queries, repo *sqlxRepo.Repositories, db *pgxpool.Pool, activityLog cache.ActivityLogCacheInterface) CreateBulkInterface {
+func NewCreateBulk(queries *dbgen.Queries, repo *sqlxRepo.Repositories, db *pgxpool.Pool, activityLog cache.ActivityLogCacheInterface, authCache cache.AuthCacheInterface, invoiceIssuer invoice.IssuerInterface) CreateBulkInterface {
 	return &CreateBulk{
-		queries:     queries,
-		repo:        repo,
-		db:          db,
-		activityLog: activityLog,
+		queries:       queries,
+		repo:          repo,
+		db:            db,
+		activityLog:   activityLog,
+		authCache:     authCache,
+		invoiceIssuer: invoiceIssuer,
 	}
 }
 
@@ -57,6 +73,7 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 	}
 
 	bookingDetailMap := make(map[int64]dbgen.GetBookingDetailPriceInfoByBookingIDRow)
+	bookingPromotionMap := make(map[int64][]promotion.Promotion)
 	customerIDs := make([]int64, len(req.Checkouts))
 	applyCount := int64(0)
 	for i, checkout := range req.Checkouts {
@@ -89,6 +106,13 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 			bookingDetailMap[bookingDetailPriceInfo.ID] = bookingDetailPriceInfo
 		}
 
+		// get the store promotions applicable at the booking date and start time
+		promotions, err := promotion.GetApplicable(ctx, s.queries, storeID, booking.WorkDate, booking.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		bookingPromotionMap[checkout.BookingID] = promotions
+
 		customerIDs[i] = booking.CustomerID
 		applyCount += checkout.ApplyCount
 	}
@@ -103,58 +127,79 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 		}
 	}
 
-	// when customerCouponID is not nil, get coupon info
+	// when customerCouponID is not nil, check the coupon rules and get coupon info
 	couponInfo := CouponInfo{
 		ApplyCount: applyCount,
 	}
 	if req.CustomerCouponID != nil {
-		coupon, err := s.queries.GetCustomerCouponPriceInfoByID(ctx, *req.CustomerCouponID)
-		if err != nil {
-			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer coupon price info", err)
+		spendAmount := int64(0)
+		couponServices := []coupon.Service{}
+		for _, checkout := range req.Checkouts {
+			for _, detail := range checkout.Details {
+				spendAmount += detail.Price
+				if !detail.UseCoupon {
+					continue
+				}
+				bookingDetail, ok := bookingDetailMap[detail.ID]
+				if !ok {
+					return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BookingDetailNotFound)
+				}
+				couponServices = append(couponServices, coupon.Service{
+					ServiceID: bookingDetail.ServiceID,
+					IsAddon:   utils.PgBoolToBool(bookingDetail.IsAddon),
+				})
+			}
 		}
 
-		if coupon.CustomerID != customerID {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponNotBelongToCustomer)
-		}
-		if coupon.IsUsed.Bool {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponAlreadyUsed)
-		}
-		if !coupon.IsActive.Bool {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotActive)
-		}
-		if coupon.ValidTo.Valid && coupon.ValidTo.Time.Before(time.Now()) {
-			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponExpired)
+		checkResult, err := coupon.Check(ctx, s.queries, coupon.CheckParams{
+			CustomerCouponID: *req.CustomerCouponID,
+			CustomerID:       customerID,
+			SpendAmount:      spendAmount,
+			Services:         couponServices,
+			Now:              time.Now(),
+		})
+		if err != nil {
+			return nil, err
 		}
 
-		if coupon.DiscountRate.Valid {
-			discountRate, err := utils.PgNumericToFloat64(coupon.DiscountRate)
-			if err != nil {
-				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
-			}
-
-			couponInfo.DiscountRate = &discountRate
+		// check amount / apply count is not allow decimal
+		if checkResult.DiscountAmount != nil && int64(*checkResult.DiscountAmount)%applyCount != 0 {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponDiscountAmountNotDivisibleByApplyCount)
 		}
 
-		if coupon.DiscountAmount.Valid {
-			discountAmount, err := utils.PgNumericToFloat64(coupon.DiscountAmount)
-			if err != nil {
-				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to float64", err)
-			}
+		couponInfo.ID = checkResult.CouponID
+		couponInfo.DiscountRate = checkResult.DiscountRate
+		couponInfo.DiscountAmount = checkResult.DiscountAmount
+	}
 
-			// check amount / apply count is not allow decimal
-			if int64(discountAmount)%applyCount != 0 {
-				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponDiscountAmountNotDivisibleByApplyCount)
-			}
+	// get the store loyalty setting, points are not earned or redeemed when it is not enabled
+	loyaltySetting, err := points.GetEnabledStoreSetting(ctx, s.queries, storeID)
+	if err != nil {
+		return nil, err
+	}
 
-			couponInfo.DiscountAmount = &discountAmount
-		}
+	newCheckouts, needUpdateBookingDetailPriceInfos, bookingIDs, err := s.prepareCheckoutAndUpdateBookingDetailData(req.PaymentMethod, req.Checkouts, bookingDetailMap, bookingPromotionMap, staffContext.UserID, &couponInfo, loyaltySetting)
+	if err != nil {
+		return nil, err
+	}
 
-		couponInfo.ID = coupon.CouponID
+	// get the account mapped to the payment method, postings are skipped when not set
+	var ledgerAccountID *int64
+	accountID, err := s.queries.GetStorePaymentMethodAccountID(ctx, dbgen.GetStorePaymentMethodAccountIDParams{
+		StoreID:       storeID,
+		PaymentMethod: utils.StringPtrToPgText(&req.PaymentMethod, false),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store payment method account", err)
+	}
+	if err == nil {
+		ledgerAccountID = &accountID
 	}
 
-	newCheckouts, needUpdateBookingDetailPriceInfos, bookingIDs, err := s.prepareCheckoutAndUpdateBookingDetailData(req.PaymentMethod, req.Checkouts, bookingDetailMap, staffContext.UserID, &couponInfo)
+	// get customer default invoice carrier
+	customer, err := s.queries.GetCustomerByID(ctx, customerID)
 	if err != nil {
-		return nil, err
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
 	}
 
 	tx, err := s.db.Begin(ctx)
@@ -165,11 +210,39 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 
 	qtx := dbgen.New(tx)
 
+	// check today's cash drawer is not closed, the drawer lock is held until commit so a close can not miss this checkout
+	if err := cashdrawer.CheckNotClosed(ctx, qtx, storeID, errorCodes.CashDrawerClosedNotAllowCheckout); err != nil {
+		return nil, err
+	}
+
 	_, err = qtx.BulkCreateCheckout(ctx, newCheckouts)
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create checkout", err)
 	}
 
+	invoiceIDs, err := s.createPendingInvoices(ctx, qtx, storeID, customerID, customer.InvoiceCarrierType, customer.InvoiceCarrierValue, req.Checkouts, newCheckouts)
+	if err != nil {
+		return nil, err
+	}
+
+	// wallet payment deducts the customer wallet, the balance is checked under the wallet row lock
+	if req.PaymentMethod == common.PaymentMethodWallet {
+		if err := s.spendWallet(ctx, qtx, storeID, customerID, staffContext.UserID, req.Checkouts, newCheckouts); err != nil {
+			return nil, err
+		}
+	}
+
+	// redeemed points are deducted under the point row lock, then points are earned on the final amount
+	if err := s.postCheckoutPoints(ctx, qtx, storeID, customerID, staffContext.UserID, loyaltySetting, req.Checkouts, newCheckouts); err != nil {
+		return nil, err
+	}
+
+	if ledgerAccountID != nil {
+		if err := s.postCheckoutTransactions(ctx, qtx, storeID, *ledgerAccountID, req.PaymentMethod, req.Checkouts, newCheckouts); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, updateBookingDetailPriceInfo := range needUpdateBookingDetailPriceInfos {
 		err = qtx.UpdateBookingDetailPriceInfo(ctx, updateBookingDetailPriceInfo)
 		if err != nil {
@@ -185,10 +258,14 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update bookings status", err)
 	}
 
+	// redeem the coupon under the coupon row lock, the usage caps are checked again
 	if req.CustomerCouponID != nil {
-		err = qtx.UpdateCustomerCouponUsed(ctx, *req.CustomerCouponID)
-		if err != nil {
-			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer coupon used", err)
+		if err := coupon.Redeem(ctx, qtx, coupon.RedeemParams{
+			CustomerCouponID: *req.CustomerCouponID,
+			CustomerID:       customerID,
+			CouponID:         couponInfo.ID,
+		}); err != nil {
+			return nil, err
 		}
 	}
 
@@ -198,10 +275,37 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer last visit at", err)
 	}
 
+	// the visit ends the win back cycle, open contacts are counted as returned
+	if err := qtx.UpdateWinBackContactsReturned(ctx, customerID); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update win back contacts returned", err)
+	}
+
+	// promote customer level with the new checkouts counted
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	promoted, err := level.PromoteCustomer(ctx, qtx, customerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// reward the referral when this is the customer's first checkout
+	if err := referral.RewardFirstCheckout(ctx, qtx, customerID, newCheckouts[0].ID, now); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
 	}
 
+	if promoted != nil {
+		if cacheErr := s.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+			log.Println("failed to delete customer context from cache", cacheErr)
+		}
+	}
+
 	// Log activity
 	go func() {
 		logCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -215,6 +319,20 @@ func (s *CreateBulk) CreateBulk(ctx context.Context, storeID int64, req adminChe
 		}
 	}()
 
+	// Issue invoices
+	if len(invoiceIDs) > 0 {
+		go func() {
+			for _, invoiceID := range invoiceIDs {
+				issueCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				// invoice may already be marked to void by a refund, skip it
+				if err := s.invoiceIssuer.Issue(issueCtx, invoiceID); err != nil && !errors.Is(err, invoice.ErrStatusNotAllowed) {
+					log.Printf("failed to issue invoice %d: %v", invoiceID, err)
+				}
+				cancel()
+			}
+		}()
+	}
+
 	ids := make([]string, len(newCheckouts))
 	for i, newCheckout := range newCheckouts {
 		ids[i] = utils.FormatID(newCheckout.ID)
@@ -257,12 +375,162 @@ func CheckBookingNotInFuture(workDatePg pgtype.Date, startTime pgtype.Time) erro
 	return nil
 }
 
+// postCheckoutTransactions creates an INCOME account transaction for each checkout with paid amount
+func (s *CreateBulk) postCheckoutTransactions(ctx context.Context, qtx *dbgen.Queries, storeID, accountID int64, paymentMethod string, checkouts []adminCheckoutModel.CreateBulkParsedCheckoutItems, newCheckouts []dbgen.BulkCreateCheckoutParams) error {
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	transactionDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	sourceType := common.AccountTransactionSourceCheckout
+	note := fmt.Sprintf("結帳收入 (%s)", paymentMethod)
+	for i, checkout := range checkouts {
+		if checkout.PaidAmount <= 0 {
+			continue
+		}
+
+		checkoutID := newCheckouts[i].ID
+		_, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+			StoreID:         storeID,
+			AccountID:       accountID,
+			TransactionDate: transactionDate,
+			Type:            common.AccountTransactionTypeIncome,
+			Amount:          checkout.PaidAmount,
+			Note:            &note,
+			SourceType:      &sourceType,
+			SourceID:        &checkoutID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// spendWallet creates a SPEND wallet transaction for each checkout with paid amount
+func (s *CreateBulk) spendWallet(ctx context.Context, qtx *dbgen.Queries, storeID, customerID, staffID int64, checkouts []adminCheckoutModel.CreateBulkParsedCheckoutItems, newCheckouts []dbgen.BulkCreateCheckoutParams) error {
+	paymentMethod := common.PaymentMethodWallet
+	sourceType := common.CustomerWalletTransactionSourceCheckout
+	for i, checkout := range checkouts {
+		if checkout.PaidAmount <= 0 {
+			continue
+		}
+
+		checkoutID := newCheckouts[i].ID
+		_, err := wallet.PostTransaction(ctx, qtx, wallet.PostTransactionParams{
+			CustomerID:    customerID,
+			StoreID:       &storeID,
+			Type:          common.CustomerWalletTransactionTypeSpend,
+			Amount:        -checkout.PaidAmount,
+			PaymentMethod: &paymentMethod,
+			SourceType:    &sourceType,
+			SourceID:      &checkoutID,
+			CreatedBy:     &staffID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postCheckoutPoints creates a REDEEM point transaction for each checkout with redeemed points and an EARN point transaction for each checkout earning points
+func (s *CreateBulk) postCheckoutPoints(ctx context.Context, qtx *dbgen.Queries, storeID, customerID, staffID int64, loyaltySetting *dbgen.StoreLoyaltySetting, checkouts []adminCheckoutModel.CreateBulkParsedCheckoutItems, newCheckouts []dbgen.BulkCreateCheckoutParams) error {
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expiresAt := points.CalculateExpiresAt(loyaltySetting, today)
+
+	sourceType := common.CustomerPointTransactionSourceCheckout
+	for i, checkout := range checkouts {
+		checkoutID := newCheckouts[i].ID
+
+		if checkout.RedeemPoints > 0 {
+			_, err := points.PostTransaction(ctx, qtx, points.PostTransactionParams{
+				CustomerID: customerID,
+				StoreID:    &storeID,
+				Type:       common.CustomerPointTransactionTypeRedeem,
+				Points:     -checkout.RedeemPoints,
+				SourceType: &sourceType,
+				SourceID:   &checkoutID,
+				CreatedBy:  &staffID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		finalAmount, err := utils.PgNumericToInt64(newCheckouts[i].FinalAmount)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert final amount to int64", err)
+		}
+		earnPoints := points.CalculateEarnPoints(loyaltySetting, finalAmount)
+		if earnPoints <= 0 {
+			continue
+		}
+
+		_, err = points.PostTransaction(ctx, qtx, points.PostTransactionParams{
+			CustomerID: customerID,
+			StoreID:    &storeID,
+			Type:       common.CustomerPointTransactionTypeEarn,
+			Points:     earnPoints,
+			ExpiresAt:  expiresAt,
+			SourceType: &sourceType,
+			SourceID:   &checkoutID,
+			CreatedBy:  &staffID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createPendingInvoices creates a PENDING invoice for each checkout with paid amount, they are issued after commit
+func (s *CreateBulk) createPendingInvoices(ctx context.Context, qtx *dbgen.Queries, storeID, customerID int64, carrierType, carrierValue pgtype.Text, checkouts []adminCheckoutModel.CreateBulkParsedCheckoutItems, newCheckouts []dbgen.BulkCreateCheckoutParams) ([]int64, error) {
+	invoiceIDs := []int64{}
+	for i, checkout := range checkouts {
+		if checkout.PaidAmount <= 0 {
+			continue
+		}
+
+		invoiceID := utils.GenerateID()
+		err := qtx.CreateInvoice(ctx, dbgen.CreateInvoiceParams{
+			ID:           invoiceID,
+			StoreID:      storeID,
+			CheckoutID:   newCheckouts[i].ID,
+			CustomerID:   customerID,
+			Amount:       newCheckouts[i].PaidAmount,
+			CarrierType:  carrierType,
+			CarrierValue: carrierValue,
+			Status:       common.InvoiceStatusPending,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create invoice", err)
+		}
+
+		invoiceIDs = append(invoiceIDs, invoiceID)
+	}
+
+	return invoiceIDs, nil
+}
+
 func (s *CreateBulk) prepareCheckoutAndUpdateBookingDetailData(
 	paymentMethod string,
 	passedBookings []adminCheckoutModel.CreateBulkParsedCheckoutItems,
 	bookingDetailMap map[int64]dbgen.GetBookingDetailPriceInfoByBookingIDRow,
+	bookingPromotionMap map[int64][]promotion.Promotion,
 	creatorID int64,
 	couponInfo *CouponInfo,
+	loyaltySetting *dbgen.StoreLoyaltySetting,
 ) ([]dbgen.BulkCreateCheckoutParams, []dbgen.UpdateBookingDetailPriceInfoParams, []int64, error) {
 	newCheckouts := []dbgen.BulkCreateCheckoutParams{}
 	needUpdateBookingDetailPriceInfos := []dbgen.UpdateBookingDetailPriceInfoParams{}
@@ -272,7 +540,16 @@ func (s *CreateBulk) prepareCheckoutAndUpdateBookingDetailData(
 	nowPg := utils.TimePtrToPgTimestamptz(&now)
 
 	for i, booking := range passedBookings {
-		updateBookingDetailPriceInfos, totalAmount, finalAmount, err := s.prepareUpdateBookingDetail(booking.Details, bookingDetailMap, couponInfo)
+		var pointsDiscountAmount int64
+		if booking.RedeemPoints > 0 {
+			amount, err := points.CalculateRedeemAmount(loyaltySetting, booking.RedeemPoints)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			pointsDiscountAmount = amount
+		}
+
+		updateBookingDetailPriceInfos, totalAmount, finalAmount, err := s.prepareUpdateBookingDetail(booking.Details, bookingDetailMap, bookingPromotionMap[booking.BookingID], couponInfo, float64(pointsDiscountAmount))
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -289,18 +566,24 @@ func (s *CreateBulk) prepareCheckoutAndUpdateBookingDetailData(
 		if err != nil {
 			return nil, nil, nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert paid amount to pgtype.Numeric", err)
 		}
+		pointsDiscountAmountPg, err := utils.Int64PtrToPgNumeric(&pointsDiscountAmount)
+		if err != nil {
+			return nil, nil, nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert points discount amount to pgtype.Numeric", err)
+		}
 
 		newCheckouts = append(newCheckouts, dbgen.BulkCreateCheckoutParams{
-			ID:            utils.GenerateID(),
-			BookingID:     booking.BookingID,
-			TotalAmount:   totalAmountPg,
-			FinalAmount:   finalAmountPg,
-			PaidAmount:    paidAmountPg,
-			PaymentMethod: paymentMethod,
-			CouponID:      utils.Int64PtrToPgInt8(&couponInfo.ID),
-			CheckoutUser:  utils.Int64PtrToPgInt8(&creatorID),
-			CreatedAt:     nowPg,
-			UpdatedAt:     nowPg,
+			ID:                   utils.GenerateID(),
+			BookingID:            booking.BookingID,
+			TotalAmount:          totalAmountPg,
+			FinalAmount:          finalAmountPg,
+			PaidAmount:           paidAmountPg,
+			PaymentMethod:        paymentMethod,
+			CouponID:             utils.Int64PtrToPgInt8(&couponInfo.ID),
+			PointsRedeemed:       booking.RedeemPoints,
+			PointsDiscountAmount: pointsDiscountAmountPg,
+			CheckoutUser:         utils.Int64PtrToPgInt8(&creatorID),
+			CreatedAt:            nowPg,
+			UpdatedAt:            nowPg,
 		})
 
 		bookingIDs[i] = booking.BookingID
@@ -313,7 +596,9 @@ func (s *CreateBulk) prepareCheckoutAndUpdateBookingDetailData(
 func (s *CreateBulk) prepareUpdateBookingDetail(
 	passedBookingDetails []adminCheckoutModel.CreateBulkParsedDetailItems,
 	bookingDetailMap map[int64]dbgen.GetBookingDetailPriceInfoByBookingIDRow,
+	promotions []promotion.Promotion,
 	couponInfo *CouponInfo,
+	pointsDiscountAmount float64,
 ) ([]dbgen.UpdateBookingDetailPriceInfoParams, float64, float64, error) {
 	totalAmount := 0.0
 	finalAmount := 0.0
@@ -335,11 +620,30 @@ func (s *CreateBulk) prepareUpdateBookingDetail(
 
 		discountRatePg := pgtype.Numeric{Valid: false}
 		discountAmountPg := pgtype.Numeric{Valid: false}
+		promotionIDPg := pgtype.Int8{Valid: false}
+		promotionDiscountAmountPg := pgtype.Numeric{Valid: false}
+
+		// apply the store promotion with the largest discount first, the coupon is applied on the promoted price
+		useCoupon := couponInfo != nil && couponInfo.ID != 0 && bookingDetail.UseCoupon
+		applied := promotion.Apply(promotions, coupon.Service{
+			ServiceID: rawBookingDetail.ServiceID,
+			IsAddon:   utils.PgBoolToBool(rawBookingDetail.IsAddon),
+		}, originalPrice, useCoupon)
+		if applied != nil {
+			discountedPrice = applied.Price
+
+			amountPg, err := utils.Float64PtrToPgNumeric(&applied.DiscountAmount)
+			if err != nil {
+				return nil, totalAmount, finalAmount, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert promotion discount amount to pgtype.Numeric", err)
+			}
+			promotionIDPg = utils.Int64PtrToPgInt8(&applied.PromotionID)
+			promotionDiscountAmountPg = amountPg
+		}
 
 		// if coupon info exists and booking detail use coupon, apply coupon
 		if couponInfo != nil && bookingDetail.UseCoupon {
 			if couponInfo.DiscountRate != nil {
-				discountedPrice = originalPrice * *couponInfo.DiscountRate
+				discountedPrice = discountedPrice * *couponInfo.DiscountRate
 
 				ratePg, err := utils.Float64PtrToPgNumeric(couponInfo.DiscountRate)
 				if err != nil {
@@ -349,7 +653,7 @@ func (s *CreateBulk) prepareUpdateBookingDetail(
 			} else if couponInfo.DiscountAmount != nil {
 				// when coupon is DiscountAmount, apply discount amount to each booking detail (need to divide by apply count)
 				discountAmount := *couponInfo.DiscountAmount / float64(couponInfo.ApplyCount)
-				discountedPrice = originalPrice - discountAmount
+				discountedPrice = discountedPrice - discountAmount
 
 				if discountedPrice < 0 {
 					discountedPrice = 0 // not allow negative price
@@ -376,18 +680,26 @@ func (s *CreateBulk) prepareUpdateBookingDetail(
 			return nil, totalAmount, finalAmount, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert original price to float64", err)
 		}
 
-		// if original price or discount rate or discount amount is changed, update booking detail price info
-		if rawOriginalPrice != originalPrice || discountRatePg.Valid || discountAmountPg.Valid {
+		// if original price or discount rate or discount amount or promotion is changed, update booking detail price info
+		if rawOriginalPrice != originalPrice || discountRatePg.Valid || discountAmountPg.Valid || promotionIDPg.Valid {
 			bookingDetailPriceInfo = append(bookingDetailPriceInfo, dbgen.UpdateBookingDetailPriceInfoParams{
-				ID:             bookingDetail.ID,
-				Price:          originalPricePg, // store passed original price
-				DiscountRate:   discountRatePg,
-				DiscountAmount: discountAmountPg,
+				ID:                      bookingDetail.ID,
+				Price:                   originalPricePg, // store passed original price
+				DiscountRate:            discountRatePg,
+				DiscountAmount:          discountAmountPg,
+				PromotionID:             promotionIDPg,
+				PromotionDiscountAmount: promotionDiscountAmountPg,
 			})
 		}
 	}
 
 	finalAmount = math.Round(finalAmount)
 
+	// redeemed points are a discount line of the checkout, it is applied after coupon and can not exceed the remaining amount
+	if pointsDiscountAmount > finalAmount {
+		return nil, totalAmount, finalAmount, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerPointRedeemExceedsAmount)
+	}
+	finalAmount -= pointsDiscountAmount
+
 	return bookingDetailPriceInfo, totalAmount, finalAmount, nil
 }
@@ -0,0 +1,276 @@
+package adminCheckout
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCheckoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+var receiptHtmlTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html lang="zh-Hant">
+<head>
+<meta charset="utf-8">
+<title>{{.StoreName}} 消費明細</title>
+<style>
+body { font-family: sans-serif; width: 280px; margin: 0 auto; font-size: 13px; }
+h1 { font-size: 18px; text-align: center; margin: 8px 0 4px; }
+p { margin: 2px 0; }
+.center { text-align: center; }
+.muted { color: #666666; }
+table { width: 100%; border-collapse: collapse; margin: 8px 0; }
+td { padding: 2px 0; vertical-align: top; }
+td.amount { text-align: right; white-space: nowrap; }
+del { color: #AAAAAA; }
+hr { border: none; border-top: 1px dashed #000000; }
+</style>
+</head>
+<body>
+<h1>{{.StoreName}}</h1>
+{{if .StoreAddress}}<p class="center muted">{{.StoreAddress}}</p>{{end}}
+{{if .StorePhone}}<p class="center muted">{{.StorePhone}}</p>{{end}}
+<hr>
+<p>顧客：{{.CustomerName}}</p>
+<p>美甲師：{{.StylistName}}</p>
+<p>預約時段：{{.Date}} {{.StartTime}} - {{.EndTime}}</p>
+<p>結帳時間：{{.CheckoutAt}}</p>
+<hr>
+<table>
+{{range .Items}}<tr><td>{{.ServiceName}}</td><td class="amount">{{if ne .RawPrice .Price}}<del>${{.RawPrice}}</del> {{end}}${{.Price}}</td></tr>
+{{end}}</table>
+<hr>
+<table>
+<tr><td>原價總額</td><td class="amount">${{.TotalAmount}}</td></tr>
+<tr><td>優惠券</td><td class="amount">{{if .CouponName}}{{.CouponName}}{{else}}無{{end}}</td></tr>
+{{if .PointsRedeemed}}<tr><td>點數折抵</td><td class="amount">-${{.PointsDiscountAmount}} ({{.PointsRedeemed}} 點)</td></tr>
+{{end}}<tr><td>應付金額</td><td class="amount">${{.FinalAmount}}</td></tr>
+<tr><td>實收金額</td><td class="amount">${{.PaidAmount}}</td></tr>
+<tr><td>付款方式</td><td class="amount">{{.PaymentMethod}}</td></tr>
+{{if .InvoiceNumber}}<tr><td>發票號碼</td><td class="amount">{{.InvoiceNumber}}</td></tr>
+{{end}}{{if .RefundedAt}}<tr><td>已退款</td><td class="amount">{{.RefundedAt}}</td></tr>
+{{end}}</table>
+<hr>
+<p class="center">謝謝光臨</p>
+</body>
+</html>
+`))
+
+type GetReceipt struct {
+	queries *dbgen.Queries
+}
+
+func NewGetReceipt(queries *dbgen.Queries) GetReceiptInterface {
+	return &GetReceipt{
+		queries: queries,
+	}
+}
+
+func (s *GetReceipt) GetReceipt(ctx context.Context, storeID, checkoutID int64, role string, storeIDs []int64) (*adminCheckoutModel.GetReceiptResponse, error) {
+	// check store access
+	if err := utils.CheckStoreAccess(storeID, storeIDs, role); err != nil {
+		return nil, err
+	}
+
+	receipt, _, err := buildReceiptData(ctx, s.queries, storeID, checkoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	var htmlBuffer bytes.Buffer
+	if err := receiptHtmlTemplate.Execute(&htmlBuffer, receipt); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to render receipt html", err)
+	}
+
+	return &adminCheckoutModel.GetReceiptResponse{
+		ID:   utils.FormatID(checkoutID),
+		Html: htmlBuffer.String(),
+		Text: renderReceiptText(receipt),
+	}, nil
+}
+
+// buildReceiptData collects the checkout, booking details and store info of a receipt, the customer line uid is also returned
+func buildReceiptData(ctx context.Context, queries *dbgen.Queries, storeID, checkoutID int64) (*utils.ReceiptData, string, error) {
+	checkout, err := queries.GetCheckoutReceiptByID(ctx, checkoutID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutNotFound)
+		}
+		return nil, "", errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get checkout", err)
+	}
+	if checkout.StoreID != storeID {
+		return nil, "", errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutNotBelongToStore)
+	}
+
+	bookingDetails, err := queries.GetBookingDetailsByBookingID(ctx, checkout.BookingID)
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get booking details", err)
+	}
+
+	items := make([]utils.ReceiptItem, len(bookingDetails))
+	for i, detail := range bookingDetails {
+		rawPrice, err := utils.PgNumericToFloat64(detail.Price)
+		if err != nil {
+			return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert price to float64", err)
+		}
+		price := rawPrice
+
+		// promotion discount is applied before the coupon discount
+		if detail.PromotionDiscountAmount.Valid {
+			promotionDiscountAmount, err := utils.PgNumericToFloat64(detail.PromotionDiscountAmount)
+			if err != nil {
+				return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert promotion discount amount to float64", err)
+			}
+
+			price = rawPrice - promotionDiscountAmount
+		}
+
+		if detail.DiscountRate.Valid {
+			discountRate, err := utils.PgNumericToFloat64(detail.DiscountRate)
+			if err != nil {
+				return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
+			}
+
+			price = price * discountRate
+		} else if detail.DiscountAmount.Valid {
+			discountAmount, err := utils.PgNumericToFloat64(detail.DiscountAmount)
+			if err != nil {
+				return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to float64", err)
+			}
+
+			price = price - discountAmount
+		}
+
+		items[i] = utils.ReceiptItem{
+			ServiceName: detail.ServiceName,
+			RawPrice:    int64(math.Round(rawPrice)),
+			Price:       int64(math.Round(price)),
+		}
+	}
+
+	totalAmount, err := utils.PgNumericToInt64(checkout.TotalAmount)
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert total amount to int64", err)
+	}
+	finalAmount, err := utils.PgNumericToInt64(checkout.FinalAmount)
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert final amount to int64", err)
+	}
+	paidAmount, err := utils.PgNumericToInt64(checkout.PaidAmount)
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert paid amount to int64", err)
+	}
+	pointsDiscountAmount, err := utils.PgNumericToInt64(checkout.PointsDiscountAmount)
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert points discount amount to int64", err)
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, "", errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+
+	receipt := &utils.ReceiptData{
+		StoreName:            checkout.StoreName,
+		StoreAddress:         utils.PgTextToString(checkout.StoreAddress),
+		StorePhone:           utils.PgTextToString(checkout.StorePhone),
+		CustomerName:         checkout.CustomerName,
+		StylistName:          utils.PgTextToString(checkout.StylistName),
+		Date:                 utils.PgDateToDateString(checkout.WorkDate),
+		StartTime:            utils.PgTimeToTimeString(checkout.StartTime),
+		EndTime:              utils.PgTimeToTimeString(checkout.EndTime),
+		CheckoutAt:           checkout.CreatedAt.Time.In(loc).Format("2006/01/02 15:04"),
+		Items:                items,
+		PointsRedeemed:       checkout.PointsRedeemed,
+		PointsDiscountAmount: pointsDiscountAmount,
+		TotalAmount:          totalAmount,
+		FinalAmount:          finalAmount,
+		PaidAmount:           paidAmount,
+		PaymentMethod:        paymentMethodText(checkout.PaymentMethod),
+		InvoiceNumber:        utils.PgTextToString(checkout.InvoiceNumber),
+	}
+	if checkout.CouponID.Valid {
+		receipt.CouponName = fmt.Sprintf("%s (%s)", utils.PgTextToString(checkout.CouponDisplayName), utils.PgTextToString(checkout.CouponCode))
+	}
+	if checkout.RefundedAt.Valid {
+		receipt.RefundedAt = checkout.RefundedAt.Time.In(loc).Format("2006/01/02 15:04")
+	}
+
+	return receipt, checkout.CustomerLineUid, nil
+}
+
+// renderReceiptText renders the receipt as plain text, it is suitable for thermal printers
+func renderReceiptText(receipt *utils.ReceiptData) string {
+	var sb strings.Builder
+	line := strings.Repeat("-", 32)
+
+	sb.WriteString(receipt.StoreName + "\n")
+	if receipt.StoreAddress != "" {
+		sb.WriteString(receipt.StoreAddress + "\n")
+	}
+	if receipt.StorePhone != "" {
+		sb.WriteString(receipt.StorePhone + "\n")
+	}
+	sb.WriteString(line + "\n")
+	sb.WriteString(fmt.Sprintf("顧客：%s\n", receipt.CustomerName))
+	sb.WriteString(fmt.Sprintf("美甲師：%s\n", receipt.StylistName))
+	sb.WriteString(fmt.Sprintf("預約時段：%s %s - %s\n", receipt.Date, receipt.StartTime, receipt.EndTime))
+	sb.WriteString(fmt.Sprintf("結帳時間：%s\n", receipt.CheckoutAt))
+	sb.WriteString(line + "\n")
+	for _, item := range receipt.Items {
+		if item.RawPrice != item.Price {
+			sb.WriteString(fmt.Sprintf("%s  $%d (原價 $%d)\n", item.ServiceName, item.Price, item.RawPrice))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s  $%d\n", item.ServiceName, item.Price))
+	}
+	sb.WriteString(line + "\n")
+
+	couponName := "無"
+	if receipt.CouponName != "" {
+		couponName = receipt.CouponName
+	}
+	sb.WriteString(fmt.Sprintf("原價總額：$%d\n", receipt.TotalAmount))
+	sb.WriteString(fmt.Sprintf("優惠券：%s\n", couponName))
+	if receipt.PointsRedeemed > 0 {
+		sb.WriteString(fmt.Sprintf("點數折抵：-$%d (%d 點)\n", receipt.PointsDiscountAmount, receipt.PointsRedeemed))
+	}
+	sb.WriteString(fmt.Sprintf("應付金額：$%d\n", receipt.FinalAmount))
+	sb.WriteString(fmt.Sprintf("實收金額：$%d\n", receipt.PaidAmount))
+	sb.WriteString(fmt.Sprintf("付款方式：%s\n", receipt.PaymentMethod))
+	if receipt.InvoiceNumber != "" {
+		sb.WriteString(fmt.Sprintf("發票號碼：%s\n", receipt.InvoiceNumber))
+	}
+	if receipt.RefundedAt != "" {
+		sb.WriteString(fmt.Sprintf("已退款：%s\n", receipt.RefundedAt))
+	}
+	sb.WriteString(line + "\n")
+	sb.WriteString("謝謝光臨\n")
+
+	return sb.String()
+}
+
+// paymentMethodText returns the display text of the payment method
+func paymentMethodText(paymentMethod string) string {
+	switch paymentMethod {
+	case common.PaymentMethodCash:
+		return "現金"
+	case common.PaymentMethodLinePay:
+		return "LINE Pay"
+	case common.PaymentMethodWallet:
+		return "儲值金"
+	default:
+		return paymentMethod
+	}
+}
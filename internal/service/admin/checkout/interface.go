@@ -10,3 +10,15 @@ import (
 type CreateBulkInterface interface {
 	CreateBulk(ctx context.Context, storeID int64, req adminCheckoutModel.CreateBulkParsedRequest, staffContext *common.StaffContext) (*adminCheckoutModel.CreateBulkResponse, error)
 }
+
+type RefundInterface interface {
+	Refund(ctx context.Context, storeID, checkoutID int64, req adminCheckoutModel.RefundRequest, staffContext *common.StaffContext) (*adminCheckoutModel.RefundResponse, error)
+}
+
+type GetReceiptInterface interface {
+	GetReceipt(ctx context.Context, storeID, checkoutID int64, role string, storeIDs []int64) (*adminCheckoutModel.GetReceiptResponse, error)
+}
+
+type SendReceiptInterface interface {
+	SendReceipt(ctx context.Context, storeID, checkoutID int64, role string, storeIDs []int64) (*adminCheckoutModel.SendReceiptResponse, error)
+}
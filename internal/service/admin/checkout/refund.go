@@ -0,0 +1,304 @@
+package adminCheckout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCheckoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cashdrawer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/points"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Refund struct {
+	queries       *dbgen.Queries
+	db            *pgxpool.Pool
+	invoiceIssuer invoice.IssuerInterface
+}
+
+func NewRefund(queries *dbgen.Queries, db *pgxpool.Pool, invoiceIssuer invoice.IssuerInterface) RefundInterface {
+	return &Refund{
+		queries:       queries,
+		db:            db,
+		invoiceIssuer: invoiceIssuer,
+	}
+}
+
+func (s *Refund) Refund(ctx context.Context, storeID, checkoutID int64, req adminCheckoutModel.RefundRequest, staffContext *common.StaffContext) (*adminCheckoutModel.RefundResponse, error) {
+	storeIDs := make([]int64, len(staffContext.StoreList))
+	for i, store := range staffContext.StoreList {
+		storeIDs[i] = store.ID
+	}
+
+	// check store access
+	if err := utils.CheckStoreAccess(storeID, storeIDs, staffContext.Role); err != nil {
+		return nil, err
+	}
+
+	reason := "結帳退款"
+	if req.Reason != nil && *req.Reason != "" {
+		reason = *req.Reason
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	checkout, err := qtx.GetCheckoutByIDForUpdate(ctx, checkoutID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get checkout", err)
+	}
+	if checkout.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutNotBelongToStore)
+	}
+	if checkout.RefundedAt.Valid {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutAlreadyRefunded)
+	}
+
+	// cash refund changes today's expected cash, so it is not allowed after the cash drawer is closed
+	if checkout.PaymentMethod == common.PaymentMethodCash {
+		if err := cashdrawer.CheckNotClosed(ctx, qtx, storeID, errorCodes.CashDrawerClosedNotAllowRefund); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	err = qtx.UpdateCheckoutRefunded(ctx, dbgen.UpdateCheckoutRefundedParams{
+		ID:           checkoutID,
+		RefundedAt:   utils.TimePtrToPgTimestamptz(&now),
+		RefundReason: utils.StringPtrToPgText(req.Reason, true),
+		RefundedBy:   utils.Int64PtrToPgInt8(&staffContext.UserID),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update checkout refunded", err)
+	}
+
+	if err := s.reverseCheckoutTransactions(ctx, qtx, storeID, checkout); err != nil {
+		return nil, err
+	}
+
+	// wallet payment is credited back to the customer wallet
+	if checkout.PaymentMethod == common.PaymentMethodWallet {
+		if err := s.refundWallet(ctx, qtx, storeID, staffContext.UserID, checkout); err != nil {
+			return nil, err
+		}
+	}
+
+	// redeemed points are returned and earned points are taken back
+	if err := s.reverseCheckoutPoints(ctx, qtx, storeID, staffContext.UserID, checkout); err != nil {
+		return nil, err
+	}
+
+	// mark invoice to be voided, the provider is called after commit
+	invoiceStatus := ""
+	var voidInvoiceID *int64
+	checkoutInvoice, err := qtx.GetInvoiceByCheckoutIDForUpdate(ctx, checkoutID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get invoice", err)
+	}
+	if err == nil {
+		invoiceStatus = checkoutInvoice.Status
+		if checkoutInvoice.Status != common.InvoiceStatusVoided {
+			err = qtx.UpdateInvoiceVoidPending(ctx, dbgen.UpdateInvoiceVoidPendingParams{
+				ID:         checkoutInvoice.ID,
+				VoidReason: utils.StringPtrToPgText(&reason, false),
+			})
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update invoice void pending", err)
+			}
+			invoiceStatus = common.InvoiceStatusVoidPending
+			voidInvoiceID = &checkoutInvoice.ID
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	// Void invoice
+	if voidInvoiceID != nil {
+		go func() {
+			voidCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			if err := s.invoiceIssuer.Void(voidCtx, *voidInvoiceID); err != nil {
+				log.Printf("failed to void invoice %d: %v", *voidInvoiceID, err)
+			}
+		}()
+	}
+
+	return &adminCheckoutModel.RefundResponse{
+		ID:            utils.FormatID(checkoutID),
+		RefundedAt:    now.Format(time.RFC3339),
+		InvoiceStatus: invoiceStatus,
+	}, nil
+}
+
+// reverseCheckoutTransactions creates an account transaction of the opposite type and the same amount against each transaction the checkout was posted
+func (s *Refund) reverseCheckoutTransactions(ctx context.Context, qtx *dbgen.Queries, storeID int64, checkout dbgen.GetCheckoutByIDForUpdateRow) error {
+	checkoutSource := common.AccountTransactionSourceCheckout
+	postedTransactions, err := qtx.GetAccountTransactionsBySource(ctx, dbgen.GetAccountTransactionsBySourceParams{
+		SourceType: utils.StringPtrToPgText(&checkoutSource, false),
+		SourceID:   utils.Int64PtrToPgInt8(&checkout.ID),
+	})
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get checkout account transactions", err)
+	}
+	if len(postedTransactions) == 0 {
+		return nil
+	}
+
+	// the checkout may be posted to several accounts, lock them in id order before posting
+	accountIDs := make([]int64, 0, len(postedTransactions))
+	for _, postedTransaction := range postedTransactions {
+		accountIDs = append(accountIDs, postedTransaction.AccountID)
+	}
+	if err := ledger.LockAccounts(ctx, qtx, accountIDs); err != nil {
+		return err
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	transactionDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	sourceType := common.AccountTransactionSourceCheckoutRefund
+	note := fmt.Sprintf("結帳退款 (%s)", checkout.PaymentMethod)
+	for _, postedTransaction := range postedTransactions {
+		accountTransaction, err := qtx.GetAccountTransactionByID(ctx, postedTransaction.ID)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+		}
+
+		amount, err := utils.PgNumericToInt64(accountTransaction.Amount)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		// an income is reversed by an expense, an expense (e.g. a fee) is reversed by an income
+		reverseType := common.AccountTransactionTypeExpense
+		if accountTransaction.Type == common.AccountTransactionTypeExpense {
+			reverseType = common.AccountTransactionTypeIncome
+		}
+
+		_, err = ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+			StoreID:         storeID,
+			AccountID:       accountTransaction.AccountID,
+			TransactionDate: transactionDate,
+			Type:            reverseType,
+			Amount:          amount,
+			Note:            &note,
+			SourceType:      &sourceType,
+			SourceID:        &checkout.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundWallet creates a REFUND wallet transaction with the paid amount of the checkout
+func (s *Refund) refundWallet(ctx context.Context, qtx *dbgen.Queries, storeID, staffID int64, checkout dbgen.GetCheckoutByIDForUpdateRow) error {
+	paidAmount, err := utils.PgNumericToInt64(checkout.PaidAmount)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert paid amount to int64", err)
+	}
+	if paidAmount <= 0 {
+		return nil
+	}
+
+	paymentMethod := common.PaymentMethodWallet
+	sourceType := common.CustomerWalletTransactionSourceCheckout
+	_, err = wallet.PostTransaction(ctx, qtx, wallet.PostTransactionParams{
+		CustomerID:    checkout.CustomerID,
+		StoreID:       &storeID,
+		Type:          common.CustomerWalletTransactionTypeRefund,
+		Amount:        paidAmount,
+		PaymentMethod: &paymentMethod,
+		SourceType:    &sourceType,
+		SourceID:      &checkout.ID,
+		CreatedBy:     &staffID,
+	})
+
+	return err
+}
+
+// reverseCheckoutPoints creates a REFUND point transaction against each point transaction of the checkout.
+// Earned points already used by the customer can not be taken back, so the deduction is capped to the balance.
+func (s *Refund) reverseCheckoutPoints(ctx context.Context, qtx *dbgen.Queries, storeID, staffID int64, checkout dbgen.GetCheckoutByIDForUpdateRow) error {
+	sourceType := common.CustomerPointTransactionSourceCheckout
+	postedTransactions, err := qtx.GetCustomerPointTransactionsBySource(ctx, dbgen.GetCustomerPointTransactionsBySourceParams{
+		SourceType: utils.StringPtrToPgText(&sourceType, false),
+		SourceID:   utils.Int64PtrToPgInt8(&checkout.ID),
+	})
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get checkout point transactions", err)
+	}
+	if len(postedTransactions) == 0 {
+		return nil
+	}
+
+	loyaltySetting, err := points.GetEnabledStoreSetting(ctx, qtx, storeID)
+	if err != nil {
+		return err
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	for _, postedTransaction := range postedTransactions {
+		params := points.PostTransactionParams{
+			CustomerID: checkout.CustomerID,
+			StoreID:    &storeID,
+			Type:       common.CustomerPointTransactionTypeRefund,
+			Points:     -postedTransaction.Points,
+			SourceType: &sourceType,
+			SourceID:   &checkout.ID,
+			CreatedBy:  &staffID,
+		}
+		switch postedTransaction.Type {
+		case common.CustomerPointTransactionTypeRedeem:
+			params.ExpiresAt = points.CalculateExpiresAt(loyaltySetting, today)
+		case common.CustomerPointTransactionTypeEarn:
+			params.CapToBalance = true
+		default:
+			continue
+		}
+
+		if _, err := points.PostTransaction(ctx, qtx, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
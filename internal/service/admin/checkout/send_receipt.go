@@ -0,0 +1,48 @@
+package adminCheckout
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCheckoutModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/checkout"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type SendReceipt struct {
+	queries       *dbgen.Queries
+	lineMessenger *utils.LineMessageClient
+}
+
+func NewSendReceipt(queries *dbgen.Queries, lineMessenger *utils.LineMessageClient) SendReceiptInterface {
+	return &SendReceipt{
+		queries:       queries,
+		lineMessenger: lineMessenger,
+	}
+}
+
+func (s *SendReceipt) SendReceipt(ctx context.Context, storeID, checkoutID int64, role string, storeIDs []int64) (*adminCheckoutModel.SendReceiptResponse, error) {
+	// check store access
+	if err := utils.CheckStoreAccess(storeID, storeIDs, role); err != nil {
+		return nil, err
+	}
+
+	receipt, customerLineUid, err := buildReceiptData(ctx, s.queries, storeID, checkoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	// walk-in customers without LINE cannot receive the receipt
+	if customerLineUid == "" {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CheckoutCustomerLineNotLinked)
+	}
+
+	// push the receipt to customer through LINE
+	if err := s.lineMessenger.SendCheckoutReceipt(customerLineUid, receipt); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.CheckoutReceiptSendFailed, "failed to send checkout receipt", err)
+	}
+
+	return &adminCheckoutModel.SendReceiptResponse{
+		ID: utils.FormatID(checkoutID),
+	}, nil
+}
@@ -3,6 +3,8 @@ package adminCoupon
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminCouponModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
@@ -12,22 +14,34 @@ import (
 
 type Create struct {
 	queries *dbgen.Queries
+	db      *pgxpool.Pool
 	repo    *sqlxRepo.Repositories
 }
 
-func NewCreate(queries *dbgen.Queries, repo *sqlxRepo.Repositories) CreateInterface {
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool, repo *sqlxRepo.Repositories) CreateInterface {
 	return &Create{
 		queries: queries,
+		db:      db,
 		repo:    repo,
 	}
 }
 
-func (s *Create) Create(ctx context.Context, req adminCouponModel.CreateRequest) (*adminCouponModel.CreateResponse, error) {
+func (s *Create) Create(ctx context.Context, req adminCouponModel.CreateParsedRequest) (*adminCouponModel.CreateResponse, error) {
 	// Validate DiscountRate and DiscountAmount pass at least one
 	if err := s.ValidateDiscountRateAndDiscountAmount(req); err != nil {
 		return nil, err
 	}
 
+	// Validate start date is not after end date
+	if req.StartDate != nil && req.EndDate != nil && req.StartDate.After(*req.EndDate) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponDateRangeInvalid)
+	}
+
+	// Check eligible services exist
+	if err := checkServicesExist(ctx, s.queries, req.ServiceIds); err != nil {
+		return nil, err
+	}
+
 	// Check if coupon name already exists
 	exists, err := s.queries.CheckCouponNameExists(ctx, req.Name)
 	if err != nil {
@@ -56,23 +70,56 @@ func (s *Create) Create(ctx context.Context, req adminCouponModel.CreateRequest)
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValTypeConversionFailed)
 	}
 
+	minSpendAmount, err := utils.Int64PtrToPgNumeric(req.MinSpendAmount)
+	if err != nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValTypeConversionFailed)
+	}
+
 	couponID := utils.GenerateID()
 	isActive := true
 
-	err = s.queries.CreateCoupon(ctx, dbgen.CreateCouponParams{
-		ID:             couponID,
-		Name:           req.Name,
-		DisplayName:    req.DisplayName,
-		Code:           req.Code,
-		DiscountRate:   discountRate,
-		DiscountAmount: discountAmount,
-		IsActive:       utils.BoolPtrToPgBool(&isActive),
-		Note:           utils.StringPtrToPgText(req.Note, true),
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	err = qtx.CreateCoupon(ctx, dbgen.CreateCouponParams{
+		ID:                    couponID,
+		Name:                  req.Name,
+		DisplayName:           req.DisplayName,
+		Code:                  req.Code,
+		DiscountRate:          discountRate,
+		DiscountAmount:        discountAmount,
+		IsActive:              utils.BoolPtrToPgBool(&isActive),
+		Note:                  utils.StringPtrToPgText(req.Note, true),
+		StartDate:             utils.TimePtrToPgDate(req.StartDate),
+		EndDate:               utils.TimePtrToPgDate(req.EndDate),
+		MinSpendAmount:        minSpendAmount,
+		ServiceScope:          req.ServiceScope,
+		TotalUsageLimit:       utils.Int32PtrToPgInt4(req.TotalUsageLimit),
+		PerCustomerUsageLimit: utils.Int32PtrToPgInt4(req.PerCustomerUsageLimit),
+		IsRepeatable:          req.IsRepeatable,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create coupon", err)
 	}
 
+	if len(req.ServiceIds) > 0 {
+		if err := qtx.CreateCouponServices(ctx, dbgen.CreateCouponServicesParams{
+			CouponID:   couponID,
+			ServiceIds: req.ServiceIds,
+		}); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create coupon services", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
 	response := &adminCouponModel.CreateResponse{
 		ID: utils.FormatID(couponID),
 	}
@@ -81,7 +128,7 @@ func (s *Create) Create(ctx context.Context, req adminCouponModel.CreateRequest)
 }
 
 // ValidateDiscountRateAndDiscountAmount validates the discount rate and discount amount
-func (s *Create) ValidateDiscountRateAndDiscountAmount(req adminCouponModel.CreateRequest) error {
+func (s *Create) ValidateDiscountRateAndDiscountAmount(req adminCouponModel.CreateParsedRequest) error {
 	if req.DiscountRate == nil && req.DiscountAmount == nil {
 		return errorCodes.NewServiceErrorWithCode(errorCodes.CouponDiscountRequired)
 	}
@@ -91,3 +138,20 @@ func (s *Create) ValidateDiscountRateAndDiscountAmount(req adminCouponModel.Crea
 
 	return nil
 }
+
+// checkServicesExist checks all eligible services exist, the service ids are expected to be distinct
+func checkServicesExist(ctx context.Context, queries *dbgen.Queries, serviceIDs []int64) error {
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	services, err := queries.GetServiceByIds(ctx, serviceIDs)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get services", err)
+	}
+	if len(services) != len(serviceIDs) {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.ServiceNotFound)
+	}
+
+	return nil
+}
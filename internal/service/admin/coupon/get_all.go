@@ -5,16 +5,21 @@ import (
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminCouponModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type GetAll struct {
-	repo *sqlxRepo.Repositories
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
 }
 
-func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
-	return &GetAll{repo: repo}
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
 }
 
 func (s *GetAll) GetAll(ctx context.Context, req adminCouponModel.GetAllParsedRequest) (*adminCouponModel.GetAllResponse, error) {
@@ -30,6 +35,20 @@ func (s *GetAll) GetAll(ctx context.Context, req adminCouponModel.GetAllParsedRe
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get coupon list", err)
 	}
 
+	// get eligible services of the coupons
+	couponIDs := make([]int64, len(results))
+	for i, result := range results {
+		couponIDs[i] = result.ID
+	}
+	couponServices, err := s.queries.GetCouponServicesByCouponIDs(ctx, couponIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon services", err)
+	}
+	serviceIDsMap := make(map[int64][]string)
+	for _, couponService := range couponServices {
+		serviceIDsMap[couponService.CouponID] = append(serviceIDsMap[couponService.CouponID], utils.FormatID(couponService.ServiceID))
+	}
+
 	items := make([]adminCouponModel.GetAllCouponItemDTO, len(results))
 	for i, result := range results {
 		discountRate, err := utils.PgNumericToFloat64(result.DiscountRate)
@@ -41,17 +60,35 @@ func (s *GetAll) GetAll(ctx context.Context, req adminCouponModel.GetAllParsedRe
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to int64", err)
 		}
 
+		minSpendAmount, err := utils.PgNumericToInt64(result.MinSpendAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert min spend amount to int64", err)
+		}
+		serviceIDs, ok := serviceIDsMap[result.ID]
+		if !ok {
+			serviceIDs = []string{}
+		}
+
 		items[i] = adminCouponModel.GetAllCouponItemDTO{
-			ID:             utils.FormatID(result.ID),
-			Name:           result.Name,
-			DisplayName:    result.DisplayName,
-			Code:           result.Code,
-			DiscountRate:   discountRate,
-			DiscountAmount: discountAmount,
-			IsActive:       utils.PgBoolToBool(result.IsActive),
-			Note:           utils.PgTextToString(result.Note),
-			CreatedAt:      utils.PgTimestamptzToTimeString(result.CreatedAt),
-			UpdatedAt:      utils.PgTimestamptzToTimeString(result.UpdatedAt),
+			ID:                    utils.FormatID(result.ID),
+			Name:                  result.Name,
+			DisplayName:           result.DisplayName,
+			Code:                  result.Code,
+			DiscountRate:          discountRate,
+			DiscountAmount:        discountAmount,
+			IsActive:              utils.PgBoolToBool(result.IsActive),
+			StartDate:             utils.PgDateToDateString(result.StartDate),
+			EndDate:               utils.PgDateToDateString(result.EndDate),
+			MinSpendAmount:        minSpendAmount,
+			ServiceScope:          result.ServiceScope,
+			ServiceIds:            serviceIDs,
+			TotalUsageLimit:       utils.PgInt4ToInt32Ptr(result.TotalUsageLimit),
+			PerCustomerUsageLimit: utils.PgInt4ToInt32Ptr(result.PerCustomerUsageLimit),
+			IsRepeatable:          result.IsRepeatable,
+			UsedCount:             result.UsedCount,
+			Note:                  utils.PgTextToString(result.Note),
+			CreatedAt:             utils.PgTimestamptzToTimeString(result.CreatedAt),
+			UpdatedAt:             utils.PgTimestamptzToTimeString(result.UpdatedAt),
 		}
 	}
 
@@ -7,7 +7,7 @@ import (
 )
 
 type CreateInterface interface {
-	Create(ctx context.Context, req adminCouponModel.CreateRequest) (*adminCouponModel.CreateResponse, error)
+	Create(ctx context.Context, req adminCouponModel.CreateParsedRequest) (*adminCouponModel.CreateResponse, error)
 }
 
 type GetAllInterface interface {
@@ -15,5 +15,5 @@ type GetAllInterface interface {
 }
 
 type UpdateInterface interface {
-	Update(ctx context.Context, couponID int64, req adminCouponModel.UpdateRequest) (*adminCouponModel.UpdateResponse, error)
+	Update(ctx context.Context, couponID int64, req adminCouponModel.UpdateParsedRequest) (*adminCouponModel.UpdateResponse, error)
 }
@@ -2,6 +2,12 @@ package adminCoupon
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminCouponModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon"
@@ -12,24 +18,46 @@ import (
 
 type Update struct {
 	queries *dbgen.Queries
+	db      *sqlx.DB
 	repo    *sqlxRepo.Repositories
 }
 
-func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories) UpdateInterface {
+func NewUpdate(queries *dbgen.Queries, db *sqlx.DB, repo *sqlxRepo.Repositories) UpdateInterface {
 	return &Update{
 		queries: queries,
+		db:      db,
 		repo:    repo,
 	}
 }
 
-func (s *Update) Update(ctx context.Context, couponID int64, req adminCouponModel.UpdateRequest) (*adminCouponModel.UpdateResponse, error) {
+func (s *Update) Update(ctx context.Context, couponID int64, req adminCouponModel.UpdateParsedRequest) (*adminCouponModel.UpdateResponse, error) {
 	// Ensure coupon exists
-	exists, err := s.queries.CheckCouponExists(ctx, couponID)
+	rule, err := s.queries.GetCouponRuleByID(ctx, couponID)
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon", err)
+	}
+
+	// Validate start date is not after end date, the stored date is used when not updated
+	startDate := pgDateToTimePtr(rule.StartDate)
+	if req.StartDate != nil {
+		startDate = req.StartDate
+	}
+	endDate := pgDateToTimePtr(rule.EndDate)
+	if req.EndDate != nil {
+		endDate = req.EndDate
 	}
-	if !exists {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+	if startDate != nil && endDate != nil && !startDate.IsZero() && !endDate.IsZero() && startDate.After(*endDate) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponDateRangeInvalid)
+	}
+
+	// Check eligible services exist
+	if req.ServiceIds != nil {
+		if err := checkServicesExist(ctx, s.queries, *req.ServiceIds); err != nil {
+			return nil, err
+		}
 	}
 
 	// Name uniqueness excluding self
@@ -46,17 +74,48 @@ func (s *Update) Update(ctx context.Context, couponID int64, req adminCouponMode
 		}
 	}
 
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
 	// Perform partial update
-	if err := s.repo.Coupon.UpdateCoupon(ctx, couponID, sqlxRepo.UpdateCouponParams{
-		Name:     req.Name,
-		IsActive: req.IsActive,
-		Note:     req.Note,
+	if err := s.repo.Coupon.UpdateCouponTx(ctx, tx, couponID, sqlxRepo.UpdateCouponTxParams{
+		Name:                  req.Name,
+		IsActive:              req.IsActive,
+		StartDate:             req.StartDate,
+		EndDate:               req.EndDate,
+		MinSpendAmount:        req.MinSpendAmount,
+		ServiceScope:          req.ServiceScope,
+		TotalUsageLimit:       req.TotalUsageLimit,
+		PerCustomerUsageLimit: req.PerCustomerUsageLimit,
+		IsRepeatable:          req.IsRepeatable,
+		Note:                  req.Note,
 	}); err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update coupon", err)
 	}
 
+	if req.ServiceIds != nil {
+		if err := s.repo.Coupon.ReplaceCouponServicesTx(ctx, tx, couponID, *req.ServiceIds); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to replace coupon services", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
 	response := &adminCouponModel.UpdateResponse{
 		ID: utils.FormatID(couponID),
 	}
 	return response, nil
 }
+
+// pgDateToTimePtr returns nil when the date is null
+func pgDateToTimePtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
@@ -0,0 +1,92 @@
+package adminCouponCampaign
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	runner  campaign.RunnerInterface
+}
+
+func NewCreate(queries *dbgen.Queries, runner campaign.RunnerInterface) CreateInterface {
+	return &Create{
+		queries: queries,
+		runner:  runner,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, req adminCouponCampaignModel.CreateParsedRequest, creatorID int64) (*adminCouponCampaignModel.CreateResponse, error) {
+	if req.LastVisitFrom != nil && req.LastVisitTo != nil && req.LastVisitFrom.After(*req.LastVisitTo) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponCampaignLastVisitRangeInvalid)
+	}
+
+	coupons, err := s.queries.GetCouponByIDs(ctx, []int64{req.CouponID})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon", err)
+	}
+	if len(coupons) == 0 {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+	}
+	if !utils.PgBoolToBool(coupons[0].IsActive) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotActive)
+	}
+
+	if req.StoreID != nil {
+		if _, err := s.queries.GetStoreByID(ctx, *req.StoreID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreNotFound)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store", err)
+		}
+	}
+
+	campaignID := utils.GenerateID()
+	if err := s.queries.CreateCouponCampaign(ctx, dbgen.CreateCouponCampaignParams{
+		ID:             campaignID,
+		Name:           req.Name,
+		CouponID:       req.CouponID,
+		ValidMonths:    utils.Int32PtrToPgInt4(req.ValidMonths),
+		CustomerLevels: req.CustomerLevels,
+		LastVisitFrom:  utils.TimePtrToPgDate(req.LastVisitFrom),
+		LastVisitTo:    utils.TimePtrToPgDate(req.LastVisitTo),
+		BirthdayMonth:  utils.Int32PtrToPgInt4(req.BirthdayMonth),
+		StoreID:        utils.Int64PtrToPgInt8(req.StoreID),
+		SendLine:       req.SendLine,
+		LineMessage:    utils.StringPtrToPgText(req.LineMessage, true),
+		Status:         common.CouponCampaignStatusPending,
+		CreatedBy:      creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create coupon campaign", err)
+	}
+
+	// the final target count is recorded by the runner when it starts
+	targetCount, err := s.queries.CountCouponCampaignTargetCustomers(ctx, campaignID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count coupon campaign target customers", err)
+	}
+
+	// Run campaign
+	go func() {
+		if err := s.runner.Run(context.Background(), campaignID); err != nil {
+			log.Printf("failed to run coupon campaign %d: %v", campaignID, err)
+		}
+	}()
+
+	return &adminCouponCampaignModel.CreateResponse{
+		ID:          utils.FormatID(campaignID),
+		Status:      common.CouponCampaignStatusPending,
+		TargetCount: targetCount,
+	}, nil
+}
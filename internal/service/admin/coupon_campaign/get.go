@@ -0,0 +1,64 @@
+package adminCouponCampaign
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, campaignID int64) (*adminCouponCampaignModel.GetResponse, error) {
+	campaign, err := s.queries.GetCouponCampaignByID(ctx, campaignID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponCampaignNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon campaign", err)
+	}
+
+	customerLevels := campaign.CustomerLevels
+	if customerLevels == nil {
+		customerLevels = []string{}
+	}
+
+	return &adminCouponCampaignModel.GetResponse{
+		ID:              utils.FormatID(campaign.ID),
+		Name:            campaign.Name,
+		CouponID:        utils.FormatID(campaign.CouponID),
+		ValidMonths:     utils.PgInt4ToInt32Ptr(campaign.ValidMonths),
+		CustomerLevels:  customerLevels,
+		LastVisitFrom:   utils.PgDateToDateString(campaign.LastVisitFrom),
+		LastVisitTo:     utils.PgDateToDateString(campaign.LastVisitTo),
+		BirthdayMonth:   utils.PgInt4ToInt32Ptr(campaign.BirthdayMonth),
+		StoreID:         utils.PgInt8ToIDString(campaign.StoreID),
+		SendLine:        campaign.SendLine,
+		LineMessage:     utils.PgTextToString(campaign.LineMessage),
+		Status:          campaign.Status,
+		TargetCount:     campaign.TargetCount,
+		IssuedCount:     campaign.IssuedCount,
+		SkippedCount:    campaign.SkippedCount,
+		LineSentCount:   campaign.LineSentCount,
+		LineFailedCount: campaign.LineFailedCount,
+		ErrorMessage:    utils.PgTextToString(campaign.ErrorMessage),
+		StartedAt:       utils.PgTimestamptzToTimeString(campaign.StartedAt),
+		FinishedAt:      utils.PgTimestamptzToTimeString(campaign.FinishedAt),
+		CreatedBy:       utils.FormatID(campaign.CreatedBy),
+		CreatedAt:       utils.PgTimestamptzToTimeString(campaign.CreatedAt),
+		UpdatedAt:       utils.PgTimestamptzToTimeString(campaign.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package adminCouponCampaign
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, req adminCouponCampaignModel.GetAllParsedRequest) (*adminCouponCampaignModel.GetAllResponse, error) {
+	total, items, err := s.repo.CouponCampaign.GetAllCouponCampaignsByFilter(ctx, sqlxRepo.GetAllCouponCampaignsByFilterParams{
+		Status: req.Status,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon campaigns", err)
+	}
+
+	responseItems := make([]adminCouponCampaignModel.GetAllItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminCouponCampaignModel.GetAllItem{
+			ID:              utils.FormatID(item.ID),
+			Name:            item.Name,
+			CouponID:        utils.FormatID(item.CouponID),
+			CouponName:      item.CouponName,
+			Status:          item.Status,
+			SendLine:        item.SendLine,
+			TargetCount:     item.TargetCount,
+			IssuedCount:     item.IssuedCount,
+			SkippedCount:    item.SkippedCount,
+			LineSentCount:   item.LineSentCount,
+			LineFailedCount: item.LineFailedCount,
+			StartedAt:       utils.PgTimestamptzToTimeString(item.StartedAt),
+			FinishedAt:      utils.PgTimestamptzToTimeString(item.FinishedAt),
+			CreatedAt:       utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCouponCampaignModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package adminCouponCampaign
+
+import (
+	"context"
+
+	adminCouponCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/coupon_campaign"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminCouponCampaignModel.CreateParsedRequest, creatorID int64) (*adminCouponCampaignModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, req adminCouponCampaignModel.GetAllParsedRequest) (*adminCouponCampaignModel.GetAllResponse, error)
+}
+
+type GetInterface interface {
+	Get(ctx context.Context, campaignID int64) (*adminCouponCampaignModel.GetResponse, error)
+}
@@ -0,0 +1,66 @@
+package adminCustomer
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/referral"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+}
+
+func NewCreate(queries *dbgen.Queries) CreateInterface {
+	return &Create{
+		queries: queries,
+	}
+}
+
+// Create creates a customer without a LINE account for walk-ins and phone bookings.
+// The customer is linked when registering with LINE using the same phone and birthday.
+func (s *Create) Create(ctx context.Context, req adminCustomerModel.CreateRequest, staffID int64) (*adminCustomerModel.CreateResponse, error) {
+	birthday, err := utils.DateStringToPgDate(req.Birthday)
+	if err != nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValFieldDateFormat)
+	}
+
+	// the phone identifies the walk-in customer, a customer with the same phone is a duplicate
+	exists, err := s.queries.CheckActiveCustomerExistsByPhone(ctx, req.Phone)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer exists by phone", err)
+	}
+	if exists {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerPhoneAlreadyExists)
+	}
+
+	referralCode, err := referral.GenerateReferralCode()
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to generate referral code", err)
+	}
+
+	customerID := utils.GenerateID()
+	defaultLevel := common.CustomerLevelNormal
+	if err := s.queries.CreateWalkInCustomer(ctx, dbgen.CreateWalkInCustomerParams{
+		ID:           customerID,
+		Name:         req.Name,
+		Phone:        req.Phone,
+		Birthday:     birthday,
+		Email:        utils.StringPtrToPgText(req.Email, true),
+		City:         utils.StringPtrToPgText(req.City, true),
+		StoreNote:    utils.StringPtrToPgText(req.StoreNote, true),
+		Level:        utils.StringPtrToPgText(&defaultLevel, true),
+		ReferralCode: utils.StringPtrToPgText(&referralCode, true),
+		CreatedBy:    utils.Int64PtrToPgInt8(&staffID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer", err)
+	}
+
+	return &adminCustomerModel.CreateResponse{
+		ID: utils.FormatID(customerID),
+	}, nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
+const customerGetNoteLimit = 10
+
 type Get struct {
 	queries *dbgen.Queries
 }
@@ -31,29 +33,119 @@ func (s *Get) Get(ctx context.Context, customerID int64) (*adminCustomerModel.Ge
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.SysDatabaseError)
 	}
 
+	var mergedIntoCustomerID *string
+	if customer.MergedIntoCustomerID.Valid {
+		id := utils.FormatID(customer.MergedIntoCustomerID.Int64)
+		mergedIntoCustomerID = &id
+	}
+
+	// pinned notes first, then the latest ones, the full timeline is in the customer notes API
+	notes, err := s.queries.GetCustomerNotesByCustomerID(ctx, dbgen.GetCustomerNotesByCustomerIDParams{
+		CustomerID:  customerID,
+		LimitCount:  customerGetNoteLimit,
+		OffsetCount: 0,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer notes", err)
+	}
+
+	noteItems := make([]adminCustomerModel.GetNote, len(notes))
+	for i, note := range notes {
+		noteItems[i] = adminCustomerModel.GetNote{
+			ID:                utils.FormatID(note.ID),
+			BookingID:         utils.PgInt8ToIDString(note.BookingID),
+			Content:           note.Content,
+			IsPinned:          note.IsPinned,
+			CreatedBy:         utils.PgInt8ToIDString(note.CreatedBy),
+			CreatedByUsername: utils.PgTextToString(note.CreatedByUsername),
+			CreatedAt:         utils.PgTimestamptzToTimeString(note.CreatedAt),
+		}
+	}
+
+	metrics, err := s.getMetrics(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert to response format
 	response := &adminCustomerModel.GetResponse{
-		ID:             utils.FormatID(customer.ID),
-		Name:           customer.Name,
-		LineName:       utils.PgTextToString(customer.LineName),
-		Phone:          customer.Phone,
-		Birthday:       utils.PgDateToDateString(customer.Birthday),
-		Email:          utils.PgTextToString(customer.Email),
-		City:           utils.PgTextToString(customer.City),
-		FavoriteShapes: customer.FavoriteShapes,
-		FavoriteColors: customer.FavoriteColors,
-		FavoriteStyles: customer.FavoriteStyles,
-		IsIntrovert:    utils.PgBoolToBool(customer.IsIntrovert),
-		ReferralSource: customer.ReferralSource,
-		Referrer:       utils.PgTextToString(customer.Referrer),
-		CustomerNote:   utils.PgTextToString(customer.CustomerNote),
-		StoreNote:      utils.PgTextToString(customer.StoreNote),
-		Level:          utils.PgTextToString(customer.Level),
-		IsBlacklisted:  utils.PgBoolToBool(customer.IsBlacklisted),
-		LastVisitAt:    utils.PgTimestamptzToTimeString(customer.LastVisitAt),
-		CreatedAt:      utils.PgTimestamptzToTimeString(customer.CreatedAt),
-		UpdatedAt:      utils.PgTimestamptzToTimeString(customer.UpdatedAt),
+		ID:                   utils.FormatID(customer.ID),
+		Name:                 customer.Name,
+		LineName:             utils.PgTextToString(customer.LineName),
+		Phone:                customer.Phone,
+		Birthday:             utils.PgDateToDateString(customer.Birthday),
+		Email:                utils.PgTextToString(customer.Email),
+		City:                 utils.PgTextToString(customer.City),
+		FavoriteShapes:       customer.FavoriteShapes,
+		FavoriteColors:       customer.FavoriteColors,
+		FavoriteStyles:       customer.FavoriteStyles,
+		IsIntrovert:          utils.PgBoolToBool(customer.IsIntrovert),
+		ReferralSource:       customer.ReferralSource,
+		Referrer:             utils.PgTextToString(customer.Referrer),
+		ReferralCode:         utils.PgTextToString(customer.ReferralCode),
+		CustomerNote:         utils.PgTextToString(customer.CustomerNote),
+		StoreNote:            utils.PgTextToString(customer.StoreNote),
+		Level:                utils.PgTextToString(customer.Level),
+		IsBlacklisted:        utils.PgBoolToBool(customer.IsBlacklisted),
+		RequiresDeposit:      customer.RequiresDeposit,
+		LastVisitAt:          utils.PgTimestamptzToTimeString(customer.LastVisitAt),
+		MergedIntoCustomerID: mergedIntoCustomerID,
+		ErasedAt:             utils.PgTimestamptzToTimeString(customer.ErasedAt),
+		Tags:                 customer.Tags,
+		Notes:                noteItems,
+		Metrics:              metrics,
+		CreatedAt:            utils.PgTimestamptzToTimeString(customer.CreatedAt),
+		UpdatedAt:            utils.PgTimestamptzToTimeString(customer.UpdatedAt),
 	}
 
 	return response, nil
 }
+
+func (s *Get) getMetrics(ctx context.Context, customerID int64) (*adminCustomerModel.GetMetrics, error) {
+	metric, err := s.queries.GetCustomerMetricByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer metrics", err)
+	}
+
+	lifetimeSpend, err := utils.PgNumericToInt64(metric.LifetimeSpend)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert lifetime spend to int64", err)
+	}
+	averageTicket, err := utils.PgNumericToFloat64(metric.AverageTicket)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert average ticket to float64", err)
+	}
+	noShowRate, err := utils.PgNumericToFloat64(metric.NoShowRate)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert no-show rate to float64", err)
+	}
+	cancellationRate, err := utils.PgNumericToFloat64(metric.CancellationRate)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert cancellation rate to float64", err)
+	}
+
+	var visitFrequencyDays *float64
+	if metric.VisitFrequencyDays.Valid {
+		days, err := utils.PgNumericToFloat64(metric.VisitFrequencyDays)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert visit frequency days to float64", err)
+		}
+		visitFrequencyDays = &days
+	}
+
+	return &adminCustomerModel.GetMetrics{
+		LifetimeSpend:      lifetimeSpend,
+		AverageTicket:      averageTicket,
+		VisitCount:         metric.VisitCount,
+		VisitFrequencyDays: visitFrequencyDays,
+		DaysSinceLastVisit: utils.PgInt4ToInt32Ptr(metric.DaysSinceLastVisit),
+		NoShowRate:         noShowRate,
+		CancellationRate:   cancellationRate,
+		ChurnRiskScore:     utils.PgInt4ToInt32Ptr(metric.ChurnRiskScore),
+		ChurnRiskLevel:     utils.PgTextToString(metric.ChurnRiskLevel),
+		CalculatedAt:       utils.PgTimestamptzToTimeString(metric.CalculatedAt),
+	}, nil
+}
@@ -22,15 +22,22 @@ func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
 func (s *GetAll) GetAll(ctx context.Context, req adminCustomerModel.GetAllParsedRequest) (*adminCustomerModel.GetAllResponse, error) {
 	// Get customers from repository
 	total, results, err := s.repo.Customer.GetAllCustomersByFilter(ctx, sqlxRepo.GetAllCustomersByFilterParams{
-		Name:          req.Name,
-		LineName:      req.LineName,
-		Phone:         req.Phone,
-		Level:         req.Level,
-		IsBlacklisted: req.IsBlacklisted,
-		MinPastDays:   req.MinPastDays,
-		Limit:         &req.Limit,
-		Offset:        &req.Offset,
-		Sort:          &req.Sort,
+		Name:                req.Name,
+		LineName:            req.LineName,
+		Phone:               req.Phone,
+		Level:               req.Level,
+		IsBlacklisted:       req.IsBlacklisted,
+		RequiresDeposit:     req.RequiresDeposit,
+		MinPastDays:         req.MinPastDays,
+		Tags:                req.Tags,
+		ChurnRiskLevel:      req.ChurnRiskLevel,
+		MinChurnRiskScore:   req.MinChurnRiskScore,
+		MaxChurnRiskScore:   req.MaxChurnRiskScore,
+		MinNoShowRate:       req.MinNoShowRate,
+		MinCancellationRate: req.MinCancellationRate,
+		Limit:               &req.Limit,
+		Offset:              &req.Offset,
+		Sort:                &req.Sort,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Failed to get customers", err)
@@ -38,17 +45,32 @@ func (s *GetAll) GetAll(ctx context.Context, req adminCustomerModel.GetAllParsed
 
 	items := make([]adminCustomerModel.GetAllCustomerItem, len(results))
 	for i, result := range results {
+		// customers not calculated by the nightly job yet have no metrics
+		var lifetimeSpend *int64
+		if result.LifetimeSpend.Valid {
+			spend, err := utils.PgNumericToInt64(result.LifetimeSpend)
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert lifetime spend to int64", err)
+			}
+			lifetimeSpend = &spend
+		}
+
 		items[i] = adminCustomerModel.GetAllCustomerItem{
-			ID:            utils.FormatID(result.ID),
-			Name:          result.Name,
-			LineName:      utils.PgTextToString(result.LineName),
-			Phone:         result.Phone,
-			Birthday:      utils.PgDateToDateString(result.Birthday),
-			City:          utils.PgTextToString(result.City),
-			Level:         utils.PgTextToString(result.Level),
-			IsBlacklisted: utils.PgBoolToBool(result.IsBlacklisted),
-			LastVisitAt:   utils.PgTimestamptzToTimeString(result.LastVisitAt),
-			UpdatedAt:     utils.PgTimestamptzToTimeString(result.UpdatedAt),
+			ID:              utils.FormatID(result.ID),
+			Name:            result.Name,
+			LineName:        utils.PgTextToString(result.LineName),
+			Phone:           result.Phone,
+			Birthday:        utils.PgDateToDateString(result.Birthday),
+			City:            utils.PgTextToString(result.City),
+			Level:           utils.PgTextToString(result.Level),
+			IsBlacklisted:   utils.PgBoolToBool(result.IsBlacklisted),
+			RequiresDeposit: result.RequiresDeposit,
+			LastVisitAt:     utils.PgTimestamptzToTimeString(result.LastVisitAt),
+			Tags:            result.Tags,
+			LifetimeSpend:   lifetimeSpend,
+			ChurnRiskScore:  utils.PgInt4ToInt32Ptr(result.ChurnRiskScore),
+			ChurnRiskLevel:  utils.PgTextToString(result.ChurnRiskLevel),
+			UpdatedAt:       utils.PgTimestamptzToTimeString(result.UpdatedAt),
 		}
 	}
 
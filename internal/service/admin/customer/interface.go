@@ -15,5 +15,9 @@ type GetInterface interface {
 }
 
 type UpdateInterface interface {
-	Update(ctx context.Context, customerID int64, req adminCustomerModel.UpdateRequest) (*adminCustomerModel.UpdateResponse, error)
+	Update(ctx context.Context, customerID int64, req adminCustomerModel.UpdateRequest, staffID int64) (*adminCustomerModel.UpdateResponse, error)
+}
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminCustomerModel.CreateRequest, staffID int64) (*adminCustomerModel.CreateResponse, error)
 }
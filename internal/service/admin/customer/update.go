@@ -2,10 +2,14 @@ package adminCustomer
 
 import (
 	"context"
+	"errors"
 	"log"
 
+	"github.com/jackc/pgx/v5"
+
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminCustomerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
@@ -26,50 +30,70 @@ func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories, authCache ca
 	}
 }
 
-func (s *Update) Update(ctx context.Context, customerID int64, req adminCustomerModel.UpdateRequest) (*adminCustomerModel.UpdateResponse, error) {
+func (s *Update) Update(ctx context.Context, customerID int64, req adminCustomerModel.UpdateRequest, staffID int64) (*adminCustomerModel.UpdateResponse, error) {
 	// verify customer exists
-	exists, err := s.queries.CheckCustomerExistsByID(ctx, customerID)
+	existing, err := s.queries.GetCustomerByID(ctx, customerID)
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer exists", err)
-	}
-	if !exists {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
 	}
 
 	// update customer
 	customer, err := s.repo.Customer.UpdateCustomer(ctx, customerID, sqlxRepo.UpdateCustomerParams{
-		StoreNote:     req.StoreNote,
-		Level:         req.Level,
-		IsBlacklisted: req.IsBlacklisted,
+		StoreNote:       req.StoreNote,
+		Level:           req.Level,
+		IsBlacklisted:   req.IsBlacklisted,
+		RequiresDeposit: req.RequiresDeposit,
+		Tags:            req.Tags,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer", err)
 	}
 
+	// record the manual level change
+	if req.Level != nil && *req.Level != utils.PgTextToString(existing.Level) {
+		err = s.queries.CreateCustomerLevelHistory(ctx, dbgen.CreateCustomerLevelHistoryParams{
+			ID:         utils.GenerateID(),
+			CustomerID: customerID,
+			FromLevel:  existing.Level,
+			ToLevel:    *req.Level,
+			Reason:     common.CustomerLevelChangeReasonManual,
+			Note:       utils.StringPtrToPgText(req.LevelNote, true),
+			CreatedBy:  utils.Int64PtrToPgInt8(&staffID),
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer level history", err)
+		}
+	}
+
 	if cacheErr := s.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
 		log.Println("failed to delete customer context from cache", cacheErr)
 	}
 
 	return &adminCustomerModel.UpdateResponse{
-		ID:             utils.FormatID(customer.ID),
-		Name:           customer.Name,
-		LineName:       utils.PgTextToString(customer.LineName),
-		Phone:          customer.Phone,
-		Birthday:       utils.PgDateToDateString(customer.Birthday),
-		Email:          utils.PgTextToString(customer.Email),
-		City:           utils.PgTextToString(customer.City),
-		FavoriteShapes: customer.FavoriteShapes,
-		FavoriteColors: customer.FavoriteColors,
-		FavoriteStyles: customer.FavoriteStyles,
-		IsIntrovert:    utils.PgBoolToBool(customer.IsIntrovert),
-		ReferralSource: customer.ReferralSource,
-		Referrer:       utils.PgTextToString(customer.Referrer),
-		CustomerNote:   utils.PgTextToString(customer.CustomerNote),
-		StoreNote:      utils.PgTextToString(customer.StoreNote),
-		Level:          utils.PgTextToString(customer.Level),
-		IsBlacklisted:  utils.PgBoolToBool(customer.IsBlacklisted),
-		LastVisitAt:    utils.PgTimestamptzToTimeString(customer.LastVisitAt),
-		CreatedAt:      utils.PgTimestamptzToTimeString(customer.CreatedAt),
-		UpdatedAt:      utils.PgTimestamptzToTimeString(customer.UpdatedAt),
+		ID:              utils.FormatID(customer.ID),
+		Name:            customer.Name,
+		LineName:        utils.PgTextToString(customer.LineName),
+		Phone:           customer.Phone,
+		Birthday:        utils.PgDateToDateString(customer.Birthday),
+		Email:           utils.PgTextToString(customer.Email),
+		City:            utils.PgTextToString(customer.City),
+		FavoriteShapes:  customer.FavoriteShapes,
+		FavoriteColors:  customer.FavoriteColors,
+		FavoriteStyles:  customer.FavoriteStyles,
+		IsIntrovert:     utils.PgBoolToBool(customer.IsIntrovert),
+		ReferralSource:  customer.ReferralSource,
+		Referrer:        utils.PgTextToString(customer.Referrer),
+		CustomerNote:    utils.PgTextToString(customer.CustomerNote),
+		StoreNote:       utils.PgTextToString(customer.StoreNote),
+		Level:           utils.PgTextToString(customer.Level),
+		IsBlacklisted:   utils.PgBoolToBool(customer.IsBlacklisted),
+		RequiresDeposit: customer.RequiresDeposit,
+		LastVisitAt:     utils.PgTimestamptzToTimeString(customer.LastVisitAt),
+		Tags:            customer.Tags,
+		CreatedAt:       utils.PgTimestamptzToTimeString(customer.CreatedAt),
+		UpdatedAt:       utils.PgTimestamptzToTimeString(customer.UpdatedAt),
 	}, nil
 }
@@ -0,0 +1,67 @@
+package adminCustomerBlacklistDecision
+
+import (
+	"context"
+	"encoding/json"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerBlacklistDecisionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, req adminCustomerBlacklistDecisionModel.GetAllParsedRequest) (*adminCustomerBlacklistDecisionModel.GetAllResponse, error) {
+	total, items, err := s.repo.CustomerBlacklistDecision.GetAllCustomerBlacklistDecisionsByFilter(ctx, sqlxRepo.GetAllCustomerBlacklistDecisionsByFilterParams{
+		CustomerID: req.CustomerID,
+		Status:     req.Status,
+		RuleType:   req.RuleType,
+		Action:     req.Action,
+		Limit:      &req.Limit,
+		Offset:     &req.Offset,
+		Sort:       &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist decisions", err)
+	}
+
+	responseItems := make([]adminCustomerBlacklistDecisionModel.GetAllItem, len(items))
+	for i, item := range items {
+		evidence := []common.BlacklistEvidence{}
+		if err := json.Unmarshal(item.Evidence, &evidence); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to unmarshal blacklist evidence", err)
+		}
+
+		responseItems[i] = adminCustomerBlacklistDecisionModel.GetAllItem{
+			ID:             utils.FormatID(item.ID),
+			CustomerID:     utils.FormatID(item.CustomerID),
+			CustomerName:   item.CustomerName,
+			CustomerPhone:  item.CustomerPhone,
+			RuleType:       item.RuleType,
+			Action:         item.Action,
+			ThresholdCount: item.ThresholdCount,
+			PeriodMonths:   item.PeriodMonths,
+			Evidence:       evidence,
+			Status:         item.Status,
+			OverturnedBy:   utils.PgInt8ToIDString(item.OverturnedBy),
+			OverturnReason: utils.PgTextToString(item.OverturnReason),
+			OverturnedAt:   utils.PgTimestamptzToTimeString(item.OverturnedAt),
+			CreatedAt:      utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCustomerBlacklistDecisionModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
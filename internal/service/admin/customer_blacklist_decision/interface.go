@@ -0,0 +1,15 @@
+package adminCustomerBlacklistDecision
+
+import (
+	"context"
+
+	adminCustomerBlacklistDecisionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_blacklist_decision"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, req adminCustomerBlacklistDecisionModel.GetAllParsedRequest) (*adminCustomerBlacklistDecisionModel.GetAllResponse, error)
+}
+
+type OverturnInterface interface {
+	Overturn(ctx context.Context, decisionID int64, req adminCustomerBlacklistDecisionModel.OverturnRequest, staffID int64) (*adminCustomerBlacklistDecisionModel.OverturnResponse, error)
+}
@@ -0,0 +1,101 @@
+package adminCustomerBlacklistDecision
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerBlacklistDecisionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_blacklist_decision"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/blacklist"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Overturn struct {
+	queries   *dbgen.Queries
+	db        *pgxpool.Pool
+	authCache cache.AuthCacheInterface
+}
+
+func NewOverturn(queries *dbgen.Queries, db *pgxpool.Pool, authCache cache.AuthCacheInterface) OverturnInterface {
+	return &Overturn{
+		queries:   queries,
+		db:        db,
+		authCache: authCache,
+	}
+}
+
+func (s *Overturn) Overturn(ctx context.Context, decisionID int64, req adminCustomerBlacklistDecisionModel.OverturnRequest, staffID int64) (*adminCustomerBlacklistDecisionModel.OverturnResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	decision, err := qtx.GetCustomerBlacklistDecisionByIDForUpdate(ctx, decisionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerBlacklistDecisionNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist decision", err)
+	}
+	if decision.Status != common.BlacklistDecisionStatusActive {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerBlacklistDecisionAlreadyOverturned)
+	}
+
+	// lock the customer before checking the other decisions, the same lock is taken by the escalation
+	if _, err := qtx.GetCustomerBlacklistStateForUpdate(ctx, decision.CustomerID); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist state", err)
+	}
+
+	if err := qtx.OverturnCustomerBlacklistDecision(ctx, dbgen.OverturnCustomerBlacklistDecisionParams{
+		ID:             decisionID,
+		OverturnedBy:   utils.Int64PtrToPgInt8(&staffID),
+		OverturnReason: utils.StringPtrToPgText(&req.Reason, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to overturn customer blacklist decision", err)
+	}
+
+	// the flag is kept while another active decision has the same action
+	activeCount, err := qtx.CountActiveCustomerBlacklistDecisionsByAction(ctx, dbgen.CountActiveCustomerBlacklistDecisionsByActionParams{
+		CustomerID: decision.CustomerID,
+		Action:     decision.Action,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count active customer blacklist decisions", err)
+	}
+	if activeCount == 0 {
+		if err := blacklist.SetCustomerFlag(ctx, qtx, decision.CustomerID, decision.Action, false); err != nil {
+			return nil, err
+		}
+	}
+
+	state, err := qtx.GetCustomerBlacklistStateForUpdate(ctx, decision.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist state", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	if cacheErr := s.authCache.DeleteCustomerContext(ctx, decision.CustomerID); cacheErr != nil {
+		log.Println("failed to delete customer context from cache", cacheErr)
+	}
+
+	return &adminCustomerBlacklistDecisionModel.OverturnResponse{
+		ID:              utils.FormatID(decisionID),
+		CustomerID:      utils.FormatID(decision.CustomerID),
+		Status:          common.BlacklistDecisionStatusOverturned,
+		IsBlacklisted:   utils.PgBoolToBool(state.IsBlacklisted),
+		RequiresDeposit: state.RequiresDeposit,
+	}, nil
+}
@@ -30,24 +30,26 @@ func (s *Create) Create(ctx context.Context, req adminCustomerCouponModel.Create
 	if !exists {
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
 	}
-	couponExists, err := s.queries.CheckCouponExists(ctx, req.CouponId)
+	coupons, err := s.queries.GetCouponByIDs(ctx, []int64{req.CouponId})
 	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon", err)
 	}
-	if !couponExists {
+	if len(coupons) == 0 {
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
 	}
 
-	// check customer coupon already exists
-	customerCouponExists, err := s.queries.CheckCustomerCouponExists(ctx, dbgen.CheckCustomerCouponExistsParams{
-		CustomerID: req.CustomerId,
-		CouponID:   req.CouponId,
-	})
-	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer coupon existence", err)
-	}
-	if customerCouponExists {
-		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponAlreadyExists)
+	// check customer coupon already exists, repeatable coupons can be issued multiple times
+	if !coupons[0].IsRepeatable {
+		customerCouponExists, err := s.queries.CheckCustomerCouponExists(ctx, dbgen.CheckCustomerCouponExistsParams{
+			CustomerID: req.CustomerId,
+			CouponID:   req.CouponId,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer coupon existence", err)
+		}
+		if customerCouponExists {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponAlreadyExists)
+		}
 	}
 
 	validFrom, validTo, err := s.setValidFromAndTo(req.Period)
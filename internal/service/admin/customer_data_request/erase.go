@@ -0,0 +1,66 @@
+package adminCustomerDataRequest
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerDataRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/privacy"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Erase struct {
+	db        *pgxpool.Pool
+	authCache cache.AuthCacheInterface
+}
+
+func NewErase(db *pgxpool.Pool, authCache cache.AuthCacheInterface) EraseInterface {
+	return &Erase{
+		db:        db,
+		authCache: authCache,
+	}
+}
+
+func (s *Erase) Erase(ctx context.Context, customerID int64, req adminCustomerDataRequestModel.EraseRequest, staffID int64) (*adminCustomerDataRequestModel.EraseResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := privacy.Erase(ctx, qtx, customerID); err != nil {
+		return nil, err
+	}
+
+	// every personal data request is logged with the staff who handled it
+	if err := qtx.CreateCustomerDataRequest(ctx, dbgen.CreateCustomerDataRequestParams{
+		ID:          utils.GenerateID(),
+		CustomerID:  customerID,
+		RequestType: common.CustomerDataRequestTypeErasure,
+		RequestedBy: common.CustomerDataRequestedByStaff,
+		StaffUserID: utils.Int64PtrToPgInt8(&staffID),
+		Note:        utils.StringPtrToPgText(req.Note, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer data request", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	if cacheErr := s.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+		log.Println("failed to delete customer context from cache", cacheErr)
+	}
+
+	return &adminCustomerDataRequestModel.EraseResponse{
+		ID: utils.FormatID(customerID),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package adminCustomerDataRequest
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerDataRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/privacy"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Export struct {
+	queries *dbgen.Queries
+}
+
+func NewExport(queries *dbgen.Queries) ExportInterface {
+	return &Export{
+		queries: queries,
+	}
+}
+
+func (s *Export) Export(ctx context.Context, customerID int64, staffID int64) (*adminCustomerDataRequestModel.ExportResponse, error) {
+	export, err := privacy.BuildExport(ctx, s.queries, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// every personal data request is logged with the staff who handled it
+	if err := s.queries.CreateCustomerDataRequest(ctx, dbgen.CreateCustomerDataRequestParams{
+		ID:          utils.GenerateID(),
+		CustomerID:  customerID,
+		RequestType: common.CustomerDataRequestTypeExport,
+		RequestedBy: common.CustomerDataRequestedByStaff,
+		StaffUserID: utils.Int64PtrToPgInt8(&staffID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer data request", err)
+	}
+
+	return export, nil
+}
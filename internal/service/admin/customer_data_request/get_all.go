@@ -0,0 +1,54 @@
+package adminCustomerDataRequest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerDataRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_data_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64) (*adminCustomerDataRequestModel.GetAllResponse, error) {
+	// erased customers are still found, their data requests are kept as the record of the erasure
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	requests, err := s.queries.GetCustomerDataRequestsByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer data requests", err)
+	}
+
+	items := make([]adminCustomerDataRequestModel.GetAllItem, len(requests))
+	for i, request := range requests {
+		items[i] = adminCustomerDataRequestModel.GetAllItem{
+			ID:          utils.FormatID(request.ID),
+			RequestType: request.RequestType,
+			RequestedBy: request.RequestedBy,
+			StaffUserID: utils.PgInt8ToIDString(request.StaffUserID),
+			Note:        utils.PgTextToString(request.Note),
+			CreatedAt:   utils.PgTimestamptzToTimeString(request.CreatedAt),
+		}
+	}
+
+	return &adminCustomerDataRequestModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
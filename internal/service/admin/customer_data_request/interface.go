@@ -0,0 +1,19 @@
+package adminCustomerDataRequest
+
+import (
+	"context"
+
+	adminCustomerDataRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_data_request"
+)
+
+type ExportInterface interface {
+	Export(ctx context.Context, customerID int64, staffID int64) (*adminCustomerDataRequestModel.ExportResponse, error)
+}
+
+type EraseInterface interface {
+	Erase(ctx context.Context, customerID int64, req adminCustomerDataRequestModel.EraseRequest, staffID int64) (*adminCustomerDataRequestModel.EraseResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64) (*adminCustomerDataRequestModel.GetAllResponse, error)
+}
@@ -0,0 +1,73 @@
+package adminCustomerHealthQuestionnaire
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64, req adminCustomerHealthQuestionnaireModel.GetAllParsedRequest) (*adminCustomerHealthQuestionnaireModel.GetAllResponse, error) {
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	total, err := s.queries.CountCustomerHealthQuestionnairesByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count customer health questionnaires", err)
+	}
+
+	questionnaires, err := s.queries.GetCustomerHealthQuestionnairesByCustomerID(ctx, dbgen.GetCustomerHealthQuestionnairesByCustomerIDParams{
+		CustomerID:  customerID,
+		LimitCount:  int32(req.Limit),
+		OffsetCount: int32(req.Offset),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer health questionnaires", err)
+	}
+
+	items := make([]adminCustomerHealthQuestionnaireModel.GetAllItem, len(questionnaires))
+	for i, questionnaire := range questionnaires {
+		items[i] = adminCustomerHealthQuestionnaireModel.GetAllItem{
+			ID:                utils.FormatID(questionnaire.ID),
+			Version:           questionnaire.Version,
+			Allergies:         emptyIfNil(questionnaire.Allergies),
+			AllergyNote:       utils.PgTextToString(questionnaire.AllergyNote),
+			SkinConditions:    emptyIfNil(questionnaire.SkinConditions),
+			SkinConditionNote: utils.PgTextToString(questionnaire.SkinConditionNote),
+			IsPregnant:        questionnaire.IsPregnant,
+			OtherNote:         utils.PgTextToString(questionnaire.OtherNote),
+			AnsweredAt:        utils.PgTimestamptzToTimeString(questionnaire.CreatedAt),
+		}
+	}
+
+	return &adminCustomerHealthQuestionnaireModel.GetAllResponse{
+		Total: int(total),
+		Items: items,
+	}, nil
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,11 @@
+package adminCustomerHealthQuestionnaire
+
+import (
+	"context"
+
+	adminCustomerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_health_questionnaire"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64, req adminCustomerHealthQuestionnaireModel.GetAllParsedRequest) (*adminCustomerHealthQuestionnaireModel.GetAllResponse, error)
+}
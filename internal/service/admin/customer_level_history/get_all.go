@@ -0,0 +1,63 @@
+package adminCustomerLevelHistory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLevelHistoryModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_history"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64, req adminCustomerLevelHistoryModel.GetAllParsedRequest) (*adminCustomerLevelHistoryModel.GetAllResponse, error) {
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	total, items, err := s.repo.CustomerLevelHistory.GetAllCustomerLevelHistoriesByFilter(ctx, customerID, sqlxRepo.GetAllCustomerLevelHistoriesByFilterParams{
+		Reason: req.Reason,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer level histories", err)
+	}
+
+	responseItems := make([]adminCustomerLevelHistoryModel.GetAllItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminCustomerLevelHistoryModel.GetAllItem{
+			ID:        utils.FormatID(item.ID),
+			FromLevel: utils.PgTextToString(item.FromLevel),
+			ToLevel:   item.ToLevel,
+			Reason:    item.Reason,
+			Note:      utils.PgTextToString(item.Note),
+			CreatedBy: utils.PgInt8ToIDString(item.CreatedBy),
+			CreatedAt: utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCustomerLevelHistoryModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package adminCustomerLevelHistory
+
+import (
+	"context"
+
+	adminCustomerLevelHistoryModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_history"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64, req adminCustomerLevelHistoryModel.GetAllParsedRequest) (*adminCustomerLevelHistoryModel.GetAllResponse, error)
+}
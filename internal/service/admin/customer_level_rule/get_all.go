@@ -0,0 +1,56 @@
+package adminCustomerLevelRule
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLevelRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context) (*adminCustomerLevelRuleModel.GetAllResponse, error) {
+	rules, err := s.queries.GetAllCustomerLevelRules(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer level rules", err)
+	}
+
+	items := make([]adminCustomerLevelRuleModel.GetAllItem, len(rules))
+	for i, rule := range rules {
+		minSpendAmount, err := utils.PgNumericToInt64(rule.MinSpendAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert min spend amount", err)
+		}
+
+		var benefitCouponID *string
+		if rule.BenefitCouponID.Valid {
+			id := utils.FormatID(rule.BenefitCouponID.Int64)
+			benefitCouponID = &id
+		}
+
+		items[i] = adminCustomerLevelRuleModel.GetAllItem{
+			Level:                    rule.Level,
+			IsActive:                 rule.IsActive,
+			PeriodMonths:             rule.PeriodMonths,
+			MinSpendAmount:           minSpendAmount,
+			MinVisitCount:            rule.MinVisitCount,
+			BenefitCouponID:          benefitCouponID,
+			BenefitCouponValidMonths: utils.PgInt4ToInt32Ptr(rule.BenefitCouponValidMonths),
+			UpdatedAt:                utils.PgTimestamptzToTimeString(rule.UpdatedAt),
+		}
+	}
+
+	return &adminCustomerLevelRuleModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminCustomerLevelRule
+
+import (
+	"context"
+
+	adminCustomerLevelRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_rule"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context) (*adminCustomerLevelRuleModel.GetAllResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, req adminCustomerLevelRuleModel.UpdateParsedRequest) (*adminCustomerLevelRuleModel.UpdateResponse, error)
+}
@@ -0,0 +1,59 @@
+package adminCustomerLevelRule
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLevelRuleModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_level_rule"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, req adminCustomerLevelRuleModel.UpdateParsedRequest) (*adminCustomerLevelRuleModel.UpdateResponse, error) {
+	// a rule without any threshold would promote every customer with a checkout
+	if req.MinSpendAmount == 0 && req.MinVisitCount == 0 {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerLevelRuleThresholdRequired)
+	}
+
+	if req.BenefitCouponID != nil {
+		exists, err := s.queries.CheckCouponExists(ctx, *req.BenefitCouponID)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		}
+		if !exists {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+		}
+	}
+
+	minSpendAmount, err := utils.Int64PtrToPgNumeric(&req.MinSpendAmount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert min spend amount", err)
+	}
+
+	// the rule applies to the next evaluation, customers already promoted keep their level
+	if err := s.queries.UpsertCustomerLevelRule(ctx, dbgen.UpsertCustomerLevelRuleParams{
+		Level:                    req.Level,
+		IsActive:                 req.IsActive,
+		PeriodMonths:             req.PeriodMonths,
+		MinSpendAmount:           minSpendAmount,
+		MinVisitCount:            req.MinVisitCount,
+		BenefitCouponID:          utils.Int64PtrToPgInt8(req.BenefitCouponID),
+		BenefitCouponValidMonths: utils.Int32PtrToPgInt4(req.BenefitCouponValidMonths),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer level rule", err)
+	}
+
+	return &adminCustomerLevelRuleModel.UpdateResponse{
+		Level: req.Level,
+	}, nil
+}
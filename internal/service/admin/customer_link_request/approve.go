@@ -0,0 +1,103 @@
+package adminCustomerLinkRequest
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLinkRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_link_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Approve struct {
+	queries   *dbgen.Queries
+	db        *pgxpool.Pool
+	authCache cache.AuthCacheInterface
+}
+
+func NewApprove(queries *dbgen.Queries, db *pgxpool.Pool, authCache cache.AuthCacheInterface) ApproveInterface {
+	return &Approve{
+		queries:   queries,
+		db:        db,
+		authCache: authCache,
+	}
+}
+
+// Approve merges the customer registered with LINE into the walk-in customer of the link request in one transaction,
+// the LINE account, tokens and history move to the walk-in customer (see merge.Customer).
+func (s *Approve) Approve(ctx context.Context, linkRequestID int64, req adminCustomerLinkRequestModel.ReviewRequest, staffID int64) (*adminCustomerLinkRequestModel.ReviewResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	linkRequest, err := getPendingLinkRequest(ctx, qtx, linkRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := merge.Customer(ctx, qtx, merge.CustomerParams{
+		CustomerID:       linkRequest.LinkCustomerID,
+		MergedCustomerID: linkRequest.CustomerID,
+		Note:             req.Note,
+		StaffID:          staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := qtx.UpdateCustomerLinkRequestReviewed(ctx, dbgen.UpdateCustomerLinkRequestReviewedParams{
+		ID:              linkRequestID,
+		Status:          common.CustomerLinkRequestStatusApproved,
+		CustomerMergeID: utils.Int64PtrToPgInt8(&result.ID),
+		Note:            utils.StringPtrToPgText(req.Note, true),
+		ReviewedBy:      utils.Int64PtrToPgInt8(&staffID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer link request", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	for _, id := range []int64{linkRequest.LinkCustomerID, linkRequest.CustomerID} {
+		if cacheErr := s.authCache.DeleteCustomerContext(ctx, id); cacheErr != nil {
+			log.Println("failed to delete customer context from cache", cacheErr)
+		}
+	}
+
+	return &adminCustomerLinkRequestModel.ReviewResponse{
+		ID:              utils.FormatID(linkRequestID),
+		CustomerID:      utils.FormatID(linkRequest.CustomerID),
+		LinkCustomerID:  utils.FormatID(linkRequest.LinkCustomerID),
+		Status:          common.CustomerLinkRequestStatusApproved,
+		CustomerMergeID: utils.FormatID(result.ID),
+	}, nil
+}
+
+// getPendingLinkRequest locks the link request and makes sure it has not been reviewed
+func getPendingLinkRequest(ctx context.Context, qtx *dbgen.Queries, linkRequestID int64) (dbgen.CustomerLinkRequest, error) {
+	linkRequest, err := qtx.GetCustomerLinkRequestByIDForUpdate(ctx, linkRequestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return linkRequest, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerLinkRequestNotFound)
+		}
+		return linkRequest, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer link request", err)
+	}
+	if linkRequest.Status != common.CustomerLinkRequestStatusPending {
+		return linkRequest, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerLinkRequestAlreadyReviewed)
+	}
+
+	return linkRequest, nil
+}
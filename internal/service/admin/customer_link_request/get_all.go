@@ -0,0 +1,48 @@
+package adminCustomerLinkRequest
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLinkRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_link_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+// GetAll returns the pending link requests with both customers, oldest first, so staff can verify the registrant
+func (s *GetAll) GetAll(ctx context.Context) (*adminCustomerLinkRequestModel.GetAllResponse, error) {
+	requests, err := s.queries.GetPendingCustomerLinkRequests(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get pending customer link requests", err)
+	}
+
+	items := make([]adminCustomerLinkRequestModel.GetAllItem, len(requests))
+	for i, request := range requests {
+		items[i] = adminCustomerLinkRequestModel.GetAllItem{
+			ID:                   utils.FormatID(request.ID),
+			CustomerID:           utils.FormatID(request.CustomerID),
+			CustomerName:         request.CustomerName,
+			CustomerLineName:     utils.PgTextToString(request.CustomerLineName),
+			CustomerPhone:        request.CustomerPhone,
+			LinkCustomerID:       utils.FormatID(request.LinkCustomerID),
+			LinkCustomerName:     request.LinkCustomerName,
+			LinkCustomerPhone:    request.LinkCustomerPhone,
+			LinkCustomerBirthday: utils.PgDateToDateString(request.LinkCustomerBirthday),
+			CreatedAt:            utils.PgTimestamptzToTimeString(request.CreatedAt),
+		}
+	}
+
+	return &adminCustomerLinkRequestModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
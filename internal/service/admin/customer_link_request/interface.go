@@ -0,0 +1,19 @@
+package adminCustomerLinkRequest
+
+import (
+	"context"
+
+	adminCustomerLinkRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_link_request"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context) (*adminCustomerLinkRequestModel.GetAllResponse, error)
+}
+
+type ApproveInterface interface {
+	Approve(ctx context.Context, linkRequestID int64, req adminCustomerLinkRequestModel.ReviewRequest, staffID int64) (*adminCustomerLinkRequestModel.ReviewResponse, error)
+}
+
+type RejectInterface interface {
+	Reject(ctx context.Context, linkRequestID int64, req adminCustomerLinkRequestModel.ReviewRequest, staffID int64) (*adminCustomerLinkRequestModel.ReviewResponse, error)
+}
@@ -0,0 +1,61 @@
+package adminCustomerLinkRequest
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerLinkRequestModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_link_request"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Reject struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewReject(queries *dbgen.Queries, db *pgxpool.Pool) RejectInterface {
+	return &Reject{
+		queries: queries,
+		db:      db,
+	}
+}
+
+// Reject closes the link request, the customer registered with LINE stays a separate customer
+func (s *Reject) Reject(ctx context.Context, linkRequestID int64, req adminCustomerLinkRequestModel.ReviewRequest, staffID int64) (*adminCustomerLinkRequestModel.ReviewResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	linkRequest, err := getPendingLinkRequest(ctx, qtx, linkRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := qtx.UpdateCustomerLinkRequestReviewed(ctx, dbgen.UpdateCustomerLinkRequestReviewedParams{
+		ID:         linkRequestID,
+		Status:     common.CustomerLinkRequestStatusRejected,
+		Note:       utils.StringPtrToPgText(req.Note, true),
+		ReviewedBy: utils.Int64PtrToPgInt8(&staffID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer link request", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCustomerLinkRequestModel.ReviewResponse{
+		ID:             utils.FormatID(linkRequestID),
+		CustomerID:     utils.FormatID(linkRequest.CustomerID),
+		LinkCustomerID: utils.FormatID(linkRequest.LinkCustomerID),
+		Status:         common.CustomerLinkRequestStatusRejected,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package adminCustomerMerge
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerMergeModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries   *dbgen.Queries
+	db        *pgxpool.Pool
+	authCache cache.AuthCacheInterface
+}
+
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool, authCache cache.AuthCacheInterface) CreateInterface {
+	return &Create{
+		queries:   queries,
+		db:        db,
+		authCache: authCache,
+	}
+}
+
+// Create merges the duplicate customer into the customer in one transaction, see merge.Customer for what is moved.
+func (s *Create) Create(ctx context.Context, customerID int64, req adminCustomerMergeModel.CreateParsedRequest, staffID int64) (*adminCustomerMergeModel.CreateResponse, error) {
+	if customerID == req.MergedCustomerID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerMergeSameCustomer)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	result, err := merge.Customer(ctx, qtx, merge.CustomerParams{
+		CustomerID:       customerID,
+		MergedCustomerID: req.MergedCustomerID,
+		Note:             req.Note,
+		StaffID:          staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	for _, id := range []int64{customerID, req.MergedCustomerID} {
+		if cacheErr := s.authCache.DeleteCustomerContext(ctx, id); cacheErr != nil {
+			log.Println("failed to delete customer context from cache", cacheErr)
+		}
+	}
+
+	return &adminCustomerMergeModel.CreateResponse{
+		ID:               utils.FormatID(result.ID),
+		CustomerID:       utils.FormatID(customerID),
+		MergedCustomerID: utils.FormatID(req.MergedCustomerID),
+		MovedCounts:      result.MovedCounts,
+	}, nil
+}
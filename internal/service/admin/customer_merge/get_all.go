@@ -0,0 +1,77 @@
+package adminCustomerMerge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerMergeModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_merge"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64) (*adminCustomerMergeModel.GetAllResponse, error) {
+	if _, err := getCustomer(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	merges, err := s.queries.GetCustomerMergesByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer merges", err)
+	}
+
+	items := make([]adminCustomerMergeModel.GetAllItem, len(merges))
+	for i, merge := range merges {
+		// the name is taken from the snapshot, the merged customer may have been renamed since
+		var snapshot struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(merge.MergedCustomerSnapshot, &snapshot); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to unmarshal merged customer snapshot", err)
+		}
+
+		var movedCounts common.CustomerMergeMovedCounts
+		if err := json.Unmarshal(merge.MovedCounts, &movedCounts); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to unmarshal moved counts", err)
+		}
+
+		items[i] = adminCustomerMergeModel.GetAllItem{
+			ID:                 utils.FormatID(merge.ID),
+			MergedCustomerID:   utils.FormatID(merge.MergedCustomerID),
+			MergedCustomerName: snapshot.Name,
+			MovedCounts:        movedCounts,
+			Note:               utils.PgTextToString(merge.Note),
+			CreatedBy:          utils.PgInt8ToIDString(merge.CreatedBy),
+			CreatedAt:          utils.PgTimestamptzToTimeString(merge.CreatedAt),
+		}
+	}
+
+	return &adminCustomerMergeModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
+
+func getCustomer(ctx context.Context, queries *dbgen.Queries, customerID int64) (dbgen.GetCustomerByIDRow, error) {
+	customer, err := queries.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customer, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return customer, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+	return customer, nil
+}
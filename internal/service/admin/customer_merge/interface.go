@@ -0,0 +1,15 @@
+package adminCustomerMerge
+
+import (
+	"context"
+
+	adminCustomerMergeModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_merge"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, customerID int64, req adminCustomerMergeModel.CreateParsedRequest, staffID int64) (*adminCustomerMergeModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64) (*adminCustomerMergeModel.GetAllResponse, error)
+}
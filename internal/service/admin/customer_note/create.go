@@ -0,0 +1,63 @@
+package adminCustomerNote
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+}
+
+func NewCreate(queries *dbgen.Queries) CreateInterface {
+	return &Create{
+		queries: queries,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, customerID int64, req adminCustomerNoteModel.CreateParsedRequest, creatorID int64) (*adminCustomerNoteModel.CreateResponse, error) {
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	// the linked booking must be one of the customer's bookings
+	if req.BookingID != nil {
+		booking, err := s.queries.GetBookingInfoWithDateByID(ctx, *req.BookingID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BookingNotFound)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get booking", err)
+		}
+		if booking.CustomerID != customerID {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNoteBookingNotBelongToCustomer)
+		}
+	}
+
+	noteID := utils.GenerateID()
+	err := s.queries.CreateCustomerNote(ctx, dbgen.CreateCustomerNoteParams{
+		ID:         noteID,
+		CustomerID: customerID,
+		BookingID:  utils.Int64PtrToPgInt8(req.BookingID),
+		Content:    req.Content,
+		IsPinned:   req.IsPinned,
+		CreatedBy:  utils.Int64PtrToPgInt8(&creatorID),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer note", err)
+	}
+
+	return &adminCustomerNoteModel.CreateResponse{
+		ID: utils.FormatID(noteID),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package adminCustomerNote
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Delete struct {
+	queries *dbgen.Queries
+}
+
+func NewDelete(queries *dbgen.Queries) DeleteInterface {
+	return &Delete{
+		queries: queries,
+	}
+}
+
+func (s *Delete) Delete(ctx context.Context, customerID, noteID int64, deleterID int64, deleterRole string) (*adminCustomerNoteModel.DeleteResponse, error) {
+	if err := checkNoteEditable(ctx, s.queries, customerID, noteID, deleterID, deleterRole); err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.DeleteCustomerNote(ctx, noteID); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete customer note", err)
+	}
+
+	return &adminCustomerNoteModel.DeleteResponse{
+		Deleted: utils.FormatID(noteID),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package adminCustomerNote
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64, req adminCustomerNoteModel.GetAllParsedRequest) (*adminCustomerNoteModel.GetAllResponse, error) {
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	total, err := s.queries.CountCustomerNotesByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count customer notes", err)
+	}
+
+	notes, err := s.queries.GetCustomerNotesByCustomerID(ctx, dbgen.GetCustomerNotesByCustomerIDParams{
+		CustomerID:  customerID,
+		LimitCount:  int32(req.Limit),
+		OffsetCount: int32(req.Offset),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer notes", err)
+	}
+
+	items := make([]adminCustomerNoteModel.GetAllItem, len(notes))
+	for i, note := range notes {
+		items[i] = adminCustomerNoteModel.GetAllItem{
+			ID:                utils.FormatID(note.ID),
+			BookingID:         utils.PgInt8ToIDString(note.BookingID),
+			Content:           note.Content,
+			IsPinned:          note.IsPinned,
+			CreatedBy:         utils.PgInt8ToIDString(note.CreatedBy),
+			CreatedByUsername: utils.PgTextToString(note.CreatedByUsername),
+			CreatedAt:         utils.PgTimestamptzToTimeString(note.CreatedAt),
+			UpdatedAt:         utils.PgTimestamptzToTimeString(note.UpdatedAt),
+		}
+	}
+
+	return &adminCustomerNoteModel.GetAllResponse{
+		Total: int(total),
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package adminCustomerNote
+
+import (
+	"context"
+
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, customerID int64, req adminCustomerNoteModel.CreateParsedRequest, creatorID int64) (*adminCustomerNoteModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64, req adminCustomerNoteModel.GetAllParsedRequest) (*adminCustomerNoteModel.GetAllResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, customerID, noteID int64, req adminCustomerNoteModel.UpdateRequest, updaterID int64, updaterRole string) (*adminCustomerNoteModel.UpdateResponse, error)
+}
+
+type DeleteInterface interface {
+	Delete(ctx context.Context, customerID, noteID int64, deleterID int64, deleterRole string) (*adminCustomerNoteModel.DeleteResponse, error)
+}
@@ -0,0 +1,64 @@
+package adminCustomerNote
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerNoteModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_note"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, customerID, noteID int64, req adminCustomerNoteModel.UpdateRequest, updaterID int64, updaterRole string) (*adminCustomerNoteModel.UpdateResponse, error) {
+	if err := checkNoteEditable(ctx, s.queries, customerID, noteID, updaterID, updaterRole); err != nil {
+		return nil, err
+	}
+
+	err := s.queries.UpdateCustomerNote(ctx, dbgen.UpdateCustomerNoteParams{
+		ID:       noteID,
+		Content:  utils.StringPtrToPgText(req.Content, false),
+		IsPinned: utils.BoolPtrToPgBool(req.IsPinned),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer note", err)
+	}
+
+	return &adminCustomerNoteModel.UpdateResponse{
+		ID: utils.FormatID(noteID),
+	}, nil
+}
+
+// checkNoteEditable checks the note belongs to the customer and is written by the staff,
+// managers and above can edit the notes of others.
+func checkNoteEditable(ctx context.Context, queries *dbgen.Queries, customerID, noteID, staffID int64, staffRole string) error {
+	note, err := queries.GetCustomerNoteByID(ctx, noteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNoteNotFound)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer note", err)
+	}
+	if note.CustomerID != customerID {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNoteNotFound)
+	}
+
+	if staffRole == common.RoleStylist && (!note.CreatedBy.Valid || note.CreatedBy.Int64 != staffID) {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNoteNotAuthor)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package adminCustomerPoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerPointModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_point"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/points"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Adjust struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewAdjust(queries *dbgen.Queries, db *pgxpool.Pool) AdjustInterface {
+	return &Adjust{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Adjust) Adjust(ctx context.Context, storeID, customerID int64, req adminCustomerPointModel.AdjustRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerPointModel.AdjustResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	loyaltySetting, err := points.GetEnabledStoreSetting(ctx, s.queries, storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// added points follow the store expiry rule, deducted points use the lots expiring first
+	posted, err := points.PostTransaction(ctx, qtx, points.PostTransactionParams{
+		CustomerID: customerID,
+		StoreID:    &storeID,
+		Type:       common.CustomerPointTransactionTypeAdjust,
+		Points:     req.Points,
+		ExpiresAt:  points.CalculateExpiresAt(loyaltySetting, today),
+		Note:       &req.Note,
+		CreatedBy:  &staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCustomerPointModel.AdjustResponse{
+		CustomerID: utils.FormatID(customerID),
+		Balance:    posted.Balance,
+	}, nil
+}
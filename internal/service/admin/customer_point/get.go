@@ -0,0 +1,60 @@
+package adminCustomerPoint
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerPointModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_point"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, customerID int64) (*adminCustomerPointModel.GetResponse, error) {
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	// customer without point record has zero balance
+	point, err := s.queries.GetCustomerPointByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &adminCustomerPointModel.GetResponse{
+				CustomerID: utils.FormatID(customerID),
+				Balance:    0,
+				UpdatedAt:  "",
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point", err)
+	}
+
+	return &adminCustomerPointModel.GetResponse{
+		CustomerID: utils.FormatID(customerID),
+		Balance:    point.Balance,
+		UpdatedAt:  utils.PgTimestamptzToTimeString(point.UpdatedAt),
+	}, nil
+}
+
+// checkCustomerExists checks the customer exists
+func checkCustomerExists(ctx context.Context, queries *dbgen.Queries, customerID int64) error {
+	if _, err := queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	return nil
+}
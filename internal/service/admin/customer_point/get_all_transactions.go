@@ -0,0 +1,63 @@
+package adminCustomerPoint
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerPointModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_point"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAllTransactions struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAllTransactions(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllTransactionsInterface {
+	return &GetAllTransactions{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAllTransactions) GetAllTransactions(ctx context.Context, customerID int64, req adminCustomerPointModel.GetAllTransactionsParsedRequest) (*adminCustomerPointModel.GetAllTransactionsResponse, error) {
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	total, items, err := s.repo.CustomerPointTransaction.GetAllCustomerPointTransactionsByFilter(ctx, customerID, sqlxRepo.GetAllCustomerPointTransactionsByFilterParams{
+		Type:   req.Type,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point transactions", err)
+	}
+
+	responseItems := make([]adminCustomerPointModel.GetAllTransactionsItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminCustomerPointModel.GetAllTransactionsItem{
+			ID:              utils.FormatID(item.ID),
+			StoreID:         utils.PgInt8ToIDString(item.StoreID),
+			StoreName:       utils.PgTextToString(item.StoreName),
+			Type:            item.Type,
+			Points:          item.Points,
+			Balance:         item.Balance,
+			RemainingPoints: item.RemainingPoints,
+			ExpiresAt:       utils.PgDateToDateString(item.ExpiresAt),
+			SourceType:      utils.PgTextToString(item.SourceType),
+			SourceID:        utils.PgInt8ToIDString(item.SourceID),
+			Note:            utils.PgTextToString(item.Note),
+			CreatedBy:       utils.PgInt8ToIDString(item.CreatedBy),
+			CreatedAt:       utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCustomerPointModel.GetAllTransactionsResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package adminCustomerPoint
+
+import (
+	"context"
+
+	adminCustomerPointModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_point"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context, customerID int64) (*adminCustomerPointModel.GetResponse, error)
+}
+
+type GetAllTransactionsInterface interface {
+	GetAllTransactions(ctx context.Context, customerID int64, req adminCustomerPointModel.GetAllTransactionsParsedRequest) (*adminCustomerPointModel.GetAllTransactionsResponse, error)
+}
+
+type AdjustInterface interface {
+	Adjust(ctx context.Context, storeID, customerID int64, req adminCustomerPointModel.AdjustRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerPointModel.AdjustResponse, error)
+}
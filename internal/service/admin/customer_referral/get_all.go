@@ -0,0 +1,68 @@
+package adminCustomerReferral
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerReferralModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_referral"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, customerID int64, req adminCustomerReferralModel.GetAllParsedRequest) (*adminCustomerReferralModel.GetAllResponse, error) {
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	total, items, err := s.repo.CustomerReferral.GetAllCustomerReferralsByFilter(ctx, customerID, sqlxRepo.GetAllCustomerReferralsByFilterParams{
+		Status: req.Status,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer referrals", err)
+	}
+
+	responseItems := make([]adminCustomerReferralModel.GetAllItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminCustomerReferralModel.GetAllItem{
+			ID: utils.FormatID(item.ID),
+			Referee: adminCustomerReferralModel.GetAllRefereeDTO{
+				ID:    utils.FormatID(item.RefereeCustomerID),
+				Name:  item.RefereeName,
+				Phone: item.RefereePhone,
+			},
+			Status:                   item.Status,
+			FirstCheckoutID:          utils.PgInt8ToIDString(item.FirstCheckoutID),
+			ReferrerCustomerCouponID: utils.PgInt8ToIDString(item.ReferrerCustomerCouponID),
+			RefereeCustomerCouponID:  utils.PgInt8ToIDString(item.RefereeCustomerCouponID),
+			CompletedAt:              utils.PgTimestamptzToTimeString(item.CompletedAt),
+			CreatedAt:                utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCustomerReferralModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
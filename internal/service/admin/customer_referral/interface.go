@@ -0,0 +1,11 @@
+package adminCustomerReferral
+
+import (
+	"context"
+
+	adminCustomerReferralModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_referral"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, customerID int64, req adminCustomerReferralModel.GetAllParsedRequest) (*adminCustomerReferralModel.GetAllResponse, error)
+}
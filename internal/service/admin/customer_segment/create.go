@@ -0,0 +1,60 @@
+package adminCustomerSegment
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+}
+
+func NewCreate(queries *dbgen.Queries) CreateInterface {
+	return &Create{
+		queries: queries,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, req adminCustomerSegmentModel.CreateRequest, creatorID int64) (*adminCustomerSegmentModel.CreateResponse, error) {
+	if req.MinTotalSpend != nil && req.MaxTotalSpend != nil && *req.MinTotalSpend > *req.MaxTotalSpend {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerSegmentSpendRangeInvalid)
+	}
+	if req.MinVisitCount != nil && req.MaxVisitCount != nil && *req.MinVisitCount > *req.MaxVisitCount {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerSegmentVisitRangeInvalid)
+	}
+
+	minTotalSpend, err := utils.Int64PtrToPgNumeric(req.MinTotalSpend)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert min total spend", err)
+	}
+	maxTotalSpend, err := utils.Int64PtrToPgNumeric(req.MaxTotalSpend)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert max total spend", err)
+	}
+
+	segmentID := utils.GenerateID()
+	if err := s.queries.CreateCustomerSegment(ctx, dbgen.CreateCustomerSegmentParams{
+		ID:                segmentID,
+		Name:              req.Name,
+		Description:       utils.StringPtrToPgText(req.Description, true),
+		Level:             utils.StringPtrToPgText(req.Level, true),
+		IsBlacklisted:     utils.BoolPtrToPgBool(req.IsBlacklisted),
+		MinPastDays:       utils.Int32PtrToPgInt4(req.MinPastDays),
+		SpendPeriodMonths: utils.Int32PtrToPgInt4(req.SpendPeriodMonths),
+		MinTotalSpend:     minTotalSpend,
+		MaxTotalSpend:     maxTotalSpend,
+		MinVisitCount:     utils.Int32PtrToPgInt4(req.MinVisitCount),
+		MaxVisitCount:     utils.Int32PtrToPgInt4(req.MaxVisitCount),
+		CreatedBy:         creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer segment", err)
+	}
+
+	return &adminCustomerSegmentModel.CreateResponse{
+		ID: utils.FormatID(segmentID),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package adminCustomerSegment
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, req adminCustomerSegmentModel.GetAllParsedRequest) (*adminCustomerSegmentModel.GetAllResponse, error) {
+	total, items, err := s.repo.CustomerSegment.GetAllCustomerSegmentsByFilter(ctx, sqlxRepo.GetAllCustomerSegmentsByFilterParams{
+		Name:   req.Name,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer segments", err)
+	}
+
+	responseItems := make([]adminCustomerSegmentModel.GetAllItem, len(items))
+	for i, item := range items {
+		minTotalSpend, err := numericToInt64Ptr(item.MinTotalSpend)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert min total spend", err)
+		}
+		maxTotalSpend, err := numericToInt64Ptr(item.MaxTotalSpend)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert max total spend", err)
+		}
+
+		var isBlacklisted *bool
+		if item.IsBlacklisted.Valid {
+			isBlacklisted = &item.IsBlacklisted.Bool
+		}
+
+		responseItems[i] = adminCustomerSegmentModel.GetAllItem{
+			ID:                utils.FormatID(item.ID),
+			Name:              item.Name,
+			Description:       utils.PgTextToString(item.Description),
+			Level:             utils.PgTextToString(item.Level),
+			IsBlacklisted:     isBlacklisted,
+			MinPastDays:       utils.PgInt4ToInt32Ptr(item.MinPastDays),
+			SpendPeriodMonths: utils.PgInt4ToInt32Ptr(item.SpendPeriodMonths),
+			MinTotalSpend:     minTotalSpend,
+			MaxTotalSpend:     maxTotalSpend,
+			MinVisitCount:     utils.PgInt4ToInt32Ptr(item.MinVisitCount),
+			MaxVisitCount:     utils.PgInt4ToInt32Ptr(item.MaxVisitCount),
+			CreatedAt:         utils.PgTimestamptzToTimeString(item.CreatedAt),
+			UpdatedAt:         utils.PgTimestamptzToTimeString(item.UpdatedAt),
+		}
+	}
+
+	return &adminCustomerSegmentModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
+
+// numericToInt64Ptr converts the nullable amount, nil is returned when it is null
+func numericToInt64Ptr(n pgtype.Numeric) (*int64, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	value, err := utils.PgNumericToInt64(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
@@ -0,0 +1,68 @@
+package adminCustomerSegment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetCustomers struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetCustomers(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetCustomersInterface {
+	return &GetCustomers{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetCustomers) GetCustomers(ctx context.Context, segmentID int64, req adminCustomerSegmentModel.GetCustomersParsedRequest) (*adminCustomerSegmentModel.GetCustomersResponse, error) {
+	customerSegment, err := s.queries.GetCustomerSegmentByID(ctx, segmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerSegmentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer segment", err)
+	}
+
+	filter, err := segment.Filter(customerSegment)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = &req.Limit
+	filter.Offset = &req.Offset
+	filter.Sort = &req.Sort
+
+	total, results, err := s.repo.Customer.GetAllCustomersByFilter(ctx, filter)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get segment customers", err)
+	}
+
+	items := make([]adminCustomerSegmentModel.GetCustomersItem, len(results))
+	for i, result := range results {
+		items[i] = adminCustomerSegmentModel.GetCustomersItem{
+			ID:            utils.FormatID(result.ID),
+			Name:          result.Name,
+			LineName:      utils.PgTextToString(result.LineName),
+			Phone:         result.Phone,
+			Level:         utils.PgTextToString(result.Level),
+			IsBlacklisted: utils.PgBoolToBool(result.IsBlacklisted),
+			LastVisitAt:   utils.PgTimestamptzToTimeString(result.LastVisitAt),
+		}
+	}
+
+	return &adminCustomerSegmentModel.GetCustomersResponse{
+		Total: total,
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package adminCustomerSegment
+
+import (
+	"context"
+
+	adminCustomerSegmentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_segment"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminCustomerSegmentModel.CreateRequest, creatorID int64) (*adminCustomerSegmentModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, req adminCustomerSegmentModel.GetAllParsedRequest) (*adminCustomerSegmentModel.GetAllResponse, error)
+}
+
+type GetCustomersInterface interface {
+	GetCustomers(ctx context.Context, segmentID int64, req adminCustomerSegmentModel.GetCustomersParsedRequest) (*adminCustomerSegmentModel.GetCustomersResponse, error)
+}
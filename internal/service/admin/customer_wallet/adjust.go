@@ -0,0 +1,66 @@
+package adminCustomerWallet
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Adjust struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewAdjust(queries *dbgen.Queries, db *pgxpool.Pool) AdjustInterface {
+	return &Adjust{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Adjust) Adjust(ctx context.Context, storeID, customerID int64, req adminCustomerWalletModel.AdjustRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerWalletModel.AdjustResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// adjustment only corrects the wallet balance, no account transaction is posted
+	posted, err := wallet.PostTransaction(ctx, qtx, wallet.PostTransactionParams{
+		CustomerID: customerID,
+		StoreID:    &storeID,
+		Type:       common.CustomerWalletTransactionTypeAdjust,
+		Amount:     req.Amount,
+		Note:       &req.Note,
+		CreatedBy:  &staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCustomerWalletModel.AdjustResponse{
+		CustomerID: utils.FormatID(customerID),
+		Balance:    posted.Balance,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package adminCustomerWallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, customerID int64) (*adminCustomerWalletModel.GetResponse, error) {
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	// customer without wallet has zero balance
+	wallet, err := s.queries.GetCustomerWalletByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &adminCustomerWalletModel.GetResponse{
+				CustomerID: utils.FormatID(customerID),
+				Balance:    0,
+				UpdatedAt:  "",
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet", err)
+	}
+
+	balance, err := utils.PgNumericToInt64(wallet.Balance)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance to int64", err)
+	}
+
+	return &adminCustomerWalletModel.GetResponse{
+		CustomerID: utils.FormatID(customerID),
+		Balance:    balance,
+		UpdatedAt:  utils.PgTimestamptzToTimeString(wallet.UpdatedAt),
+	}, nil
+}
+
+// checkCustomerExists checks the customer exists
+func checkCustomerExists(ctx context.Context, queries *dbgen.Queries, customerID int64) error {
+	if _, err := queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package adminCustomerWallet
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAllTransactions struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAllTransactions(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllTransactionsInterface {
+	return &GetAllTransactions{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAllTransactions) GetAllTransactions(ctx context.Context, customerID int64, req adminCustomerWalletModel.GetAllTransactionsParsedRequest) (*adminCustomerWalletModel.GetAllTransactionsResponse, error) {
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	total, items, err := s.repo.CustomerWalletTransaction.GetAllCustomerWalletTransactionsByFilter(ctx, customerID, sqlxRepo.GetAllCustomerWalletTransactionsByFilterParams{
+		Type:   req.Type,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet transactions", err)
+	}
+
+	responseItems := make([]adminCustomerWalletModel.GetAllTransactionsItem, len(items))
+	for i, item := range items {
+		amount, err := utils.PgNumericToInt64(item.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+		balance, err := utils.PgNumericToInt64(item.Balance)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance to int64", err)
+		}
+
+		responseItems[i] = adminCustomerWalletModel.GetAllTransactionsItem{
+			ID:            utils.FormatID(item.ID),
+			StoreID:       utils.PgInt8ToIDString(item.StoreID),
+			StoreName:     utils.PgTextToString(item.StoreName),
+			Type:          item.Type,
+			Amount:        amount,
+			Balance:       balance,
+			PaymentMethod: utils.PgTextToString(item.PaymentMethod),
+			SourceType:    utils.PgTextToString(item.SourceType),
+			SourceID:      utils.PgInt8ToIDString(item.SourceID),
+			Note:          utils.PgTextToString(item.Note),
+			CreatedBy:     utils.PgInt8ToIDString(item.CreatedBy),
+			CreatedAt:     utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminCustomerWalletModel.GetAllTransactionsResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package adminCustomerWallet
+
+import (
+	"context"
+
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context, customerID int64) (*adminCustomerWalletModel.GetResponse, error)
+}
+
+type GetAllTransactionsInterface interface {
+	GetAllTransactions(ctx context.Context, customerID int64, req adminCustomerWalletModel.GetAllTransactionsParsedRequest) (*adminCustomerWalletModel.GetAllTransactionsResponse, error)
+}
+
+type TopUpInterface interface {
+	TopUp(ctx context.Context, storeID, customerID int64, req adminCustomerWalletModel.TopUpRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerWalletModel.TopUpResponse, error)
+}
+
+type AdjustInterface interface {
+	Adjust(ctx context.Context, storeID, customerID int64, req adminCustomerWalletModel.AdjustRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerWalletModel.AdjustResponse, error)
+}
+
+type RedeemInterface interface {
+	Redeem(ctx context.Context, customerID int64, req adminCustomerWalletModel.RedeemRequest, staffID int64) (*adminCustomerWalletModel.RedeemResponse, error)
+}
@@ -0,0 +1,59 @@
+package adminCustomerWallet
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Redeem struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewRedeem(queries *dbgen.Queries, db *pgxpool.Pool) RedeemInterface {
+	return &Redeem{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Redeem) Redeem(ctx context.Context, customerID int64, req adminCustomerWalletModel.RedeemRequest, staffID int64) (*adminCustomerWalletModel.RedeemResponse, error) {
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	result, err := wallet.RedeemGiftCard(ctx, qtx, wallet.RedeemGiftCardParams{
+		Code:       strings.ToUpper(strings.TrimSpace(req.Code)),
+		CustomerID: customerID,
+		CreatedBy:  &staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCustomerWalletModel.RedeemResponse{
+		CustomerID: utils.FormatID(customerID),
+		Amount:     result.Amount,
+		Balance:    result.Balance,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package adminCustomerWallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminCustomerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cashdrawer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type TopUp struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewTopUp(queries *dbgen.Queries, db *pgxpool.Pool) TopUpInterface {
+	return &TopUp{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *TopUp) TopUp(ctx context.Context, storeID, customerID int64, req adminCustomerWalletModel.TopUpRequest, staffID int64, role string, creatorStoreIDs []int64) (*adminCustomerWalletModel.TopUpResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if err := checkCustomerExists(ctx, s.queries, customerID); err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// get the account mapped to the payment method, postings are skipped when not set
+	var ledgerAccountID *int64
+	accountID, err := s.queries.GetStorePaymentMethodAccountID(ctx, dbgen.GetStorePaymentMethodAccountIDParams{
+		StoreID:       storeID,
+		PaymentMethod: utils.StringPtrToPgText(&req.PaymentMethod, false),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store payment method account", err)
+	}
+	if err == nil {
+		ledgerAccountID = &accountID
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	// cash top-up changes today's expected cash, so it is not allowed after the cash drawer is closed,
+	// the drawer lock is held until commit so a close can not miss this top-up
+	if req.PaymentMethod == common.PaymentMethodCash {
+		if err := cashdrawer.CheckNotClosed(ctx, qtx, storeID, errorCodes.CashDrawerClosedNotAllowTopUp); err != nil {
+			return nil, err
+		}
+	}
+
+	posted, err := wallet.PostTransaction(ctx, qtx, wallet.PostTransactionParams{
+		CustomerID:    customerID,
+		StoreID:       &storeID,
+		Type:          common.CustomerWalletTransactionTypeTopUp,
+		Amount:        req.Amount,
+		PaymentMethod: &req.PaymentMethod,
+		Note:          req.Note,
+		CreatedBy:     &staffID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ledgerAccountID != nil {
+		note := fmt.Sprintf("儲值收入 (%s)", req.PaymentMethod)
+		sourceType := common.AccountTransactionSourceWalletTopUp
+		_, err := ledger.PostTransaction(ctx, qtx, ledger.PostTransactionParams{
+			StoreID:         storeID,
+			AccountID:       *ledgerAccountID,
+			TransactionDate: today,
+			Type:            common.AccountTransactionTypeIncome,
+			Amount:          req.Amount,
+			Note:            &note,
+			SourceType:      &sourceType,
+			SourceID:        &posted.TransactionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminCustomerWalletModel.TopUpResponse{
+		CustomerID: utils.FormatID(customerID),
+		Balance:    posted.Balance,
+	}, nil
+}
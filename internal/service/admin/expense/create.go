@@ -9,6 +9,7 @@ import (
 	adminExpenseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	expenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -102,6 +103,11 @@ func (s *Create) Create(ctx context.Context, storeID int64, req adminExpenseMode
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create expense", err)
 	}
 
+	// expense paid by the store directly is posted to the mapped account, postings are skipped when not set
+	if err := expenseService.SyncLedger(ctx, qtx, storeID, expenseID, creatorID); err != nil {
+		return nil, err
+	}
+
 	_, err = qtx.BatchCreateExpenseItems(ctx, itemRows)
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create expense items", err)
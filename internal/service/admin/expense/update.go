@@ -5,23 +5,27 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminExpenseModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	expenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
 type Update struct {
 	queries *dbgen.Queries
 	repo    *sqlxRepo.Repositories
+	pgxPool *pgxpool.Pool
 }
 
-func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories) UpdateInterface {
+func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories, pgxPool *pgxpool.Pool) UpdateInterface {
 	return &Update{
 		queries: queries,
 		repo:    repo,
+		pgxPool: pgxPool,
 	}
 }
 
@@ -153,6 +157,14 @@ func (s *Update) Update(ctx context.Context, storeID, expenseID int64, req admin
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update expense", err)
 	}
 
+	// the account transaction follows the amount, date, category, payer and reimbursement of the expense
+	if req.Amount != nil || req.OtherFee != nil || req.ExpenseDate != nil || req.Category != nil || req.PayerID != nil ||
+		req.PayerIDIsNone != nil || req.IsReimbursed != nil || req.ReimbursedAt != nil {
+		if err := expenseService.SyncLedgerInNewTx(ctx, s.pgxPool, storeID, expenseID, updaterID); err != nil {
+			return nil, err
+		}
+	}
+
 	return &adminExpenseModel.UpdateResponse{
 		ID: utils.FormatID(result.ID),
 	}, nil
@@ -9,6 +9,7 @@ import (
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminExpenseItemModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/expense_item"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	expenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -119,6 +120,11 @@ func (s *Create) Create(ctx context.Context, storeID, expenseID int64, req admin
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update expense amount", err)
 	}
 
+	// the account transaction follows the expense amount
+	if err := expenseService.SyncLedger(ctx, qtx, storeID, expenseID, creatorID); err != nil {
+		return nil, err
+	}
+
 	// if isArrived is true, update product current stock
 	if req.IsArrived {
 		newProductStock := int64(product.CurrentStock) + req.Quantity
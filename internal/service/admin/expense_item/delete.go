@@ -9,6 +9,7 @@ import (
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminExpenseItemModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/expense_item"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	expenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -106,6 +107,11 @@ func (s *Delete) Delete(ctx context.Context, storeID, expenseID, expenseItemID i
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update expense amount", err)
 	}
 
+	// the account transaction follows the expense amount
+	if err := expenseService.SyncLedger(ctx, qtx, storeID, expenseID, creatorID); err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
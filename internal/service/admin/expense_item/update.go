@@ -5,11 +5,13 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jmoiron/sqlx"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminExpenseItemModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/expense_item"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	expenseService "github.com/tkoleo84119/nail-salon-backend/internal/service/expense"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -17,13 +19,15 @@ type Update struct {
 	queries *dbgen.Queries
 	repo    *sqlxRepo.Repositories
 	db      *sqlx.DB
+	pgxPool *pgxpool.Pool
 }
 
-func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories, db *sqlx.DB) UpdateInterface {
+func NewUpdate(queries *dbgen.Queries, repo *sqlxRepo.Repositories, db *sqlx.DB, pgxPool *pgxpool.Pool) UpdateInterface {
 	return &Update{
 		queries: queries,
 		repo:    repo,
 		db:      db,
+		pgxPool: pgxPool,
 	}
 }
 
@@ -124,6 +128,13 @@ func (s *Update) Update(ctx context.Context, storeID, expenseID, expenseItemID i
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
 	}
 
+	// the account transaction follows the expense amount
+	if req.Price != nil || req.Quantity != nil {
+		if err := expenseService.SyncLedgerInNewTx(ctx, s.pgxPool, storeID, expenseID, updaterID); err != nil {
+			return nil, err
+		}
+	}
+
 	return &adminExpenseItemModel.UpdateResponse{
 		ID: utils.FormatID(updatedExpenseItem.ID),
 	}, nil
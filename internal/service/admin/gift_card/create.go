@@ -0,0 +1,61 @@
+package adminGiftCard
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminGiftCardModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/gift_card"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+}
+
+func NewCreate(queries *dbgen.Queries) CreateInterface {
+	return &Create{
+		queries: queries,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, storeID int64, req adminGiftCardModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminGiftCardModel.CreateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	code, err := wallet.GenerateGiftCardCode()
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to generate gift card code", err)
+	}
+
+	amount, err := utils.Int64PtrToPgNumeric(&req.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	giftCardID := utils.GenerateID()
+	expiresAt := utils.TimePtrToPgDate(req.ExpiresAt)
+	if err := s.queries.CreateGiftCard(ctx, dbgen.CreateGiftCardParams{
+		ID:        giftCardID,
+		StoreID:   storeID,
+		Code:      code,
+		Amount:    amount,
+		Status:    common.GiftCardStatusActive,
+		ExpiresAt: expiresAt,
+		Note:      utils.StringPtrToPgText(req.Note, true),
+		CreatedBy: creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create gift card", err)
+	}
+
+	return &adminGiftCardModel.CreateResponse{
+		ID:        utils.FormatID(giftCardID),
+		Code:      code,
+		Amount:    req.Amount,
+		Status:    common.GiftCardStatusActive,
+		ExpiresAt: utils.PgDateToDateString(expiresAt),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package adminGiftCard
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminGiftCardModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/gift_card"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID int64, req adminGiftCardModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminGiftCardModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	total, items, err := s.repo.GiftCard.GetAllGiftCardsByFilter(ctx, storeID, sqlxRepo.GetAllGiftCardsByFilterParams{
+		Code:   req.Code,
+		Status: req.Status,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get gift cards", err)
+	}
+
+	responseItems := make([]adminGiftCardModel.GetAllItem, len(items))
+	for i, item := range items {
+		amount, err := utils.PgNumericToInt64(item.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+
+		responseItems[i] = adminGiftCardModel.GetAllItem{
+			ID:                   utils.FormatID(item.ID),
+			Code:                 item.Code,
+			Amount:               amount,
+			Status:               item.Status,
+			ExpiresAt:            utils.PgDateToDateString(item.ExpiresAt),
+			Note:                 utils.PgTextToString(item.Note),
+			RedeemedCustomerID:   utils.PgInt8ToIDString(item.RedeemedCustomerID),
+			RedeemedCustomerName: utils.PgTextToString(item.RedeemedCustomerName),
+			RedeemedAt:           utils.PgTimestamptzToTimeString(item.RedeemedAt),
+			CreatedAt:            utils.PgTimestamptzToTimeString(item.CreatedAt),
+			UpdatedAt:            utils.PgTimestamptzToTimeString(item.UpdatedAt),
+		}
+	}
+
+	return &adminGiftCardModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminGiftCard
+
+import (
+	"context"
+
+	adminGiftCardModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/gift_card"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, storeID int64, req adminGiftCardModel.CreateParsedRequest, creatorID int64, role string, creatorStoreIDs []int64) (*adminGiftCardModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID int64, req adminGiftCardModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminGiftCardModel.GetAllResponse, error)
+}
@@ -0,0 +1,69 @@
+package adminInvoice
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminInvoiceModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/invoice"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID int64, req adminInvoiceModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminInvoiceModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	total, items, err := s.repo.Invoice.GetAllInvoicesByFilter(ctx, storeID, sqlxRepo.GetAllInvoicesByFilterParams{
+		Status:        req.Status,
+		InvoiceNumber: req.InvoiceNumber,
+		CheckoutID:    req.CheckoutID,
+		Limit:         &req.Limit,
+		Offset:        &req.Offset,
+		Sort:          &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get invoices", err)
+	}
+
+	responseItems := make([]adminInvoiceModel.GetAllItem, len(items))
+	for i, item := range items {
+		amount, err := utils.PgNumericToInt64(item.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+
+		responseItems[i] = adminInvoiceModel.GetAllItem{
+			ID:            utils.FormatID(item.ID),
+			CheckoutID:    utils.FormatID(item.CheckoutID),
+			CustomerID:    utils.FormatID(item.CustomerID),
+			CustomerName:  item.CustomerName,
+			Amount:        amount,
+			CarrierType:   utils.PgTextToString(item.CarrierType),
+			CarrierValue:  utils.PgTextToString(item.CarrierValue),
+			InvoiceNumber: utils.PgTextToString(item.InvoiceNumber),
+			Status:        item.Status,
+			ErrorMessage:  utils.PgTextToString(item.ErrorMessage),
+			IssuedAt:      utils.PgTimestamptzToTimeString(item.IssuedAt),
+			VoidedAt:      utils.PgTimestamptzToTimeString(item.VoidedAt),
+			VoidReason:    utils.PgTextToString(item.VoidReason),
+			CreatedAt:     utils.PgTimestamptzToTimeString(item.CreatedAt),
+			UpdatedAt:     utils.PgTimestamptzToTimeString(item.UpdatedAt),
+		}
+	}
+
+	return &adminInvoiceModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminInvoice
+
+import (
+	"context"
+
+	adminInvoiceModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/invoice"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID int64, req adminInvoiceModel.GetAllParsedRequest, role string, creatorStoreIDs []int64) (*adminInvoiceModel.GetAllResponse, error)
+}
+
+type RetryInterface interface {
+	Retry(ctx context.Context, storeID, invoiceID int64, role string, creatorStoreIDs []int64) (*adminInvoiceModel.RetryResponse, error)
+}
@@ -0,0 +1,77 @@
+package adminInvoice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminInvoiceModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/invoice"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Retry struct {
+	queries       *dbgen.Queries
+	invoiceIssuer invoice.IssuerInterface
+}
+
+func NewRetry(queries *dbgen.Queries, invoiceIssuer invoice.IssuerInterface) RetryInterface {
+	return &Retry{
+		queries:       queries,
+		invoiceIssuer: invoiceIssuer,
+	}
+}
+
+func (s *Retry) Retry(ctx context.Context, storeID, invoiceID int64, role string, creatorStoreIDs []int64) (*adminInvoiceModel.RetryResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	target, err := s.queries.GetInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.InvoiceNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get invoice", err)
+	}
+	if target.StoreID != storeID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.InvoiceNotBelongToStore)
+	}
+
+	// issue the invoice which is not issued yet, or void the invoice which is not voided yet
+	switch target.Status {
+	case common.InvoiceStatusPending, common.InvoiceStatusFailed:
+		err = s.invoiceIssuer.Issue(ctx, invoiceID)
+	case common.InvoiceStatusVoidPending, common.InvoiceStatusVoidFailed:
+		err = s.invoiceIssuer.Void(ctx, invoiceID)
+	default:
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.InvoiceStatusNotAllowedToRetry)
+	}
+	if err != nil {
+		if errors.Is(err, invoice.ErrStatusNotAllowed) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.InvoiceStatusNotAllowedToRetry)
+		}
+		if errors.Is(err, invoice.ErrProviderFailed) {
+			return nil, errorCodes.NewServiceError(errorCodes.InvoiceProviderFailed, "failed to process invoice by provider", err)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to process invoice", err)
+	}
+
+	updated, err := s.queries.GetInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get invoice", err)
+	}
+
+	return &adminInvoiceModel.RetryResponse{
+		ID:            utils.FormatID(updated.ID),
+		InvoiceNumber: utils.PgTextToString(updated.InvoiceNumber),
+		Status:        updated.Status,
+		ErrorMessage:  utils.PgTextToString(updated.ErrorMessage),
+		IssuedAt:      utils.PgTimestamptzToTimeString(updated.IssuedAt),
+		VoidedAt:      utils.PgTimestamptzToTimeString(updated.VoidedAt),
+	}, nil
+}
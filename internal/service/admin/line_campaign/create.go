@@ -0,0 +1,74 @@
+package adminLineCampaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	runner  campaign.LineRunnerInterface
+}
+
+func NewCreate(queries *dbgen.Queries, runner campaign.LineRunnerInterface) CreateInterface {
+	return &Create{
+		queries: queries,
+		runner:  runner,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, req adminLineCampaignModel.CreateParsedRequest, creatorID int64) (*adminLineCampaignModel.CreateResponse, error) {
+	if _, err := s.queries.GetCustomerSegmentByID(ctx, req.SegmentID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerSegmentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer segment", err)
+	}
+
+	// only keep the content of the message type
+	params := dbgen.CreateLineCampaignParams{
+		ID:          utils.GenerateID(),
+		Name:        req.Name,
+		SegmentID:   req.SegmentID,
+		MessageType: req.MessageType,
+		Status:      common.LineCampaignStatusPending,
+		CreatedBy:   creatorID,
+	}
+	if req.MessageType == common.LineCampaignMessageTypeFlex {
+		flexContents, err := json.Marshal(req.FlexContents)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal flex contents", err)
+		}
+		params.AltText = utils.StringPtrToPgText(req.AltText, true)
+		params.FlexContents = flexContents
+	} else {
+		params.TextContent = utils.StringPtrToPgText(req.Text, true)
+	}
+
+	if err := s.queries.CreateLineCampaign(ctx, params); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create line campaign", err)
+	}
+
+	// Run campaign
+	go func() {
+		if err := s.runner.Run(context.Background(), params.ID); err != nil {
+			log.Printf("failed to run line campaign %d: %v", params.ID, err)
+		}
+	}()
+
+	return &adminLineCampaignModel.CreateResponse{
+		ID:     utils.FormatID(params.ID),
+		Status: common.LineCampaignStatusPending,
+	}, nil
+}
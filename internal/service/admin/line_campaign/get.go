@@ -0,0 +1,59 @@
+package adminLineCampaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, campaignID int64) (*adminLineCampaignModel.GetResponse, error) {
+	campaign, err := s.queries.GetLineCampaignByID(ctx, campaignID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.LineCampaignNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get line campaign", err)
+	}
+
+	var flexContents json.RawMessage
+	if len(campaign.FlexContents) > 0 {
+		flexContents = json.RawMessage(campaign.FlexContents)
+	}
+
+	return &adminLineCampaignModel.GetResponse{
+		ID:           utils.FormatID(campaign.ID),
+		Name:         campaign.Name,
+		SegmentID:    utils.FormatID(campaign.SegmentID),
+		MessageType:  campaign.MessageType,
+		Text:         utils.PgTextToString(campaign.TextContent),
+		AltText:      utils.PgTextToString(campaign.AltText),
+		FlexContents: flexContents,
+		Status:       campaign.Status,
+		TargetCount:  campaign.TargetCount,
+		SentCount:    campaign.SentCount,
+		FailedCount:  campaign.FailedCount,
+		ErrorMessage: utils.PgTextToString(campaign.ErrorMessage),
+		StartedAt:    utils.PgTimestamptzToTimeString(campaign.StartedAt),
+		FinishedAt:   utils.PgTimestamptzToTimeString(campaign.FinishedAt),
+		CreatedBy:    utils.FormatID(campaign.CreatedBy),
+		CreatedAt:    utils.PgTimestamptzToTimeString(campaign.CreatedAt),
+		UpdatedAt:    utils.PgTimestamptzToTimeString(campaign.UpdatedAt),
+	}, nil
+}
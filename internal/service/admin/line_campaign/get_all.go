@@ -0,0 +1,56 @@
+package adminLineCampaign
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetAll(repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		repo: repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, req adminLineCampaignModel.GetAllParsedRequest) (*adminLineCampaignModel.GetAllResponse, error) {
+	total, items, err := s.repo.LineCampaign.GetAllLineCampaignsByFilter(ctx, sqlxRepo.GetAllLineCampaignsByFilterParams{
+		SegmentID: req.SegmentID,
+		Status:    req.Status,
+		Limit:     &req.Limit,
+		Offset:    &req.Offset,
+		Sort:      &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get line campaigns", err)
+	}
+
+	responseItems := make([]adminLineCampaignModel.GetAllItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminLineCampaignModel.GetAllItem{
+			ID:          utils.FormatID(item.ID),
+			Name:        item.Name,
+			SegmentID:   utils.FormatID(item.SegmentID),
+			SegmentName: item.SegmentName,
+			MessageType: item.MessageType,
+			Status:      item.Status,
+			TargetCount: item.TargetCount,
+			SentCount:   item.SentCount,
+			FailedCount: item.FailedCount,
+			StartedAt:   utils.PgTimestamptzToTimeString(item.StartedAt),
+			FinishedAt:  utils.PgTimestamptzToTimeString(item.FinishedAt),
+			CreatedAt:   utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &adminLineCampaignModel.GetAllResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package adminLineCampaign
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetRecipients struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetRecipients(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetRecipientsInterface {
+	return &GetRecipients{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetRecipients) GetRecipients(ctx context.Context, campaignID int64, req adminLineCampaignModel.GetRecipientsParsedRequest) (*adminLineCampaignModel.GetRecipientsResponse, error) {
+	if _, err := s.queries.GetLineCampaignByID(ctx, campaignID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.LineCampaignNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get line campaign", err)
+	}
+
+	total, items, err := s.repo.LineCampaign.GetAllLineCampaignRecipientsByFilter(ctx, campaignID, sqlxRepo.GetAllLineCampaignRecipientsByFilterParams{
+		Status: req.Status,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get line campaign recipients", err)
+	}
+
+	responseItems := make([]adminLineCampaignModel.GetRecipientsItem, len(items))
+	for i, item := range items {
+		responseItems[i] = adminLineCampaignModel.GetRecipientsItem{
+			CustomerID:   utils.FormatID(item.CustomerID),
+			CustomerName: item.CustomerName,
+			Status:       item.Status,
+			ErrorMessage: utils.PgTextToString(item.ErrorMessage),
+			SentAt:       utils.PgTimestamptzToTimeString(item.SentAt),
+			UpdatedAt:    utils.PgTimestamptzToTimeString(item.UpdatedAt),
+		}
+	}
+
+	return &adminLineCampaignModel.GetRecipientsResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package adminLineCampaign
+
+import (
+	"context"
+
+	adminLineCampaignModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/line_campaign"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminLineCampaignModel.CreateParsedRequest, creatorID int64) (*adminLineCampaignModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, req adminLineCampaignModel.GetAllParsedRequest) (*adminLineCampaignModel.GetAllResponse, error)
+}
+
+type GetInterface interface {
+	Get(ctx context.Context, campaignID int64) (*adminLineCampaignModel.GetResponse, error)
+}
+
+type GetRecipientsInterface interface {
+	GetRecipients(ctx context.Context, campaignID int64, req adminLineCampaignModel.GetRecipientsParsedRequest) (*adminLineCampaignModel.GetRecipientsResponse, error)
+}
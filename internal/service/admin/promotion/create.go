@@ -0,0 +1,151 @@
+package adminPromotion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewCreate(queries *dbgen.Queries, db *pgxpool.Pool) CreateInterface {
+	return &Create{
+		queries: queries,
+		db:      db,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, req adminPromotionModel.CreateParsedRequest, creatorID int64) (*adminPromotionModel.CreateResponse, error) {
+	// Validate DiscountRate and DiscountAmount pass exactly one
+	if req.DiscountRate == nil && req.DiscountAmount == nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.PromotionDiscountRequired)
+	}
+	if req.DiscountRate != nil && req.DiscountAmount != nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.PromotionDiscountExclusive)
+	}
+
+	// Validate date and time window
+	if req.StartDate.After(req.EndDate) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.PromotionDateRangeInvalid)
+	}
+	if err := validateTimeRange(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	// Check store exists when the promotion is limited to a store
+	if req.StoreID != nil {
+		if _, err := s.queries.GetStoreByID(ctx, *req.StoreID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreNotFound)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store", err)
+		}
+	}
+
+	// Check eligible services exist
+	if err := checkServicesExist(ctx, s.queries, req.ServiceIds); err != nil {
+		return nil, err
+	}
+
+	discountRate, err := utils.Float64PtrToPgNumeric(req.DiscountRate)
+	if err != nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValTypeConversionFailed)
+	}
+	discountAmount, err := utils.Int64PtrToPgNumeric(req.DiscountAmount)
+	if err != nil {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValTypeConversionFailed)
+	}
+
+	var weekdays []int32
+	if len(req.Weekdays) > 0 {
+		weekdays = req.Weekdays
+	}
+
+	promotionID := utils.GenerateID()
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := qtx.CreatePromotion(ctx, dbgen.CreatePromotionParams{
+		ID:              promotionID,
+		Name:            req.Name,
+		StoreID:         utils.Int64PtrToPgInt8(req.StoreID),
+		DiscountRate:    discountRate,
+		DiscountAmount:  discountAmount,
+		StartDate:       utils.TimePtrToPgDate(&req.StartDate),
+		EndDate:         utils.TimePtrToPgDate(&req.EndDate),
+		Weekdays:        weekdays,
+		StartTime:       utils.TimePtrToPgTime(req.StartTime),
+		EndTime:         utils.TimePtrToPgTime(req.EndTime),
+		ServiceScope:    req.ServiceScope,
+		StackWithCoupon: req.StackWithCoupon,
+		Note:            utils.StringPtrToPgText(req.Note, true),
+		CreatedBy:       creatorID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create promotion", err)
+	}
+
+	if len(req.ServiceIds) > 0 {
+		if err := qtx.CreatePromotionServices(ctx, dbgen.CreatePromotionServicesParams{
+			PromotionID: promotionID,
+			ServiceIds:  req.ServiceIds,
+		}); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create promotion services", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminPromotionModel.CreateResponse{
+		ID: utils.FormatID(promotionID),
+	}, nil
+}
+
+// validateTimeRange checks the start time is before the end time when both are set, only the time of day is compared
+func validateTimeRange(startTime, endTime *time.Time) error {
+	start := utils.TimePtrToPgTime(startTime)
+	end := utils.TimePtrToPgTime(endTime)
+	if !start.Valid || !end.Valid {
+		return nil
+	}
+	if end.Microseconds <= start.Microseconds {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.PromotionTimeRangeInvalid)
+	}
+
+	return nil
+}
+
+// checkServicesExist checks all eligible services exist, the service ids are expected to be distinct
+func checkServicesExist(ctx context.Context, queries *dbgen.Queries, serviceIDs []int64) error {
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	services, err := queries.GetServiceByIds(ctx, serviceIDs)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get services", err)
+	}
+	if len(services) != len(serviceIDs) {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.ServiceNotFound)
+	}
+
+	return nil
+}
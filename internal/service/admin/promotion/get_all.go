@@ -0,0 +1,114 @@
+package adminPromotion
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+	repo    *sqlxRepo.Repositories
+}
+
+func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+		repo:    repo,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, req adminPromotionModel.GetAllParsedRequest) (*adminPromotionModel.GetAllResponse, error) {
+	total, results, err := s.repo.Promotion.GetAllPromotionsByFilter(ctx, sqlxRepo.GetAllPromotionsByFilterParams{
+		Name:     req.Name,
+		StoreID:  req.StoreID,
+		IsActive: req.IsActive,
+		Limit:    &req.Limit,
+		Offset:   &req.Offset,
+		Sort:     &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get promotion list", err)
+	}
+
+	// get eligible services of the promotions
+	promotionIDs := make([]int64, len(results))
+	for i, result := range results {
+		promotionIDs[i] = result.ID
+	}
+	promotionServices, err := s.queries.GetPromotionServicesByPromotionIDs(ctx, promotionIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get promotion services", err)
+	}
+	serviceIDsMap := make(map[int64][]string)
+	for _, promotionService := range promotionServices {
+		serviceIDsMap[promotionService.PromotionID] = append(serviceIDsMap[promotionService.PromotionID], utils.FormatID(promotionService.ServiceID))
+	}
+
+	items := make([]adminPromotionModel.GetAllPromotionItemDTO, len(results))
+	for i, result := range results {
+		discountRate, err := utils.PgNumericToFloat64(result.DiscountRate)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
+		}
+		discountAmount, err := utils.PgNumericToInt64(result.DiscountAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to int64", err)
+		}
+		totalDiscountAmount, err := utils.PgNumericToInt64(result.TotalDiscountAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert total discount amount to int64", err)
+		}
+
+		// weekdays are returned as a comma separated string
+		weekdays := []int32{}
+		if result.Weekdays != "" {
+			for _, w := range strings.Split(result.Weekdays, ",") {
+				weekday, err := strconv.ParseInt(w, 10, 32)
+				if err != nil {
+					return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert weekday to int32", err)
+				}
+				weekdays = append(weekdays, int32(weekday))
+			}
+		}
+
+		serviceIDs, ok := serviceIDsMap[result.ID]
+		if !ok {
+			serviceIDs = []string{}
+		}
+
+		items[i] = adminPromotionModel.GetAllPromotionItemDTO{
+			ID:                  utils.FormatID(result.ID),
+			Name:                result.Name,
+			StoreID:             utils.PgInt8ToIDString(result.StoreID),
+			StoreName:           result.StoreName,
+			DiscountRate:        discountRate,
+			DiscountAmount:      discountAmount,
+			StartDate:           utils.PgDateToDateString(result.StartDate),
+			EndDate:             utils.PgDateToDateString(result.EndDate),
+			Weekdays:            weekdays,
+			StartTime:           utils.PgTimeToTimeString(result.StartTime),
+			EndTime:             utils.PgTimeToTimeString(result.EndTime),
+			ServiceScope:        result.ServiceScope,
+			ServiceIds:          serviceIDs,
+			StackWithCoupon:     result.StackWithCoupon,
+			IsActive:            result.IsActive,
+			AppliedCount:        result.AppliedCount,
+			TotalDiscountAmount: totalDiscountAmount,
+			Note:                utils.PgTextToString(result.Note),
+			CreatedAt:           utils.PgTimestamptzToTimeString(result.CreatedAt),
+			UpdatedAt:           utils.PgTimestamptzToTimeString(result.UpdatedAt),
+		}
+	}
+
+	return &adminPromotionModel.GetAllResponse{
+		Total: total,
+		Items: items,
+	}, nil
+}
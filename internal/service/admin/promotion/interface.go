@@ -0,0 +1,19 @@
+package adminPromotion
+
+import (
+	"context"
+
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminPromotionModel.CreateParsedRequest, creatorID int64) (*adminPromotionModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, req adminPromotionModel.GetAllParsedRequest) (*adminPromotionModel.GetAllResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, promotionID int64, req adminPromotionModel.UpdateParsedRequest) (*adminPromotionModel.UpdateResponse, error)
+}
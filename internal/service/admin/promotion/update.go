@@ -0,0 +1,134 @@
+package adminPromotion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminPromotionModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/promotion"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+	db      *sqlx.DB
+	repo    *sqlxRepo.Repositories
+}
+
+func NewUpdate(queries *dbgen.Queries, db *sqlx.DB, repo *sqlxRepo.Repositories) UpdateInterface {
+	return &Update{
+		queries: queries,
+		db:      db,
+		repo:    repo,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, promotionID int64, req adminPromotionModel.UpdateParsedRequest) (*adminPromotionModel.UpdateResponse, error) {
+	// Ensure promotion exists
+	promotion, err := s.queries.GetPromotionByID(ctx, promotionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.PromotionNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get promotion", err)
+	}
+
+	// Validate date and time window, the stored value is used when not updated
+	startDate := promotion.StartDate.Time
+	if req.StartDate != nil {
+		startDate = *req.StartDate
+	}
+	endDate := promotion.EndDate.Time
+	if req.EndDate != nil {
+		endDate = *req.EndDate
+	}
+	if startDate.After(endDate) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.PromotionDateRangeInvalid)
+	}
+
+	startTime := pgTimeToTimePtr(promotion.StartTime)
+	if req.StartTime != nil {
+		startTime = req.StartTime
+	}
+	endTime := pgTimeToTimePtr(promotion.EndTime)
+	if req.EndTime != nil {
+		endTime = req.EndTime
+	}
+	if err := validateTimeRange(startTime, endTime); err != nil {
+		return nil, err
+	}
+
+	// Check store exists, zero store id applies the promotion to all stores
+	if req.StoreID != nil && *req.StoreID != 0 {
+		if _, err := s.queries.GetStoreByID(ctx, *req.StoreID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreNotFound)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store", err)
+		}
+	}
+
+	// Check eligible services exist
+	if req.ServiceIds != nil {
+		if err := checkServicesExist(ctx, s.queries, *req.ServiceIds); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	// Perform partial update
+	if err := s.repo.Promotion.UpdatePromotionTx(ctx, tx, promotionID, sqlxRepo.UpdatePromotionTxParams{
+		Name:            req.Name,
+		StoreID:         req.StoreID,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		Weekdays:        req.Weekdays,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		ServiceScope:    req.ServiceScope,
+		StackWithCoupon: req.StackWithCoupon,
+		IsActive:        req.IsActive,
+		Note:            req.Note,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update promotion", err)
+	}
+
+	if req.ServiceIds != nil {
+		if err := s.repo.Promotion.ReplacePromotionServicesTx(ctx, tx, promotionID, *req.ServiceIds); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to replace promotion services", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminPromotionModel.UpdateResponse{
+		ID: utils.FormatID(promotionID),
+	}, nil
+}
+
+// pgTimeToTimePtr returns nil when the time is null
+func pgTimeToTimePtr(t pgtype.Time) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	value, err := utils.PgTimeToTime(t)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,54 @@
+package adminReferralSetting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReferralSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/referral_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context) (*adminReferralSettingModel.GetResponse, error) {
+	setting, err := s.queries.GetReferralSetting(ctx)
+	if err != nil {
+		// the referral program is disabled until the setting is saved
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &adminReferralSettingModel.GetResponse{
+				IsEnabled: false,
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get referral setting", err)
+	}
+
+	var referrerCouponID, refereeCouponID *string
+	if setting.ReferrerCouponID.Valid {
+		id := utils.FormatID(setting.ReferrerCouponID.Int64)
+		referrerCouponID = &id
+	}
+	if setting.RefereeCouponID.Valid {
+		id := utils.FormatID(setting.RefereeCouponID.Int64)
+		refereeCouponID = &id
+	}
+
+	return &adminReferralSettingModel.GetResponse{
+		IsEnabled:         setting.IsEnabled,
+		ReferrerCouponID:  referrerCouponID,
+		RefereeCouponID:   refereeCouponID,
+		CouponValidMonths: utils.PgInt4ToInt32Ptr(setting.CouponValidMonths),
+		UpdatedAt:         utils.PgTimestamptzToTimeString(setting.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminReferralSetting
+
+import (
+	"context"
+
+	adminReferralSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/referral_setting"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context) (*adminReferralSettingModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, req adminReferralSettingModel.UpdateParsedRequest) (*adminReferralSettingModel.UpdateResponse, error)
+}
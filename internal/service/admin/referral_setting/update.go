@@ -0,0 +1,49 @@
+package adminReferralSetting
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReferralSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/referral_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, req adminReferralSettingModel.UpdateParsedRequest) (*adminReferralSettingModel.UpdateResponse, error) {
+	for _, couponID := range []*int64{req.ReferrerCouponID, req.RefereeCouponID} {
+		if couponID == nil {
+			continue
+		}
+		exists, err := s.queries.CheckCouponExists(ctx, *couponID)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		}
+		if !exists {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+		}
+	}
+
+	// the setting applies to referrals completed afterwards, issued rewards are not changed
+	if err := s.queries.UpsertReferralSetting(ctx, dbgen.UpsertReferralSettingParams{
+		IsEnabled:         req.IsEnabled,
+		ReferrerCouponID:  utils.Int64PtrToPgInt8(req.ReferrerCouponID),
+		RefereeCouponID:   utils.Int64PtrToPgInt8(req.RefereeCouponID),
+		CouponValidMonths: utils.Int32PtrToPgInt4(req.CouponValidMonths),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update referral setting", err)
+	}
+
+	return &adminReferralSettingModel.UpdateResponse{
+		IsEnabled: req.IsEnabled,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package adminReport
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/report"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetReferral struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetReferral(repo *sqlxRepo.Repositories) GetReferralInterface {
+	return &GetReferral{
+		repo: repo,
+	}
+}
+
+func (s *GetReferral) GetReferral(ctx context.Context, req adminReportModel.GetReferralParsedRequest) (*adminReportModel.GetReferralResponse, error) {
+	total, items, err := s.repo.CustomerReferral.GetReferralReportByFilter(ctx, sqlxRepo.GetReferralReportByFilterParams{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Limit:     &req.Limit,
+		Offset:    &req.Offset,
+		Sort:      &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get referral report", err)
+	}
+
+	responseItems := make([]adminReportModel.ReferrerStat, len(items))
+	for i, item := range items {
+		responseItems[i] = adminReportModel.ReferrerStat{
+			CustomerID:     utils.FormatID(item.CustomerID),
+			CustomerName:   item.CustomerName,
+			CustomerPhone:  item.CustomerPhone,
+			ReferralCode:   utils.PgTextToString(item.ReferralCode),
+			ReferredCount:  item.ReferredCount,
+			CompletedCount: item.CompletedCount,
+			LastReferredAt: utils.PgTimestamptzToTimeString(item.LastReferredAt),
+		}
+	}
+
+	return &adminReportModel.GetReferralResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package adminReport
+
+import (
+	"context"
+	"math"
+	"time"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminReportModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/report"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetStoreWinBack struct {
+	queries *dbgen.Queries
+}
+
+func NewGetStoreWinBack(queries *dbgen.Queries) GetStoreWinBackInterface {
+	return &GetStoreWinBack{
+		queries: queries,
+	}
+}
+
+func (s *GetStoreWinBack) GetStoreWinBack(ctx context.Context, storeID int64, req adminReportModel.GetStoreWinBackParsedRequest, role string, creatorStoreIDs []int64) (*adminReportModel.GetStoreWinBackResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	// validate date range not exceed 1 year
+	dateDiff := req.EndDate.Sub(req.StartDate)
+	if dateDiff > 365*24*time.Hour {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ReportDateRangeExceed1Year)
+	}
+
+	// contacts are counted by the contacted date, the return is counted whenever the customer checks out afterwards
+	stats, err := s.queries.GetWinBackContactStatsByStoreID(ctx, dbgen.GetWinBackContactStatsByStoreIDParams{
+		StoreID:   storeID,
+		StartDate: utils.TimePtrToPgDate(&req.StartDate),
+		EndDate:   utils.TimePtrToPgDate(&req.EndDate),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get win back contact stats", err)
+	}
+
+	var returnRate float64
+	if stats.ContactedCount > 0 {
+		returnRate = math.Round(float64(stats.ReturnedCount)/float64(stats.ContactedCount)*10000) / 10000
+	}
+
+	return &adminReportModel.GetStoreWinBackResponse{
+		StartDate:         req.StartDate.Format("2006-01-02"),
+		EndDate:           req.EndDate.Format("2006-01-02"),
+		ContactedCount:    int(stats.ContactedCount),
+		CouponIssuedCount: int(stats.CouponIssuedCount),
+		ReturnedCount:     int(stats.ReturnedCount),
+		ReturnRate:        returnRate,
+	}, nil
+}
@@ -17,3 +17,11 @@ type GetStorePerformanceInterface interface {
 type GetStoreExpenseInterface interface {
 	GetStoreExpense(ctx context.Context, storeID int64, req adminReportModel.GetStoreExpenseParsedRequest, staffRole string, storeIDs []int64) (*adminReportModel.GetStoreExpenseResponse, error)
 }
+
+type GetReferralInterface interface {
+	GetReferral(ctx context.Context, req adminReportModel.GetReferralParsedRequest) (*adminReportModel.GetReferralResponse, error)
+}
+
+type GetStoreWinBackInterface interface {
+	GetStoreWinBack(ctx context.Context, storeID int64, req adminReportModel.GetStoreWinBackParsedRequest, role string, creatorStoreIDs []int64) (*adminReportModel.GetStoreWinBackResponse, error)
+}
@@ -2,6 +2,7 @@ package adminService
 
 import (
 	"context"
+	"slices"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	adminServiceModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/service"
@@ -44,6 +45,11 @@ func (s *Create) Create(ctx context.Context, req adminServiceModel.CreateRequest
 		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert price", err)
 	}
 
+	allergens := []string{}
+	if req.Allergens != nil {
+		allergens = uniqueAllergens(*req.Allergens)
+	}
+
 	// Create service
 	createdService, err := s.queries.CreateService(ctx, dbgen.CreateServiceParams{
 		ID:              serviceID,
@@ -53,6 +59,7 @@ func (s *Create) Create(ctx context.Context, req adminServiceModel.CreateRequest
 		IsAddon:         utils.BoolPtrToPgBool(req.IsAddon),
 		IsVisible:       utils.BoolPtrToPgBool(req.IsVisible),
 		Note:            utils.StringPtrToPgText(req.Note, true),
+		Allergens:       allergens,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create service", err)
@@ -68,6 +75,7 @@ func (s *Create) Create(ctx context.Context, req adminServiceModel.CreateRequest
 		IsVisible:       utils.PgBoolToBool(createdService.IsVisible),
 		IsActive:        utils.PgBoolToBool(createdService.IsActive),
 		Note:            utils.PgTextToString(createdService.Note),
+		Allergens:       createdService.Allergens,
 		CreatedAt:       utils.PgTimestamptzToTimeString(createdService.CreatedAt),
 		UpdatedAt:       utils.PgTimestamptzToTimeString(createdService.UpdatedAt),
 	}
@@ -84,3 +92,14 @@ func (s *Create) validatePermissions(creatorRole string) error {
 		return errorCodes.NewServiceErrorWithCode(errorCodes.AuthPermissionDenied)
 	}
 }
+
+// uniqueAllergens removes the duplicated allergens and keeps the order
+func uniqueAllergens(allergens []string) []string {
+	result := make([]string, 0, len(allergens))
+	for _, allergen := range allergens {
+		if !slices.Contains(result, allergen) {
+			result = append(result, allergen)
+		}
+	}
+	return result
+}
@@ -48,6 +48,7 @@ func (s *Get) Get(ctx context.Context, serviceID int64) (*adminServiceModel.GetR
 		IsActive:        utils.PgBoolToBool(service.IsActive),
 		IsVisible:       utils.PgBoolToBool(service.IsVisible),
 		Note:            utils.PgTextToString(service.Note),
+		Allergens:       service.Allergens,
 		CreatedAt:       utils.PgTimestamptzToTimeString(service.CreatedAt),
 		UpdatedAt:       utils.PgTimestamptzToTimeString(service.UpdatedAt),
 	}
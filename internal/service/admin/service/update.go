@@ -49,6 +49,12 @@ func (s *Update) Update(ctx context.Context, serviceID int64, req adminServiceMo
 		}
 	}
 
+	var allergens *[]string
+	if req.Allergens != nil {
+		unique := uniqueAllergens(*req.Allergens)
+		allergens = &unique
+	}
+
 	updatedService, err := s.repo.Service.UpdateService(ctx, serviceID, sqlx.UpdateServiceParams{
 		SortOrder:       req.SortOrder,
 		Name:            req.Name,
@@ -58,6 +64,7 @@ func (s *Update) Update(ctx context.Context, serviceID int64, req adminServiceMo
 		IsVisible:       req.IsVisible,
 		IsActive:        req.IsActive,
 		Note:            req.Note,
+		Allergens:       allergens,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update service", err)
@@ -78,6 +85,7 @@ func (s *Update) Update(ctx context.Context, serviceID int64, req adminServiceMo
 		IsVisible:       utils.PgBoolToBool(updatedService.IsVisible),
 		IsActive:        utils.PgBoolToBool(updatedService.IsActive),
 		Note:            utils.PgTextToString(updatedService.Note),
+		Allergens:       updatedService.Allergens,
 		CreatedAt:       utils.PgTimestamptzToTimeString(updatedService.CreatedAt),
 		UpdatedAt:       utils.PgTimestamptzToTimeString(updatedService.UpdatedAt),
 	}
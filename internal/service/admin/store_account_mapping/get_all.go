@@ -0,0 +1,63 @@
+package adminStoreAccountMapping
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreAccountMappingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_account_mapping"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreAccountMappingModel.GetAllResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	mappings, err := s.queries.GetStoreAccountMappingsByStoreID(ctx, storeID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store account mappings", err)
+	}
+
+	items := make([]adminStoreAccountMappingModel.GetAllItem, len(mappings))
+	for i, mapping := range mappings {
+		var payer *adminStoreAccountMappingModel.GetAllPayer
+		if mapping.PayerID.Valid {
+			payer = &adminStoreAccountMappingModel.GetAllPayer{
+				ID:   utils.FormatID(mapping.PayerID.Int64),
+				Name: mapping.PayerName,
+			}
+		}
+
+		var paymentMethod *string
+		if mapping.PaymentMethod.Valid {
+			paymentMethod = &mapping.PaymentMethod.String
+		}
+
+		items[i] = adminStoreAccountMappingModel.GetAllItem{
+			ID:            utils.FormatID(mapping.ID),
+			MappingType:   mapping.MappingType,
+			PaymentMethod: paymentMethod,
+			Payer:         payer,
+			Account: adminStoreAccountMappingModel.GetAllAccount{
+				ID:   utils.FormatID(mapping.AccountID),
+				Name: mapping.AccountName,
+			},
+			UpdatedAt: utils.PgTimestamptzToTimeString(mapping.UpdatedAt),
+		}
+	}
+
+	return &adminStoreAccountMappingModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminStoreAccountMapping
+
+import (
+	"context"
+
+	adminStoreAccountMappingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_account_mapping"
+)
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreAccountMappingModel.GetAllResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, storeID int64, req adminStoreAccountMappingModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminStoreAccountMappingModel.UpdateResponse, error)
+}
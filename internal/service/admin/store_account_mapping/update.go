@@ -0,0 +1,139 @@
+package adminStoreAccountMapping
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreAccountMappingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_account_mapping"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewUpdate(queries *dbgen.Queries, db *pgxpool.Pool) UpdateInterface {
+	return &Update{
+		queries: queries,
+		db:      db,
+	}
+}
+
+// Update replaces all account mappings of the store with the given items
+func (s *Update) Update(ctx context.Context, storeID int64, req adminStoreAccountMappingModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminStoreAccountMappingModel.UpdateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	seenKeys := make(map[string]bool, len(req.Items))
+	accountIDSet := make(map[int64]bool)
+	payerIDSet := make(map[int64]bool)
+	for _, item := range req.Items {
+		var key string
+		switch item.MappingType {
+		case common.StoreAccountMappingTypePaymentMethod:
+			if item.PaymentMethod == nil {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreAccountMappingPaymentMethodRequired)
+			}
+			if item.PayerID != nil {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreAccountMappingPayerNotAllowed)
+			}
+			key = fmt.Sprintf("%s:%s", item.MappingType, *item.PaymentMethod)
+		case common.StoreAccountMappingTypeExpensePayer:
+			key = fmt.Sprintf("%s:0", item.MappingType)
+			if item.PayerID != nil {
+				key = fmt.Sprintf("%s:%d", item.MappingType, *item.PayerID)
+				payerIDSet[*item.PayerID] = true
+			}
+		}
+
+		if seenKeys[key] {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreAccountMappingDuplicate)
+		}
+		seenKeys[key] = true
+		accountIDSet[item.AccountID] = true
+	}
+
+	// check all accounts belong to the store
+	if len(accountIDSet) > 0 {
+		accountIDs := make([]int64, 0, len(accountIDSet))
+		for accountID := range accountIDSet {
+			accountIDs = append(accountIDs, accountID)
+		}
+
+		count, err := s.queries.CountStoreAccountsByIDs(ctx, dbgen.CountStoreAccountsByIDsParams{
+			Column1: accountIDs,
+			StoreID: storeID,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count store accounts", err)
+		}
+		if count != int64(len(accountIDs)) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+		}
+	}
+
+	// check all payers have access to the store
+	for payerID := range payerIDSet {
+		payerHasAccess, err := s.queries.CheckStaffHasStoreAccess(ctx, dbgen.CheckStaffHasStoreAccessParams{
+			StaffUserID: payerID,
+			StoreID:     storeID,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check payer store access", err)
+		}
+		if !payerHasAccess {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StaffNotFound)
+		}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := qtx.DeleteStoreAccountMappingsByStoreID(ctx, storeID); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete store account mappings", err)
+	}
+
+	if len(req.Items) > 0 {
+		params := make([]dbgen.BulkCreateStoreAccountMappingsParams, len(req.Items))
+		for i, item := range req.Items {
+			// payment method only applies to PAYMENT_METHOD mappings
+			paymentMethod := item.PaymentMethod
+			if item.MappingType != common.StoreAccountMappingTypePaymentMethod {
+				paymentMethod = nil
+			}
+
+			params[i] = dbgen.BulkCreateStoreAccountMappingsParams{
+				ID:            utils.GenerateID(),
+				StoreID:       storeID,
+				MappingType:   item.MappingType,
+				PaymentMethod: utils.StringPtrToPgText(paymentMethod, true),
+				PayerID:       utils.Int64PtrToPgInt8(item.PayerID),
+				AccountID:     item.AccountID,
+			}
+		}
+
+		if _, err := qtx.BulkCreateStoreAccountMappings(ctx, params); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create store account mappings", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &adminStoreAccountMappingModel.UpdateResponse{
+		Count: len(req.Items),
+	}, nil
+}
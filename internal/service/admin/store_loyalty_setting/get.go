@@ -0,0 +1,48 @@
+package adminStoreLoyaltySetting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreLoyaltySettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_loyalty_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreLoyaltySettingModel.GetResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	setting, err := s.queries.GetStoreLoyaltySettingByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.StoreLoyaltySettingNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store loyalty setting", err)
+	}
+
+	return &adminStoreLoyaltySettingModel.GetResponse{
+		StoreID:          utils.FormatID(setting.StoreID),
+		IsEnabled:        setting.IsEnabled,
+		EarnAmountUnit:   setting.EarnAmountUnit,
+		EarnPoints:       setting.EarnPoints,
+		RedeemPointsUnit: setting.RedeemPointsUnit,
+		RedeemAmount:     setting.RedeemAmount,
+		ExpiryMonths:     utils.PgInt4ToInt32Ptr(setting.ExpiryMonths),
+		UpdatedAt:        utils.PgTimestamptzToTimeString(setting.UpdatedAt),
+	}, nil
+}
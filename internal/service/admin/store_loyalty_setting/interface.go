@@ -0,0 +1,15 @@
+package adminStoreLoyaltySetting
+
+import (
+	"context"
+
+	adminStoreLoyaltySettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_loyalty_setting"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreLoyaltySettingModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, storeID int64, req adminStoreLoyaltySettingModel.UpdateRequest, role string, creatorStoreIDs []int64) (*adminStoreLoyaltySettingModel.UpdateResponse, error)
+}
@@ -0,0 +1,43 @@
+package adminStoreLoyaltySetting
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreLoyaltySettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_loyalty_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, storeID int64, req adminStoreLoyaltySettingModel.UpdateRequest, role string, creatorStoreIDs []int64) (*adminStoreLoyaltySettingModel.UpdateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	// the rule only applies to points earned after the update, existing points keep their expiry date
+	if err := s.queries.UpsertStoreLoyaltySetting(ctx, dbgen.UpsertStoreLoyaltySettingParams{
+		StoreID:          storeID,
+		IsEnabled:        *req.IsEnabled,
+		EarnAmountUnit:   req.EarnAmountUnit,
+		EarnPoints:       req.EarnPoints,
+		RedeemPointsUnit: req.RedeemPointsUnit,
+		RedeemAmount:     req.RedeemAmount,
+		ExpiryMonths:     utils.Int32PtrToPgInt4(req.ExpiryMonths),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update store loyalty setting", err)
+	}
+
+	return &adminStoreLoyaltySettingModel.UpdateResponse{
+		StoreID: utils.FormatID(storeID),
+	}, nil
+}
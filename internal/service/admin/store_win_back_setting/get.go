@@ -0,0 +1,59 @@
+package adminStoreWinBackSetting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreWinBackSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_win_back_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreWinBackSettingModel.GetResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	setting, err := s.queries.GetStoreWinBackSettingByStoreID(ctx, storeID)
+	if err != nil {
+		// the win back is disabled until the setting is saved
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &adminStoreWinBackSettingModel.GetResponse{
+				StoreID:         utils.FormatID(storeID),
+				IsEnabled:       false,
+				InactiveDays:    45,
+				CouponValidDays: 30,
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store win back setting", err)
+	}
+
+	var couponID *string
+	if setting.CouponID.Valid {
+		id := utils.FormatID(setting.CouponID.Int64)
+		couponID = &id
+	}
+
+	return &adminStoreWinBackSettingModel.GetResponse{
+		StoreID:         utils.FormatID(setting.StoreID),
+		IsEnabled:       setting.IsEnabled,
+		InactiveDays:    setting.InactiveDays,
+		CouponID:        couponID,
+		CouponValidDays: setting.CouponValidDays,
+		LineMessage:     utils.PgTextToString(setting.LineMessage),
+		UpdatedAt:       utils.PgTimestamptzToTimeString(setting.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,15 @@
+package adminStoreWinBackSetting
+
+import (
+	"context"
+
+	adminStoreWinBackSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_win_back_setting"
+)
+
+type GetInterface interface {
+	Get(ctx context.Context, storeID int64, role string, creatorStoreIDs []int64) (*adminStoreWinBackSettingModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, storeID int64, req adminStoreWinBackSettingModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminStoreWinBackSettingModel.UpdateResponse, error)
+}
@@ -0,0 +1,52 @@
+package adminStoreWinBackSetting
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminStoreWinBackSettingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/store_win_back_setting"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+func (s *Update) Update(ctx context.Context, storeID int64, req adminStoreWinBackSettingModel.UpdateParsedRequest, role string, creatorStoreIDs []int64) (*adminStoreWinBackSettingModel.UpdateResponse, error) {
+	if err := utils.CheckStoreAccess(storeID, creatorStoreIDs, role); err != nil {
+		return nil, err
+	}
+
+	if req.CouponID != nil {
+		exists, err := s.queries.CheckCouponExists(ctx, *req.CouponID)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check coupon existence", err)
+		}
+		if !exists {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotFound)
+		}
+	}
+
+	// the setting applies to the next job run, contacts already made are not changed
+	if err := s.queries.UpsertStoreWinBackSetting(ctx, dbgen.UpsertStoreWinBackSettingParams{
+		StoreID:         storeID,
+		IsEnabled:       req.IsEnabled,
+		InactiveDays:    req.InactiveDays,
+		CouponID:        utils.Int64PtrToPgInt8(req.CouponID),
+		CouponValidDays: req.CouponValidDays,
+		LineMessage:     utils.StringPtrToPgText(req.LineMessage, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update store win back setting", err)
+	}
+
+	return &adminStoreWinBackSettingModel.UpdateResponse{
+		StoreID: utils.FormatID(storeID),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package adminTermsDocument
+
+import (
+	"context"
+	"log"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Create struct {
+	queries   *dbgen.Queries
+	authCache cache.AuthCacheInterface
+}
+
+func NewCreate(queries *dbgen.Queries, authCache cache.AuthCacheInterface) CreateInterface {
+	return &Create{
+		queries:   queries,
+		authCache: authCache,
+	}
+}
+
+func (s *Create) Create(ctx context.Context, req adminTermsDocumentModel.CreateParsedRequest, creatorID int64) (*adminTermsDocumentModel.CreateResponse, error) {
+	versionExists, err := s.queries.CheckTermsDocumentVersionExists(ctx, req.Version)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check terms document version existence", err)
+	}
+	if versionExists {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentVersionAlreadyExists)
+	}
+
+	termsDocumentID := utils.GenerateID()
+	err = s.queries.CreateTermsDocument(ctx, dbgen.CreateTermsDocumentParams{
+		ID:            termsDocumentID,
+		Version:       req.Version,
+		Title:         req.Title,
+		Content:       req.Content,
+		EffectiveDate: utils.TimePtrToPgDate(&req.EffectiveDate),
+		IsRequired:    req.IsRequired,
+		CreatedBy:     utils.Int64PtrToPgInt8(&creatorID),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create terms document", err)
+	}
+
+	// the required terms may change, customers are checked against the new document
+	if cacheErr := s.authCache.DeleteRequiredTerms(ctx); cacheErr != nil {
+		log.Println("failed to delete required terms from cache", cacheErr)
+	}
+
+	return &adminTermsDocumentModel.CreateResponse{
+		ID: utils.FormatID(termsDocumentID),
+	}, nil
+}
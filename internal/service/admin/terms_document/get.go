@@ -0,0 +1,41 @@
+package adminTermsDocument
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Get struct {
+	queries *dbgen.Queries
+}
+
+func NewGet(queries *dbgen.Queries) GetInterface {
+	return &Get{
+		queries: queries,
+	}
+}
+
+func (s *Get) Get(ctx context.Context, termsDocumentID int64) (*adminTermsDocumentModel.GetResponse, error) {
+	termsDocument, err := s.queries.GetTermsDocumentByID(ctx, termsDocumentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get terms document", err)
+	}
+
+	return &adminTermsDocumentModel.GetResponse{
+		ID:            utils.FormatID(termsDocument.ID),
+		Version:       termsDocument.Version,
+		Title:         termsDocument.Title,
+		Content:       termsDocument.Content,
+		EffectiveDate: utils.PgDateToDateString(termsDocument.EffectiveDate),
+		IsRequired:    termsDocument.IsRequired,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package adminTermsDocument
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetAll struct {
+	queries *dbgen.Queries
+}
+
+func NewGetAll(queries *dbgen.Queries) GetAllInterface {
+	return &GetAll{
+		queries: queries,
+	}
+}
+
+func (s *GetAll) GetAll(ctx context.Context) (*adminTermsDocumentModel.GetAllResponse, error) {
+	termsDocuments, err := s.queries.GetAllTermsDocuments(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get terms documents", err)
+	}
+
+	items := make([]adminTermsDocumentModel.GetAllItem, len(termsDocuments))
+	for i, termsDocument := range termsDocuments {
+		items[i] = adminTermsDocumentModel.GetAllItem{
+			ID:            utils.FormatID(termsDocument.ID),
+			Version:       termsDocument.Version,
+			Title:         termsDocument.Title,
+			EffectiveDate: utils.PgDateToDateString(termsDocument.EffectiveDate),
+			IsRequired:    termsDocument.IsRequired,
+			CreatedBy:     utils.PgInt8ToIDString(termsDocument.CreatedBy),
+			CreatedAt:     utils.PgTimestamptzToTimeString(termsDocument.CreatedAt),
+			UpdatedAt:     utils.PgTimestamptzToTimeString(termsDocument.UpdatedAt),
+		}
+	}
+
+	return &adminTermsDocumentModel.GetAllResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package adminTermsDocument
+
+import (
+	"context"
+
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+)
+
+type CreateInterface interface {
+	Create(ctx context.Context, req adminTermsDocumentModel.CreateParsedRequest, creatorID int64) (*adminTermsDocumentModel.CreateResponse, error)
+}
+
+type GetAllInterface interface {
+	GetAll(ctx context.Context) (*adminTermsDocumentModel.GetAllResponse, error)
+}
+
+type GetInterface interface {
+	Get(ctx context.Context, termsDocumentID int64) (*adminTermsDocumentModel.GetResponse, error)
+}
+
+type UpdateInterface interface {
+	Update(ctx context.Context, termsDocumentID int64, req adminTermsDocumentModel.UpdateRequest) (*adminTermsDocumentModel.UpdateResponse, error)
+}
@@ -0,0 +1,66 @@
+package adminTermsDocument
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	adminTermsDocumentModel "github.com/tkoleo84119/nail-salon-backend/internal/model/admin/terms_document"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Update struct {
+	queries *dbgen.Queries
+}
+
+func NewUpdate(queries *dbgen.Queries) UpdateInterface {
+	return &Update{
+		queries: queries,
+	}
+}
+
+// Update only changes the wording of a terms document not yet effective and not accepted by any customer,
+// an acceptance must always point at the text the customer saw, so changes to a published document need a new version
+func (s *Update) Update(ctx context.Context, termsDocumentID int64, req adminTermsDocumentModel.UpdateRequest) (*adminTermsDocumentModel.UpdateResponse, error) {
+	termsDocument, err := s.queries.GetTermsDocumentByID(ctx, termsDocumentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get terms document", err)
+	}
+
+	// terms become effective on its effective date (Asia/Taipei)
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+	if utils.PgDateToDateString(termsDocument.EffectiveDate) <= today {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotEditable)
+	}
+
+	accepted, err := s.queries.CheckCustomerTermsAcceptanceExistsByVersion(ctx, termsDocument.Version)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer terms acceptance", err)
+	}
+	if accepted {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotEditable)
+	}
+
+	err = s.queries.UpdateTermsDocument(ctx, dbgen.UpdateTermsDocumentParams{
+		ID:      termsDocumentID,
+		Title:   utils.StringPtrToPgText(req.Title, false),
+		Content: utils.StringPtrToPgText(req.Content, false),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update terms document", err)
+	}
+
+	return &adminTermsDocumentModel.UpdateResponse{
+		ID: utils.FormatID(termsDocumentID),
+	}, nil
+}
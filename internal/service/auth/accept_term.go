@@ -2,8 +2,10 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	authModel "github.com/tkoleo84119/nail-salon-backend/internal/model/auth"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
@@ -21,6 +23,24 @@ func NewAcceptTerm(queries *dbgen.Queries) AcceptTermInterface {
 }
 
 func (s *AcceptTerm) AcceptTerm(ctx context.Context, req authModel.AcceptTermRequest, customerID int64) (*authModel.AcceptTermResponse, error) {
+	termsDocument, err := s.queries.GetTermsDocumentByVersion(ctx, req.TermsVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get terms document", err)
+	}
+
+	// terms can not be accepted before its effective date (Asia/Taipei)
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+	if utils.PgDateToDateString(termsDocument.EffectiveDate) > today {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotEffective)
+	}
+
 	exists, err := s.queries.CheckCustomerTermsExistsByCustomerIDAndVersion(ctx, dbgen.CheckCustomerTermsExistsByCustomerIDAndVersionParams{
 		CustomerID:   customerID,
 		TermsVersion: req.TermsVersion,
@@ -70,15 +70,23 @@ func (s *LineLogin) LineLogin(ctx context.Context, req auth.LineLoginRequest, lo
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer exists", err)
 	}
 
-	// check if customer has accepted the terms version 1
-	exist, err := s.queries.CheckCustomerTermsExistsByCustomerIDAndVersion(ctx, dbgen.CheckCustomerTermsExistsByCustomerIDAndVersionParams{
-		CustomerID:   customer.ID,
-		TermsVersion: common.CustomerTermsVersion1,
-	})
-	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer terms exists", err)
+	// check if customer has accepted the latest required terms
+	needCheckTerms := false
+	var termsVersion *string
+	requiredTerms, err := s.queries.GetCurrentRequiredTermsDocument(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get current required terms document", err)
+	}
+	if err == nil {
+		acceptedTerms, err := s.queries.GetCustomerLatestAcceptedTermsEffectiveDate(ctx, customer.ID)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer accepted terms", err)
+		}
+		if !acceptedTerms.Valid || acceptedTerms.Time.Before(requiredTerms.EffectiveDate.Time) {
+			needCheckTerms = true
+			termsVersion = &requiredTerms.Version
+		}
 	}
-	needCheckTerms := !exist
 
 	// Customer exists, generate tokens
 	accessToken, expiresIn, err := s.generateAccessToken(customer.ID)
@@ -99,8 +107,8 @@ func (s *LineLogin) LineLogin(ctx context.Context, req auth.LineLoginRequest, lo
 		return nil, err
 	}
 
-	// check if customer line_name is different from profile.Name
-	if profile.Name != "" && utils.PgTextToString(customer.LineName) != profile.Name {
+	// check if customer line_name is different from profile.Name, skipped when logging in with the LINE account of a merged customer
+	if profile.Name != "" && customer.LineUid == profile.ProviderUid && utils.PgTextToString(customer.LineName) != profile.Name {
 		err = qtx.UpdateCustomerLineName(ctx, dbgen.UpdateCustomerLineNameParams{
 			ID:       customer.ID,
 			LineName: utils.StringPtrToPgText(&profile.Name, true),
@@ -118,6 +126,7 @@ func (s *LineLogin) LineLogin(ctx context.Context, req auth.LineLoginRequest, lo
 	response := &auth.LineLoginResponse{
 		NeedRegister:   false,
 		NeedCheckTerms: &needCheckTerms,
+		TermsVersion:   termsVersion,
 		AccessToken:    &accessToken,
 		RefreshToken:   &refreshToken,
 		ExpiresIn:      &expiresIn,
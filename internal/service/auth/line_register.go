@@ -2,10 +2,12 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/netip"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/tkoleo84119/nail-salon-backend/internal/config"
@@ -14,6 +16,7 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/referral"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -52,15 +55,50 @@ func (s *LineRegister) LineRegister(ctx context.Context, req auth.LineRegisterRe
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyExists)
 	}
 
-	// Prepare customer record
-	customerID := utils.GenerateID()
-
 	birthday, err := utils.DateStringToPgDate(req.Birthday)
 	if err != nil {
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.ValFieldDateFormat)
 	}
+
+	// Find the walk-in customer created by staff with the same phone and birthday. Phone and birthday are not a proof
+	// of identity, so the link waits for staff approval and the response never tells whether a customer matched
+	linkRequested := req.LinkCustomer != nil && *req.LinkCustomer
+	var linkCustomerID *int64
+	if linkRequested {
+		id, err := s.queries.GetLinkableCustomerByPhoneAndBirthday(ctx, dbgen.GetLinkableCustomerByPhoneAndBirthdayParams{
+			Phone:    req.Phone,
+			Birthday: birthday,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get linkable customer", err)
+		}
+		if err == nil {
+			linkCustomerID = &id
+		}
+	}
+
+	// Find the referrer by referral code, a customer asking to link an existing profile is not a new referee
+	var referrerID *int64
+	if !linkRequested && req.ReferralCode != nil && *req.ReferralCode != "" {
+		id, err := s.queries.GetCustomerIDByReferralCode(ctx, utils.StringPtrToPgText(req.ReferralCode, false))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerReferralCodeInvalid)
+			}
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer by referral code", err)
+		}
+		referrerID = &id
+	}
+
+	// Prepare customer record
+	customerID := utils.GenerateID()
 	defaultLevel := "NORMAL"
 
+	referralCode, err := referral.GenerateReferralCode()
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to generate referral code", err)
+	}
+
 	// Convert favorite arrays to PostgreSQL text arrays
 	var favoriteShapes, favoriteColors, favoriteStyles, referralSource []string
 	if req.FavoriteShapes != nil {
@@ -102,11 +140,39 @@ func (s *LineRegister) LineRegister(ctx context.Context, req auth.LineRegisterRe
 		Referrer:       utils.StringPtrToPgText(req.Referrer, true),
 		CustomerNote:   utils.StringPtrToPgText(req.CustomerNote, true),
 		Level:          utils.StringPtrToPgText(&defaultLevel, true),
+		ReferralCode:   utils.StringPtrToPgText(&referralCode, true),
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer", err)
 	}
 
+	// the new customer is merged into the walk-in customer once staff approve the link
+	if linkCustomerID != nil {
+		err = qtx.CreateCustomerLinkRequest(ctx, dbgen.CreateCustomerLinkRequestParams{
+			ID:             utils.GenerateID(),
+			CustomerID:     customerID,
+			LinkCustomerID: *linkCustomerID,
+			Status:         common.CustomerLinkRequestStatusPending,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer link request", err)
+		}
+	}
+
+	// Link the customer to the referrer, rewards are issued after the first checkout
+	if referrerID != nil {
+		err = qtx.CreateCustomerReferral(ctx, dbgen.CreateCustomerReferralParams{
+			ID:                 utils.GenerateID(),
+			ReferrerCustomerID: *referrerID,
+			RefereeCustomerID:  customerID,
+			ReferralCode:       *req.ReferralCode,
+			Status:             common.CustomerReferralStatusPending,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer referral", err)
+		}
+	}
+
 	// Generate access token
 	accessToken, err := s.generateAccessToken(customerID)
 	if err != nil {
@@ -119,18 +185,24 @@ func (s *LineRegister) LineRegister(ctx context.Context, req auth.LineRegisterRe
 		return nil, err
 	}
 
-	// Generate customer terms acceptance
-	customerTermsAcceptanceID := utils.GenerateID()
-	acceptedAt := time.Now()
-	acceptedAtPg := utils.TimePtrToPgTimestamptz(&acceptedAt)
-	err = qtx.CreateCustomerTermsAcceptance(ctx, dbgen.CreateCustomerTermsAcceptanceParams{
-		ID:           customerTermsAcceptanceID,
-		CustomerID:   customerID,
-		TermsVersion: common.CustomerTermsVersion1,
-		AcceptedAt:   acceptedAtPg,
-	})
-	if err != nil {
-		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer terms acceptance", err)
+	// Generate customer terms acceptance of the current terms document
+	currentTerms, err := qtx.GetCurrentTermsDocument(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get current terms document", err)
+	}
+	if err == nil {
+		customerTermsAcceptanceID := utils.GenerateID()
+		acceptedAt := time.Now()
+		acceptedAtPg := utils.TimePtrToPgTimestamptz(&acceptedAt)
+		err = qtx.CreateCustomerTermsAcceptance(ctx, dbgen.CreateCustomerTermsAcceptanceParams{
+			ID:           customerTermsAcceptanceID,
+			CustomerID:   customerID,
+			TermsVersion: currentTerms.Version,
+			AcceptedAt:   acceptedAtPg,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer terms acceptance", err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -0,0 +1,174 @@
+package blacklist
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type EscalateResult struct {
+	DecisionID int64
+	RuleType   string
+	Action     string
+}
+
+// Escalate evaluates the active blacklist rules against the customer's no-shows and late cancellations within the given transaction queries.
+// A decision with the counted bookings as evidence is logged for every rule reached, and the customer is flagged by the rule action.
+// Rules whose action the customer already carries are skipped, and bookings recorded before the latest decision of the rule are not counted again,
+// so an overturned decision needs new incidents to be reached again. Nil is returned when nothing is escalated.
+func Escalate(ctx context.Context, qtx *dbgen.Queries, customerID int64, now time.Time) ([]EscalateResult, error) {
+	rules, err := qtx.GetActiveBlacklistRules(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get active blacklist rules", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	state, err := qtx.GetCustomerBlacklistStateForUpdate(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist state", err)
+	}
+	isBlacklisted := utils.PgBoolToBool(state.IsBlacklisted)
+	requiresDeposit := state.RequiresDeposit
+
+	// rules are ordered with blacklist first, a blacklisted customer needs no deposit restriction
+	var results []EscalateResult
+	for _, rule := range rules {
+		if isBlacklisted || (rule.Action == common.BlacklistActionDepositRequired && requiresDeposit) {
+			continue
+		}
+
+		evidence, err := getEvidence(ctx, qtx, customerID, rule, now)
+		if err != nil {
+			return nil, err
+		}
+		if len(evidence) < int(rule.ThresholdCount) {
+			continue
+		}
+
+		evidenceJSON, err := json.Marshal(evidence)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal blacklist evidence", err)
+		}
+
+		decisionID := utils.GenerateID()
+		if err := qtx.CreateCustomerBlacklistDecision(ctx, dbgen.CreateCustomerBlacklistDecisionParams{
+			ID:             decisionID,
+			CustomerID:     customerID,
+			RuleType:       rule.Type,
+			Action:         rule.Action,
+			ThresholdCount: rule.ThresholdCount,
+			PeriodMonths:   rule.PeriodMonths,
+			Evidence:       evidenceJSON,
+		}); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer blacklist decision", err)
+		}
+
+		if err := SetCustomerFlag(ctx, qtx, customerID, rule.Action, true); err != nil {
+			return nil, err
+		}
+		if rule.Action == common.BlacklistActionBlacklist {
+			isBlacklisted = true
+		} else {
+			requiresDeposit = true
+		}
+
+		results = append(results, EscalateResult{
+			DecisionID: decisionID,
+			RuleType:   rule.Type,
+			Action:     rule.Action,
+		})
+	}
+
+	return results, nil
+}
+
+// EscalateInNewTx runs Escalate in its own transaction, for the services writing the booking with sqlx.
+// It must be called after the booking change is committed.
+func EscalateInNewTx(ctx context.Context, db *pgxpool.Pool, customerID int64, now time.Time) ([]EscalateResult, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	results, err := Escalate(ctx, dbgen.New(tx), customerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return results, nil
+}
+
+// SetCustomerFlag sets or clears the customer flag of the blacklist action
+func SetCustomerFlag(ctx context.Context, qtx *dbgen.Queries, customerID int64, action string, value bool) error {
+	if action == common.BlacklistActionBlacklist {
+		if err := qtx.UpdateCustomerIsBlacklisted(ctx, dbgen.UpdateCustomerIsBlacklistedParams{
+			ID:            customerID,
+			IsBlacklisted: utils.BoolPtrToPgBool(&value),
+		}); err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer is blacklisted", err)
+		}
+		return nil
+	}
+
+	if err := qtx.UpdateCustomerRequiresDeposit(ctx, dbgen.UpdateCustomerRequiresDepositParams{
+		ID:              customerID,
+		RequiresDeposit: value,
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer requires deposit", err)
+	}
+	return nil
+}
+
+// getEvidence returns the bookings counted by the rule within its period
+func getEvidence(ctx context.Context, qtx *dbgen.Queries, customerID int64, rule dbgen.BlacklistRule, now time.Time) ([]common.BlacklistEvidence, error) {
+	decidedAt, err := qtx.GetLatestCustomerBlacklistDecidedAt(ctx, dbgen.GetLatestCustomerBlacklistDecidedAtParams{
+		CustomerID: customerID,
+		RuleType:   rule.Type,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get latest customer blacklist decision", err)
+	}
+
+	params := dbgen.GetCustomerBlacklistIncidentsParams{
+		CustomerID:    customerID,
+		Status:        common.BookingStatusNoShow,
+		RecordedAfter: decidedAt,
+	}
+	since := now.AddDate(0, -int(rule.PeriodMonths), 0)
+	params.Since = utils.TimePtrToPgTimestamptz(&since)
+	if rule.Type == common.BlacklistRuleTypeLateCancel {
+		params.Status = common.BookingStatusCancelled
+		params.LateCancelHours = rule.LateCancelHours
+	}
+
+	incidents, err := qtx.GetCustomerBlacklistIncidents(ctx, params)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer blacklist incidents", err)
+	}
+
+	evidence := make([]common.BlacklistEvidence, len(incidents))
+	for i, incident := range incidents {
+		evidence[i] = common.BlacklistEvidence{
+			BookingID:   utils.FormatID(incident.ID),
+			Date:        utils.PgDateToDateString(incident.WorkDate),
+			StartTime:   utils.PgTimeToTimeString(incident.StartTime),
+			Status:      incident.Status,
+			CancelledAt: utils.PgTimestamptzToTimeString(incident.CancelledAt),
+		}
+	}
+
+	return evidence, nil
+}
@@ -13,6 +13,7 @@ import (
 	bookingModel "github.com/tkoleo84119/nail-salon-backend/internal/model/booking"
 	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/blacklist"
 	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
@@ -22,14 +23,16 @@ type Cancel struct {
 	db            *pgxpool.Pool
 	lineMessenger *utils.LineMessageClient
 	activityLog   cache.ActivityLogCacheInterface
+	authCache     cache.AuthCacheInterface
 }
 
-func NewCancel(queries *dbgen.Queries, db *pgxpool.Pool, lineMessenger *utils.LineMessageClient, activityLog cache.ActivityLogCacheInterface) CancelInterface {
+func NewCancel(queries *dbgen.Queries, db *pgxpool.Pool, lineMessenger *utils.LineMessageClient, activityLog cache.ActivityLogCacheInterface, authCache cache.AuthCacheInterface) CancelInterface {
 	return &Cancel{
 		queries:       queries,
 		db:            db,
 		lineMessenger: lineMessenger,
 		activityLog:   activityLog,
+		authCache:     authCache,
 	}
 }
 
@@ -81,10 +84,26 @@ func (s *Cancel) Cancel(ctx context.Context, bookingID int64, req bookingModel.C
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update time slot status", err)
 	}
 
+	// a late cancellation may reach the blacklist rules
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	escalated, err := blacklist.Escalate(ctx, qtx, customerID, time.Now().In(loc))
+	if err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "transaction commit failed", err)
 	}
 
+	if len(escalated) > 0 {
+		if cacheErr := s.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+			log.Println("failed to delete customer context from cache", cacheErr)
+		}
+	}
+
 	newBooking, err := s.queries.GetBookingDetailByID(ctx, bookingID)
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get booking", err)
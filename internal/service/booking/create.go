@@ -54,6 +54,10 @@ func (s *Create) Create(ctx context.Context, req bookingModel.CreateParsedReques
 	if customer.IsBlacklisted.Bool {
 		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerIsBlacklisted)
 	}
+	// customers restricted to deposit bookings book through the store after paying the deposit
+	if customer.RequiresDeposit {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerDepositRequired)
+	}
 
 	// Check if stylist exists
 	stylistName, err := s.queries.GetActiveStylistNameByID(ctx, req.StylistId)
@@ -189,3 +189,15 @@ func (c *ActivityLogCache) LogAdminBookingCompleted(ctx context.Context, staffNa
 	}
 	return c.LogActivity(ctx, common.ActivityAdminBookingCompleted, message)
 }
+
+// LogBirthdayBenefitIssued to Redis List
+func (c *ActivityLogCache) LogBirthdayBenefitIssued(ctx context.Context, issuedCount int) error {
+	message := fmt.Sprintf("系統發送生日禮優惠券給 %d 位顧客", issuedCount)
+	return c.LogActivity(ctx, common.ActivityBirthdayBenefitIssued, message)
+}
+
+// LogWinBackContacted to Redis List
+func (c *ActivityLogCache) LogWinBackContacted(ctx context.Context, storeName string, contactedCount int) error {
+	message := fmt.Sprintf("系統發送回流關懷訊息給 %d 位久未來店的顧客 (門市：%s)", contactedCount, storeName)
+	return c.LogActivity(ctx, common.ActivityWinBackContacted, message)
+}
@@ -12,9 +12,12 @@ import (
 
 const (
 	authCacheExpiration = time.Hour
+	// short expiration so a terms document becomes required soon after its effective date
+	requiredTermsCacheExpiration = 5 * time.Minute
 
 	staffCacheKeyPrefix    = "auth:staff:"
 	customerCacheKeyPrefix = "auth:customer:"
+	requiredTermsCacheKey  = "auth:required_terms"
 )
 
 type AuthCache struct {
@@ -157,3 +160,45 @@ func (c *AuthCache) DeleteCustomerContext(ctx context.Context, customerID int64)
 
 	return nil
 }
+
+// GetRequiredTerms from Redis
+func (c *AuthCache) GetRequiredTerms(ctx context.Context) (*common.RequiredTerms, error) {
+	val, err := c.redis.Get(ctx, requiredTermsCacheKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var requiredTerms common.RequiredTerms
+	if err := json.Unmarshal([]byte(val), &requiredTerms); err != nil {
+		// JSON unmarshal failed, delete the wrong cache data
+		_ = c.redis.Del(ctx, requiredTermsCacheKey)
+		return nil, fmt.Errorf("failed to unmarshal required terms: %w", err)
+	}
+
+	return &requiredTerms, nil
+}
+
+// SetRequiredTerms to Redis
+func (c *AuthCache) SetRequiredTerms(ctx context.Context, requiredTerms *common.RequiredTerms) error {
+	data, err := json.Marshal(requiredTerms)
+	if err != nil {
+		return fmt.Errorf("failed to marshal required terms: %w", err)
+	}
+
+	err = c.redis.Set(ctx, requiredTermsCacheKey, data, requiredTermsCacheExpiration).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set required terms to redis: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRequiredTerms from Redis
+func (c *AuthCache) DeleteRequiredTerms(ctx context.Context) error {
+	err := c.redis.Del(ctx, requiredTermsCacheKey).Err()
+	if err != nil {
+		return fmt.Errorf("failed to delete required terms from redis: %w", err)
+	}
+
+	return nil
+}
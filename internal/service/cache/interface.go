@@ -15,6 +15,10 @@ type AuthCacheInterface interface {
 	GetCustomerContext(ctx context.Context, customerID int64) (*common.CustomerContext, error)
 	SetCustomerContext(ctx context.Context, customerID int64, customerContext *common.CustomerContext) error
 	DeleteCustomerContext(ctx context.Context, customerID int64) error
+
+	GetRequiredTerms(ctx context.Context) (*common.RequiredTerms, error)
+	SetRequiredTerms(ctx context.Context, requiredTerms *common.RequiredTerms) error
+	DeleteRequiredTerms(ctx context.Context) error
 }
 
 type ActivityLogCacheInterface interface {
@@ -30,4 +34,6 @@ type ActivityLogCacheInterface interface {
 	LogAdminBookingUpdate(ctx context.Context, staffName string, customerName string, lineName string, storeName string) error
 	LogAdminBookingCancel(ctx context.Context, staffName string, customerName string, lineName string, storeName string) error
 	LogAdminBookingCompleted(ctx context.Context, staffName string, customerName string, lineName string, checkoutCount int, storeName string) error
+	LogBirthdayBenefitIssued(ctx context.Context, issuedCount int) error
+	LogWinBackContacted(ctx context.Context, storeName string, contactedCount int) error
 }
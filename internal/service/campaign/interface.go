@@ -0,0 +1,15 @@
+package campaign
+
+import (
+	"context"
+)
+
+type RunnerInterface interface {
+	Run(ctx context.Context, campaignID int64) error
+	Resume(ctx context.Context, campaignID int64) error
+}
+
+type LineRunnerInterface interface {
+	Run(ctx context.Context, campaignID int64) error
+	Resume(ctx context.Context, campaignID int64) error
+}
@@ -0,0 +1,202 @@
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/segment"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// LineBatchInterval is the wait between multicast requests to stay within the LINE API rate limit
+const LineBatchInterval = time.Second
+
+type LineRunner struct {
+	queries       *dbgen.Queries
+	repo          *sqlxRepo.Repositories
+	lineMessenger *utils.LineMessageClient
+}
+
+func NewLineRunner(queries *dbgen.Queries, repo *sqlxRepo.Repositories, lineMessenger *utils.LineMessageClient) LineRunnerInterface {
+	return &LineRunner{
+		queries:       queries,
+		repo:          repo,
+		lineMessenger: lineMessenger,
+	}
+}
+
+// Run snapshots the customers of the campaign segment as recipients, then multicasts the message batch by batch,
+// ordered by customer id. The delivery status of each recipient follows the result of its batch, a failed batch does
+// not stop the campaign. The campaign is marked FAILED with the error message when any database operation fails.
+func (r *LineRunner) Run(ctx context.Context, campaignID int64) error {
+	campaign, err := r.queries.GetLineCampaignByID(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to get line campaign: %w", err)
+	}
+	if campaign.Status != common.LineCampaignStatusPending {
+		return ErrStatusNotAllowed
+	}
+
+	// claim the campaign, another runner may have started it
+	if _, err := r.queries.UpdateLineCampaignRunning(ctx, campaignID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrStatusNotAllowed
+		}
+		return fmt.Errorf("failed to update line campaign running: %w", err)
+	}
+
+	return r.finish(ctx, campaign)
+}
+
+// Resume continues a campaign left behind by a stopped runner, a PENDING campaign is started by Run and a RUNNING
+// campaign without progress since StaleTimeout is claimed again, its counts are recalculated from the recipients
+// and the remaining PENDING recipients are sent.
+func (r *LineRunner) Resume(ctx context.Context, campaignID int64) error {
+	campaign, err := r.queries.GetLineCampaignByID(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to get line campaign: %w", err)
+	}
+	if campaign.Status == common.LineCampaignStatusPending {
+		return r.Run(ctx, campaignID)
+	}
+	if campaign.Status != common.LineCampaignStatusRunning {
+		return ErrStatusNotAllowed
+	}
+
+	// claim the campaign, the runner may still be alive or another instance may have resumed it
+	if _, err := r.queries.UpdateLineCampaignResumed(ctx, dbgen.UpdateLineCampaignResumedParams{
+		ID:        campaignID,
+		UpdatedAt: pgtype.Timestamptz{Time: time.Now().Add(-StaleTimeout), Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrStatusNotAllowed
+		}
+		return fmt.Errorf("failed to update line campaign resumed: %w", err)
+	}
+
+	return r.finish(ctx, campaign)
+}
+
+// finish processes the claimed campaign and marks it COMPLETED, or FAILED with the error message
+func (r *LineRunner) finish(ctx context.Context, campaign dbgen.LineCampaign) error {
+	if err := r.process(ctx, campaign); err != nil {
+		if updateErr := r.queries.UpdateLineCampaignFailed(ctx, dbgen.UpdateLineCampaignFailedParams{
+			ID:           campaign.ID,
+			ErrorMessage: pgtype.Text{String: err.Error(), Valid: true},
+		}); updateErr != nil {
+			log.Printf("failed to update line campaign %d failed: %v", campaign.ID, updateErr)
+		}
+		return err
+	}
+
+	if err := r.queries.UpdateLineCampaignCompleted(ctx, campaign.ID); err != nil {
+		return fmt.Errorf("failed to update line campaign completed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LineRunner) process(ctx context.Context, campaign dbgen.LineCampaign) error {
+	// a resumed campaign keeps the recipients snapshotted by its first run
+	targetCount, err := r.queries.CountLineCampaignRecipients(ctx, campaign.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count line campaign recipients: %w", err)
+	}
+	if targetCount == 0 {
+		customerSegment, err := r.queries.GetCustomerSegmentByID(ctx, campaign.SegmentID)
+		if err != nil {
+			return fmt.Errorf("failed to get customer segment: %w", err)
+		}
+		filter, err := segment.Filter(customerSegment)
+		if err != nil {
+			return err
+		}
+
+		targetCount, err = r.repo.LineCampaign.CreateLineCampaignRecipientsByCustomerFilter(ctx, campaign.ID, filter)
+		if err != nil {
+			return err
+		}
+	}
+	if err := r.queries.UpdateLineCampaignTargetCount(ctx, dbgen.UpdateLineCampaignTargetCountParams{
+		ID:          campaign.ID,
+		TargetCount: int32(targetCount),
+	}); err != nil {
+		return fmt.Errorf("failed to update line campaign target count: %w", err)
+	}
+
+	var lastCustomerID int64
+	for {
+		recipients, err := r.queries.GetPendingLineCampaignRecipients(ctx, dbgen.GetPendingLineCampaignRecipientsParams{
+			CampaignID: campaign.ID,
+			CustomerID: lastCustomerID,
+			Limit:      utils.LineMulticastMaxRecipients,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get pending line campaign recipients: %w", err)
+		}
+
+		if len(recipients) == 0 {
+			break
+		}
+
+		customerIDs := make([]int64, len(recipients))
+		lineUids := make([]string, len(recipients))
+		for i, recipient := range recipients {
+			customerIDs[i] = recipient.CustomerID
+			lineUids[i] = recipient.LineUid
+		}
+
+		var sentCount, failedCount int32
+		if sendErr := r.send(campaign, lineUids); sendErr != nil {
+			log.Printf("failed to send line campaign %d batch: %v", campaign.ID, sendErr)
+			if err := r.queries.UpdateLineCampaignRecipientsFailed(ctx, dbgen.UpdateLineCampaignRecipientsFailedParams{
+				CampaignID:   campaign.ID,
+				Column2:      customerIDs,
+				ErrorMessage: pgtype.Text{String: sendErr.Error(), Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to update line campaign recipients failed: %w", err)
+			}
+			failedCount = int32(len(recipients))
+		} else {
+			if err := r.queries.UpdateLineCampaignRecipientsSent(ctx, dbgen.UpdateLineCampaignRecipientsSentParams{
+				CampaignID: campaign.ID,
+				Column2:    customerIDs,
+			}); err != nil {
+				return fmt.Errorf("failed to update line campaign recipients sent: %w", err)
+			}
+			sentCount = int32(len(recipients))
+		}
+
+		if err := r.queries.UpdateLineCampaignProgress(ctx, dbgen.UpdateLineCampaignProgressParams{
+			ID:          campaign.ID,
+			SentCount:   sentCount,
+			FailedCount: failedCount,
+		}); err != nil {
+			return fmt.Errorf("failed to update line campaign progress: %w", err)
+		}
+
+		lastCustomerID = customerIDs[len(customerIDs)-1]
+		time.Sleep(LineBatchInterval)
+	}
+
+	return nil
+}
+
+// send multicasts the campaign message to the LINE users of the batch
+func (r *LineRunner) send(campaign dbgen.LineCampaign, lineUids []string) error {
+	if campaign.MessageType == common.LineCampaignMessageTypeFlex {
+		return r.lineMessenger.SendMulticastFlexMessage(lineUids, campaign.AltText.String, json.RawMessage(campaign.FlexContents))
+	}
+
+	return r.lineMessenger.SendMulticastTextMessage(lineUids, campaign.TextContent.String)
+}
@@ -0,0 +1,277 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const BatchSize = 200
+
+// StaleTimeout is how long a RUNNING campaign may go without progress before it is considered stopped and resumed
+const StaleTimeout = 10 * time.Minute
+
+var (
+	// ErrStatusNotAllowed is returned when the campaign is not PENDING, or not a stale RUNNING campaign when resuming
+	ErrStatusNotAllowed = errors.New("campaign status not allowed")
+)
+
+type Runner struct {
+	queries       *dbgen.Queries
+	db            *pgxpool.Pool
+	lineMessenger *utils.LineMessageClient
+}
+
+func NewRunner(queries *dbgen.Queries, db *pgxpool.Pool, lineMessenger *utils.LineMessageClient) RunnerInterface {
+	return &Runner{
+		queries:       queries,
+		db:            db,
+		lineMessenger: lineMessenger,
+	}
+}
+
+type lineTarget struct {
+	lineUid string
+	validTo *time.Time
+}
+
+// Run issues the campaign coupon to the target customers batch by batch, ordered by customer id.
+// Each batch is issued in its own transaction together with its progress, then the LINE messages of the batch
+// are sent when enabled. The campaign is marked FAILED with the error message when any batch fails.
+func (r *Runner) Run(ctx context.Context, campaignID int64) error {
+	campaign, err := r.queries.GetCouponCampaignByID(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to get coupon campaign: %w", err)
+	}
+	if campaign.Status != common.CouponCampaignStatusPending {
+		return ErrStatusNotAllowed
+	}
+
+	targetCount, err := r.queries.CountCouponCampaignTargetCustomers(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to count coupon campaign target customers: %w", err)
+	}
+
+	// claim the campaign, another runner may have started it
+	if _, err := r.queries.UpdateCouponCampaignRunning(ctx, dbgen.UpdateCouponCampaignRunningParams{
+		ID:          campaignID,
+		TargetCount: int32(targetCount),
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrStatusNotAllowed
+		}
+		return fmt.Errorf("failed to update coupon campaign running: %w", err)
+	}
+
+	return r.finish(ctx, campaign)
+}
+
+// Resume continues a campaign left behind by a stopped runner, a PENDING campaign is started by Run and a RUNNING
+// campaign without progress since StaleTimeout is claimed again and continues after its last customer id.
+func (r *Runner) Resume(ctx context.Context, campaignID int64) error {
+	campaign, err := r.queries.GetCouponCampaignByID(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to get coupon campaign: %w", err)
+	}
+	if campaign.Status == common.CouponCampaignStatusPending {
+		return r.Run(ctx, campaignID)
+	}
+	if campaign.Status != common.CouponCampaignStatusRunning {
+		return ErrStatusNotAllowed
+	}
+
+	// claim the campaign, the runner may still be alive or another instance may have resumed it
+	if _, err := r.queries.UpdateCouponCampaignResumed(ctx, dbgen.UpdateCouponCampaignResumedParams{
+		ID:        campaignID,
+		UpdatedAt: pgtype.Timestamptz{Time: time.Now().Add(-StaleTimeout), Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrStatusNotAllowed
+		}
+		return fmt.Errorf("failed to update coupon campaign resumed: %w", err)
+	}
+
+	return r.finish(ctx, campaign)
+}
+
+// finish processes the claimed campaign and marks it COMPLETED, or FAILED with the error message
+func (r *Runner) finish(ctx context.Context, campaign dbgen.CouponCampaign) error {
+	if err := r.process(ctx, campaign); err != nil {
+		if updateErr := r.queries.UpdateCouponCampaignFailed(ctx, dbgen.UpdateCouponCampaignFailedParams{
+			ID:           campaign.ID,
+			ErrorMessage: pgtype.Text{String: err.Error(), Valid: true},
+		}); updateErr != nil {
+			log.Printf("failed to update coupon campaign %d failed: %v", campaign.ID, updateErr)
+		}
+		return err
+	}
+
+	if err := r.queries.UpdateCouponCampaignCompleted(ctx, campaign.ID); err != nil {
+		return fmt.Errorf("failed to update coupon campaign completed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Runner) process(ctx context.Context, campaign dbgen.CouponCampaign) error {
+	coupons, err := r.queries.GetCouponByIDs(ctx, []int64{campaign.CouponID})
+	if err != nil {
+		return fmt.Errorf("failed to get coupon: %w", err)
+	}
+	if len(coupons) == 0 || !utils.PgBoolToBool(coupons[0].IsActive) {
+		return fmt.Errorf("coupon %d is not active", campaign.CouponID)
+	}
+	couponInfo := coupons[0]
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return fmt.Errorf("failed to load location: %w", err)
+	}
+
+	lastCustomerID := campaign.LastCustomerID
+	for {
+		customers, err := r.queries.GetCouponCampaignTargetCustomers(ctx, dbgen.GetCouponCampaignTargetCustomersParams{
+			ID:    campaign.ID,
+			ID_2:  lastCustomerID,
+			Limit: BatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get coupon campaign target customers: %w", err)
+		}
+
+		if len(customers) == 0 {
+			break
+		}
+
+		now := time.Now().In(loc)
+		lastCustomerID = customers[len(customers)-1].ID
+		targets, err := r.issueBatch(ctx, campaign, couponInfo.IsRepeatable, customers, lastCustomerID, now)
+		if err != nil {
+			return err
+		}
+
+		var lineSentCount, lineFailedCount int32
+		if campaign.SendLine {
+			for _, target := range targets {
+				// walk-in customers without LINE only receive the coupon
+				if target.lineUid == "" {
+					continue
+				}
+				message := buildLineMessage(campaign, couponInfo.DisplayName, target.validTo)
+				if err := r.lineMessenger.SendTextMessage(target.lineUid, message); err != nil {
+					log.Printf("failed to send coupon campaign %d LINE message: %v", campaign.ID, err)
+					lineFailedCount++
+					continue
+				}
+				lineSentCount++
+			}
+		}
+
+		if campaign.SendLine {
+			if err := r.queries.UpdateCouponCampaignProgress(ctx, dbgen.UpdateCouponCampaignProgressParams{
+				ID:              campaign.ID,
+				LineSentCount:   lineSentCount,
+				LineFailedCount: lineFailedCount,
+				LastCustomerID:  lastCustomerID,
+			}); err != nil {
+				return fmt.Errorf("failed to update coupon campaign progress: %w", err)
+			}
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil
+}
+
+// issueBatch issues the coupon to the customers of the batch and records the progress up to lastCustomerID within
+// a transaction, so a resumed campaign never issues a committed batch again. The issued customers are returned,
+// a non-repeatable coupon already held by the customer is skipped
+func (r *Runner) issueBatch(ctx context.Context, campaign dbgen.CouponCampaign, isRepeatable bool, customers []dbgen.GetCouponCampaignTargetCustomersRow, lastCustomerID int64, now time.Time) ([]lineTarget, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	sourceType := common.CustomerCouponSourceCampaign
+	validMonths := utils.PgInt4ToInt32Ptr(campaign.ValidMonths)
+	targets := []lineTarget{}
+	var skippedCount int32
+	for _, customer := range customers {
+		if !isRepeatable {
+			exists, err := qtx.CheckCustomerCouponExists(ctx, dbgen.CheckCustomerCouponExistsParams{
+				CustomerID: customer.ID,
+				CouponID:   campaign.CouponID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to check customer coupon existence: %w", err)
+			}
+			if exists {
+				skippedCount++
+				continue
+			}
+		}
+
+		customerCouponID, err := coupon.Issue(ctx, qtx, coupon.IssueParams{
+			CustomerID:  customer.ID,
+			CouponID:    campaign.CouponID,
+			ValidMonths: validMonths,
+			SourceType:  &sourceType,
+			SourceID:    &campaign.ID,
+			Now:         now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("customer %d: %w", customer.ID, err)
+		}
+		if customerCouponID == nil {
+			skippedCount++
+			continue
+		}
+
+		targets = append(targets, lineTarget{
+			lineUid: customer.LineUid,
+			validTo: coupon.ValidTo(validMonths, nil, now),
+		})
+	}
+
+	if err := qtx.UpdateCouponCampaignProgress(ctx, dbgen.UpdateCouponCampaignProgressParams{
+		ID:             campaign.ID,
+		IssuedCount:    int32(len(targets)),
+		SkippedCount:   skippedCount,
+		LastCustomerID: lastCustomerID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update coupon campaign progress: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return targets, nil
+}
+
+// buildLineMessage returns the campaign message, a default message is used when not set
+func buildLineMessage(campaign dbgen.CouponCampaign, couponName string, validTo *time.Time) string {
+	if campaign.LineMessage.Valid && campaign.LineMessage.String != "" {
+		return campaign.LineMessage.String
+	}
+
+	if validTo == nil {
+		return fmt.Sprintf("您獲得一張優惠券「%s」，歡迎預約使用！", couponName)
+	}
+	return fmt.Sprintf("您獲得一張優惠券「%s」，有效期限至 %s，歡迎預約使用！", couponName, validTo.Format("2006-01-02"))
+}
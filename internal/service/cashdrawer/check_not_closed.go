@@ -0,0 +1,44 @@
+package cashdrawer
+
+import (
+	"context"
+	"time"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// CheckNotClosed locks the store's cash drawer of today (Asia/Taipei) and checks it is not closed, closedErrCode is returned when closed.
+// It must be called with transaction queries, the lock is released on commit or rollback.
+func CheckNotClosed(ctx context.Context, qtx *dbgen.Queries, storeID int64, closedErrCode string) error {
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	todayPg := utils.TimePtrToPgDate(&today)
+
+	if err := qtx.LockCashDrawerByDate(ctx, dbgen.LockCashDrawerByDateParams{
+		StoreID:   storeID,
+		CloseDate: todayPg,
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to lock cash drawer", err)
+	}
+
+	closed, err := qtx.CheckCashDrawerCloseExists(ctx, dbgen.CheckCashDrawerCloseExistsParams{
+		StoreID:   storeID,
+		CloseDate: todayPg,
+	})
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check cash drawer close exists", err)
+	}
+	if closed {
+		return errorCodes.NewServiceErrorWithCode(closedErrCode)
+	}
+
+	return nil
+}
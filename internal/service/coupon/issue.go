@@ -0,0 +1,95 @@
+package coupon
+
+import (
+	"context"
+	"time"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type IssueParams struct {
+	CustomerID  int64
+	CouponID    int64
+	ValidMonths *int32
+	// ValidDays is used instead of ValidMonths when set
+	ValidDays  *int32
+	SourceType *string
+	SourceID   *int64
+	Now        time.Time
+}
+
+// Issue issues the coupon to the customer within the given transaction queries and returns the customer coupon id.
+// The coupon is valid until the end of the day ValidMonths (or ValidDays) later, nil for both means it never expires.
+// Nil is returned without error when the coupon is missing or inactive, or when a non-repeatable coupon without source
+// has already been issued to the customer, since such coupon can only be issued once per customer.
+func Issue(ctx context.Context, qtx *dbgen.Queries, params IssueParams) (*int64, error) {
+	coupons, err := qtx.GetCouponByIDs(ctx, []int64{params.CouponID})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon", err)
+	}
+	if len(coupons) == 0 || !utils.PgBoolToBool(coupons[0].IsActive) {
+		return nil, nil
+	}
+
+	validTo := ValidTo(params.ValidMonths, params.ValidDays, params.Now)
+
+	id := utils.GenerateID()
+	if params.SourceType != nil {
+		if err := qtx.CreateCustomerCouponWithSource(ctx, dbgen.CreateCustomerCouponWithSourceParams{
+			ID:         id,
+			CustomerID: params.CustomerID,
+			CouponID:   params.CouponID,
+			ValidFrom:  utils.TimePtrToPgTimestamptz(&params.Now),
+			ValidTo:    utils.TimePtrToPgTimestamptz(validTo),
+			SourceType: utils.StringPtrToPgText(params.SourceType, true),
+			SourceID:   utils.Int64PtrToPgInt8(params.SourceID),
+		}); err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer coupon", err)
+		}
+
+		return &id, nil
+	}
+
+	if !coupons[0].IsRepeatable {
+		exists, err := qtx.CheckCustomerCouponExists(ctx, dbgen.CheckCustomerCouponExistsParams{
+			CustomerID: params.CustomerID,
+			CouponID:   params.CouponID,
+		})
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer coupon existence", err)
+		}
+		if exists {
+			return nil, nil
+		}
+	}
+
+	if err := qtx.CreateCustomerCoupon(ctx, dbgen.CreateCustomerCouponParams{
+		ID:         id,
+		CustomerID: params.CustomerID,
+		CouponID:   params.CouponID,
+		ValidFrom:  utils.TimePtrToPgTimestamptz(&params.Now),
+		ValidTo:    utils.TimePtrToPgTimestamptz(validTo),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer coupon", err)
+	}
+
+	return &id, nil
+}
+
+// ValidTo returns the end of the day validDays (or validMonths when validDays is nil) after now, nil when both are nil
+func ValidTo(validMonths, validDays *int32, now time.Time) *time.Time {
+	var end time.Time
+	switch {
+	case validDays != nil:
+		end = now.AddDate(0, 0, int(*validDays))
+	case validMonths != nil:
+		end = now.AddDate(0, int(*validMonths), 0)
+	default:
+		return nil
+	}
+
+	end = time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, now.Location())
+	return &end
+}
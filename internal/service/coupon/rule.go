@@ -0,0 +1,231 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Service struct {
+	ServiceID int64
+	IsAddon   bool
+}
+
+type CheckParams struct {
+	CustomerCouponID int64
+	CustomerID       int64
+	// SpendAmount is the total amount before discount used to check the minimum spend
+	SpendAmount int64
+	// Services are the services the coupon is applied to
+	Services []Service
+	// AnyService allows the coupon when it is eligible for at least one of the services, otherwise all services must be eligible
+	AnyService bool
+	Now        time.Time
+}
+
+type CheckResult struct {
+	CouponID       int64
+	DiscountRate   *float64
+	DiscountAmount *float64
+}
+
+type RedeemParams struct {
+	CustomerCouponID int64
+	CustomerID       int64
+	CouponID         int64
+}
+
+// Check validates the customer coupon against the owner, usage status, validity period and the coupon rules
+// (date window, minimum spend, eligible services and usage caps), and returns the discount of the coupon.
+// The first failed rule is returned as a service error.
+func Check(ctx context.Context, q *dbgen.Queries, params CheckParams) (*CheckResult, error) {
+	customerCoupon, err := q.GetCustomerCouponPriceInfoByID(ctx, params.CustomerCouponID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer coupon price info", err)
+	}
+
+	if customerCoupon.CustomerID != params.CustomerID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponNotBelongToCustomer)
+	}
+	if customerCoupon.IsUsed.Bool {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponAlreadyUsed)
+	}
+	if !customerCoupon.IsActive.Bool {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotActive)
+	}
+	if customerCoupon.ValidFrom.Valid && customerCoupon.ValidFrom.Time.After(params.Now) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotStarted)
+	}
+	if customerCoupon.ValidTo.Valid && customerCoupon.ValidTo.Time.Before(params.Now) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponExpired)
+	}
+
+	rule, err := q.GetCouponRuleByID(ctx, customerCoupon.CouponID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon rule", err)
+	}
+
+	// coupon date window is compared with the date in Asia/Taipei
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	now := params.Now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if rule.StartDate.Valid && today.Before(rule.StartDate.Time) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponNotStarted)
+	}
+	if rule.EndDate.Valid && today.After(rule.EndDate.Time) {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponEnded)
+	}
+
+	if rule.MinSpendAmount.Valid {
+		minSpendAmount, err := utils.PgNumericToInt64(rule.MinSpendAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert min spend amount to int64", err)
+		}
+		if params.SpendAmount < minSpendAmount {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CouponMinSpendNotReached)
+		}
+	}
+
+	if err := checkServices(ctx, q, rule.ID, rule.ServiceScope, params.Services, params.AnyService); err != nil {
+		return nil, err
+	}
+
+	if err := checkUsageLimits(ctx, q, rule.ID, params.CustomerID, rule.TotalUsageLimit, rule.PerCustomerUsageLimit); err != nil {
+		return nil, err
+	}
+
+	result := &CheckResult{
+		CouponID: customerCoupon.CouponID,
+	}
+	if customerCoupon.DiscountRate.Valid {
+		discountRate, err := utils.PgNumericToFloat64(customerCoupon.DiscountRate)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
+		}
+		result.DiscountRate = &discountRate
+	}
+	if customerCoupon.DiscountAmount.Valid {
+		discountAmount, err := utils.PgNumericToFloat64(customerCoupon.DiscountAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to float64", err)
+		}
+		result.DiscountAmount = &discountAmount
+	}
+
+	return result, nil
+}
+
+// Redeem marks the customer coupon as used within the given transaction queries.
+// The coupon row is locked and the usage caps are checked again, so concurrent checkouts cannot exceed the caps,
+// and the customer coupon is only marked when it is still unused, so it cannot be redeemed twice.
+func Redeem(ctx context.Context, qtx *dbgen.Queries, params RedeemParams) error {
+	rule, err := qtx.GetCouponRuleByIDForUpdate(ctx, params.CouponID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to lock coupon", err)
+	}
+
+	if err := checkUsageLimits(ctx, qtx, rule.ID, params.CustomerID, rule.TotalUsageLimit, rule.PerCustomerUsageLimit); err != nil {
+		return err
+	}
+
+	// only an unused coupon is updated, a concurrent checkout redeeming the same coupon gets no rows
+	if _, err := qtx.UpdateCustomerCouponUsed(ctx, params.CustomerCouponID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerCouponAlreadyUsed)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer coupon used", err)
+	}
+
+	return nil
+}
+
+// IsServiceEligible returns whether the service matches the service scope and, when not empty, the eligible service ids of the coupon
+func IsServiceEligible(serviceScope string, eligibleServiceIDs []int64, service Service) bool {
+	switch serviceScope {
+	case common.CouponServiceScopeMain:
+		if service.IsAddon {
+			return false
+		}
+	case common.CouponServiceScopeAddon:
+		if !service.IsAddon {
+			return false
+		}
+	}
+
+	if len(eligibleServiceIDs) == 0 {
+		return true
+	}
+	for _, id := range eligibleServiceIDs {
+		if id == service.ServiceID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkServices checks the services the coupon is applied to are eligible
+func checkServices(ctx context.Context, q *dbgen.Queries, couponID int64, serviceScope string, services []Service, anyService bool) error {
+	eligibleServiceIDs, err := q.GetCouponServiceIDsByCouponID(ctx, couponID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get coupon services", err)
+	}
+
+	eligibleCount := 0
+	for _, service := range services {
+		if IsServiceEligible(serviceScope, eligibleServiceIDs, service) {
+			eligibleCount++
+		}
+	}
+
+	if anyService && eligibleCount == 0 && len(services) > 0 {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CouponServiceNotEligible)
+	}
+	if !anyService && eligibleCount != len(services) {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CouponServiceNotEligible)
+	}
+
+	return nil
+}
+
+// checkUsageLimits checks the redemptions of the coupon have not reached the total and per customer caps
+func checkUsageLimits(ctx context.Context, q *dbgen.Queries, couponID, customerID int64, totalUsageLimit, perCustomerUsageLimit pgtype.Int4) error {
+	if totalUsageLimit.Valid {
+		count, err := q.CountCouponRedemptions(ctx, couponID)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count coupon redemptions", err)
+		}
+		if count >= int64(totalUsageLimit.Int32) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CouponTotalUsageLimitReached)
+		}
+	}
+
+	if perCustomerUsageLimit.Valid {
+		count, err := q.CountCustomerCouponRedemptions(ctx, dbgen.CountCustomerCouponRedemptionsParams{
+			CustomerID: customerID,
+			CouponID:   couponID,
+		})
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to count customer coupon redemptions", err)
+		}
+		if count >= int64(perCustomerUsageLimit.Int32) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CouponCustomerUsageLimitReached)
+		}
+	}
+
+	return nil
+}
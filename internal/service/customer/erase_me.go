@@ -0,0 +1,66 @@
+package customer
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/cache"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/privacy"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type EraseMe struct {
+	db        *pgxpool.Pool
+	authCache cache.AuthCacheInterface
+}
+
+func NewEraseMe(db *pgxpool.Pool, authCache cache.AuthCacheInterface) EraseMeInterface {
+	return &EraseMe{
+		db:        db,
+		authCache: authCache,
+	}
+}
+
+func (s *EraseMe) EraseMe(ctx context.Context, customerID int64, req customerModel.EraseMeRequest) (*customerModel.EraseMeResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	if err := privacy.Erase(ctx, qtx, customerID); err != nil {
+		return nil, err
+	}
+
+	// every personal data request is logged
+	if err := qtx.CreateCustomerDataRequest(ctx, dbgen.CreateCustomerDataRequestParams{
+		ID:          utils.GenerateID(),
+		CustomerID:  customerID,
+		RequestType: common.CustomerDataRequestTypeErasure,
+		RequestedBy: common.CustomerDataRequestedByCustomer,
+		Note:        utils.StringPtrToPgText(req.Note, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer data request", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	// the cached context still has the personal data
+	if cacheErr := s.authCache.DeleteCustomerContext(ctx, customerID); cacheErr != nil {
+		log.Println("failed to delete customer context from cache", cacheErr)
+	}
+
+	return &customerModel.EraseMeResponse{
+		ID: utils.FormatID(customerID),
+	}, nil
+}
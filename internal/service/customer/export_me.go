@@ -0,0 +1,41 @@
+package customer
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/privacy"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type ExportMe struct {
+	queries *dbgen.Queries
+}
+
+func NewExportMe(queries *dbgen.Queries) ExportMeInterface {
+	return &ExportMe{
+		queries: queries,
+	}
+}
+
+func (s *ExportMe) ExportMe(ctx context.Context, customerID int64) (*customerModel.ExportMeResponse, error) {
+	export, err := privacy.BuildExport(ctx, s.queries, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// every personal data request is logged
+	if err := s.queries.CreateCustomerDataRequest(ctx, dbgen.CreateCustomerDataRequestParams{
+		ID:          utils.GenerateID(),
+		CustomerID:  customerID,
+		RequestType: common.CustomerDataRequestTypeExport,
+		RequestedBy: common.CustomerDataRequestedByCustomer,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer data request", err)
+	}
+
+	return export, nil
+}
@@ -44,19 +44,33 @@ func (s *GetMe) GetMe(ctx context.Context, customerID int64) (*customerModel.Get
 		favoriteStyles = customerData.FavoriteStyles
 	}
 
+	// customer without point record has no points yet
+	var points int32
+	customerPoint, err := s.queries.GetCustomerPointByCustomerID(ctx, customerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point", err)
+	}
+	if err == nil {
+		points = customerPoint.Balance
+	}
+
 	// Build response
 	response := &customerModel.GetMeResponse{
-		ID:             utils.FormatID(customerData.ID),
-		Name:           customerData.Name,
-		Phone:          customerData.Phone,
-		Email:          utils.PgTextToString(customerData.Email),
-		Birthday:       utils.PgDateToDateString(customerData.Birthday),
-		City:           utils.PgTextToString(customerData.City),
-		FavoriteShapes: favoriteShapes,
-		FavoriteColors: favoriteColors,
-		FavoriteStyles: favoriteStyles,
-		IsIntrovert:    customerData.IsIntrovert.Bool,
-		CustomerNote:   utils.PgTextToString(customerData.CustomerNote),
+		ID:                  utils.FormatID(customerData.ID),
+		Name:                customerData.Name,
+		Phone:               customerData.Phone,
+		Email:               utils.PgTextToString(customerData.Email),
+		Birthday:            utils.PgDateToDateString(customerData.Birthday),
+		City:                utils.PgTextToString(customerData.City),
+		FavoriteShapes:      favoriteShapes,
+		FavoriteColors:      favoriteColors,
+		FavoriteStyles:      favoriteStyles,
+		IsIntrovert:         customerData.IsIntrovert.Bool,
+		CustomerNote:        utils.PgTextToString(customerData.CustomerNote),
+		InvoiceCarrierType:  utils.PgTextToString(customerData.InvoiceCarrierType),
+		InvoiceCarrierValue: utils.PgTextToString(customerData.InvoiceCarrierValue),
+		Points:              points,
+		ReferralCode:        utils.PgTextToString(customerData.ReferralCode),
 	}
 
 	return response, nil
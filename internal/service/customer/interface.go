@@ -13,3 +13,11 @@ type GetMeInterface interface {
 type UpdateMeInterface interface {
 	UpdateMe(ctx context.Context, customerID int64, req customerModel.UpdateMeRequest) (*customerModel.UpdateMeResponse, error)
 }
+
+type ExportMeInterface interface {
+	ExportMe(ctx context.Context, customerID int64) (*customerModel.ExportMeResponse, error)
+}
+
+type EraseMeInterface interface {
+	EraseMe(ctx context.Context, customerID int64, req customerModel.EraseMeRequest) (*customerModel.EraseMeResponse, error)
+}
@@ -3,8 +3,10 @@ package customer
 import (
 	"context"
 	"log"
+	"regexp"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
 	customerModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
@@ -12,6 +14,13 @@ import (
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
+var (
+	// mobileBarcodeRegexp is the format of 手機條碼, e.g. /ABC+123
+	mobileBarcodeRegexp = regexp.MustCompile(`^/[0-9A-Z.+-]{7}$`)
+	// donationCodeRegexp is the format of 捐贈碼 (愛心碼)
+	donationCodeRegexp = regexp.MustCompile(`^[0-9]{3,7}$`)
+)
+
 type UpdateMe struct {
 	repo      *sqlxRepo.Repositories
 	db        *dbgen.Queries
@@ -35,18 +44,26 @@ func (s *UpdateMe) UpdateMe(ctx context.Context, customerID int64, req customerM
 		}
 	}
 
+	// Validation: check invoice carrier value matches carrier type
+	invoiceCarrierType, invoiceCarrierValue, err := parseInvoiceCarrier(req.InvoiceCarrierType, req.InvoiceCarrierValue)
+	if err != nil {
+		return nil, err
+	}
+
 	// Data Integrity: Update customer data
 	result, err := s.repo.Customer.UpdateCustomer(ctx, customerID, sqlxRepo.UpdateCustomerParams{
-		Name:           req.Name,
-		Phone:          req.Phone,
-		Birthday:       req.Birthday,
-		City:           req.City,
-		Email:          req.Email,
-		FavoriteShapes: req.FavoriteShapes,
-		FavoriteColors: req.FavoriteColors,
-		FavoriteStyles: req.FavoriteStyles,
-		IsIntrovert:    req.IsIntrovert,
-		CustomerNote:   req.CustomerNote,
+		Name:                req.Name,
+		Phone:               req.Phone,
+		Birthday:            req.Birthday,
+		City:                req.City,
+		Email:               req.Email,
+		FavoriteShapes:      req.FavoriteShapes,
+		FavoriteColors:      req.FavoriteColors,
+		FavoriteStyles:      req.FavoriteStyles,
+		IsIntrovert:         req.IsIntrovert,
+		CustomerNote:        req.CustomerNote,
+		InvoiceCarrierType:  invoiceCarrierType,
+		InvoiceCarrierValue: invoiceCarrierValue,
 	})
 	if err != nil {
 		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer", err)
@@ -72,18 +89,53 @@ func (s *UpdateMe) UpdateMe(ctx context.Context, customerID int64, req customerM
 	}
 
 	response := customerModel.UpdateMeResponse{
-		ID:             utils.FormatID(result.ID),
-		Name:           result.Name,
-		Phone:          result.Phone,
-		Birthday:       utils.PgDateToDateString(result.Birthday),
-		Email:          utils.PgTextToString(result.Email),
-		City:           utils.PgTextToString(result.City),
-		FavoriteShapes: favoriteShapes,
-		FavoriteColors: favoriteColors,
-		FavoriteStyles: favoriteStyles,
-		IsIntrovert:    utils.PgBoolToBool(result.IsIntrovert),
-		CustomerNote:   utils.PgTextToString(result.CustomerNote),
+		ID:                  utils.FormatID(result.ID),
+		Name:                result.Name,
+		Phone:               result.Phone,
+		Birthday:            utils.PgDateToDateString(result.Birthday),
+		Email:               utils.PgTextToString(result.Email),
+		City:                utils.PgTextToString(result.City),
+		FavoriteShapes:      favoriteShapes,
+		FavoriteColors:      favoriteColors,
+		FavoriteStyles:      favoriteStyles,
+		IsIntrovert:         utils.PgBoolToBool(result.IsIntrovert),
+		CustomerNote:        utils.PgTextToString(result.CustomerNote),
+		InvoiceCarrierType:  utils.PgTextToString(result.InvoiceCarrierType),
+		InvoiceCarrierValue: utils.PgTextToString(result.InvoiceCarrierValue),
 	}
 
 	return &response, nil
 }
+
+// parseInvoiceCarrier validates the carrier value by carrier type, NONE is returned as empty strings to clear the carrier
+func parseInvoiceCarrier(carrierType, carrierValue *string) (*string, *string, error) {
+	if carrierType == nil {
+		if carrierValue != nil {
+			return nil, nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerInvoiceCarrierInvalid)
+		}
+		return nil, nil, nil
+	}
+
+	empty := ""
+	value := ""
+	if carrierValue != nil {
+		value = *carrierValue
+	}
+
+	switch *carrierType {
+	case common.InvoiceCarrierTypeNone:
+		return &empty, &empty, nil
+	case common.InvoiceCarrierTypeMobileBarcode:
+		if !mobileBarcodeRegexp.MatchString(value) {
+			return nil, nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerInvoiceCarrierInvalid)
+		}
+	case common.InvoiceCarrierTypeDonation:
+		if !donationCodeRegexp.MatchString(value) {
+			return nil, nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerInvoiceCarrierInvalid)
+		}
+	default:
+		return nil, nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerInvoiceCarrierInvalid)
+	}
+
+	return carrierType, &value, nil
+}
@@ -2,11 +2,16 @@ package customerCoupon
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
 	customerCouponModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_coupon"
 	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
 	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
 	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
 )
 
@@ -23,6 +28,16 @@ func NewGetAll(queries *dbgen.Queries, repo *sqlxRepo.Repositories) GetAllInterf
 }
 
 func (s *GetAll) GetAll(ctx context.Context, customerID int64, req customerCouponModel.GetAllParsedRequest) (*customerCouponModel.GetAllResponse, error) {
+	// when bookingId is provided, get the booking services to check the coupon applicability
+	var bookingCheck *coupon.CheckParams
+	if req.BookingID != nil {
+		checkParams, err := s.getBookingCheckParams(ctx, customerID, *req.BookingID)
+		if err != nil {
+			return nil, err
+		}
+		bookingCheck = checkParams
+	}
+
 	// set default sort
 	if len(req.Sort) == 0 {
 		req.Sort = []string{"isUsed", "validTo"}
@@ -64,16 +79,16 @@ func (s *GetAll) GetAll(ctx context.Context, customerID int64, req customerCoupo
 
 	items := make([]customerCouponModel.GetAllCustomerCouponItem, len(results))
 	for i, r := range results {
-		coupon, ok := couponMap[r.CouponID]
+		couponInfo, ok := couponMap[r.CouponID]
 		if !ok {
 			return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "Coupon not found", nil)
 		}
 
-		discountRate, err := utils.PgNumericToFloat64(coupon.DiscountRate)
+		discountRate, err := utils.PgNumericToFloat64(couponInfo.DiscountRate)
 		if err != nil {
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
 		}
-		discountAmount, err := utils.PgNumericToInt64(coupon.DiscountAmount)
+		discountAmount, err := utils.PgNumericToInt64(couponInfo.DiscountAmount)
 		if err != nil {
 			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to int64", err)
 		}
@@ -87,12 +102,22 @@ func (s *GetAll) GetAll(ctx context.Context, customerID int64, req customerCoupo
 			CreatedAt: utils.PgTimestamptzToTimeString(r.CreatedAt),
 			Coupon: customerCouponModel.GetAllItemCouponDTO{
 				ID:             utils.FormatID(r.CouponID),
-				DisplayName:    coupon.DisplayName,
+				DisplayName:    couponInfo.DisplayName,
 				DiscountRate:   discountRate,
 				DiscountAmount: discountAmount,
-				IsActive:       utils.PgBoolToBool(coupon.IsActive),
+				IsActive:       utils.PgBoolToBool(couponInfo.IsActive),
 			},
 		}
+
+		if bookingCheck != nil {
+			checkParams := *bookingCheck
+			checkParams.CustomerCouponID = r.ID
+			applicability, err := s.checkApplicability(ctx, checkParams)
+			if err != nil {
+				return nil, err
+			}
+			items[i].Applicability = applicability
+		}
 	}
 
 	return &customerCouponModel.GetAllResponse{
@@ -100,3 +125,77 @@ func (s *GetAll) GetAll(ctx context.Context, customerID int64, req customerCoupo
 		Items: items,
 	}, nil
 }
+
+// getBookingCheckParams returns the coupon check params of the customer's booking, the coupon is checked at the booking time
+func (s *GetAll) getBookingCheckParams(ctx context.Context, customerID, bookingID int64) (*coupon.CheckParams, error) {
+	booking, err := s.queries.GetBookingInfoWithDateByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BookingNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get booking", err)
+	}
+	if booking.CustomerID != customerID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.BookingNotFound)
+	}
+
+	details, err := s.queries.GetBookingDetailPriceInfoByBookingID(ctx, bookingID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get booking details", err)
+	}
+
+	spendAmount := int64(0)
+	services := make([]coupon.Service, len(details))
+	for i, detail := range details {
+		price, err := utils.PgNumericToInt64(detail.Price)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert price to int64", err)
+		}
+		spendAmount += price
+		services[i] = coupon.Service{
+			ServiceID: detail.ServiceID,
+			IsAddon:   utils.PgBoolToBool(detail.IsAddon),
+		}
+	}
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+	}
+	startTime, err := utils.PgTimeToTime(booking.StartTime)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert start time", err)
+	}
+	workDate := booking.WorkDate.Time
+	bookingAt := time.Date(workDate.Year(), workDate.Month(), workDate.Day(), startTime.Hour(), startTime.Minute(), 0, 0, loc)
+
+	return &coupon.CheckParams{
+		CustomerID:  customerID,
+		SpendAmount: spendAmount,
+		Services:    services,
+		AnyService:  true,
+		Now:         bookingAt,
+	}, nil
+}
+
+// checkApplicability checks the coupon rules against the booking, the failed rule is returned as the reason
+func (s *GetAll) checkApplicability(ctx context.Context, params coupon.CheckParams) (*customerCouponModel.GetAllItemApplicabilityDTO, error) {
+	_, err := coupon.Check(ctx, s.queries, params)
+	if err == nil {
+		return &customerCouponModel.GetAllItemApplicabilityDTO{
+			IsApplicable: true,
+		}, nil
+	}
+
+	code, ok := errorCodes.IsServiceError(err)
+	if !ok || code == errorCodes.SysDatabaseError || code == errorCodes.SysInternalError || code == errorCodes.ValTypeConversionFailed {
+		return nil, err
+	}
+
+	manager := errorCodes.GetManager()
+	return &customerCouponModel.GetAllItemApplicabilityDTO{
+		IsApplicable: false,
+		ReasonCode:   manager.GetCode(code),
+		Reason:       manager.GetMessage(code),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package customerHealthQuestionnaire
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetMe struct {
+	queries *dbgen.Queries
+}
+
+func NewGetMe(queries *dbgen.Queries) GetMeInterface {
+	return &GetMe{
+		queries: queries,
+	}
+}
+
+func (s *GetMe) GetMe(ctx context.Context, customerID int64) (*customerHealthQuestionnaireModel.GetMeResponse, error) {
+	response := &customerHealthQuestionnaireModel.GetMeResponse{
+		Version:              common.CustomerHealthQuestionnaireVersion,
+		AllergyOptions:       common.HealthAllergyOptions,
+		SkinConditionOptions: common.HealthSkinConditionOptions,
+		NeedsUpdate:          true,
+	}
+
+	questionnaire, err := s.queries.GetLatestCustomerHealthQuestionnaire(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return response, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer health questionnaire", err)
+	}
+
+	response.Answer = &customerHealthQuestionnaireModel.GetMeAnswer{
+		Version:           questionnaire.Version,
+		Allergies:         emptyIfNil(questionnaire.Allergies),
+		AllergyNote:       utils.PgTextToString(questionnaire.AllergyNote),
+		SkinConditions:    emptyIfNil(questionnaire.SkinConditions),
+		SkinConditionNote: utils.PgTextToString(questionnaire.SkinConditionNote),
+		IsPregnant:        questionnaire.IsPregnant,
+		OtherNote:         utils.PgTextToString(questionnaire.OtherNote),
+		AnsweredAt:        utils.PgTimestamptzToTimeString(questionnaire.CreatedAt),
+	}
+	// answers of an outdated questionnaire should be reviewed again
+	response.NeedsUpdate = questionnaire.Version != common.CustomerHealthQuestionnaireVersion
+
+	return response, nil
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,15 @@
+package customerHealthQuestionnaire
+
+import (
+	"context"
+
+	customerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_health_questionnaire"
+)
+
+type GetMeInterface interface {
+	GetMe(ctx context.Context, customerID int64) (*customerHealthQuestionnaireModel.GetMeResponse, error)
+}
+
+type SubmitMeInterface interface {
+	SubmitMe(ctx context.Context, customerID int64, req customerHealthQuestionnaireModel.SubmitMeRequest) (*customerHealthQuestionnaireModel.SubmitMeResponse, error)
+}
@@ -0,0 +1,61 @@
+package customerHealthQuestionnaire
+
+import (
+	"context"
+	"slices"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	customerHealthQuestionnaireModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_health_questionnaire"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type SubmitMe struct {
+	queries *dbgen.Queries
+}
+
+func NewSubmitMe(queries *dbgen.Queries) SubmitMeInterface {
+	return &SubmitMe{
+		queries: queries,
+	}
+}
+
+func (s *SubmitMe) SubmitMe(ctx context.Context, customerID int64, req customerHealthQuestionnaireModel.SubmitMeRequest) (*customerHealthQuestionnaireModel.SubmitMeResponse, error) {
+	if req.Version != common.CustomerHealthQuestionnaireVersion {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerHealthQuestionnaireVersionOutdated)
+	}
+
+	// every submission is kept, the latest one is the current answer
+	questionnaireID := utils.GenerateID()
+	answeredAt, err := s.queries.CreateCustomerHealthQuestionnaire(ctx, dbgen.CreateCustomerHealthQuestionnaireParams{
+		ID:                questionnaireID,
+		CustomerID:        customerID,
+		Version:           req.Version,
+		Allergies:         uniqueStrings(req.Allergies),
+		AllergyNote:       utils.StringPtrToPgText(req.AllergyNote, true),
+		SkinConditions:    uniqueStrings(req.SkinConditions),
+		SkinConditionNote: utils.StringPtrToPgText(req.SkinConditionNote, true),
+		IsPregnant:        *req.IsPregnant,
+		OtherNote:         utils.StringPtrToPgText(req.OtherNote, true),
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer health questionnaire", err)
+	}
+
+	return &customerHealthQuestionnaireModel.SubmitMeResponse{
+		ID:         utils.FormatID(questionnaireID),
+		Version:    req.Version,
+		AnsweredAt: utils.PgTimestamptzToTimeString(answeredAt),
+	}, nil
+}
+
+func uniqueStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package customerWallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetMe struct {
+	queries *dbgen.Queries
+}
+
+func NewGetMe(queries *dbgen.Queries) GetMeInterface {
+	return &GetMe{
+		queries: queries,
+	}
+}
+
+func (s *GetMe) GetMe(ctx context.Context, customerID int64) (*customerWalletModel.GetMeResponse, error) {
+	// customer without wallet has zero balance
+	wallet, err := s.queries.GetCustomerWalletByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &customerWalletModel.GetMeResponse{
+				Balance:   0,
+				UpdatedAt: "",
+			}, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet", err)
+	}
+
+	balance, err := utils.PgNumericToInt64(wallet.Balance)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance to int64", err)
+	}
+
+	return &customerWalletModel.GetMeResponse{
+		Balance:   balance,
+		UpdatedAt: utils.PgTimestamptzToTimeString(wallet.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package customerWallet
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetMyTransactions struct {
+	repo *sqlxRepo.Repositories
+}
+
+func NewGetMyTransactions(repo *sqlxRepo.Repositories) GetMyTransactionsInterface {
+	return &GetMyTransactions{
+		repo: repo,
+	}
+}
+
+func (s *GetMyTransactions) GetMyTransactions(ctx context.Context, customerID int64, req customerWalletModel.GetMyTransactionsParsedRequest) (*customerWalletModel.GetMyTransactionsResponse, error) {
+	total, items, err := s.repo.CustomerWalletTransaction.GetAllCustomerWalletTransactionsByFilter(ctx, customerID, sqlxRepo.GetAllCustomerWalletTransactionsByFilterParams{
+		Type:   req.Type,
+		Limit:  &req.Limit,
+		Offset: &req.Offset,
+		Sort:   &req.Sort,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet transactions", err)
+	}
+
+	responseItems := make([]customerWalletModel.GetMyTransactionsItem, len(items))
+	for i, item := range items {
+		amount, err := utils.PgNumericToInt64(item.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount to int64", err)
+		}
+		balance, err := utils.PgNumericToInt64(item.Balance)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance to int64", err)
+		}
+
+		responseItems[i] = customerWalletModel.GetMyTransactionsItem{
+			ID:            utils.FormatID(item.ID),
+			StoreName:     utils.PgTextToString(item.StoreName),
+			Type:          item.Type,
+			Amount:        amount,
+			Balance:       balance,
+			PaymentMethod: utils.PgTextToString(item.PaymentMethod),
+			Note:          utils.PgTextToString(item.Note),
+			CreatedAt:     utils.PgTimestamptzToTimeString(item.CreatedAt),
+		}
+	}
+
+	return &customerWalletModel.GetMyTransactionsResponse{
+		Total: total,
+		Items: responseItems,
+	}, nil
+}
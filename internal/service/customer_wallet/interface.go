@@ -0,0 +1,19 @@
+package customerWallet
+
+import (
+	"context"
+
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+)
+
+type GetMeInterface interface {
+	GetMe(ctx context.Context, customerID int64) (*customerWalletModel.GetMeResponse, error)
+}
+
+type GetMyTransactionsInterface interface {
+	GetMyTransactions(ctx context.Context, customerID int64, req customerWalletModel.GetMyTransactionsParsedRequest) (*customerWalletModel.GetMyTransactionsResponse, error)
+}
+
+type RedeemInterface interface {
+	Redeem(ctx context.Context, customerID int64, req customerWalletModel.RedeemRequest) (*customerWalletModel.RedeemResponse, error)
+}
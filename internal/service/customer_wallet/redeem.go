@@ -0,0 +1,50 @@
+package customerWallet
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	customerWalletModel "github.com/tkoleo84119/nail-salon-backend/internal/model/customer_wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+)
+
+type Redeem struct {
+	db *pgxpool.Pool
+}
+
+func NewRedeem(db *pgxpool.Pool) RedeemInterface {
+	return &Redeem{
+		db: db,
+	}
+}
+
+func (s *Redeem) Redeem(ctx context.Context, customerID int64, req customerWalletModel.RedeemRequest) (*customerWalletModel.RedeemResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	result, err := wallet.RedeemGiftCard(ctx, qtx, wallet.RedeemGiftCardParams{
+		Code:       strings.ToUpper(strings.TrimSpace(req.Code)),
+		CustomerID: customerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return &customerWalletModel.RedeemResponse{
+		Amount:  result.Amount,
+		Balance: result.Balance,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package expense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/ledger"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// SyncLedger makes the account transaction of the expense match its current content within the given transaction queries.
+// An expense paid by the store is posted on its expense date, an expense paid by a staff is posted when it is reimbursed.
+// Otherwise the posting is reversed. The expense row is locked until the transaction ends, so concurrent syncs post the latest content.
+func SyncLedger(ctx context.Context, qtx *dbgen.Queries, storeID, expenseID, staffID int64) error {
+	expense, err := qtx.GetStoreExpenseByIDForUpdate(ctx, dbgen.GetStoreExpenseByIDForUpdateParams{
+		ID:      expenseID,
+		StoreID: storeID,
+	})
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get expense", err)
+	}
+
+	posting, err := getPosting(ctx, qtx, storeID, expense)
+	if err != nil {
+		return err
+	}
+
+	return ledger.ReplaceSourceTransaction(ctx, qtx, common.AccountTransactionSourceExpense, expenseID, posting, staffID)
+}
+
+// SyncLedgerInNewTx runs SyncLedger in its own transaction, for the services writing the expense with sqlx.
+// It must be called after the expense change is committed.
+func SyncLedgerInNewTx(ctx context.Context, db *pgxpool.Pool, storeID, expenseID, staffID int64) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := SyncLedger(ctx, dbgen.New(tx), storeID, expenseID, staffID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// getPosting returns the account transaction the expense should have, nil when it should have none
+func getPosting(ctx context.Context, qtx *dbgen.Queries, storeID int64, expense dbgen.GetStoreExpenseByIDForUpdateRow) (*ledger.PostTransactionParams, error) {
+	category := utils.PgTextToString(expense.Category)
+
+	var transactionDate time.Time
+	var note string
+	switch {
+	case !expense.PayerID.Valid:
+		transactionDate = expense.ExpenseDate.Time
+		note = fmt.Sprintf("支出 (%s)", category)
+	case expense.IsReimbursed.Valid && expense.IsReimbursed.Bool && expense.ReimbursedAt.Valid:
+		loc, err := time.LoadLocation("Asia/Taipei")
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+		}
+		transactionDate = expense.ReimbursedAt.Time.In(loc)
+		note = fmt.Sprintf("支出結清 (%s)", category)
+	default:
+		// advanced by a staff and not reimbursed yet
+		return nil, nil
+	}
+
+	var totalAmount int64
+	if expense.Amount.Valid {
+		amount, err := utils.PgNumericToInt64(expense.Amount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+		}
+		totalAmount = amount
+	}
+	if expense.OtherFee.Valid {
+		otherFee, err := utils.PgNumericToInt64(expense.OtherFee)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert other fee", err)
+		}
+		totalAmount += otherFee
+	}
+	if totalAmount <= 0 {
+		return nil, nil
+	}
+
+	// postings are skipped when no account is mapped
+	accountID, err := qtx.GetStoreExpensePayerAccountID(ctx, dbgen.GetStoreExpensePayerAccountIDParams{
+		StoreID: storeID,
+		PayerID: expense.PayerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store expense payer account", err)
+	}
+
+	sourceType := common.AccountTransactionSourceExpense
+	expenseID := expense.ID
+
+	return &ledger.PostTransactionParams{
+		StoreID:         storeID,
+		AccountID:       accountID,
+		TransactionDate: transactionDate,
+		Type:            common.AccountTransactionTypeExpense,
+		Amount:          totalAmount,
+		Note:            &note,
+		SourceType:      &sourceType,
+		SourceID:        &expenseID,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// GetCustomerFlags returns the critical answers of the latest questionnaire of the customer,
+// it is nil when the customer has not answered or has nothing to flag.
+func GetCustomerFlags(ctx context.Context, queries *dbgen.Queries, customerID int64) (*common.CustomerHealthFlags, error) {
+	questionnaire, err := queries.GetLatestCustomerHealthQuestionnaire(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return ToFlags(questionnaire), nil
+}
+
+// GetCustomersFlags returns the flags of the customers by customer ID, customers without flags are not in the map.
+func GetCustomersFlags(ctx context.Context, queries *dbgen.Queries, customerIDs []int64) (map[int64]*common.CustomerHealthFlags, error) {
+	flags := make(map[int64]*common.CustomerHealthFlags)
+	if len(customerIDs) == 0 {
+		return flags, nil
+	}
+
+	questionnaires, err := queries.GetLatestCustomerHealthQuestionnairesByCustomerIDs(ctx, customerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, questionnaire := range questionnaires {
+		if flag := ToFlags(questionnaire); flag != nil {
+			flags[questionnaire.CustomerID] = flag
+		}
+	}
+
+	return flags, nil
+}
+
+// ToFlags returns nil when the questionnaire has no allergy, skin condition or pregnancy.
+func ToFlags(questionnaire dbgen.CustomerHealthQuestionnaire) *common.CustomerHealthFlags {
+	allergyNote := utils.PgTextToString(questionnaire.AllergyNote)
+	skinConditionNote := utils.PgTextToString(questionnaire.SkinConditionNote)
+	if len(questionnaire.Allergies) == 0 && allergyNote == "" &&
+		len(questionnaire.SkinConditions) == 0 && skinConditionNote == "" && !questionnaire.IsPregnant {
+		return nil
+	}
+
+	return &common.CustomerHealthFlags{
+		Version:           questionnaire.Version,
+		Allergies:         emptyIfNil(questionnaire.Allergies),
+		AllergyNote:       allergyNote,
+		SkinConditions:    emptyIfNil(questionnaire.SkinConditions),
+		SkinConditionNote: skinConditionNote,
+		IsPregnant:        questionnaire.IsPregnant,
+		AnsweredAt:        utils.PgTimestamptzToTimeString(questionnaire.CreatedAt),
+	}
+}
+
+// GetAllergyWarnings returns the services containing allergens the customer is allergic to, in the order of the service IDs.
+func GetAllergyWarnings(ctx context.Context, queries *dbgen.Queries, flags *common.CustomerHealthFlags, serviceIDs []int64) ([]common.HealthAllergyWarning, error) {
+	warnings := []common.HealthAllergyWarning{}
+	if flags == nil || len(flags.Allergies) == 0 || len(serviceIDs) == 0 {
+		return warnings, nil
+	}
+
+	services, err := queries.GetServiceAllergensByIDs(ctx, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	serviceMap := make(map[int64]dbgen.GetServiceAllergensByIDsRow, len(services))
+	for _, service := range services {
+		serviceMap[service.ID] = service
+	}
+
+	for _, serviceID := range serviceIDs {
+		service, ok := serviceMap[serviceID]
+		if !ok {
+			continue
+		}
+
+		var allergens []string
+		for _, allergen := range service.Allergens {
+			if slices.Contains(flags.Allergies, allergen) {
+				allergens = append(allergens, allergen)
+			}
+		}
+		if len(allergens) > 0 {
+			warnings = append(warnings, common.HealthAllergyWarning{
+				ServiceID:   utils.FormatID(service.ID),
+				ServiceName: service.Name,
+				Allergens:   allergens,
+			})
+		}
+	}
+
+	return warnings, nil
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
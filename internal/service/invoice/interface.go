@@ -0,0 +1,16 @@
+package invoice
+
+import (
+	"context"
+)
+
+// ProviderInterface is the upstream e-invoice platform (加值中心) used to issue and void invoices
+type ProviderInterface interface {
+	Issue(ctx context.Context, req IssueRequest) (*IssueResult, error)
+	Void(ctx context.Context, req VoidRequest) error
+}
+
+type IssuerInterface interface {
+	Issue(ctx context.Context, invoiceID int64) error
+	Void(ctx context.Context, invoiceID int64) error
+}
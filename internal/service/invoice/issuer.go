@@ -0,0 +1,147 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+var (
+	// ErrStatusNotAllowed is returned when the invoice status does not allow the operation
+	ErrStatusNotAllowed = errors.New("invoice status not allowed")
+	// ErrProviderFailed wraps the error returned by the provider, the failure is already recorded on the invoice
+	ErrProviderFailed = errors.New("invoice provider failed")
+)
+
+type Issuer struct {
+	db       *pgxpool.Pool
+	provider ProviderInterface
+}
+
+func NewIssuer(db *pgxpool.Pool, provider ProviderInterface) IssuerInterface {
+	return &Issuer{
+		db:       db,
+		provider: provider,
+	}
+}
+
+// Issue issues a PENDING or FAILED invoice through the provider and records the result.
+// The invoice row is locked during the provider call, so a refund waits until the issue is finished.
+func (s *Issuer) Issue(ctx context.Context, invoiceID int64) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	invoice, err := qtx.GetInvoiceByIDForUpdate(ctx, invoiceID)
+	if err != nil {
+		return fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice.Status != common.InvoiceStatusPending && invoice.Status != common.InvoiceStatusFailed {
+		return ErrStatusNotAllowed
+	}
+
+	amount, err := utils.PgNumericToInt64(invoice.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to convert amount: %w", err)
+	}
+
+	result, issueErr := s.provider.Issue(ctx, IssueRequest{
+		InvoiceID:    invoice.ID,
+		StoreID:      invoice.StoreID,
+		Amount:       amount,
+		CarrierType:  utils.PgTextToStringPtr(invoice.CarrierType),
+		CarrierValue: utils.PgTextToStringPtr(invoice.CarrierValue),
+	})
+	if issueErr != nil {
+		if err := qtx.UpdateInvoiceIssueFailed(ctx, dbgen.UpdateInvoiceIssueFailedParams{
+			ID:           invoice.ID,
+			ErrorMessage: pgtype.Text{String: issueErr.Error(), Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to update invoice issue failed: %w", err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return fmt.Errorf("%w: %v", ErrProviderFailed, issueErr)
+	}
+
+	if err := qtx.UpdateInvoiceIssued(ctx, dbgen.UpdateInvoiceIssuedParams{
+		ID:            invoice.ID,
+		InvoiceNumber: utils.StringPtrToPgText(&result.InvoiceNumber, true),
+		RandomCode:    utils.StringPtrToPgText(&result.RandomCode, true),
+		IssuedAt:      utils.TimePtrToPgTimestamptz(&result.IssuedAt),
+	}); err != nil {
+		return fmt.Errorf("failed to update invoice issued: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Void voids a VOID_PENDING or VOID_FAILED invoice through the provider and records the result.
+// An invoice which was never issued is voided locally without calling the provider.
+func (s *Issuer) Void(ctx context.Context, invoiceID int64) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := dbgen.New(tx)
+
+	invoice, err := qtx.GetInvoiceByIDForUpdate(ctx, invoiceID)
+	if err != nil {
+		return fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice.Status != common.InvoiceStatusVoidPending && invoice.Status != common.InvoiceStatusVoidFailed {
+		return ErrStatusNotAllowed
+	}
+
+	if invoice.InvoiceNumber.Valid {
+		voidErr := s.provider.Void(ctx, VoidRequest{
+			InvoiceNumber: invoice.InvoiceNumber.String,
+			Reason:        utils.PgTextToString(invoice.VoidReason),
+		})
+		if voidErr != nil {
+			if err := qtx.UpdateInvoiceVoidFailed(ctx, dbgen.UpdateInvoiceVoidFailedParams{
+				ID:           invoice.ID,
+				ErrorMessage: pgtype.Text{String: voidErr.Error(), Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to update invoice void failed: %w", err)
+			}
+			if err := tx.Commit(ctx); err != nil {
+				return fmt.Errorf("failed to commit transaction: %w", err)
+			}
+			return fmt.Errorf("%w: %v", ErrProviderFailed, voidErr)
+		}
+	}
+
+	now := time.Now()
+	if err := qtx.UpdateInvoiceVoided(ctx, dbgen.UpdateInvoiceVoidedParams{
+		ID:       invoice.ID,
+		VoidedAt: utils.TimePtrToPgTimestamptz(&now),
+	}); err != nil {
+		return fmt.Errorf("failed to update invoice voided: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
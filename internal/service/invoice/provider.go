@@ -0,0 +1,39 @@
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/config"
+)
+
+const ProviderStub = "stub"
+
+type IssueRequest struct {
+	InvoiceID    int64
+	StoreID      int64
+	Amount       int64
+	CarrierType  *string
+	CarrierValue *string
+}
+
+type IssueResult struct {
+	InvoiceNumber string
+	RandomCode    string
+	IssuedAt      time.Time
+}
+
+type VoidRequest struct {
+	InvoiceNumber string
+	Reason        string
+}
+
+// NewProvider returns the provider configured by INVOICE_PROVIDER
+func NewProvider(cfg config.InvoiceConfig) (ProviderInterface, error) {
+	switch cfg.Provider {
+	case ProviderStub:
+		return NewStubProvider(), nil
+	default:
+		return nil, fmt.Errorf("unsupported invoice provider: %s", cfg.Provider)
+	}
+}
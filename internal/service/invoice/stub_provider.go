@@ -0,0 +1,35 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+)
+
+// StubProvider is a local provider for development, it issues fake invoice numbers without calling any upstream
+type StubProvider struct{}
+
+func NewStubProvider() ProviderInterface {
+	return &StubProvider{}
+}
+
+func (p *StubProvider) Issue(ctx context.Context, req IssueRequest) (*IssueResult, error) {
+	// invoice number is 2 track letters + 8 digits, e.g. ST12345678
+	invoiceNumber := fmt.Sprintf("ST%08d", rand.Intn(100000000))
+	randomCode := fmt.Sprintf("%04d", rand.Intn(10000))
+
+	log.Printf("[invoice stub] issue invoice %s, amount: %d", invoiceNumber, req.Amount)
+
+	return &IssueResult{
+		InvoiceNumber: invoiceNumber,
+		RandomCode:    randomCode,
+		IssuedAt:      time.Now(),
+	}, nil
+}
+
+func (p *StubProvider) Void(ctx context.Context, req VoidRequest) error {
+	log.Printf("[invoice stub] void invoice %s, reason: %s", req.InvoiceNumber, req.Reason)
+	return nil
+}
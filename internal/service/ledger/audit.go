@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// TransactionSnapshot is the content of a transaction saved in the audit trail
+type TransactionSnapshot struct {
+	TransactionDate string `json:"transactionDate"`
+	Type            string `json:"type"`
+	Amount          int64  `json:"amount"`
+	Note            string `json:"note"`
+}
+
+// SnapshotOf converts the transaction to the content saved in the audit trail
+func SnapshotOf(accountTransaction dbgen.GetAccountTransactionByIDRow) (*TransactionSnapshot, error) {
+	amount, err := utils.PgNumericToInt64(accountTransaction.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	return &TransactionSnapshot{
+		TransactionDate: utils.PgDateToDateString(accountTransaction.TransactionDate),
+		Type:            accountTransaction.Type,
+		Amount:          amount,
+		Note:            utils.PgTextToString(accountTransaction.Note),
+	}, nil
+}
+
+type CreateAuditParams struct {
+	AccountID     int64
+	TransactionID int64
+	Action        string
+	Before        *TransactionSnapshot
+	After         *TransactionSnapshot
+	StaffID       int64
+}
+
+// CreateAudit records a manual change of an account transaction
+func CreateAudit(ctx context.Context, qtx *dbgen.Queries, params CreateAuditParams) error {
+	var beforeData, afterData []byte
+	if params.Before != nil {
+		data, err := json.Marshal(params.Before)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal audit before data", err)
+		}
+		beforeData = data
+	}
+	if params.After != nil {
+		data, err := json.Marshal(params.After)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal audit after data", err)
+		}
+		afterData = data
+	}
+
+	if err := qtx.CreateAccountTransactionAudit(ctx, dbgen.CreateAccountTransactionAuditParams{
+		ID:                   utils.GenerateID(),
+		AccountID:            params.AccountID,
+		AccountTransactionID: params.TransactionID,
+		Action:               params.Action,
+		BeforeData:           beforeData,
+		AfterData:            afterData,
+		CreatedBy:            params.StaffID,
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account transaction audit", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+// DeleteTransaction deletes a single transaction, records the audit and recomputes the balances of the account.
+// The caller must hold the account lock within the same transaction.
+func DeleteTransaction(ctx context.Context, qtx *dbgen.Queries, accountTransaction dbgen.GetAccountTransactionByIDRow, staffID int64) error {
+	before, err := SnapshotOf(accountTransaction)
+	if err != nil {
+		return err
+	}
+
+	// bank statement lines reconciled with this transaction need to be reconciled again
+	if err := qtx.ResetAccountStatementLinesByTransactionID(ctx, pgtype.Int8{Int64: accountTransaction.ID, Valid: true}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to reset account statement lines", err)
+	}
+
+	if err := qtx.DeleteAccountTransactionByID(ctx, accountTransaction.ID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete account transaction", err)
+	}
+
+	if err := CreateAudit(ctx, qtx, CreateAuditParams{
+		AccountID:     accountTransaction.AccountID,
+		TransactionID: accountTransaction.ID,
+		Action:        common.AccountTransactionAuditActionDelete,
+		Before:        before,
+		StaffID:       staffID,
+	}); err != nil {
+		return err
+	}
+
+	return RecomputeBalances(ctx, qtx, accountTransaction.AccountID)
+}
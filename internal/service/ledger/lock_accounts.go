@@ -0,0 +1,31 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+// LockAccounts locks the account rows in ascending id order within the given transaction queries.
+// Every change touching more than one account locks them through here first, so concurrent changes can not deadlock.
+func LockAccounts(ctx context.Context, qtx *dbgen.Queries, accountIDs []int64) error {
+	ids := slices.Clone(accountIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	for _, id := range ids {
+		if _, err := qtx.GetAccountByIDForUpdate(ctx, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+			}
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+		}
+	}
+
+	return nil
+}
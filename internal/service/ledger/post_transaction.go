@@ -0,0 +1,117 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type PostTransactionParams struct {
+	StoreID         int64
+	AccountID       int64
+	TransactionDate time.Time
+	Type            string
+	Amount          int64
+	Note            *string
+	SourceType      *string
+	SourceID        *int64
+}
+
+// PostTransaction inserts a transaction to the account within the given transaction queries.
+// The account row is locked so concurrent postings compute the balance sequentially.
+// A back-dated transaction takes the balance as of its date, and shifts the balances of later transactions.
+func PostTransaction(ctx context.Context, qtx *dbgen.Queries, params PostTransactionParams) (int64, error) {
+	account, err := qtx.GetAccountByIDForUpdate(ctx, params.AccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotFound)
+		}
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account", err)
+	}
+	if account.StoreID != params.StoreID {
+		return 0, errorCodes.NewServiceErrorWithCode(errorCodes.AccountNotBelongToStore)
+	}
+
+	transactionDate := utils.TimePtrToPgDate(&params.TransactionDate)
+	currentBalance, err := qtx.GetAccountBalanceAsOfDate(ctx, dbgen.GetAccountBalanceAsOfDateParams{
+		AccountID:       params.AccountID,
+		TransactionDate: transactionDate,
+	})
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account balance as of date", err)
+	}
+
+	balance, err := nextBalance(currentBalance, params.Type, params.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	balanceNumeric, err := utils.Int64PtrToPgNumeric(&balance)
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance", err)
+	}
+
+	amountNumeric, err := utils.Int64PtrToPgNumeric(&params.Amount)
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	transactionID, err := qtx.CreateAccountTransaction(ctx, dbgen.CreateAccountTransactionParams{
+		ID:              utils.GenerateID(),
+		AccountID:       params.AccountID,
+		TransactionDate: transactionDate,
+		Type:            params.Type,
+		Amount:          amountNumeric,
+		Balance:         balanceNumeric,
+		Note:            utils.StringPtrToPgText(params.Note, true),
+		SourceType:      utils.StringPtrToPgText(params.SourceType, true),
+		SourceID:        utils.Int64PtrToPgInt8(params.SourceID),
+	})
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create account transaction", err)
+	}
+
+	delta := balance - currentBalance
+	deltaNumeric, err := utils.Int64PtrToPgNumeric(&delta)
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert delta", err)
+	}
+	if err := qtx.ShiftAccountTransactionBalancesAfterDate(ctx, dbgen.ShiftAccountTransactionBalancesAfterDateParams{
+		AccountID:       params.AccountID,
+		TransactionDate: transactionDate,
+		Delta:           deltaNumeric,
+	}); err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to shift account transaction balances", err)
+	}
+
+	if delta < 0 {
+		if err := checkNoNegativeBalance(ctx, qtx, params.AccountID); err != nil {
+			return 0, err
+		}
+	}
+
+	return transactionID, nil
+}
+
+// nextBalance applies the amount to the current balance, the balance is not allowed to be less than 0
+func nextBalance(currentBalance int64, transactionType string, amount int64) (int64, error) {
+	balance := currentBalance
+	if transactionType == common.AccountTransactionTypeIncome {
+		balance += amount
+	} else {
+		balance -= amount
+	}
+
+	if balance < 0 {
+		return 0, errorCodes.NewServiceErrorWithCode(errorCodes.AccountBalanceNotEnough)
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,31 @@
+package ledger
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+// RecomputeBalances recomputes the running balance of every transaction of the account in date order.
+// The caller must hold the account lock (GetAccountByIDForUpdate) within the same transaction.
+func RecomputeBalances(ctx context.Context, qtx *dbgen.Queries, accountID int64) error {
+	if err := qtx.RecomputeAccountTransactionBalances(ctx, accountID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to recompute account transaction balances", err)
+	}
+
+	return checkNoNegativeBalance(ctx, qtx, accountID)
+}
+
+// checkNoNegativeBalance makes sure no transaction of the account ends with a balance less than 0
+func checkNoNegativeBalance(ctx context.Context, qtx *dbgen.Queries, accountID int64) error {
+	negativeExists, err := qtx.CheckAccountNegativeBalanceExists(ctx, accountID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check account negative balance", err)
+	}
+	if negativeExists {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.AccountBalanceNotEnough)
+	}
+
+	return nil
+}
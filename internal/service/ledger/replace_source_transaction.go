@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// ReplaceSourceTransaction makes the postings of the source match the given posting within the given transaction queries.
+// A single posting on the same account is adjusted in place, otherwise the postings are deleted and the posting is posted again.
+// A nil posting reverses the source by deleting its postings. Adjustments and deletions are recorded in the audit trail by the staff.
+func ReplaceSourceTransaction(ctx context.Context, qtx *dbgen.Queries, sourceType string, sourceID int64, posting *PostTransactionParams, staffID int64) error {
+	rows, err := qtx.GetAccountTransactionsBySource(ctx, dbgen.GetAccountTransactionsBySourceParams{
+		SourceType: utils.StringPtrToPgText(&sourceType, false),
+		SourceID:   utils.Int64PtrToPgInt8(&sourceID),
+	})
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get source account transactions", err)
+	}
+
+	accountIDs := make([]int64, 0, len(rows)+1)
+	for _, row := range rows {
+		accountIDs = append(accountIDs, row.AccountID)
+	}
+	if posting != nil {
+		accountIDs = append(accountIDs, posting.AccountID)
+	}
+	if err := LockAccounts(ctx, qtx, accountIDs); err != nil {
+		return err
+	}
+
+	if posting != nil && len(rows) == 1 && rows[0].AccountID == posting.AccountID {
+		return adjustTransaction(ctx, qtx, rows[0].ID, *posting, staffID)
+	}
+
+	for _, row := range rows {
+		accountTransaction, err := qtx.GetAccountTransactionByID(ctx, row.ID)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+		}
+		if err := DeleteTransaction(ctx, qtx, accountTransaction, staffID); err != nil {
+			return err
+		}
+	}
+
+	if posting == nil {
+		return nil
+	}
+
+	_, err = PostTransaction(ctx, qtx, *posting)
+	return err
+}
+
+// adjustTransaction updates the transaction to the posting, nothing is changed when they are the same
+func adjustTransaction(ctx context.Context, qtx *dbgen.Queries, transactionID int64, posting PostTransactionParams, staffID int64) error {
+	accountTransaction, err := qtx.GetAccountTransactionByID(ctx, transactionID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get account transaction", err)
+	}
+
+	before, err := SnapshotOf(accountTransaction)
+	if err != nil {
+		return err
+	}
+	after := TransactionSnapshot{
+		TransactionDate: posting.TransactionDate.Format("2006-01-02"),
+		Type:            posting.Type,
+		Amount:          posting.Amount,
+		Note:            utils.PgTextToString(utils.StringPtrToPgText(posting.Note, true)),
+	}
+	if *before == after {
+		return nil
+	}
+
+	amountNumeric, err := utils.Int64PtrToPgNumeric(&posting.Amount)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	if err := qtx.UpdateAccountTransactionEntry(ctx, dbgen.UpdateAccountTransactionEntryParams{
+		ID:              transactionID,
+		TransactionDate: utils.TimePtrToPgDate(&posting.TransactionDate),
+		Type:            posting.Type,
+		Amount:          amountNumeric,
+		Note:            utils.StringPtrToPgText(posting.Note, true),
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update account transaction", err)
+	}
+
+	if err := CreateAudit(ctx, qtx, CreateAuditParams{
+		AccountID:     accountTransaction.AccountID,
+		TransactionID: transactionID,
+		Action:        common.AccountTransactionAuditActionUpdate,
+		Before:        before,
+		After:         &after,
+		StaffID:       staffID,
+	}); err != nil {
+		return err
+	}
+
+	// only the note changes won't affect the balances
+	if before.TransactionDate == after.TransactionDate && before.Type == after.Type && before.Amount == after.Amount {
+		return nil
+	}
+
+	return RecomputeBalances(ctx, qtx, accountTransaction.AccountID)
+}
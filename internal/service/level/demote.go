@@ -0,0 +1,117 @@
+package level
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type DemoteResult struct {
+	FromLevel string
+	ToLevel   string
+}
+
+// DemoteCustomer evaluates the active rule of the customer's current level within the given transaction queries,
+// and demotes the customer to the highest lower level still qualified (NORMAL when none) once the current rule is no longer met.
+// Levels without an active rule and levels last set by staff are kept, nil is returned when the level is unchanged.
+func DemoteCustomer(ctx context.Context, qtx *dbgen.Queries, customerID int64, now time.Time) (*DemoteResult, error) {
+	rules, err := qtx.GetActiveCustomerLevelRules(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get active customer level rules", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	currentLevel, err := qtx.GetCustomerLevelByIDForUpdate(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer level", err)
+	}
+	fromLevel := utils.PgTextToString(currentLevel)
+	fromRank := common.CustomerLevelRank(fromLevel)
+	if fromRank == 0 {
+		return nil, nil
+	}
+
+	// evaluate from the highest level, the first qualified level below the current one is the target
+	sort.Slice(rules, func(i, j int) bool {
+		return common.CustomerLevelRank(rules[i].Level) > common.CustomerLevelRank(rules[j].Level)
+	})
+
+	var currentRule *dbgen.CustomerLevelRule
+	for i := range rules {
+		if rules[i].Level == fromLevel {
+			currentRule = &rules[i]
+			break
+		}
+	}
+	if currentRule == nil {
+		return nil, nil
+	}
+
+	// a level granted by staff is kept until the rules promote the customer again
+	reason, err := qtx.GetLatestCustomerLevelHistoryReason(ctx, customerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get latest customer level history", err)
+	}
+	if reason == common.CustomerLevelChangeReasonManual {
+		return nil, nil
+	}
+
+	qualified, note, err := checkRule(ctx, qtx, customerID, *currentRule, now)
+	if err != nil {
+		return nil, err
+	}
+	if qualified {
+		return nil, nil
+	}
+
+	toLevel := common.CustomerLevelNormal
+	for _, rule := range rules {
+		if common.CustomerLevelRank(rule.Level) >= fromRank {
+			continue
+		}
+
+		qualified, _, err := checkRule(ctx, qtx, customerID, rule, now)
+		if err != nil {
+			return nil, err
+		}
+		if qualified {
+			toLevel = rule.Level
+			break
+		}
+	}
+
+	if err := qtx.UpdateCustomerLevel(ctx, dbgen.UpdateCustomerLevelParams{
+		ID:    customerID,
+		Level: utils.StringPtrToPgText(&toLevel, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer level", err)
+	}
+
+	note = fmt.Sprintf("未達 %s 門檻，%s", fromLevel, note)
+	if err := qtx.CreateCustomerLevelHistory(ctx, dbgen.CreateCustomerLevelHistoryParams{
+		ID:         utils.GenerateID(),
+		CustomerID: customerID,
+		FromLevel:  currentLevel,
+		ToLevel:    toLevel,
+		Reason:     common.CustomerLevelChangeReasonRule,
+		Note:       utils.StringPtrToPgText(&note, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer level history", err)
+	}
+
+	return &DemoteResult{
+		FromLevel: fromLevel,
+		ToLevel:   toLevel,
+	}, nil
+}
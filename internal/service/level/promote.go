@@ -0,0 +1,132 @@
+package level
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type PromoteResult struct {
+	FromLevel string
+	ToLevel   string
+}
+
+// PromoteCustomer evaluates the active level rules against the customer's checkouts within the given transaction queries,
+// and promotes the customer to the highest level whose spend and visit thresholds are both reached.
+// Only promotion happens here (demotion is evaluated nightly by DemoteCustomer), nil is returned when the level is unchanged.
+// The benefit coupons of every level unlocked by the promotion are issued to the customer.
+func PromoteCustomer(ctx context.Context, qtx *dbgen.Queries, customerID int64, now time.Time) (*PromoteResult, error) {
+	rules, err := qtx.GetActiveCustomerLevelRules(ctx)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get active customer level rules", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	currentLevel, err := qtx.GetCustomerLevelByIDForUpdate(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer level", err)
+	}
+	fromLevel := utils.PgTextToString(currentLevel)
+	fromRank := common.CustomerLevelRank(fromLevel)
+
+	// evaluate from the highest level, the first qualified level is the target
+	sort.Slice(rules, func(i, j int) bool {
+		return common.CustomerLevelRank(rules[i].Level) > common.CustomerLevelRank(rules[j].Level)
+	})
+
+	var target *dbgen.CustomerLevelRule
+	var note string
+	for i := range rules {
+		rule := rules[i]
+		if common.CustomerLevelRank(rule.Level) <= fromRank {
+			break
+		}
+
+		qualified, ruleNote, err := checkRule(ctx, qtx, customerID, rule, now)
+		if err != nil {
+			return nil, err
+		}
+		if qualified {
+			target = &rule
+			note = ruleNote
+			break
+		}
+	}
+	if target == nil {
+		return nil, nil
+	}
+
+	if err := qtx.UpdateCustomerLevel(ctx, dbgen.UpdateCustomerLevelParams{
+		ID:    customerID,
+		Level: utils.StringPtrToPgText(&target.Level, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer level", err)
+	}
+
+	if err := qtx.CreateCustomerLevelHistory(ctx, dbgen.CreateCustomerLevelHistoryParams{
+		ID:         utils.GenerateID(),
+		CustomerID: customerID,
+		FromLevel:  currentLevel,
+		ToLevel:    target.Level,
+		Reason:     common.CustomerLevelChangeReasonRule,
+		Note:       utils.StringPtrToPgText(&note, true),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer level history", err)
+	}
+
+	targetRank := common.CustomerLevelRank(target.Level)
+	for _, rule := range rules {
+		rank := common.CustomerLevelRank(rule.Level)
+		if rank <= fromRank || rank > targetRank || !rule.BenefitCouponID.Valid {
+			continue
+		}
+		if _, err := coupon.Issue(ctx, qtx, coupon.IssueParams{
+			CustomerID:  customerID,
+			CouponID:    rule.BenefitCouponID.Int64,
+			ValidMonths: utils.PgInt4ToInt32Ptr(rule.BenefitCouponValidMonths),
+			Now:         now,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PromoteResult{
+		FromLevel: fromLevel,
+		ToLevel:   target.Level,
+	}, nil
+}
+
+// checkRule returns whether the customer reaches the thresholds of the rule, and the note describing the checkout stats
+func checkRule(ctx context.Context, qtx *dbgen.Queries, customerID int64, rule dbgen.CustomerLevelRule, now time.Time) (bool, string, error) {
+	since := now.AddDate(0, -int(rule.PeriodMonths), 0)
+	stats, err := qtx.GetCustomerCheckoutStatsSince(ctx, dbgen.GetCustomerCheckoutStatsSinceParams{
+		CustomerID: customerID,
+		CreatedAt:  utils.TimePtrToPgTimestamptz(&since),
+	})
+	if err != nil {
+		return false, "", errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer checkout stats", err)
+	}
+
+	totalSpend, err := utils.PgNumericToFloat64(stats.TotalSpend)
+	if err != nil {
+		return false, "", errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert total spend", err)
+	}
+	minSpendAmount, err := utils.PgNumericToFloat64(rule.MinSpendAmount)
+	if err != nil {
+		return false, "", errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert min spend amount", err)
+	}
+
+	qualified := totalSpend >= minSpendAmount && stats.VisitCount >= int64(rule.MinVisitCount)
+	note := fmt.Sprintf("近 %d 個月消費 %.0f 元、來店 %d 次", rule.PeriodMonths, totalSpend, stats.VisitCount)
+
+	return qualified, note, nil
+}
@@ -0,0 +1,394 @@
+package merge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/points"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/wallet"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type CustomerParams struct {
+	CustomerID       int64
+	MergedCustomerID int64
+	Note             *string
+	StaffID          int64
+}
+
+type CustomerResult struct {
+	ID          int64
+	MovedCounts common.CustomerMergeMovedCounts
+}
+
+// Customer merges the duplicate customer into the customer within the given transaction queries.
+// The duplicate is kept as a tombstone pointing to the customer, so the history left on it (wallet, points, level) is not cascaded away
+// and its LINE account logs in as the customer instead of registering again.
+func Customer(ctx context.Context, qtx *dbgen.Queries, params CustomerParams) (*CustomerResult, error) {
+	if params.CustomerID == params.MergedCustomerID {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerMergeSameCustomer)
+	}
+
+	// lock both customers in id order, so concurrent merges of the same customers do not deadlock
+	if err := qtx.LockCustomersForMerge(ctx, []int64{params.CustomerID, params.MergedCustomerID}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to lock customers", err)
+	}
+
+	customer, err := getCustomer(ctx, qtx, params.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := getCustomer(ctx, qtx, params.MergedCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer.MergedIntoCustomerID.Valid || merged.MergedIntoCustomerID.Valid {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyMerged)
+	}
+	if customer.ErasedAt.Valid || merged.ErasedAt.Valid {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyErased)
+	}
+
+	mergeID := utils.GenerateID()
+	moveParams := dbgen.MoveCustomerBookingsParams{
+		CustomerID:       params.CustomerID,
+		MergedCustomerID: params.MergedCustomerID,
+	}
+
+	var movedCounts common.CustomerMergeMovedCounts
+
+	// checkouts belong to the bookings, so they follow the bookings
+	if movedCounts.Bookings, err = qtx.MoveCustomerBookings(ctx, moveParams); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move bookings", err)
+	}
+	if movedCounts.Invoices, err = qtx.MoveCustomerInvoices(ctx, dbgen.MoveCustomerInvoicesParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move invoices", err)
+	}
+	// a general coupon the customer already has stays with the duplicate
+	if movedCounts.CustomerCoupons, err = qtx.MoveCustomerCoupons(ctx, dbgen.MoveCustomerCouponsParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer coupons", err)
+	}
+	if movedCounts.TermsAcceptances, err = qtx.MoveCustomerTermsAcceptances(ctx, dbgen.MoveCustomerTermsAcceptancesParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move terms acceptances", err)
+	}
+	if movedCounts.Tokens, err = qtx.MoveCustomerTokens(ctx, dbgen.MoveCustomerTokensParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer tokens", err)
+	}
+	// the duplicate stays the referee of its own referral, a customer is referred only once
+	if movedCounts.Referrals, err = qtx.MoveCustomerReferralsAsReferrer(ctx, dbgen.MoveCustomerReferralsAsReferrerParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer referrals", err)
+	}
+	if movedCounts.BirthdayBenefits, err = qtx.MoveCustomerBirthdayBenefits(ctx, dbgen.MoveCustomerBirthdayBenefitsParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move birthday benefits", err)
+	}
+	if movedCounts.WinBackContacts, err = qtx.MoveWinBackContacts(ctx, dbgen.MoveWinBackContactsParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move win back contacts", err)
+	}
+	if movedCounts.Notes, err = qtx.MoveCustomerNotes(ctx, dbgen.MoveCustomerNotesParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer notes", err)
+	}
+	if movedCounts.HealthQuestionnaires, err = qtx.MoveCustomerHealthQuestionnaires(ctx, dbgen.MoveCustomerHealthQuestionnairesParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer health questionnaires", err)
+	}
+	if movedCounts.BlacklistDecisions, err = qtx.MoveCustomerBlacklistDecisions(ctx, dbgen.MoveCustomerBlacklistDecisionsParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to move customer blacklist decisions", err)
+	}
+
+	if movedCounts.WalletBalance, err = moveWalletBalance(ctx, qtx, params.CustomerID, params.MergedCustomerID, mergeID, params.StaffID); err != nil {
+		return nil, err
+	}
+	if movedCounts.Points, err = movePoints(ctx, qtx, params.CustomerID, params.MergedCustomerID, mergeID, params.StaffID); err != nil {
+		return nil, err
+	}
+
+	if err := reconcileCustomer(ctx, qtx, customer, merged, params.Note, params.StaffID); err != nil {
+		return nil, err
+	}
+
+	// the LINE account moves to the customer when the customer has none, otherwise it stays and resolves to the customer on login
+	mergedLineUid, mergedLineName := merged.LineUid, merged.LineName
+	if customer.LineUid == "" {
+		mergedLineUid, mergedLineName = "", pgtype.Text{}
+	}
+	if err := qtx.MarkCustomerMerged(ctx, dbgen.MarkCustomerMergedParams{
+		MergedIntoCustomerID: utils.Int64PtrToPgInt8(&params.CustomerID),
+		LineUid:              mergedLineUid,
+		LineName:             mergedLineName,
+		ID:                   params.MergedCustomerID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to mark customer merged", err)
+	}
+
+	// customers merged into the duplicate before now point to the customer
+	if err := qtx.UpdateCustomersMergedInto(ctx, dbgen.UpdateCustomersMergedIntoParams(moveParams)); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update merged customers", err)
+	}
+
+	customerSnapshot, err := json.Marshal(customer)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal customer snapshot", err)
+	}
+	mergedSnapshot, err := json.Marshal(merged)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal merged customer snapshot", err)
+	}
+	movedCountsJSON, err := json.Marshal(movedCounts)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal moved counts", err)
+	}
+
+	if err := qtx.CreateCustomerMerge(ctx, dbgen.CreateCustomerMergeParams{
+		ID:                     mergeID,
+		CustomerID:             params.CustomerID,
+		MergedCustomerID:       params.MergedCustomerID,
+		CustomerSnapshot:       customerSnapshot,
+		MergedCustomerSnapshot: mergedSnapshot,
+		MovedCounts:            movedCountsJSON,
+		Note:                   utils.StringPtrToPgText(params.Note, true),
+		CreatedBy:              utils.Int64PtrToPgInt8(&params.StaffID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer merge", err)
+	}
+
+	return &CustomerResult{
+		ID:          mergeID,
+		MovedCounts: movedCounts,
+	}, nil
+}
+
+func getCustomer(ctx context.Context, qtx *dbgen.Queries, customerID int64) (dbgen.GetCustomerByIDRow, error) {
+	customer, err := qtx.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customer, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return customer, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+	return customer, nil
+}
+
+// moveWalletBalance moves the wallet balance of the duplicate to the customer with a pair of adjustments, it returns the moved amount
+func moveWalletBalance(ctx context.Context, qtx *dbgen.Queries, customerID, mergedCustomerID, mergeID, staffID int64) (int64, error) {
+	mergedWallet, err := qtx.GetCustomerWalletByCustomerIDForUpdate(ctx, mergedCustomerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet", err)
+	}
+
+	balance, err := utils.PgNumericToInt64(mergedWallet.Balance)
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert wallet balance", err)
+	}
+	if balance <= 0 {
+		return 0, nil
+	}
+
+	sourceType := common.CustomerWalletTransactionSourceCustomerMerge
+	note := fmt.Sprintf("合併顧客 %d 至 %d", mergedCustomerID, customerID)
+	for _, posting := range []struct {
+		customerID int64
+		amount     int64
+	}{
+		{mergedCustomerID, -balance},
+		{customerID, balance},
+	} {
+		if _, err := wallet.PostTransaction(ctx, qtx, wallet.PostTransactionParams{
+			CustomerID: posting.customerID,
+			Type:       common.CustomerWalletTransactionTypeAdjust,
+			Amount:     posting.amount,
+			SourceType: &sourceType,
+			SourceID:   &mergeID,
+			Note:       &note,
+			CreatedBy:  &staffID,
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	return balance, nil
+}
+
+// movePoints moves the remaining point lots of the duplicate to the customer, the lots keep their expiry date.
+// It returns the moved points.
+func movePoints(ctx context.Context, qtx *dbgen.Queries, customerID, mergedCustomerID, mergeID, staffID int64) (int32, error) {
+	lots, err := qtx.GetCustomerPointRemainingLotsForUpdate(ctx, mergedCustomerID)
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point lots", err)
+	}
+	if len(lots) == 0 {
+		return 0, nil
+	}
+
+	sourceType := common.CustomerPointTransactionSourceCustomerMerge
+	note := fmt.Sprintf("合併顧客 %d 至 %d", mergedCustomerID, customerID)
+
+	// deduct first, the deduction is capped so a balance out of sync with the lots never fails the merge
+	deducted, err := points.PostTransaction(ctx, qtx, points.PostTransactionParams{
+		CustomerID:   mergedCustomerID,
+		Type:         common.CustomerPointTransactionTypeAdjust,
+		Points:       -sumRemainingPoints(lots),
+		CapToBalance: true,
+		SourceType:   &sourceType,
+		SourceID:     &mergeID,
+		Note:         &note,
+		CreatedBy:    &staffID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// lots of the same expiry date are added as one lot, the lots are ordered by expiry date
+	moved := -deducted.Points
+	remaining := moved
+	for i := 0; i < len(lots) && remaining > 0; {
+		expiresAt := lots[i].ExpiresAt
+		var lotPoints int32
+		for ; i < len(lots) && lots[i].ExpiresAt == expiresAt; i++ {
+			lotPoints += lots[i].RemainingPoints
+		}
+		lotPoints = min(lotPoints, remaining)
+		remaining -= lotPoints
+
+		var lotExpiresAt *time.Time
+		if expiresAt.Valid {
+			lotExpiresAt = &expiresAt.Time
+		}
+		if _, err := points.PostTransaction(ctx, qtx, points.PostTransactionParams{
+			CustomerID: customerID,
+			Type:       common.CustomerPointTransactionTypeAdjust,
+			Points:     lotPoints,
+			ExpiresAt:  lotExpiresAt,
+			SourceType: &sourceType,
+			SourceID:   &mergeID,
+			Note:       &note,
+			CreatedBy:  &staffID,
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	return moved, nil
+}
+
+func sumRemainingPoints(lots []dbgen.GetCustomerPointRemainingLotsForUpdateRow) int32 {
+	var total int32
+	for _, lot := range lots {
+		total += lot.RemainingPoints
+	}
+	return total
+}
+
+// reconcileCustomer fills the blank profile fields of the customer from the duplicate, unions the preferences, tags and notes,
+// keeps the higher level, the blacklist and the deposit restriction, and recomputes the last visit from the checkouts now belonging to the customer.
+// The name, phone and birthday of the customer are kept.
+func reconcileCustomer(ctx context.Context, qtx *dbgen.Queries, customer, merged dbgen.GetCustomerByIDRow, note *string, staffID int64) error {
+	isBlacklisted := utils.PgBoolToBool(customer.IsBlacklisted) || utils.PgBoolToBool(merged.IsBlacklisted)
+	params := dbgen.UpdateCustomerMergedProfileParams{
+		ID:                  customer.ID,
+		LineUid:             customer.LineUid,
+		LineName:            customer.LineName,
+		Email:               fillBlankText(customer.Email, merged.Email),
+		City:                fillBlankText(customer.City, merged.City),
+		FavoriteShapes:      unionStrings(customer.FavoriteShapes, merged.FavoriteShapes),
+		FavoriteColors:      unionStrings(customer.FavoriteColors, merged.FavoriteColors),
+		FavoriteStyles:      unionStrings(customer.FavoriteStyles, merged.FavoriteStyles),
+		ReferralSource:      unionStrings(customer.ReferralSource, merged.ReferralSource),
+		Referrer:            fillBlankText(customer.Referrer, merged.Referrer),
+		CustomerNote:        combineNotes(customer.CustomerNote, merged.CustomerNote),
+		StoreNote:           combineNotes(customer.StoreNote, merged.StoreNote),
+		Level:               customer.Level,
+		IsBlacklisted:       utils.BoolPtrToPgBool(&isBlacklisted),
+		RequiresDeposit:     customer.RequiresDeposit || merged.RequiresDeposit,
+		InvoiceCarrierType:  customer.InvoiceCarrierType,
+		InvoiceCarrierValue: customer.InvoiceCarrierValue,
+		Tags:                unionStrings(customer.Tags, merged.Tags),
+	}
+
+	if customer.LineUid == "" {
+		params.LineUid = merged.LineUid
+		params.LineName = merged.LineName
+	}
+
+	// the carrier type and value go together
+	if utils.PgTextToString(customer.InvoiceCarrierType) == "" {
+		params.InvoiceCarrierType = merged.InvoiceCarrierType
+		params.InvoiceCarrierValue = merged.InvoiceCarrierValue
+	}
+
+	levelChanged := common.CustomerLevelRank(utils.PgTextToString(merged.Level)) > common.CustomerLevelRank(utils.PgTextToString(customer.Level))
+	if levelChanged {
+		params.Level = merged.Level
+	}
+
+	lastCheckoutAt, err := qtx.GetCustomerLastCheckoutAt(ctx, customer.ID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer last checkout", err)
+	}
+	params.LastVisitAt = lastCheckoutAt
+	if !lastCheckoutAt.Valid {
+		params.LastVisitAt = customer.LastVisitAt
+		if merged.LastVisitAt.Valid && (!customer.LastVisitAt.Valid || merged.LastVisitAt.Time.After(customer.LastVisitAt.Time)) {
+			params.LastVisitAt = merged.LastVisitAt
+		}
+	}
+
+	if err := qtx.UpdateCustomerMergedProfile(ctx, params); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer", err)
+	}
+
+	if levelChanged {
+		if err := qtx.CreateCustomerLevelHistory(ctx, dbgen.CreateCustomerLevelHistoryParams{
+			ID:         utils.GenerateID(),
+			CustomerID: customer.ID,
+			FromLevel:  customer.Level,
+			ToLevel:    utils.PgTextToString(merged.Level),
+			Reason:     common.CustomerLevelChangeReasonMerge,
+			Note:       utils.StringPtrToPgText(note, true),
+			CreatedBy:  utils.Int64PtrToPgInt8(&staffID),
+		}); err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer level history", err)
+		}
+	}
+
+	return nil
+}
+
+func fillBlankText(value, fallback pgtype.Text) pgtype.Text {
+	if utils.PgTextToString(value) == "" {
+		return fallback
+	}
+	return value
+}
+
+func unionStrings(values, others []string) []string {
+	result := slices.Clone(values)
+	for _, other := range others {
+		if !slices.Contains(result, other) {
+			result = append(result, other)
+		}
+	}
+	return result
+}
+
+func combineNotes(note, other pgtype.Text) pgtype.Text {
+	noteString, otherString := utils.PgTextToString(note), utils.PgTextToString(other)
+	if otherString == "" || otherString == noteString {
+		return note
+	}
+	if noteString == "" {
+		return other
+	}
+	return pgtype.Text{String: noteString + "\n" + otherString, Valid: true}
+}
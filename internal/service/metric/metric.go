@@ -0,0 +1,113 @@
+package metric
+
+import (
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// weights of the churn risk score, the score is between 0 and 100
+const (
+	churnRecencyWeight      = 70
+	churnNoShowWeight       = 15
+	churnCancellationWeight = 15
+	// churnMaxOverdueRatio is how many expected intervals past the last visit reaches the full recency weight
+	churnMaxOverdueRatio = 3
+)
+
+// Calculate builds the metrics of the customer from the checkouts and bookings.
+// The checkouts are the non-refunded ones, the bookings are the completed, no-show and cancelled ones.
+func Calculate(source dbgen.GetCustomerMetricSourcesRow, now time.Time) (dbgen.UpsertCustomerMetricParams, error) {
+	lifetimeSpend, err := utils.PgNumericToFloat64(source.LifetimeSpend)
+	if err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+
+	var averageTicket float64
+	if source.CheckoutCount > 0 {
+		averageTicket = lifetimeSpend / float64(source.CheckoutCount)
+	}
+
+	// average days between the first and the last visit, needs at least two visits
+	var visitFrequencyDays *float64
+	if source.VisitCount >= 2 && source.FirstVisitAt.Valid && source.LastVisitAt.Valid {
+		days := source.LastVisitAt.Time.Sub(source.FirstVisitAt.Time).Hours() / 24 / float64(source.VisitCount-1)
+		visitFrequencyDays = &days
+	}
+
+	var daysSinceLastVisit *int32
+	if source.LastVisitAt.Valid {
+		days := int32(now.Sub(source.LastVisitAt.Time).Hours() / 24)
+		daysSinceLastVisit = &days
+	}
+
+	var noShowRate, cancellationRate float64
+	if source.BookingCount > 0 {
+		noShowRate = float64(source.NoShowCount) / float64(source.BookingCount)
+		cancellationRate = float64(source.CancelledCount) / float64(source.BookingCount)
+	}
+
+	params := dbgen.UpsertCustomerMetricParams{
+		CustomerID:         source.CustomerID,
+		VisitCount:         int32(source.VisitCount),
+		DaysSinceLastVisit: utils.Int32PtrToPgInt4(daysSinceLastVisit),
+		CalculatedAt:       utils.TimePtrToPgTimestamptz(&now),
+	}
+
+	// customers never visited have no churn risk
+	if daysSinceLastVisit != nil {
+		score := ChurnRiskScore(*daysSinceLastVisit, visitFrequencyDays, noShowRate, cancellationRate)
+		params.ChurnRiskScore = pgtype.Int4{Int32: score, Valid: true}
+		params.ChurnRiskLevel = pgtype.Text{String: ChurnRiskLevel(score), Valid: true}
+	}
+
+	if params.LifetimeSpend, err = utils.Float64PtrToPgNumeric(&lifetimeSpend); err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+	if params.AverageTicket, err = utils.Float64PtrToPgNumeric(&averageTicket); err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+	if params.VisitFrequencyDays, err = utils.Float64PtrToPgNumeric(visitFrequencyDays); err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+	if params.NoShowRate, err = utils.Float64PtrToPgNumeric(&noShowRate); err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+	if params.CancellationRate, err = utils.Float64PtrToPgNumeric(&cancellationRate); err != nil {
+		return dbgen.UpsertCustomerMetricParams{}, err
+	}
+
+	return params, nil
+}
+
+// ChurnRiskScore scores how likely the customer stops visiting, between 0 and 100.
+// Recency counts from the customer's own visit frequency: visiting on time scores 0, and the full recency weight
+// is reached after churnMaxOverdueRatio intervals. No-show and cancellation rates add the rest.
+func ChurnRiskScore(daysSinceLastVisit int32, visitFrequencyDays *float64, noShowRate, cancellationRate float64) int32 {
+	expectedInterval := float64(common.ChurnDefaultVisitIntervalDays)
+	if visitFrequencyDays != nil && *visitFrequencyDays > 0 {
+		expectedInterval = *visitFrequencyDays
+	}
+
+	overdue := (float64(daysSinceLastVisit)/expectedInterval - 1) / (churnMaxOverdueRatio - 1)
+	overdue = math.Min(math.Max(overdue, 0), 1)
+
+	score := overdue*churnRecencyWeight + noShowRate*churnNoShowWeight + cancellationRate*churnCancellationWeight
+	return int32(math.Min(math.Round(score), 100))
+}
+
+func ChurnRiskLevel(score int32) string {
+	switch {
+	case score >= 60:
+		return common.ChurnRiskLevelHigh
+	case score >= 30:
+		return common.ChurnRiskLevelMedium
+	default:
+		return common.ChurnRiskLevelLow
+	}
+}
@@ -0,0 +1,129 @@
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+func days(d float64) *float64 {
+	return &d
+}
+
+func TestChurnRiskScore(t *testing.T) {
+	cases := []struct {
+		name               string
+		daysSinceLastVisit int32
+		visitFrequencyDays *float64
+		noShowRate         float64
+		cancellationRate   float64
+		want               int32
+	}{
+		{name: "visiting earlier than usual", daysSinceLastVisit: 5, visitFrequencyDays: days(10), want: 0},
+		{name: "on time", daysSinceLastVisit: 10, visitFrequencyDays: days(10), want: 0},
+		{name: "2x overdue is half the recency weight", daysSinceLastVisit: 20, visitFrequencyDays: days(10), want: 35},
+		{name: "3x overdue reaches the full recency weight", daysSinceLastVisit: 30, visitFrequencyDays: days(10), want: 70},
+		{name: "beyond 3x overdue is capped", daysSinceLastVisit: 365, visitFrequencyDays: days(10), want: 70},
+		{name: "single visit uses the default interval on time", daysSinceLastVisit: common.ChurnDefaultVisitIntervalDays, visitFrequencyDays: nil, want: 0},
+		{name: "single visit uses the default interval at 3x", daysSinceLastVisit: 3 * common.ChurnDefaultVisitIntervalDays, visitFrequencyDays: nil, want: 70},
+		{name: "zero frequency falls back to the default interval", daysSinceLastVisit: 3 * common.ChurnDefaultVisitIntervalDays, visitFrequencyDays: days(0), want: 70},
+		{name: "visited today", daysSinceLastVisit: 0, visitFrequencyDays: days(10), want: 0},
+		{name: "no-show and cancellation on time", daysSinceLastVisit: 10, visitFrequencyDays: days(10), noShowRate: 0.2, cancellationRate: 0.1, want: 5},
+		{name: "everything at the maximum", daysSinceLastVisit: 30, visitFrequencyDays: days(10), noShowRate: 1, cancellationRate: 1, want: 100},
+	}
+
+	for _, tc := range cases {
+		got := ChurnRiskScore(tc.daysSinceLastVisit, tc.visitFrequencyDays, tc.noShowRate, tc.cancellationRate)
+		assert.Equal(t, tc.want, got, tc.name)
+	}
+}
+
+func TestChurnRiskLevel(t *testing.T) {
+	cases := map[int32]string{
+		0:   common.ChurnRiskLevelLow,
+		29:  common.ChurnRiskLevelLow,
+		30:  common.ChurnRiskLevelMedium,
+		59:  common.ChurnRiskLevelMedium,
+		60:  common.ChurnRiskLevelHigh,
+		100: common.ChurnRiskLevelHigh,
+	}
+	for score, want := range cases {
+		assert.Equal(t, want, ChurnRiskLevel(score), "score %d", score)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	now := time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC)
+	visitAt := func(daysAgo int) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.AddDate(0, 0, -daysAgo), Valid: true}
+	}
+	spend := func(amount float64) pgtype.Numeric {
+		numeric, err := utils.Float64PtrToPgNumeric(&amount)
+		require.NoError(t, err)
+		return numeric
+	}
+
+	t.Run("never visited has no churn risk", func(t *testing.T) {
+		params, err := Calculate(dbgen.GetCustomerMetricSourcesRow{
+			CustomerID:    1,
+			LifetimeSpend: spend(0),
+		}, now)
+		require.NoError(t, err)
+
+		assert.False(t, params.DaysSinceLastVisit.Valid)
+		assert.False(t, params.VisitFrequencyDays.Valid)
+		assert.False(t, params.ChurnRiskScore.Valid)
+		assert.False(t, params.ChurnRiskLevel.Valid)
+	})
+
+	t.Run("single visit uses the default interval", func(t *testing.T) {
+		params, err := Calculate(dbgen.GetCustomerMetricSourcesRow{
+			CustomerID:    1,
+			LifetimeSpend: spend(1200),
+			CheckoutCount: 1,
+			VisitCount:    1,
+			FirstVisitAt:  visitAt(90),
+			LastVisitAt:   visitAt(90),
+		}, now)
+		require.NoError(t, err)
+
+		assert.False(t, params.VisitFrequencyDays.Valid)
+		assert.Equal(t, int32(90), params.DaysSinceLastVisit.Int32)
+		assert.Equal(t, int32(70), params.ChurnRiskScore.Int32)
+		assert.Equal(t, common.ChurnRiskLevelHigh, params.ChurnRiskLevel.String)
+	})
+
+	t.Run("regular customer visiting on time", func(t *testing.T) {
+		params, err := Calculate(dbgen.GetCustomerMetricSourcesRow{
+			CustomerID:     1,
+			LifetimeSpend:  spend(3000),
+			CheckoutCount:  3,
+			VisitCount:     3,
+			FirstVisitAt:   visitAt(60),
+			LastVisitAt:    visitAt(20),
+			BookingCount:   4,
+			NoShowCount:    0,
+			CancelledCount: 1,
+		}, now)
+		require.NoError(t, err)
+
+		averageTicket, err := utils.PgNumericToFloat64(params.AverageTicket)
+		require.NoError(t, err)
+		assert.InDelta(t, 1000, averageTicket, 0.001)
+
+		visitFrequencyDays, err := utils.PgNumericToFloat64(params.VisitFrequencyDays)
+		require.NoError(t, err)
+		assert.InDelta(t, 20, visitFrequencyDays, 0.001)
+
+		// on time, only the cancellation rate 0.25 adds 3.75 points
+		assert.Equal(t, int32(4), params.ChurnRiskScore.Int32)
+		assert.Equal(t, common.ChurnRiskLevelLow, params.ChurnRiskLevel.String)
+	})
+}
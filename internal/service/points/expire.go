@@ -0,0 +1,70 @@
+package points
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// ExpirePoints clears the remaining points of the customer lots which expired before today and records them as one EXPIRE transaction.
+// The expired points are returned, it is zero when there is nothing to expire.
+func ExpirePoints(ctx context.Context, qtx *dbgen.Queries, customerID int64, today time.Time) (int32, error) {
+	point, err := qtx.GetCustomerPointByCustomerIDForUpdate(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point", err)
+	}
+
+	lots, err := qtx.GetExpiredCustomerPointLotsForUpdate(ctx, dbgen.GetExpiredCustomerPointLotsForUpdateParams{
+		CustomerID: customerID,
+		ExpiresAt:  utils.TimePtrToPgDate(&today),
+	})
+	if err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get expired customer point lots", err)
+	}
+
+	var expired int32
+	for _, lot := range lots {
+		if err := qtx.UpdateCustomerPointTransactionRemaining(ctx, dbgen.UpdateCustomerPointTransactionRemainingParams{
+			ID:              lot.ID,
+			RemainingPoints: 0,
+		}); err != nil {
+			return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer point lot", err)
+		}
+		expired += lot.RemainingPoints
+	}
+	if expired == 0 {
+		return 0, nil
+	}
+
+	balance := max(point.Balance-expired, 0)
+	if err := qtx.UpdateCustomerPointBalance(ctx, dbgen.UpdateCustomerPointBalanceParams{
+		ID:      point.ID,
+		Balance: balance,
+	}); err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer point balance", err)
+	}
+
+	note := "點數到期"
+	if err := qtx.CreateCustomerPointTransaction(ctx, dbgen.CreateCustomerPointTransactionParams{
+		ID:         utils.GenerateID(),
+		CustomerID: customerID,
+		Type:       common.CustomerPointTransactionTypeExpire,
+		Points:     balance - point.Balance,
+		Balance:    balance,
+		Note:       utils.StringPtrToPgText(&note, true),
+	}); err != nil {
+		return 0, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer point transaction", err)
+	}
+
+	return expired, nil
+}
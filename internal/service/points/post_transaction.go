@@ -0,0 +1,143 @@
+package points
+
+import (
+	"context"
+	"time"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type PostTransactionParams struct {
+	CustomerID   int64
+	StoreID      *int64
+	Type         string
+	Points       int32
+	ExpiresAt    *time.Time
+	CapToBalance bool
+	SourceType   *string
+	SourceID     *int64
+	Note         *string
+	CreatedBy    *int64
+}
+
+type PostTransactionResult struct {
+	TransactionID int64
+	Points        int32
+	Balance       int32
+}
+
+// PostTransaction applies signed points to the customer point balance within the given transaction queries.
+// Positive points become a lot that expires at ExpiresAt, negative points consume the remaining lots which expire first.
+// When CapToBalance is set, a deduction larger than the balance is reduced to the balance instead of being rejected,
+// and nothing is recorded if there is no balance left to deduct.
+func PostTransaction(ctx context.Context, qtx *dbgen.Queries, params PostTransactionParams) (*PostTransactionResult, error) {
+	if err := qtx.CreateCustomerPointIfNotExists(ctx, dbgen.CreateCustomerPointIfNotExistsParams{
+		ID:         utils.GenerateID(),
+		CustomerID: params.CustomerID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer point", err)
+	}
+
+	point, err := qtx.GetCustomerPointByCustomerIDForUpdate(ctx, params.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point", err)
+	}
+
+	points := params.Points
+	if points < 0 && point.Balance+points < 0 {
+		if !params.CapToBalance {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerPointInsufficientBalance)
+		}
+		points = -point.Balance
+	}
+	if points == 0 {
+		return &PostTransactionResult{
+			Balance: point.Balance,
+		}, nil
+	}
+
+	var remainingPoints int32
+	var expiresAt *time.Time
+	if points > 0 {
+		remainingPoints = points
+		expiresAt = params.ExpiresAt
+	} else if err := consumeLots(ctx, qtx, params.CustomerID, -points); err != nil {
+		return nil, err
+	}
+
+	balance := point.Balance + points
+	if err := qtx.UpdateCustomerPointBalance(ctx, dbgen.UpdateCustomerPointBalanceParams{
+		ID:      point.ID,
+		Balance: balance,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer point balance", err)
+	}
+
+	transactionID := utils.GenerateID()
+	if err := qtx.CreateCustomerPointTransaction(ctx, dbgen.CreateCustomerPointTransactionParams{
+		ID:              transactionID,
+		CustomerID:      params.CustomerID,
+		StoreID:         utils.Int64PtrToPgInt8(params.StoreID),
+		Type:            params.Type,
+		Points:          points,
+		Balance:         balance,
+		RemainingPoints: remainingPoints,
+		ExpiresAt:       utils.TimePtrToPgDate(expiresAt),
+		SourceType:      utils.StringPtrToPgText(params.SourceType, true),
+		SourceID:        utils.Int64PtrToPgInt8(params.SourceID),
+		Note:            utils.StringPtrToPgText(params.Note, true),
+		CreatedBy:       utils.Int64PtrToPgInt8(params.CreatedBy),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer point transaction", err)
+	}
+
+	return &PostTransactionResult{
+		TransactionID: transactionID,
+		Points:        points,
+		Balance:       balance,
+	}, nil
+}
+
+// consumeLots deducts points from the remaining lots of the customer, the lots expiring first are used first
+func consumeLots(ctx context.Context, qtx *dbgen.Queries, customerID int64, points int32) error {
+	lots, err := qtx.GetCustomerPointRemainingLotsForUpdate(ctx, customerID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer point lots", err)
+	}
+
+	remainingPoints := make([]int32, len(lots))
+	for i, lot := range lots {
+		remainingPoints[i] = lot.RemainingPoints
+	}
+
+	for i, used := range allocateLots(remainingPoints, points) {
+		if used == 0 {
+			continue
+		}
+		if err := qtx.UpdateCustomerPointTransactionRemaining(ctx, dbgen.UpdateCustomerPointTransactionRemainingParams{
+			ID:              lots[i].ID,
+			RemainingPoints: lots[i].RemainingPoints - used,
+		}); err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer point lot", err)
+		}
+	}
+
+	return nil
+}
+
+// allocateLots returns the points used from each lot in order, earlier lots are used up before later ones
+func allocateLots(remainingPoints []int32, points int32) []int32 {
+	used := make([]int32, len(remainingPoints))
+	for i, remaining := range remainingPoints {
+		if points <= 0 {
+			break
+		}
+
+		used[i] = min(remaining, points)
+		points -= used[i]
+	}
+
+	return used
+}
@@ -0,0 +1,29 @@
+package points
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateLots(t *testing.T) {
+	// lots are ordered by expiry, the ones expiring first are used first
+	cases := []struct {
+		name      string
+		remaining []int32
+		points    int32
+		want      []int32
+	}{
+		{name: "first lot covers the points", remaining: []int32{50, 30}, points: 20, want: []int32{20, 0}},
+		{name: "first lot used up exactly", remaining: []int32{50, 30}, points: 50, want: []int32{50, 0}},
+		{name: "spills into the next lot", remaining: []int32{50, 30, 10}, points: 60, want: []int32{50, 10, 0}},
+		{name: "all lots used", remaining: []int32{50, 30}, points: 80, want: []int32{50, 30}},
+		{name: "more points than the lots", remaining: []int32{50, 30}, points: 100, want: []int32{50, 30}},
+		{name: "no points", remaining: []int32{50}, points: 0, want: []int32{0}},
+		{name: "no lots", remaining: []int32{}, points: 10, want: []int32{}},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, allocateLots(tc.remaining, tc.points), tc.name)
+	}
+}
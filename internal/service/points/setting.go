@@ -0,0 +1,63 @@
+package points
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+// GetEnabledStoreSetting returns the loyalty setting of the store, nil is returned when it is not set or disabled
+func GetEnabledStoreSetting(ctx context.Context, queries *dbgen.Queries, storeID int64) (*dbgen.StoreLoyaltySetting, error) {
+	setting, err := queries.GetStoreLoyaltySettingByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get store loyalty setting", err)
+	}
+	if !setting.IsEnabled {
+		return nil, nil
+	}
+
+	return &setting, nil
+}
+
+// CalculateEarnPoints returns the points earned by the amount, every full earn amount unit earns the earn points
+func CalculateEarnPoints(setting *dbgen.StoreLoyaltySetting, amount int64) int32 {
+	if setting == nil || amount <= 0 {
+		return 0
+	}
+
+	return int32(amount/int64(setting.EarnAmountUnit)) * setting.EarnPoints
+}
+
+// CalculateRedeemAmount returns the discount amount of the redeemed points, the points must be a multiple of the redeem points unit
+func CalculateRedeemAmount(setting *dbgen.StoreLoyaltySetting, points int32) (int64, error) {
+	if setting == nil {
+		return 0, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerPointNotEnabled)
+	}
+	if points%setting.RedeemPointsUnit != 0 {
+		return 0, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerPointRedeemUnitInvalid)
+	}
+
+	return int64(points/setting.RedeemPointsUnit) * int64(setting.RedeemAmount), nil
+}
+
+// CalculateExpiresAt returns the expiry date of points earned on the given day, nil means the points never expire.
+// The day is kept in the expiry month, points earned on 01-31 with 1 expiry month expire on the last day of February.
+func CalculateExpiresAt(setting *dbgen.StoreLoyaltySetting, day time.Time) *time.Time {
+	if setting == nil || !setting.ExpiryMonths.Valid {
+		return nil
+	}
+
+	// AddDate normalizes 02-31 into March, so the day is clamped to the last day of the expiry month
+	firstOfMonth := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location()).AddDate(0, int(setting.ExpiryMonths.Int32), 0)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	expiresAt := time.Date(firstOfMonth.Year(), firstOfMonth.Month(), min(day.Day(), lastDay), day.Hour(), day.Minute(), day.Second(), day.Nanosecond(), day.Location())
+	return &expiresAt
+}
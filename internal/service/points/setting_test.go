@@ -0,0 +1,88 @@
+package points
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+)
+
+func TestCalculateEarnPoints(t *testing.T) {
+	setting := &dbgen.StoreLoyaltySetting{EarnAmountUnit: 100, EarnPoints: 2}
+
+	cases := []struct {
+		name    string
+		setting *dbgen.StoreLoyaltySetting
+		amount  int64
+		want    int32
+	}{
+		{name: "below one unit rounds down to zero", setting: setting, amount: 99, want: 0},
+		{name: "exactly one unit", setting: setting, amount: 100, want: 2},
+		{name: "partial unit rounds down", setting: setting, amount: 199, want: 2},
+		{name: "several units", setting: setting, amount: 1250, want: 24},
+		{name: "zero amount", setting: setting, amount: 0, want: 0},
+		{name: "negative amount", setting: setting, amount: -500, want: 0},
+		{name: "disabled setting", setting: nil, amount: 1000, want: 0},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, CalculateEarnPoints(tc.setting, tc.amount), tc.name)
+	}
+}
+
+func TestCalculateRedeemAmount(t *testing.T) {
+	setting := &dbgen.StoreLoyaltySetting{RedeemPointsUnit: 10, RedeemAmount: 5}
+
+	amount, err := CalculateRedeemAmount(setting, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15), amount)
+
+	_, err = CalculateRedeemAmount(setting, 25)
+	assert.Error(t, err, "points not a multiple of the unit")
+
+	_, err = CalculateRedeemAmount(nil, 10)
+	assert.Error(t, err, "disabled setting")
+}
+
+func TestCalculateExpiresAt(t *testing.T) {
+	expiryMonths := func(months int32) *dbgen.StoreLoyaltySetting {
+		return &dbgen.StoreLoyaltySetting{ExpiryMonths: pgtype.Int4{Int32: months, Valid: true}}
+	}
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		name    string
+		setting *dbgen.StoreLoyaltySetting
+		day     time.Time
+		want    *time.Time
+	}{
+		{name: "same day of the expiry month", setting: expiryMonths(12), day: date(2024, time.March, 15), want: ptr(date(2025, time.March, 15))},
+		{name: "month end clamps in leap year", setting: expiryMonths(1), day: date(2024, time.January, 31), want: ptr(date(2024, time.February, 29))},
+		{name: "month end clamps in common year", setting: expiryMonths(1), day: date(2025, time.January, 31), want: ptr(date(2025, time.February, 28))},
+		{name: "31st kept in a 31 day month", setting: expiryMonths(3), day: date(2024, time.December, 31), want: ptr(date(2025, time.March, 31))},
+		{name: "day kept when the expiry month is long enough", setting: expiryMonths(2), day: date(2024, time.February, 29), want: ptr(date(2024, time.April, 29))},
+		{name: "leap day after a year", setting: expiryMonths(12), day: date(2024, time.February, 29), want: ptr(date(2025, time.February, 28))},
+		{name: "never expires", setting: &dbgen.StoreLoyaltySetting{}, day: date(2024, time.January, 31), want: nil},
+		{name: "disabled setting", setting: nil, day: date(2024, time.January, 31), want: nil},
+	}
+
+	for _, tc := range cases {
+		got := CalculateExpiresAt(tc.setting, tc.day)
+		if tc.want == nil {
+			assert.Nil(t, got, tc.name)
+			continue
+		}
+		if assert.NotNil(t, got, tc.name) {
+			assert.True(t, tc.want.Equal(*got), "%s: %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,246 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// personalSnapshotKeys are the personal fields removed from the customer merge snapshots on erasure
+var personalSnapshotKeys = []string{
+	"name", "line_uid", "line_name", "phone", "birthday", "email", "city",
+	"favorite_shapes", "favorite_colors", "favorite_styles", "referral_source", "referrer",
+	"customer_note", "store_note", "invoice_carrier_type", "invoice_carrier_value", "referral_code", "tags",
+}
+
+// BuildExport collects the personal data of the customer into an export archive
+func BuildExport(ctx context.Context, queries *dbgen.Queries, customerID int64) (*common.CustomerDataExport, error) {
+	customer, err := queries.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+	if customer.MergedIntoCustomerID.Valid {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyMerged)
+	}
+	if customer.ErasedAt.Valid {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyErased)
+	}
+
+	bookings, err := queries.GetCustomerBookingsForExport(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer bookings", err)
+	}
+	checkouts, err := queries.GetCustomerCheckoutsForExport(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer checkouts", err)
+	}
+	coupons, err := queries.GetCustomerCouponsForExport(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer coupons", err)
+	}
+	termsAcceptances, err := queries.GetCustomerTermsAcceptancesByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer terms acceptances", err)
+	}
+	healthQuestionnaires, err := queries.GetCustomerHealthQuestionnairesForExport(ctx, customerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer health questionnaires", err)
+	}
+
+	export := &common.CustomerDataExport{
+		Profile: common.CustomerDataExportProfile{
+			ID:                  utils.FormatID(customer.ID),
+			Name:                customer.Name,
+			LineName:            utils.PgTextToString(customer.LineName),
+			Phone:               customer.Phone,
+			Birthday:            utils.PgDateToDateString(customer.Birthday),
+			Email:               utils.PgTextToString(customer.Email),
+			City:                utils.PgTextToString(customer.City),
+			FavoriteShapes:      emptyIfNil(customer.FavoriteShapes),
+			FavoriteColors:      emptyIfNil(customer.FavoriteColors),
+			FavoriteStyles:      emptyIfNil(customer.FavoriteStyles),
+			IsIntrovert:         utils.PgBoolToBool(customer.IsIntrovert),
+			ReferralSource:      emptyIfNil(customer.ReferralSource),
+			Referrer:            utils.PgTextToString(customer.Referrer),
+			CustomerNote:        utils.PgTextToString(customer.CustomerNote),
+			Level:               utils.PgTextToString(customer.Level),
+			ReferralCode:        utils.PgTextToString(customer.ReferralCode),
+			InvoiceCarrierType:  utils.PgTextToString(customer.InvoiceCarrierType),
+			InvoiceCarrierValue: utils.PgTextToString(customer.InvoiceCarrierValue),
+			LastVisitAt:         utils.PgTimestamptzToTimeString(customer.LastVisitAt),
+			CreatedAt:           utils.PgTimestamptzToTimeString(customer.CreatedAt),
+		},
+		Bookings:             make([]common.CustomerDataExportBooking, 0, len(bookings)),
+		Checkouts:            make([]common.CustomerDataExportCheckout, 0, len(checkouts)),
+		Coupons:              make([]common.CustomerDataExportCoupon, 0, len(coupons)),
+		TermsAcceptances:     make([]common.CustomerDataExportTermsAcceptance, 0, len(termsAcceptances)),
+		HealthQuestionnaires: make([]common.CustomerDataExportHealthQuestionnaire, 0, len(healthQuestionnaires)),
+		ExportedAt:           time.Now().Format(time.RFC3339),
+	}
+
+	for _, booking := range bookings {
+		export.Bookings = append(export.Bookings, common.CustomerDataExportBooking{
+			ID:           utils.FormatID(booking.ID),
+			StoreName:    booking.StoreName,
+			StylistName:  utils.PgTextToString(booking.StylistName),
+			Date:         utils.PgDateToDateString(booking.WorkDate),
+			StartTime:    utils.PgTimeToTimeString(booking.StartTime),
+			EndTime:      utils.PgTimeToTimeString(booking.EndTime),
+			Services:     emptyIfNil(booking.ServiceNames),
+			Status:       booking.Status,
+			Note:         utils.PgTextToString(booking.Note),
+			CancelReason: utils.PgTextToString(booking.CancelReason),
+			CreatedAt:    utils.PgTimestamptzToTimeString(booking.CreatedAt),
+		})
+	}
+
+	for _, checkout := range checkouts {
+		totalAmount, err := utils.PgNumericToInt64(checkout.TotalAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert checkout total amount", err)
+		}
+		finalAmount, err := utils.PgNumericToInt64(checkout.FinalAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert checkout final amount", err)
+		}
+		paidAmount, err := utils.PgNumericToInt64(checkout.PaidAmount)
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert checkout paid amount", err)
+		}
+
+		export.Checkouts = append(export.Checkouts, common.CustomerDataExportCheckout{
+			ID:             utils.FormatID(checkout.ID),
+			BookingID:      utils.FormatID(checkout.BookingID),
+			TotalAmount:    totalAmount,
+			FinalAmount:    finalAmount,
+			PaidAmount:     paidAmount,
+			PaymentMethod:  checkout.PaymentMethod,
+			PointsRedeemed: checkout.PointsRedeemed,
+			RefundedAt:     utils.PgTimestamptzToTimeString(checkout.RefundedAt),
+			CreatedAt:      utils.PgTimestamptzToTimeString(checkout.CreatedAt),
+		})
+	}
+
+	for _, coupon := range coupons {
+		export.Coupons = append(export.Coupons, common.CustomerDataExportCoupon{
+			ID:          utils.FormatID(coupon.ID),
+			DisplayName: coupon.DisplayName,
+			Code:        coupon.Code,
+			ValidFrom:   utils.PgTimestamptzToTimeString(coupon.ValidFrom),
+			ValidTo:     utils.PgTimestamptzToTimeString(coupon.ValidTo),
+			IsUsed:      utils.PgBoolToBool(coupon.IsUsed),
+			UsedAt:      utils.PgTimestamptzToTimeString(coupon.UsedAt),
+			CreatedAt:   utils.PgTimestamptzToTimeString(coupon.CreatedAt),
+		})
+	}
+
+	for _, acceptance := range termsAcceptances {
+		export.TermsAcceptances = append(export.TermsAcceptances, common.CustomerDataExportTermsAcceptance{
+			TermsVersion: acceptance.TermsVersion,
+			AcceptedAt:   utils.PgTimestamptzToTimeString(acceptance.AcceptedAt),
+		})
+	}
+
+	for _, questionnaire := range healthQuestionnaires {
+		export.HealthQuestionnaires = append(export.HealthQuestionnaires, common.CustomerDataExportHealthQuestionnaire{
+			Version:           questionnaire.Version,
+			Allergies:         emptyIfNil(questionnaire.Allergies),
+			AllergyNote:       utils.PgTextToString(questionnaire.AllergyNote),
+			SkinConditions:    emptyIfNil(questionnaire.SkinConditions),
+			SkinConditionNote: utils.PgTextToString(questionnaire.SkinConditionNote),
+			IsPregnant:        questionnaire.IsPregnant,
+			OtherNote:         utils.PgTextToString(questionnaire.OtherNote),
+			AnsweredAt:        utils.PgTimestamptzToTimeString(questionnaire.CreatedAt),
+		})
+	}
+
+	return export, nil
+}
+
+// Erase anonymises the personal fields of the customer and its merged customers.
+// Bookings, checkouts, invoices and wallet or point transactions are kept for reports.
+// It must run in a transaction, the customer row is locked until commit.
+func Erase(ctx context.Context, qtx *dbgen.Queries, customerID int64) error {
+	customer, err := qtx.GetCustomerForErasure(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerNotFound)
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer", err)
+	}
+	if customer.MergedIntoCustomerID.Valid {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyMerged)
+	}
+	if customer.ErasedAt.Valid {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerAlreadyErased)
+	}
+
+	// scheduled bookings would be served without a way to contact the customer
+	hasScheduled, err := qtx.CheckCustomerHasScheduledBookings(ctx, customerID)
+	if err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to check customer scheduled bookings", err)
+	}
+	if hasScheduled {
+		return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerErasureHasUpcomingBookings)
+	}
+
+	// the wallet balance is prepaid money, it has to be used or refunded before the erasure
+	wallet, err := qtx.GetCustomerWalletByCustomerIDForUpdate(ctx, customerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet", err)
+	}
+	if err == nil {
+		balance, err := utils.PgNumericToInt64(wallet.Balance)
+		if err != nil {
+			return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to convert wallet balance", err)
+		}
+		if balance > 0 {
+			return errorCodes.NewServiceErrorWithCode(errorCodes.CustomerErasureHasWalletBalance)
+		}
+	}
+
+	// snapshots and recipients of merged customers are cleared before they are anonymised together
+	if err := qtx.ClearCustomerMergeSnapshots(ctx, dbgen.ClearCustomerMergeSnapshotsParams{
+		CustomerID: customerID,
+		Keys:       personalSnapshotKeys,
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to clear customer merge snapshots", err)
+	}
+	if err := qtx.ClearLineCampaignRecipientsLineUid(ctx, customerID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to clear line campaign recipients", err)
+	}
+	if err := qtx.DeleteCustomerTokensByCustomerID(ctx, customerID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete customer tokens", err)
+	}
+	if err := qtx.DeleteCustomerNotesByCustomerID(ctx, customerID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete customer notes", err)
+	}
+	if err := qtx.DeleteCustomerHealthQuestionnairesByCustomerID(ctx, customerID); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to delete customer health questionnaires", err)
+	}
+	if err := qtx.AnonymizeCustomer(ctx, dbgen.AnonymizeCustomerParams{
+		Name: common.ErasedCustomerName,
+		ID:   customerID,
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to anonymize customer", err)
+	}
+
+	return nil
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,155 @@
+package promotion
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type Promotion struct {
+	ID             int64
+	DiscountRate   *float64
+	DiscountAmount *float64
+	ServiceScope   string
+	// ServiceIDs are the eligible services, empty means all services in the service scope
+	ServiceIDs []int64
+	// StackWithCoupon allows the coupon to be applied on the promoted price of the same service
+	StackWithCoupon bool
+}
+
+type Applied struct {
+	PromotionID    int64
+	DiscountAmount float64
+	Price          float64
+}
+
+// GetApplicable returns the active promotions of the store whose date, weekday and time window cover the booking date and start time
+func GetApplicable(ctx context.Context, q *dbgen.Queries, storeID int64, workDate pgtype.Date, startTime pgtype.Time) ([]Promotion, error) {
+	rows, err := q.GetActivePromotionsByStoreAndDate(ctx, dbgen.GetActivePromotionsByStoreAndDateParams{
+		StoreID:  storeID,
+		WorkDate: workDate,
+	})
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get active promotions", err)
+	}
+
+	promotions := []Promotion{}
+	promotionIDs := []int64{}
+	for _, row := range rows {
+		if !inWeekdays(row.Weekdays, workDate) || !inTimeWindow(row.StartTime, row.EndTime, startTime) {
+			continue
+		}
+
+		promotion := Promotion{
+			ID:              row.ID,
+			ServiceScope:    row.ServiceScope,
+			StackWithCoupon: row.StackWithCoupon,
+		}
+		if row.DiscountRate.Valid {
+			discountRate, err := utils.PgNumericToFloat64(row.DiscountRate)
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount rate to float64", err)
+			}
+			promotion.DiscountRate = &discountRate
+		}
+		if row.DiscountAmount.Valid {
+			discountAmount, err := utils.PgNumericToFloat64(row.DiscountAmount)
+			if err != nil {
+				return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert discount amount to float64", err)
+			}
+			promotion.DiscountAmount = &discountAmount
+		}
+
+		promotions = append(promotions, promotion)
+		promotionIDs = append(promotionIDs, row.ID)
+	}
+	if len(promotions) == 0 {
+		return promotions, nil
+	}
+
+	promotionServices, err := q.GetPromotionServicesByPromotionIDs(ctx, promotionIDs)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get promotion services", err)
+	}
+	serviceIDsMap := make(map[int64][]int64)
+	for _, promotionService := range promotionServices {
+		serviceIDsMap[promotionService.PromotionID] = append(serviceIDsMap[promotionService.PromotionID], promotionService.ServiceID)
+	}
+	for i := range promotions {
+		promotions[i].ServiceIDs = serviceIDsMap[promotions[i].ID]
+	}
+
+	return promotions, nil
+}
+
+// Apply returns the eligible promotion giving the largest discount on the service price, nil when no promotion is eligible.
+// When the service applies a coupon, promotions not stackable with coupons are skipped.
+// Promotions with the same discount keep the earlier created one.
+func Apply(promotions []Promotion, service coupon.Service, price float64, useCoupon bool) *Applied {
+	var best *Applied
+	for _, promotion := range promotions {
+		if useCoupon && !promotion.StackWithCoupon {
+			continue
+		}
+		if !coupon.IsServiceEligible(promotion.ServiceScope, promotion.ServiceIDs, service) {
+			continue
+		}
+
+		discountedPrice := price
+		if promotion.DiscountRate != nil {
+			discountedPrice = price * *promotion.DiscountRate
+		} else if promotion.DiscountAmount != nil {
+			discountedPrice = price - *promotion.DiscountAmount
+		}
+		if discountedPrice < 0 {
+			discountedPrice = 0 // not allow negative price
+		}
+
+		discountAmount := price - discountedPrice
+		if discountAmount <= 0 {
+			continue
+		}
+		if best == nil || discountAmount > best.DiscountAmount {
+			best = &Applied{
+				PromotionID:    promotion.ID,
+				DiscountAmount: discountAmount,
+				Price:          discountedPrice,
+			}
+		}
+	}
+
+	return best
+}
+
+// inWeekdays checks the weekday of the date is one of the promotion weekdays (0 is Sunday), empty weekdays means every day
+func inWeekdays(weekdays []int32, date pgtype.Date) bool {
+	if len(weekdays) == 0 {
+		return true
+	}
+
+	weekday := int32(date.Time.Weekday())
+	for _, w := range weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inTimeWindow checks the booking start time is within [startTime, endTime), a null bound is not limited
+func inTimeWindow(startTime, endTime, bookingTime pgtype.Time) bool {
+	if startTime.Valid && bookingTime.Microseconds < startTime.Microseconds {
+		return false
+	}
+	if endTime.Valid && bookingTime.Microseconds >= endTime.Microseconds {
+		return false
+	}
+
+	return true
+}
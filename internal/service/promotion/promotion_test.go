@@ -0,0 +1,211 @@
+package promotion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+)
+
+func rate(r float64) *float64 {
+	return &r
+}
+
+func amount(a float64) *float64 {
+	return &a
+}
+
+func TestApply(t *testing.T) {
+	mainService := coupon.Service{ServiceID: 1}
+	addonService := coupon.Service{ServiceID: 2, IsAddon: true}
+
+	cases := []struct {
+		name       string
+		promotions []Promotion
+		service    coupon.Service
+		price      float64
+		useCoupon  bool
+		want       *Applied
+	}{
+		{
+			name:       "discount rate",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(0.8), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      1000,
+			want:       &Applied{PromotionID: 1, DiscountAmount: 200, Price: 800},
+		},
+		{
+			name:       "fixed discount amount",
+			promotions: []Promotion{{ID: 1, DiscountAmount: amount(300), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      1000,
+			want:       &Applied{PromotionID: 1, DiscountAmount: 300, Price: 700},
+		},
+		{
+			name:       "fixed amount larger than the price clamps at 0",
+			promotions: []Promotion{{ID: 1, DiscountAmount: amount(1500), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      1000,
+			want:       &Applied{PromotionID: 1, DiscountAmount: 1000, Price: 0},
+		},
+		{
+			name: "largest discount wins between rate and fixed amount",
+			promotions: []Promotion{
+				{ID: 1, DiscountRate: rate(0.9), ServiceScope: common.CouponServiceScopeAll},
+				{ID: 2, DiscountAmount: amount(150), ServiceScope: common.CouponServiceScopeAll},
+			},
+			service: mainService,
+			price:   1000,
+			want:    &Applied{PromotionID: 2, DiscountAmount: 150, Price: 850},
+		},
+		{
+			name: "same discount keeps the earlier promotion",
+			promotions: []Promotion{
+				{ID: 1, DiscountAmount: amount(100), ServiceScope: common.CouponServiceScopeAll},
+				{ID: 2, DiscountRate: rate(0.9), ServiceScope: common.CouponServiceScopeAll},
+			},
+			service: mainService,
+			price:   1000,
+			want:    &Applied{PromotionID: 1, DiscountAmount: 100, Price: 900},
+		},
+		{
+			name:       "no discount is not applied",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(1), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      1000,
+			want:       nil,
+		},
+		{
+			name:       "zero price is not applied",
+			promotions: []Promotion{{ID: 1, DiscountAmount: amount(100), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      0,
+			want:       nil,
+		},
+		{
+			name:       "coupon skips promotions not stackable with coupons",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(0.5), ServiceScope: common.CouponServiceScopeAll}},
+			service:    mainService,
+			price:      1000,
+			useCoupon:  true,
+			want:       nil,
+		},
+		{
+			name: "coupon keeps promotions stackable with coupons",
+			promotions: []Promotion{
+				{ID: 1, DiscountRate: rate(0.5), ServiceScope: common.CouponServiceScopeAll},
+				{ID: 2, DiscountAmount: amount(100), ServiceScope: common.CouponServiceScopeAll, StackWithCoupon: true},
+			},
+			service:   mainService,
+			price:     1000,
+			useCoupon: true,
+			want:      &Applied{PromotionID: 2, DiscountAmount: 100, Price: 900},
+		},
+		{
+			name: "without coupon every promotion is considered",
+			promotions: []Promotion{
+				{ID: 1, DiscountRate: rate(0.5), ServiceScope: common.CouponServiceScopeAll},
+				{ID: 2, DiscountAmount: amount(100), ServiceScope: common.CouponServiceScopeAll, StackWithCoupon: true},
+			},
+			service: mainService,
+			price:   1000,
+			want:    &Applied{PromotionID: 1, DiscountAmount: 500, Price: 500},
+		},
+		{
+			name:       "main scope skips addon services",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(0.8), ServiceScope: common.CouponServiceScopeMain}},
+			service:    addonService,
+			price:      500,
+			want:       nil,
+		},
+		{
+			name:       "addon scope applies to addon services",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(0.8), ServiceScope: common.CouponServiceScopeAddon}},
+			service:    addonService,
+			price:      500,
+			want:       &Applied{PromotionID: 1, DiscountAmount: 100, Price: 400},
+		},
+		{
+			name:       "service not in the eligible services",
+			promotions: []Promotion{{ID: 1, DiscountRate: rate(0.8), ServiceScope: common.CouponServiceScopeAll, ServiceIDs: []int64{3, 4}}},
+			service:    mainService,
+			price:      1000,
+			want:       nil,
+		},
+		{
+			name:       "no promotions",
+			promotions: []Promotion{},
+			service:    mainService,
+			price:      1000,
+			want:       nil,
+		},
+	}
+
+	for _, tc := range cases {
+		got := Apply(tc.promotions, tc.service, tc.price, tc.useCoupon)
+		if tc.want == nil {
+			assert.Nil(t, got, tc.name)
+			continue
+		}
+		if assert.NotNil(t, got, tc.name) {
+			assert.Equal(t, tc.want.PromotionID, got.PromotionID, tc.name)
+			assert.InDelta(t, tc.want.DiscountAmount, got.DiscountAmount, 0.001, tc.name)
+			assert.InDelta(t, tc.want.Price, got.Price, 0.001, tc.name)
+		}
+	}
+}
+
+func TestInWeekdays(t *testing.T) {
+	// 2024-01-07 is a Sunday, 2024-01-08 is a Monday
+	sunday := pgtype.Date{Time: time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC), Valid: true}
+	monday := pgtype.Date{Time: time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	cases := []struct {
+		name     string
+		weekdays []int32
+		date     pgtype.Date
+		want     bool
+	}{
+		{name: "empty weekdays means every day", weekdays: nil, date: sunday, want: true},
+		{name: "sunday is 0", weekdays: []int32{0, 6}, date: sunday, want: true},
+		{name: "sunday not in weekdays", weekdays: []int32{1, 2, 3, 4, 5}, date: sunday, want: false},
+		{name: "monday in weekdays", weekdays: []int32{1, 2, 3, 4, 5}, date: monday, want: true},
+		{name: "monday not in weekend", weekdays: []int32{0, 6}, date: monday, want: false},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, inWeekdays(tc.weekdays, tc.date), tc.name)
+	}
+}
+
+func TestInTimeWindow(t *testing.T) {
+	clock := func(hour, minute int64) pgtype.Time {
+		return pgtype.Time{Microseconds: (hour*60 + minute) * 60 * 1000000, Valid: true}
+	}
+	unlimited := pgtype.Time{}
+
+	cases := []struct {
+		name      string
+		startTime pgtype.Time
+		endTime   pgtype.Time
+		booking   pgtype.Time
+		want      bool
+	}{
+		{name: "before the start", startTime: clock(10, 0), endTime: clock(14, 0), booking: clock(9, 59), want: false},
+		{name: "at the start is included", startTime: clock(10, 0), endTime: clock(14, 0), booking: clock(10, 0), want: true},
+		{name: "inside the window", startTime: clock(10, 0), endTime: clock(14, 0), booking: clock(12, 30), want: true},
+		{name: "just before the end", startTime: clock(10, 0), endTime: clock(14, 0), booking: clock(13, 59), want: true},
+		{name: "at the end is excluded", startTime: clock(10, 0), endTime: clock(14, 0), booking: clock(14, 0), want: false},
+		{name: "no start bound", startTime: unlimited, endTime: clock(14, 0), booking: clock(0, 0), want: true},
+		{name: "no end bound", startTime: clock(10, 0), endTime: unlimited, booking: clock(23, 59), want: true},
+		{name: "no bounds", startTime: unlimited, endTime: unlimited, booking: clock(3, 0), want: true},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, inTimeWindow(tc.startTime, tc.endTime, tc.booking), tc.name)
+	}
+}
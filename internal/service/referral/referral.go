@@ -0,0 +1,100 @@
+package referral
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/service/coupon"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+const referralCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const referralCodeLength = 8
+
+// GenerateReferralCode returns a random customer referral code
+func GenerateReferralCode() (string, error) {
+	code := make([]byte, referralCodeLength)
+	max := big.NewInt(int64(len(referralCodeCharset)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralCodeCharset[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// RewardFirstCheckout completes the pending referral of the referee within the given transaction queries,
+// and issues the configured reward coupons to both the referrer and the referee.
+// Nothing is done when the customer has no pending referral, and the referral is completed without rewards
+// when the referral program is disabled, so later checkouts never trigger rewards again.
+func RewardFirstCheckout(ctx context.Context, qtx *dbgen.Queries, customerID, checkoutID int64, now time.Time) error {
+	referral, err := qtx.GetPendingCustomerReferralByRefereeIDForUpdate(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get pending customer referral", err)
+	}
+
+	var referrerCouponID, refereeCouponID *int64
+
+	setting, err := qtx.GetReferralSetting(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get referral setting", err)
+	}
+	if err == nil && setting.IsEnabled {
+		sourceType := common.CustomerCouponSourceReferral
+		validMonths := utils.PgInt4ToInt32Ptr(setting.CouponValidMonths)
+
+		if setting.ReferrerCouponID.Valid {
+			referrerCouponID, err = coupon.Issue(ctx, qtx, coupon.IssueParams{
+				CustomerID:  referral.ReferrerCustomerID,
+				CouponID:    setting.ReferrerCouponID.Int64,
+				ValidMonths: validMonths,
+				SourceType:  &sourceType,
+				SourceID:    &referral.ID,
+				Now:         now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if setting.RefereeCouponID.Valid {
+			refereeCouponID, err = coupon.Issue(ctx, qtx, coupon.IssueParams{
+				CustomerID:  referral.RefereeCustomerID,
+				CouponID:    setting.RefereeCouponID.Int64,
+				ValidMonths: validMonths,
+				SourceType:  &sourceType,
+				SourceID:    &referral.ID,
+				Now:         now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := qtx.UpdateCustomerReferralCompleted(ctx, dbgen.UpdateCustomerReferralCompletedParams{
+		ID:                       referral.ID,
+		FirstCheckoutID:          utils.Int64PtrToPgInt8(&checkoutID),
+		ReferrerCustomerCouponID: utils.Int64PtrToPgInt8(referrerCouponID),
+		RefereeCustomerCouponID:  utils.Int64PtrToPgInt8(refereeCouponID),
+	}); err != nil {
+		return errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to complete customer referral", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package segment
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	sqlxRepo "github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlx"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// Filter returns the customer filter of the segment, null conditions of the segment are not limited
+func Filter(segment dbgen.CustomerSegment) (sqlxRepo.GetAllCustomersByFilterParams, error) {
+	filter := sqlxRepo.GetAllCustomersByFilterParams{
+		MinPastDays:       int4ToIntPtr(segment.MinPastDays),
+		SpendPeriodMonths: int4ToIntPtr(segment.SpendPeriodMonths),
+		MinVisitCount:     int4ToIntPtr(segment.MinVisitCount),
+		MaxVisitCount:     int4ToIntPtr(segment.MaxVisitCount),
+	}
+
+	if segment.Level.Valid {
+		filter.Level = &segment.Level.String
+	}
+	if segment.IsBlacklisted.Valid {
+		filter.IsBlacklisted = &segment.IsBlacklisted.Bool
+	}
+
+	if segment.MinTotalSpend.Valid {
+		minTotalSpend, err := utils.PgNumericToFloat64(segment.MinTotalSpend)
+		if err != nil {
+			return filter, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert min total spend to float64", err)
+		}
+		filter.MinTotalSpend = &minTotalSpend
+	}
+	if segment.MaxTotalSpend.Valid {
+		maxTotalSpend, err := utils.PgNumericToFloat64(segment.MaxTotalSpend)
+		if err != nil {
+			return filter, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert max total spend to float64", err)
+		}
+		filter.MaxTotalSpend = &maxTotalSpend
+	}
+
+	return filter, nil
+}
+
+func int4ToIntPtr(value pgtype.Int4) *int {
+	if !value.Valid {
+		return nil
+	}
+
+	v := int(value.Int32)
+	return &v
+}
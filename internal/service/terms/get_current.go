@@ -0,0 +1,40 @@
+package terms
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	termsModel "github.com/tkoleo84119/nail-salon-backend/internal/model/terms"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type GetCurrent struct {
+	queries *dbgen.Queries
+}
+
+func NewGetCurrent(queries *dbgen.Queries) GetCurrentInterface {
+	return &GetCurrent{
+		queries: queries,
+	}
+}
+
+func (s *GetCurrent) GetCurrent(ctx context.Context) (*termsModel.GetCurrentResponse, error) {
+	termsDocument, err := s.queries.GetCurrentTermsDocument(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.TermsDocumentNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get current terms document", err)
+	}
+
+	return &termsModel.GetCurrentResponse{
+		Version:       termsDocument.Version,
+		Title:         termsDocument.Title,
+		Content:       termsDocument.Content,
+		EffectiveDate: utils.PgDateToDateString(termsDocument.EffectiveDate),
+		IsRequired:    termsDocument.IsRequired,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package terms
+
+import (
+	"context"
+
+	termsModel "github.com/tkoleo84119/nail-salon-backend/internal/model/terms"
+)
+
+type GetCurrentInterface interface {
+	GetCurrent(ctx context.Context) (*termsModel.GetCurrentResponse, error)
+}
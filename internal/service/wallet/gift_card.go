@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/model/common"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+// giftCardCodeCharset excludes characters which are easily confused (0/O, 1/I)
+const giftCardCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const giftCardCodeLength = 12
+
+// GenerateGiftCardCode returns a random gift card code
+func GenerateGiftCardCode() (string, error) {
+	code := make([]byte, giftCardCodeLength)
+	max := big.NewInt(int64(len(giftCardCodeCharset)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = giftCardCodeCharset[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+type RedeemGiftCardParams struct {
+	Code       string
+	CustomerID int64
+	CreatedBy  *int64
+}
+
+type RedeemGiftCardResult struct {
+	GiftCardID int64
+	Amount     int64
+	Balance    int64
+}
+
+// RedeemGiftCard credits the gift card amount to the customer wallet and marks the card redeemed.
+// The gift card row is locked so a card can only be redeemed once.
+func RedeemGiftCard(ctx context.Context, qtx *dbgen.Queries, params RedeemGiftCardParams) (*RedeemGiftCardResult, error) {
+	giftCard, err := qtx.GetGiftCardByCodeForUpdate(ctx, params.Code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.GiftCardNotFound)
+		}
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get gift card", err)
+	}
+	if giftCard.Status != common.GiftCardStatusActive {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.GiftCardAlreadyRedeemed)
+	}
+
+	if giftCard.ExpiresAt.Valid {
+		loc, err := time.LoadLocation("Asia/Taipei")
+		if err != nil {
+			return nil, errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to load location", err)
+		}
+		now := time.Now().In(loc)
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if giftCard.ExpiresAt.Time.Before(today) {
+			return nil, errorCodes.NewServiceErrorWithCode(errorCodes.GiftCardExpired)
+		}
+	}
+
+	amount, err := utils.PgNumericToInt64(giftCard.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert gift card amount", err)
+	}
+
+	sourceType := common.CustomerWalletTransactionSourceGiftCard
+	posted, err := PostTransaction(ctx, qtx, PostTransactionParams{
+		CustomerID: params.CustomerID,
+		StoreID:    &giftCard.StoreID,
+		Type:       common.CustomerWalletTransactionTypeRedeem,
+		Amount:     amount,
+		SourceType: &sourceType,
+		SourceID:   &giftCard.ID,
+		CreatedBy:  params.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := qtx.UpdateGiftCardRedeemed(ctx, dbgen.UpdateGiftCardRedeemedParams{
+		ID:                 giftCard.ID,
+		RedeemedCustomerID: utils.Int64PtrToPgInt8(&params.CustomerID),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update gift card redeemed", err)
+	}
+
+	return &RedeemGiftCardResult{
+		GiftCardID: giftCard.ID,
+		Amount:     amount,
+		Balance:    posted.Balance,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"context"
+
+	errorCodes "github.com/tkoleo84119/nail-salon-backend/internal/errors"
+	"github.com/tkoleo84119/nail-salon-backend/internal/repository/sqlc/dbgen"
+	"github.com/tkoleo84119/nail-salon-backend/internal/utils"
+)
+
+type PostTransactionParams struct {
+	CustomerID    int64
+	StoreID       *int64
+	Type          string
+	Amount        int64
+	PaymentMethod *string
+	SourceType    *string
+	SourceID      *int64
+	Note          *string
+	CreatedBy     *int64
+}
+
+type PostTransactionResult struct {
+	TransactionID int64
+	Balance       int64
+}
+
+// PostTransaction applies a signed amount to the customer wallet within the given transaction queries.
+// The wallet is created on first use, and its row is locked so concurrent postings compute the balance sequentially.
+// The wallet balance after the posting is returned with the transaction id.
+func PostTransaction(ctx context.Context, qtx *dbgen.Queries, params PostTransactionParams) (*PostTransactionResult, error) {
+	if err := qtx.CreateCustomerWalletIfNotExists(ctx, dbgen.CreateCustomerWalletIfNotExistsParams{
+		ID:         utils.GenerateID(),
+		CustomerID: params.CustomerID,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer wallet", err)
+	}
+
+	wallet, err := qtx.GetCustomerWalletByCustomerIDForUpdate(ctx, params.CustomerID)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to get customer wallet", err)
+	}
+
+	currentBalance, err := utils.PgNumericToInt64(wallet.Balance)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert wallet balance", err)
+	}
+
+	balance := currentBalance + params.Amount
+	if balance < 0 {
+		return nil, errorCodes.NewServiceErrorWithCode(errorCodes.CustomerWalletInsufficientBalance)
+	}
+
+	balanceNumeric, err := utils.Int64PtrToPgNumeric(&balance)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert balance", err)
+	}
+	amountNumeric, err := utils.Int64PtrToPgNumeric(&params.Amount)
+	if err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.ValTypeConversionFailed, "failed to convert amount", err)
+	}
+
+	if err := qtx.UpdateCustomerWalletBalance(ctx, dbgen.UpdateCustomerWalletBalanceParams{
+		ID:      wallet.ID,
+		Balance: balanceNumeric,
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to update customer wallet balance", err)
+	}
+
+	transactionID := utils.GenerateID()
+	if err := qtx.CreateCustomerWalletTransaction(ctx, dbgen.CreateCustomerWalletTransactionParams{
+		ID:            transactionID,
+		WalletID:      wallet.ID,
+		CustomerID:    params.CustomerID,
+		StoreID:       utils.Int64PtrToPgInt8(params.StoreID),
+		Type:          params.Type,
+		Amount:        amountNumeric,
+		Balance:       balanceNumeric,
+		PaymentMethod: utils.StringPtrToPgText(params.PaymentMethod, true),
+		SourceType:    utils.StringPtrToPgText(params.SourceType, true),
+		SourceID:      utils.Int64PtrToPgInt8(params.SourceID),
+		Note:          utils.StringPtrToPgText(params.Note, true),
+		CreatedBy:     utils.Int64PtrToPgInt8(params.CreatedBy),
+	}); err != nil {
+		return nil, errorCodes.NewServiceError(errorCodes.SysDatabaseError, "failed to create customer wallet transaction", err)
+	}
+
+	return &PostTransactionResult{
+		TransactionID: transactionID,
+		Balance:       balance,
+	}, nil
+}
@@ -33,10 +33,14 @@ type LineValidator struct {
 	httpClient     *http.Client
 }
 
+// LineMulticastMaxRecipients is the max number of recipients of a LINE multicast request
+const LineMulticastMaxRecipients = 500
+
 type LineMessageClient struct {
 	channelAccessToken string
 	httpClient         *http.Client
 	messageEndpoint    string
+	multicastEndpoint  string
 }
 
 type TextMessage struct {
@@ -55,6 +59,11 @@ type PushMessageRequest struct {
 	Messages []interface{} `json:"messages"`
 }
 
+type MulticastMessageRequest struct {
+	To       []string      `json:"to"`
+	Messages []interface{} `json:"messages"`
+}
+
 type BookingData struct {
 	StoreName       string   `json:"storeName"`
 	StoreAddress    string   `json:"storeAddress"`
@@ -68,6 +77,34 @@ type BookingData struct {
 	SubServiceNames []string `json:"subServiceNames,omitempty"`
 }
 
+type ReceiptData struct {
+	StoreName            string        `json:"storeName"`
+	StoreAddress         string        `json:"storeAddress"`
+	StorePhone           string        `json:"storePhone"`
+	CustomerName         string        `json:"customerName"`
+	StylistName          string        `json:"stylistName"`
+	Date                 string        `json:"date"`
+	StartTime            string        `json:"startTime"`
+	EndTime              string        `json:"endTime"`
+	CheckoutAt           string        `json:"checkoutAt"`
+	Items                []ReceiptItem `json:"items"`
+	CouponName           string        `json:"couponName,omitempty"`
+	PointsRedeemed       int32         `json:"pointsRedeemed,omitempty"`
+	PointsDiscountAmount int64         `json:"pointsDiscountAmount,omitempty"`
+	TotalAmount          int64         `json:"totalAmount"`
+	FinalAmount          int64         `json:"finalAmount"`
+	PaidAmount           int64         `json:"paidAmount"`
+	PaymentMethod        string        `json:"paymentMethod"`
+	InvoiceNumber        string        `json:"invoiceNumber,omitempty"`
+	RefundedAt           string        `json:"refundedAt,omitempty"`
+}
+
+type ReceiptItem struct {
+	ServiceName string `json:"serviceName"`
+	RawPrice    int64  `json:"rawPrice"`
+	Price       int64  `json:"price"`
+}
+
 func NewLineValidator(channelID string) *LineValidator {
 	return &LineValidator{
 		channelID:      channelID,
@@ -156,6 +193,7 @@ func NewLineMessenger(channelAccessToken string) *LineMessageClient {
 	return &LineMessageClient{
 		channelAccessToken: channelAccessToken,
 		messageEndpoint:    "https://api.line.me/v2/bot/message/push",
+		multicastEndpoint:  "https://api.line.me/v2/bot/message/multicast",
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 			Transport: &http.Transport{
@@ -188,6 +226,27 @@ func (c *LineMessageClient) SendFlexMessage(userID string, altText string, conte
 	return c.sendMessage(userID, message)
 }
 
+// SendMulticastTextMessage sends a text message to multiple users, at most LineMulticastMaxRecipients users per request
+func (c *LineMessageClient) SendMulticastTextMessage(userIDs []string, text string) error {
+	message := TextMessage{
+		Type: "text",
+		Text: text,
+	}
+
+	return c.sendMulticastMessage(userIDs, message)
+}
+
+// SendMulticastFlexMessage sends a flex message to multiple users, at most LineMulticastMaxRecipients users per request
+func (c *LineMessageClient) SendMulticastFlexMessage(userIDs []string, altText string, contents interface{}) error {
+	message := FlexMessage{
+		Type:     "flex",
+		AltText:  altText,
+		Contents: contents,
+	}
+
+	return c.sendMulticastMessage(userIDs, message)
+}
+
 // SendBookingNotification sends a booking notification to a user
 func (c *LineMessageClient) SendBookingNotification(userID string, action common.BookingAction, bookingData *BookingData) error {
 	actionText := c.getActionText(action)
@@ -197,6 +256,14 @@ func (c *LineMessageClient) SendBookingNotification(userID string, action common
 	return c.SendFlexMessage(userID, altText, flexContent)
 }
 
+// SendCheckoutReceipt sends a checkout receipt to a user
+func (c *LineMessageClient) SendCheckoutReceipt(userID string, receiptData *ReceiptData) error {
+	flexContent := c.buildReceiptFlexContent(receiptData)
+
+	altText := fmt.Sprintf("%s - 消費明細", receiptData.StoreName)
+	return c.SendFlexMessage(userID, altText, flexContent)
+}
+
 // sendMessage is basic function to send message to line
 func (c *LineMessageClient) sendMessage(userID string, message interface{}) error {
 	requestData := PushMessageRequest{
@@ -204,12 +271,34 @@ func (c *LineMessageClient) sendMessage(userID string, message interface{}) erro
 		Messages: []interface{}{message},
 	}
 
+	return c.postMessage(c.messageEndpoint, requestData)
+}
+
+// sendMulticastMessage is basic function to send message to multiple users of line
+func (c *LineMessageClient) sendMulticastMessage(userIDs []string, message interface{}) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if len(userIDs) > LineMulticastMaxRecipients {
+		return errorCodes.NewServiceError(errorCodes.SysInternalError, fmt.Sprintf("multicast recipients exceed %d", LineMulticastMaxRecipients), nil)
+	}
+
+	requestData := MulticastMessageRequest{
+		To:       userIDs,
+		Messages: []interface{}{message},
+	}
+
+	return c.postMessage(c.multicastEndpoint, requestData)
+}
+
+// postMessage posts the message request to the line endpoint
+func (c *LineMessageClient) postMessage(endpoint string, requestData interface{}) error {
 	jsonData, err := json.Marshal(requestData)
 	if err != nil {
 		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to marshal message data", err)
 	}
 
-	req, err := http.NewRequest("POST", c.messageEndpoint, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return errorCodes.NewServiceError(errorCodes.SysInternalError, "failed to create request", err)
 	}
@@ -429,3 +518,192 @@ func (c *LineMessageClient) buildBookingFlexContent(bookingData *BookingData, _
 		},
 	}
 }
+
+// buildReceiptFlexContent is function to build checkout receipt flex content
+func (c *LineMessageClient) buildReceiptFlexContent(receiptData *ReceiptData) map[string]interface{} {
+	header := map[string]interface{}{
+		"type":   "box",
+		"layout": "vertical",
+		"contents": []map[string]interface{}{
+			{
+				"type":   "text",
+				"text":   receiptData.StoreName,
+				"weight": "bold",
+				"size":   "xl",
+				"color":  "#1DB446",
+			},
+			{
+				"type":   "text",
+				"text":   "消費明細",
+				"size":   "md",
+				"weight": "bold",
+			},
+			{
+				"type":  "text",
+				"text":  formatDateTimeWithWeekday(receiptData.Date, receiptData.StartTime, receiptData.EndTime),
+				"size":  "xs",
+				"color": "#666666",
+			},
+		},
+		"spacing": "sm",
+	}
+
+	itemContents := make([]map[string]interface{}, 0, len(receiptData.Items))
+	for _, item := range receiptData.Items {
+		priceContents := []map[string]interface{}{}
+		if item.RawPrice != item.Price {
+			priceContents = append(priceContents, map[string]interface{}{
+				"type":       "text",
+				"text":       fmt.Sprintf("$%d", item.RawPrice),
+				"size":       "xs",
+				"color":      "#AAAAAA",
+				"decoration": "line-through",
+				"align":      "end",
+			})
+		}
+		priceContents = append(priceContents, map[string]interface{}{
+			"type":  "text",
+			"text":  fmt.Sprintf("$%d", item.Price),
+			"size":  "sm",
+			"align": "end",
+		})
+
+		itemContents = append(itemContents, map[string]interface{}{
+			"type":   "box",
+			"layout": "horizontal",
+			"contents": []map[string]interface{}{
+				{
+					"type":  "text",
+					"text":  item.ServiceName,
+					"size":  "sm",
+					"color": "#666666",
+					"wrap":  true,
+					"flex":  3,
+				},
+				{
+					"type":     "box",
+					"layout":   "vertical",
+					"contents": priceContents,
+					"flex":     2,
+				},
+			},
+		})
+	}
+
+	couponName := "無"
+	if receiptData.CouponName != "" {
+		couponName = receiptData.CouponName
+	}
+
+	summaryRows := [][2]string{
+		{"原價總額", fmt.Sprintf("$%d", receiptData.TotalAmount)},
+		{"優惠券", couponName},
+	}
+	if receiptData.PointsRedeemed > 0 {
+		summaryRows = append(summaryRows, [2]string{"點數折抵", fmt.Sprintf("-$%d (%d 點)", receiptData.PointsDiscountAmount, receiptData.PointsRedeemed)})
+	}
+	summaryRows = append(summaryRows,
+		[2]string{"應付金額", fmt.Sprintf("$%d", receiptData.FinalAmount)},
+		[2]string{"實收金額", fmt.Sprintf("$%d", receiptData.PaidAmount)},
+		[2]string{"付款方式", receiptData.PaymentMethod},
+	)
+	if receiptData.InvoiceNumber != "" {
+		summaryRows = append(summaryRows, [2]string{"發票號碼", receiptData.InvoiceNumber})
+	}
+	if receiptData.RefundedAt != "" {
+		summaryRows = append(summaryRows, [2]string{"已退款", receiptData.RefundedAt})
+	}
+
+	summaryContents := make([]map[string]interface{}, len(summaryRows))
+	for i, row := range summaryRows {
+		summaryContents[i] = map[string]interface{}{
+			"type":   "box",
+			"layout": "horizontal",
+			"contents": []map[string]interface{}{
+				{
+					"type":  "text",
+					"text":  row[0],
+					"size":  "sm",
+					"color": "#666666",
+					"flex":  0,
+				},
+				{
+					"type":  "text",
+					"text":  row[1],
+					"size":  "sm",
+					"align": "end",
+				},
+			},
+		}
+	}
+
+	body := map[string]interface{}{
+		"type":    "box",
+		"layout":  "vertical",
+		"spacing": "md",
+		"contents": []map[string]interface{}{
+			{
+				"type":     "box",
+				"layout":   "vertical",
+				"spacing":  "sm",
+				"contents": itemContents,
+			},
+			{
+				"type":   "separator",
+				"margin": "xxl",
+			},
+			{
+				"type":     "box",
+				"layout":   "vertical",
+				"spacing":  "sm",
+				"margin":   "xxl",
+				"contents": summaryContents,
+			},
+		},
+	}
+
+	footerContents := []map[string]interface{}{}
+	if receiptData.StoreAddress != "" {
+		footerContents = append(footerContents, map[string]interface{}{
+			"type":  "text",
+			"text":  receiptData.StoreAddress,
+			"size":  "xs",
+			"color": "#AAAAAA",
+			"wrap":  true,
+		})
+	}
+	if receiptData.StorePhone != "" {
+		footerContents = append(footerContents, map[string]interface{}{
+			"type":  "text",
+			"text":  receiptData.StorePhone,
+			"size":  "xs",
+			"color": "#AAAAAA",
+		})
+	}
+
+	bubble := map[string]interface{}{
+		"type":   "bubble",
+		"header": header,
+		"body":   body,
+		"styles": map[string]interface{}{
+			"header": map[string]interface{}{
+				"separator": false,
+			},
+			"body": map[string]interface{}{
+				"separator": true,
+			},
+			"footer": map[string]interface{}{
+				"separator": true,
+			},
+		},
+	}
+	if len(footerContents) > 0 {
+		bubble["footer"] = map[string]interface{}{
+			"type":     "box",
+			"layout":   "vertical",
+			"contents": footerContents,
+		}
+	}
+
+	return bubble
+}
@@ -16,6 +16,13 @@ func PgTextToString(t pgtype.Text) string {
 	return t.String
 }
 
+func PgTextToStringPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
 func PgNumericToFloat64(n pgtype.Numeric) (float64, error) {
 	if !n.Valid {
 		return 0, fmt.Errorf("invalid numeric value")
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cash_drawer_closes;
\ No newline at end of file
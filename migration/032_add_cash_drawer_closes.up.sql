@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS cash_drawer_closes (
+    id                     BIGINT        PRIMARY KEY,
+    store_id               BIGINT        NOT NULL,
+    close_date             DATE          NOT NULL,
+    checkout_count         INT           NOT NULL,
+    expected_cash          NUMERIC(12,2) NOT NULL,
+    counted_cash           NUMERIC(12,2) NOT NULL,
+    difference             NUMERIC(12,2) NOT NULL,
+    account_id             BIGINT        NOT NULL,
+    account_transaction_id BIGINT,
+    note                   TEXT,
+    closed_by              BIGINT        NOT NULL,
+    created_at             TIMESTAMPTZ   DEFAULT NOW(),
+    updated_at             TIMESTAMPTZ   DEFAULT NOW(),
+    FOREIGN KEY (store_id)               REFERENCES stores(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id)             REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_transaction_id) REFERENCES account_transactions(id) ON DELETE SET NULL,
+    FOREIGN KEY (closed_by)              REFERENCES staff_users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_cash_drawer_close_store_date UNIQUE (store_id, close_date)
+);
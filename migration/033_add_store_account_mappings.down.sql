@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_account_transactions_on_source;
+
+ALTER TABLE account_transactions
+DROP COLUMN source_id,
+DROP COLUMN source_type;
+
+DROP TABLE IF EXISTS store_account_mappings;
\ No newline at end of file
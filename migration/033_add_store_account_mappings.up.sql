@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS store_account_mappings (
+  id             BIGINT      PRIMARY KEY,
+  store_id       BIGINT      NOT NULL,
+  mapping_type   VARCHAR(20) NOT NULL,
+  payment_method VARCHAR(20),
+  payer_id       BIGINT,
+  account_id     BIGINT      NOT NULL,
+  created_at     TIMESTAMPTZ DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (store_id)   REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (payer_id)   REFERENCES staff_users(id) ON DELETE CASCADE,
+  FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_store_account_mappings_on_key ON store_account_mappings (store_id, mapping_type, COALESCE(payment_method, ''), COALESCE(payer_id, 0));
+
+ALTER TABLE account_transactions
+ADD COLUMN source_type VARCHAR(30),
+ADD COLUMN source_id BIGINT;
+
+CREATE INDEX idx_account_transactions_on_source ON account_transactions (source_type, source_id);
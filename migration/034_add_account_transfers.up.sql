@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS account_transfers (
+  id               BIGINT        PRIMARY KEY,
+  store_id         BIGINT        NOT NULL,
+  from_account_id  BIGINT        NOT NULL,
+  to_account_id    BIGINT        NOT NULL,
+  transaction_date DATE          NOT NULL,
+  amount           NUMERIC(12,2) NOT NULL,
+  note             TEXT,
+  created_by       BIGINT        NOT NULL,
+  created_at       TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at       TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (store_id)        REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+  FOREIGN KEY (to_account_id)   REFERENCES accounts(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by)      REFERENCES staff_users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_account_transfers_on_store_id ON account_transfers (store_id);
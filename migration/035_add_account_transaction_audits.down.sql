@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_transactions_on_account_date;
+
+DROP TABLE IF EXISTS account_transaction_audits;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS account_transaction_audits (
+  id                     BIGINT      PRIMARY KEY,
+  account_id             BIGINT      NOT NULL,
+  account_transaction_id BIGINT      NOT NULL,
+  action                 VARCHAR(10) NOT NULL,
+  before_data            JSONB,
+  after_data             JSONB,
+  created_by             BIGINT      NOT NULL,
+  created_at             TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES staff_users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_account_transaction_audits_on_account_id ON account_transaction_audits (account_id, created_at);
+
+CREATE INDEX idx_account_transactions_on_account_date ON account_transactions (account_id, transaction_date, created_at);
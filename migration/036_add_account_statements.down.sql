@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS account_statement_lines;
+DROP TABLE IF EXISTS account_statement_imports;
+DROP TABLE IF EXISTS account_statement_layouts;
\ No newline at end of file
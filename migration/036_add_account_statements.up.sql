@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS account_statement_layouts (
+  account_id         BIGINT      PRIMARY KEY,
+  encoding           VARCHAR(10) NOT NULL DEFAULT 'UTF-8',
+  has_header         BOOLEAN     NOT NULL DEFAULT TRUE,
+  skip_rows          INT         NOT NULL DEFAULT 0,
+  delimiter          VARCHAR(1)  NOT NULL DEFAULT ',',
+  date_column        INT         NOT NULL,
+  date_format        VARCHAR(20) NOT NULL,
+  description_column INT,
+  amount_column      INT,
+  deposit_column     INT,
+  withdrawal_column  INT,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS account_statement_imports (
+  id            BIGINT       PRIMARY KEY,
+  account_id    BIGINT       NOT NULL,
+  file_name     VARCHAR(255) NOT NULL,
+  total_lines   INT          NOT NULL DEFAULT 0,
+  matched_lines INT          NOT NULL DEFAULT 0,
+  created_by    BIGINT       NOT NULL,
+  created_at    TIMESTAMPTZ  DEFAULT NOW(),
+  FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES staff_users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_account_statement_imports_on_account_id ON account_statement_imports (account_id, created_at);
+
+CREATE TABLE IF NOT EXISTS account_statement_lines (
+  id                     BIGINT        PRIMARY KEY,
+  import_id              BIGINT        NOT NULL,
+  account_id             BIGINT        NOT NULL,
+  line_number            INT           NOT NULL,
+  transaction_date       DATE          NOT NULL,
+  description            TEXT,
+  type                   VARCHAR(10)   NOT NULL,
+  amount                 NUMERIC(12,2) NOT NULL,
+  status                 VARCHAR(10)   NOT NULL,
+  account_transaction_id BIGINT,
+  created_at             TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at             TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (import_id)              REFERENCES account_statement_imports(id) ON DELETE CASCADE,
+  FOREIGN KEY (account_id)             REFERENCES accounts(id) ON DELETE CASCADE,
+  FOREIGN KEY (account_transaction_id) REFERENCES account_transactions(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_account_statement_lines_on_import_id ON account_statement_lines (import_id, line_number);
+CREATE INDEX idx_account_statement_lines_on_account_status ON account_statement_lines (account_id, status);
+CREATE UNIQUE INDEX uq_account_statement_lines_on_account_transaction_id ON account_statement_lines (account_transaction_id) WHERE account_transaction_id IS NOT NULL;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS invoices;
+
+ALTER TABLE checkouts DROP COLUMN IF EXISTS refunded_by;
+ALTER TABLE checkouts DROP COLUMN IF EXISTS refund_reason;
+ALTER TABLE checkouts DROP COLUMN IF EXISTS refunded_at;
+
+ALTER TABLE customers DROP COLUMN IF EXISTS invoice_carrier_value;
+ALTER TABLE customers DROP COLUMN IF EXISTS invoice_carrier_type;
\ No newline at end of file
@@ -0,0 +1,40 @@
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS invoice_carrier_type VARCHAR(20);
+
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS invoice_carrier_value VARCHAR(20);
+
+ALTER TABLE checkouts
+ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMPTZ;
+
+ALTER TABLE checkouts
+ADD COLUMN IF NOT EXISTS refund_reason TEXT;
+
+ALTER TABLE checkouts
+ADD COLUMN IF NOT EXISTS refunded_by BIGINT REFERENCES staff_users(id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS invoices (
+  id             BIGINT        PRIMARY KEY,
+  store_id       BIGINT        NOT NULL,
+  checkout_id    BIGINT        NOT NULL,
+  customer_id    BIGINT        NOT NULL,
+  amount         NUMERIC(12,2) NOT NULL,
+  carrier_type   VARCHAR(20),
+  carrier_value  VARCHAR(20),
+  invoice_number VARCHAR(20),
+  random_code    VARCHAR(10),
+  status         VARCHAR(20)   NOT NULL,
+  error_message  TEXT,
+  issued_at      TIMESTAMPTZ,
+  voided_at      TIMESTAMPTZ,
+  void_reason    TEXT,
+  created_at     TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (store_id)    REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (checkout_id) REFERENCES checkouts(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_invoices_on_checkout_id ON invoices (checkout_id);
+CREATE UNIQUE INDEX uq_invoices_on_invoice_number ON invoices (invoice_number) WHERE invoice_number IS NOT NULL;
+CREATE INDEX idx_invoices_on_store_status ON invoices (store_id, status, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS gift_cards;
+DROP TABLE IF EXISTS customer_wallet_transactions;
+DROP TABLE IF EXISTS customer_wallets;
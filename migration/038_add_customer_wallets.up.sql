@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS customer_wallets (
+  id          BIGINT        PRIMARY KEY,
+  customer_id BIGINT        NOT NULL,
+  balance     NUMERIC(12,2) NOT NULL DEFAULT 0,
+  created_at  TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_customer_wallets_on_customer_id ON customer_wallets (customer_id);
+
+CREATE TABLE IF NOT EXISTS customer_wallet_transactions (
+  id             BIGINT        PRIMARY KEY,
+  wallet_id      BIGINT        NOT NULL,
+  customer_id    BIGINT        NOT NULL,
+  store_id       BIGINT,
+  type           VARCHAR(20)   NOT NULL,
+  amount         NUMERIC(12,2) NOT NULL,
+  balance        NUMERIC(12,2) NOT NULL,
+  payment_method VARCHAR(50),
+  source_type    VARCHAR(30),
+  source_id      BIGINT,
+  note           TEXT,
+  created_by     BIGINT,
+  created_at     TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (wallet_id)   REFERENCES customer_wallets(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (store_id)    REFERENCES stores(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by)  REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_wallet_transactions_on_customer_id ON customer_wallet_transactions (customer_id, created_at);
+CREATE INDEX idx_customer_wallet_transactions_on_source ON customer_wallet_transactions (source_type, source_id);
+CREATE INDEX idx_customer_wallet_transactions_on_store_type ON customer_wallet_transactions (store_id, type, created_at);
+
+CREATE TABLE IF NOT EXISTS gift_cards (
+  id                   BIGINT        PRIMARY KEY,
+  store_id             BIGINT        NOT NULL,
+  code                 VARCHAR(20)   NOT NULL,
+  amount               NUMERIC(12,2) NOT NULL,
+  status               VARCHAR(20)   NOT NULL,
+  expires_at           DATE,
+  note                 TEXT,
+  redeemed_customer_id BIGINT,
+  redeemed_at          TIMESTAMPTZ,
+  created_by           BIGINT        NOT NULL,
+  created_at           TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at           TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (store_id)             REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (redeemed_customer_id) REFERENCES customers(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by)           REFERENCES staff_users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_gift_cards_on_code ON gift_cards (code);
+CREATE INDEX idx_gift_cards_on_store_status ON gift_cards (store_id, status, created_at);
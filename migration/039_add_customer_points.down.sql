@@ -0,0 +1,6 @@
+ALTER TABLE checkouts DROP COLUMN IF EXISTS points_discount_amount;
+ALTER TABLE checkouts DROP COLUMN IF EXISTS points_redeemed;
+
+DROP TABLE IF EXISTS customer_point_transactions;
+DROP TABLE IF EXISTS customer_points;
+DROP TABLE IF EXISTS store_loyalty_settings;
@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS store_loyalty_settings (
+  store_id           BIGINT      PRIMARY KEY,
+  is_enabled         BOOLEAN     NOT NULL DEFAULT FALSE,
+  earn_amount_unit   INT         NOT NULL,
+  earn_points        INT         NOT NULL,
+  redeem_points_unit INT         NOT NULL,
+  redeem_amount      INT         NOT NULL,
+  expiry_months      INT,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS customer_points (
+  id          BIGINT      PRIMARY KEY,
+  customer_id BIGINT      NOT NULL,
+  balance     INT         NOT NULL DEFAULT 0,
+  created_at  TIMESTAMPTZ DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_customer_points_on_customer_id ON customer_points (customer_id);
+
+CREATE TABLE IF NOT EXISTS customer_point_transactions (
+  id               BIGINT      PRIMARY KEY,
+  customer_id      BIGINT      NOT NULL,
+  store_id         BIGINT,
+  type             VARCHAR(20) NOT NULL,
+  points           INT         NOT NULL,
+  balance          INT         NOT NULL,
+  remaining_points INT         NOT NULL DEFAULT 0,
+  expires_at       DATE,
+  source_type      VARCHAR(30),
+  source_id        BIGINT,
+  note             TEXT,
+  created_by       BIGINT,
+  created_at       TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (store_id)    REFERENCES stores(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by)  REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_point_transactions_on_customer_id ON customer_point_transactions (customer_id, created_at);
+CREATE INDEX idx_customer_point_transactions_on_source ON customer_point_transactions (source_type, source_id);
+CREATE INDEX idx_customer_point_transactions_on_expires_at ON customer_point_transactions (expires_at) WHERE remaining_points > 0;
+
+ALTER TABLE checkouts
+ADD COLUMN IF NOT EXISTS points_redeemed INT NOT NULL DEFAULT 0;
+
+ALTER TABLE checkouts
+ADD COLUMN IF NOT EXISTS points_discount_amount NUMERIC(12,2) NOT NULL DEFAULT 0;
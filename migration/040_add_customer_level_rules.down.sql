@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_level_histories;
+DROP TABLE IF EXISTS customer_level_rules;
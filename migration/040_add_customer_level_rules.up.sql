@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS customer_level_rules (
+  level                       VARCHAR(20)   PRIMARY KEY,
+  is_active                   BOOLEAN       NOT NULL DEFAULT TRUE,
+  period_months               INT           NOT NULL DEFAULT 12,
+  min_spend_amount            NUMERIC(12,2) NOT NULL DEFAULT 0,
+  min_visit_count             INT           NOT NULL DEFAULT 0,
+  benefit_coupon_id           BIGINT,
+  benefit_coupon_valid_months INT,
+  created_at                  TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at                  TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (benefit_coupon_id) REFERENCES coupons(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS customer_level_histories (
+  id          BIGINT      PRIMARY KEY,
+  customer_id BIGINT      NOT NULL,
+  from_level  VARCHAR(20),
+  to_level    VARCHAR(20) NOT NULL,
+  reason      VARCHAR(20) NOT NULL,
+  note        TEXT,
+  created_by  BIGINT,
+  created_at  TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by)  REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_level_histories_on_customer_id ON customer_level_histories (customer_id, created_at);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS uq_customer_coupon;
+ALTER TABLE customer_coupons ADD CONSTRAINT uq_customer_coupon UNIQUE (customer_id, coupon_id);
+
+ALTER TABLE customer_coupons DROP COLUMN IF EXISTS source_id;
+ALTER TABLE customer_coupons DROP COLUMN IF EXISTS source_type;
+
+DROP TABLE IF EXISTS customer_referrals;
+DROP TABLE IF EXISTS referral_settings;
+
+DROP INDEX IF EXISTS uq_customers_on_referral_code;
+ALTER TABLE customers DROP COLUMN IF EXISTS referral_code;
@@ -0,0 +1,53 @@
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS referral_code VARCHAR(20);
+
+UPDATE customers
+SET referral_code = UPPER(SUBSTRING(MD5(id::text || RANDOM()::text) FROM 1 FOR 8))
+WHERE referral_code IS NULL;
+
+CREATE UNIQUE INDEX uq_customers_on_referral_code ON customers (referral_code);
+
+CREATE TABLE IF NOT EXISTS referral_settings (
+  id                  SMALLINT    PRIMARY KEY DEFAULT 1,
+  is_enabled          BOOLEAN     NOT NULL DEFAULT FALSE,
+  referrer_coupon_id  BIGINT,
+  referee_coupon_id   BIGINT,
+  coupon_valid_months INT,
+  created_at          TIMESTAMPTZ DEFAULT NOW(),
+  updated_at          TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT chk_referral_settings_single_row CHECK (id = 1),
+  FOREIGN KEY (referrer_coupon_id) REFERENCES coupons(id) ON DELETE SET NULL,
+  FOREIGN KEY (referee_coupon_id)  REFERENCES coupons(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS customer_referrals (
+  id                          BIGINT      PRIMARY KEY,
+  referrer_customer_id        BIGINT      NOT NULL,
+  referee_customer_id         BIGINT      NOT NULL,
+  referral_code               VARCHAR(20) NOT NULL,
+  status                      VARCHAR(20) NOT NULL,
+  first_checkout_id           BIGINT,
+  referrer_customer_coupon_id BIGINT,
+  referee_customer_coupon_id  BIGINT,
+  completed_at                TIMESTAMPTZ,
+  created_at                  TIMESTAMPTZ DEFAULT NOW(),
+  updated_at                  TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (referrer_customer_id)        REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (referee_customer_id)         REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (first_checkout_id)           REFERENCES checkouts(id) ON DELETE SET NULL,
+  FOREIGN KEY (referrer_customer_coupon_id) REFERENCES customer_coupons(id) ON DELETE SET NULL,
+  FOREIGN KEY (referee_customer_coupon_id)  REFERENCES customer_coupons(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX uq_customer_referrals_on_referee_customer_id ON customer_referrals (referee_customer_id);
+CREATE INDEX idx_customer_referrals_on_referrer_customer_id ON customer_referrals (referrer_customer_id, created_at);
+
+-- coupons issued by a source (e.g. referral rewards) may be issued to the same customer more than once
+ALTER TABLE customer_coupons
+ADD COLUMN IF NOT EXISTS source_type VARCHAR(30);
+
+ALTER TABLE customer_coupons
+ADD COLUMN IF NOT EXISTS source_id BIGINT;
+
+ALTER TABLE customer_coupons DROP CONSTRAINT IF EXISTS uq_customer_coupon;
+CREATE UNIQUE INDEX uq_customer_coupon ON customer_coupons (customer_id, coupon_id) WHERE source_type IS NULL;
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_customer_coupons_on_coupon_id_is_used;
+DROP INDEX IF EXISTS idx_customer_coupons_on_customer_id_coupon_id;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_customer_coupon ON customer_coupons (customer_id, coupon_id) WHERE source_type IS NULL;
+
+DROP TABLE IF EXISTS coupon_services;
+
+ALTER TABLE coupons DROP COLUMN IF EXISTS is_repeatable;
+ALTER TABLE coupons DROP COLUMN IF EXISTS per_customer_usage_limit;
+ALTER TABLE coupons DROP COLUMN IF EXISTS total_usage_limit;
+ALTER TABLE coupons DROP COLUMN IF EXISTS service_scope;
+ALTER TABLE coupons DROP COLUMN IF EXISTS min_spend_amount;
+ALTER TABLE coupons DROP COLUMN IF EXISTS end_date;
+ALTER TABLE coupons DROP COLUMN IF EXISTS start_date;
@@ -0,0 +1,34 @@
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS start_date DATE;
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS end_date DATE;
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS min_spend_amount NUMERIC(10,2);
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS service_scope VARCHAR(20) NOT NULL DEFAULT 'ALL';
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS total_usage_limit INT;
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS per_customer_usage_limit INT;
+
+ALTER TABLE coupons
+ADD COLUMN IF NOT EXISTS is_repeatable BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS coupon_services (
+  coupon_id  BIGINT NOT NULL,
+  service_id BIGINT NOT NULL,
+  PRIMARY KEY (coupon_id, service_id),
+  FOREIGN KEY (coupon_id)  REFERENCES coupons(id) ON DELETE CASCADE,
+  FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE
+);
+
+-- repeatable coupons can be issued to the same customer more than once,
+-- the one-per-customer rule of other coupons is checked when issuing
+DROP INDEX IF EXISTS uq_customer_coupon;
+CREATE INDEX IF NOT EXISTS idx_customer_coupons_on_customer_id_coupon_id ON customer_coupons (customer_id, coupon_id);
+CREATE INDEX IF NOT EXISTS idx_customer_coupons_on_coupon_id_is_used ON customer_coupons (coupon_id, is_used);
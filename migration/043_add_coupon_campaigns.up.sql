@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS coupon_campaigns (
+  id                BIGINT       PRIMARY KEY,
+  name              VARCHAR(100) NOT NULL,
+  coupon_id         BIGINT       NOT NULL,
+  valid_months      INT,
+  customer_levels   TEXT[],
+  last_visit_from   DATE,
+  last_visit_to     DATE,
+  birthday_month    INT,
+  store_id          BIGINT,
+  send_line         BOOLEAN      NOT NULL DEFAULT FALSE,
+  line_message      TEXT,
+  status            VARCHAR(20)  NOT NULL,
+  target_count      INT          NOT NULL DEFAULT 0,
+  issued_count      INT          NOT NULL DEFAULT 0,
+  skipped_count     INT          NOT NULL DEFAULT 0,
+  line_sent_count   INT          NOT NULL DEFAULT 0,
+  line_failed_count INT          NOT NULL DEFAULT 0,
+  last_customer_id  BIGINT       NOT NULL DEFAULT 0,
+  error_message     TEXT,
+  started_at        TIMESTAMPTZ,
+  finished_at       TIMESTAMPTZ,
+  created_by        BIGINT       NOT NULL,
+  created_at        TIMESTAMPTZ  DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ  DEFAULT NOW(),
+  FOREIGN KEY (coupon_id)  REFERENCES coupons(id) ON DELETE CASCADE,
+  FOREIGN KEY (store_id)   REFERENCES stores(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by) REFERENCES staff_users(id)
+);
+
+CREATE INDEX idx_coupon_campaigns_on_status ON coupon_campaigns (status, created_at);
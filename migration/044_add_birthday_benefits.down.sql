@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_birthday_benefits;
+DROP TABLE IF EXISTS birthday_benefit_settings;
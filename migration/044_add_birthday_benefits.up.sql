@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS birthday_benefit_settings (
+  id                SMALLINT    PRIMARY KEY DEFAULT 1,
+  is_enabled        BOOLEAN     NOT NULL DEFAULT FALSE,
+  coupon_id         BIGINT,
+  coupon_valid_days INT         NOT NULL DEFAULT 30,
+  issue_period      VARCHAR(10) NOT NULL DEFAULT 'MONTH',
+  line_message      TEXT,
+  created_at        TIMESTAMPTZ DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT chk_birthday_benefit_settings_single_row CHECK (id = 1),
+  FOREIGN KEY (coupon_id) REFERENCES coupons(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS customer_birthday_benefits (
+  id                 BIGINT      PRIMARY KEY,
+  customer_id        BIGINT      NOT NULL,
+  year               INT         NOT NULL,
+  customer_coupon_id BIGINT,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id)        REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_coupon_id) REFERENCES customer_coupons(id) ON DELETE SET NULL
+);
+
+-- birthday benefits are issued once per customer per year
+CREATE UNIQUE INDEX uq_customer_birthday_benefits_on_customer_id_year ON customer_birthday_benefits (customer_id, year);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_booking_details_on_promotion_id;
+
+ALTER TABLE booking_details DROP COLUMN IF EXISTS promotion_discount_amount;
+ALTER TABLE booking_details DROP COLUMN IF EXISTS promotion_id;
+
+DROP TABLE IF EXISTS promotion_services;
+DROP TABLE IF EXISTS promotions;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS promotions (
+  id                BIGINT        PRIMARY KEY,
+  name              VARCHAR(100)  NOT NULL,
+  store_id          BIGINT,
+  discount_rate     NUMERIC(3,2),
+  discount_amount   NUMERIC(10,2),
+  start_date        DATE          NOT NULL,
+  end_date          DATE          NOT NULL,
+  weekdays          INT[],
+  start_time        TIME,
+  end_time          TIME,
+  service_scope     VARCHAR(20)   NOT NULL DEFAULT 'ALL',
+  stack_with_coupon BOOLEAN       NOT NULL DEFAULT FALSE,
+  is_active         BOOLEAN       NOT NULL DEFAULT TRUE,
+  note              TEXT,
+  created_by        BIGINT        NOT NULL,
+  created_at        TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (store_id)   REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES staff_users(id)
+);
+
+CREATE INDEX idx_promotions_on_is_active_dates ON promotions (is_active, start_date, end_date);
+
+CREATE TABLE IF NOT EXISTS promotion_services (
+  promotion_id BIGINT NOT NULL,
+  service_id   BIGINT NOT NULL,
+  PRIMARY KEY (promotion_id, service_id),
+  FOREIGN KEY (promotion_id) REFERENCES promotions(id) ON DELETE CASCADE,
+  FOREIGN KEY (service_id)   REFERENCES services(id) ON DELETE CASCADE
+);
+
+-- the promotion applied to the booking detail at checkout, the promotion discount is applied before the coupon discount
+ALTER TABLE booking_details
+ADD COLUMN IF NOT EXISTS promotion_id BIGINT REFERENCES promotions(id) ON DELETE SET NULL;
+
+ALTER TABLE booking_details
+ADD COLUMN IF NOT EXISTS promotion_discount_amount NUMERIC(10,2);
+
+CREATE INDEX idx_booking_details_on_promotion_id ON booking_details (promotion_id) WHERE promotion_id IS NOT NULL;
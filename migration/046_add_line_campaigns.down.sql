@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS line_campaign_recipients;
+DROP TABLE IF EXISTS line_campaigns;
+DROP TABLE IF EXISTS customer_segments;
@@ -0,0 +1,58 @@
+CREATE TABLE IF NOT EXISTS customer_segments (
+  id                  BIGINT        PRIMARY KEY,
+  name                VARCHAR(100)  NOT NULL,
+  description         TEXT,
+  level               VARCHAR(20),
+  is_blacklisted      BOOLEAN,
+  min_past_days       INT,
+  spend_period_months INT,
+  min_total_spend     NUMERIC(12,2),
+  max_total_spend     NUMERIC(12,2),
+  min_visit_count     INT,
+  max_visit_count     INT,
+  created_by          BIGINT        NOT NULL,
+  created_at          TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at          TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (created_by) REFERENCES staff_users(id)
+);
+
+CREATE TABLE IF NOT EXISTS line_campaigns (
+  id             BIGINT        PRIMARY KEY,
+  name           VARCHAR(100)  NOT NULL,
+  segment_id     BIGINT        NOT NULL,
+  message_type   VARCHAR(10)   NOT NULL,
+  text_content   TEXT,
+  alt_text       VARCHAR(400),
+  flex_contents  JSONB,
+  status         VARCHAR(20)   NOT NULL,
+  target_count   INT           NOT NULL DEFAULT 0,
+  sent_count     INT           NOT NULL DEFAULT 0,
+  failed_count   INT           NOT NULL DEFAULT 0,
+  error_message  TEXT,
+  started_at     TIMESTAMPTZ,
+  finished_at    TIMESTAMPTZ,
+  created_by     BIGINT        NOT NULL,
+  created_at     TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (segment_id) REFERENCES customer_segments(id),
+  FOREIGN KEY (created_by) REFERENCES staff_users(id)
+);
+
+CREATE INDEX idx_line_campaigns_on_status ON line_campaigns (status, created_at);
+
+-- recipients are snapshotted from the segment when the campaign starts
+CREATE TABLE IF NOT EXISTS line_campaign_recipients (
+  campaign_id    BIGINT        NOT NULL,
+  customer_id    BIGINT        NOT NULL,
+  line_uid       VARCHAR(255)  NOT NULL,
+  status         VARCHAR(10)   NOT NULL DEFAULT 'PENDING',
+  error_message  TEXT,
+  sent_at        TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ   DEFAULT NOW(),
+  PRIMARY KEY (campaign_id, customer_id),
+  FOREIGN KEY (campaign_id) REFERENCES line_campaigns(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_line_campaign_recipients_on_status ON line_campaign_recipients (campaign_id, status);
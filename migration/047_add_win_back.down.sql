@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS win_back_contacts;
+DROP TABLE IF EXISTS store_win_back_settings;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS store_win_back_settings (
+  store_id          BIGINT      PRIMARY KEY,
+  is_enabled        BOOLEAN     NOT NULL DEFAULT FALSE,
+  inactive_days     INT         NOT NULL DEFAULT 45,
+  coupon_id         BIGINT,
+  coupon_valid_days INT         NOT NULL DEFAULT 30,
+  line_message      TEXT,
+  created_at        TIMESTAMPTZ DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (store_id)  REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (coupon_id) REFERENCES coupons(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS win_back_contacts (
+  id                 BIGINT      PRIMARY KEY,
+  store_id           BIGINT      NOT NULL,
+  customer_id        BIGINT      NOT NULL,
+  last_visit_at      TIMESTAMPTZ NOT NULL,
+  customer_coupon_id BIGINT,
+  contacted_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  returned_at        TIMESTAMPTZ,
+  FOREIGN KEY (store_id)           REFERENCES stores(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_id)        REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_coupon_id) REFERENCES customer_coupons(id) ON DELETE SET NULL
+);
+
+-- a cycle starts from the customer's last visit, the customer is contacted once per cycle
+CREATE UNIQUE INDEX uq_win_back_contacts_on_customer_id_last_visit_at ON win_back_contacts (customer_id, last_visit_at);
+CREATE INDEX idx_win_back_contacts_on_store_id_contacted_at ON win_back_contacts (store_id, contacted_at);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS customer_merges;
+
+DROP INDEX IF EXISTS idx_customers_on_merged_into_customer_id;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS merged_at;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS merged_into_customer_id;
@@ -0,0 +1,26 @@
+-- a merged customer is kept as a tombstone pointing to the surviving customer, so its history is not cascaded away
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS merged_into_customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL;
+
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS merged_at TIMESTAMPTZ;
+
+CREATE INDEX idx_customers_on_merged_into_customer_id ON customers (merged_into_customer_id) WHERE merged_into_customer_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS customer_merges (
+  id                       BIGINT      PRIMARY KEY,
+  customer_id              BIGINT      NOT NULL,
+  merged_customer_id       BIGINT      NOT NULL,
+  customer_snapshot        JSONB       NOT NULL,
+  merged_customer_snapshot JSONB       NOT NULL,
+  moved_counts             JSONB       NOT NULL,
+  note                     TEXT,
+  created_by               BIGINT,
+  created_at               TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id)        REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (merged_customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by)         REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_merges_on_customer_id ON customer_merges (customer_id, created_at);
+CREATE INDEX idx_customer_merges_on_merged_customer_id ON customer_merges (merged_customer_id);
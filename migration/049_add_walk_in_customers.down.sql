@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_customers_on_phone;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS created_by;
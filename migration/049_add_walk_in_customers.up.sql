@@ -0,0 +1,5 @@
+-- customers created by staff for walk-ins or phone bookings have no LINE account (line_uid is empty) until they register with LINE
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS created_by BIGINT REFERENCES staff_users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_customers_on_phone ON customers (phone);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS customer_data_requests;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS erased_at;
+
+-- erased customers have no birthday, a placeholder is required before restoring the constraint
+UPDATE customers SET birthday = '1900-01-01' WHERE birthday IS NULL;
+
+ALTER TABLE customers
+ALTER COLUMN birthday SET NOT NULL;
@@ -0,0 +1,20 @@
+-- erased customers keep their records for reports, personal fields are cleared and birthday becomes NULL
+ALTER TABLE customers
+ALTER COLUMN birthday DROP NOT NULL;
+
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS erased_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS customer_data_requests (
+  id            BIGINT      PRIMARY KEY,
+  customer_id   BIGINT      NOT NULL,
+  request_type  VARCHAR(20) NOT NULL,
+  requested_by  VARCHAR(20) NOT NULL,
+  staff_user_id BIGINT,
+  note          TEXT,
+  created_at    TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id)   REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (staff_user_id) REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_data_requests_on_customer_id ON customer_data_requests (customer_id, created_at);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS terms_documents (
+  id             BIGINT       PRIMARY KEY,
+  version        VARCHAR(50)  NOT NULL UNIQUE,
+  title          VARCHAR(100) NOT NULL,
+  content        TEXT         NOT NULL,
+  effective_date DATE         NOT NULL,
+  is_required    BOOLEAN      NOT NULL DEFAULT true,
+  created_by     BIGINT,
+  created_at     TIMESTAMPTZ  DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ  DEFAULT NOW(),
+  FOREIGN KEY (created_by) REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_terms_documents_on_effective_date ON terms_documents (effective_date);
+
+-- the v1 terms were accepted before the documents were stored, its content is filled in by the admin
+INSERT INTO terms_documents (id, version, title, content, effective_date)
+VALUES (1, 'v1', '服務條款', '', '2024-01-01')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS customer_notes;
+
+DROP INDEX IF EXISTS idx_customers_on_tags;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,21 @@
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_customers_on_tags ON customers USING GIN (tags);
+
+CREATE TABLE IF NOT EXISTS customer_notes (
+  id          BIGINT        PRIMARY KEY,
+  customer_id BIGINT        NOT NULL,
+  booking_id  BIGINT,
+  content     VARCHAR(1000) NOT NULL,
+  is_pinned   BOOLEAN       NOT NULL DEFAULT false,
+  created_by  BIGINT,
+  created_at  TIMESTAMPTZ   DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ   DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE SET NULL,
+  FOREIGN KEY (created_by) REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_notes_on_customer_id_created_at ON customer_notes (customer_id, created_at);
+CREATE INDEX idx_customer_notes_on_booking_id ON customer_notes (booking_id);
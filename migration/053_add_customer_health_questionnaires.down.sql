@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS customer_health_questionnaires;
+
+ALTER TABLE services
+DROP COLUMN IF EXISTS allergens;
@@ -0,0 +1,18 @@
+ALTER TABLE services
+ADD COLUMN IF NOT EXISTS allergens TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS customer_health_questionnaires (
+  id                  BIGINT       PRIMARY KEY,
+  customer_id         BIGINT       NOT NULL,
+  version             VARCHAR(20)  NOT NULL,
+  allergies           TEXT[]       NOT NULL DEFAULT '{}',
+  allergy_note        VARCHAR(255),
+  skin_conditions     TEXT[]       NOT NULL DEFAULT '{}',
+  skin_condition_note VARCHAR(255),
+  is_pregnant         BOOLEAN      NOT NULL DEFAULT false,
+  other_note          VARCHAR(500),
+  created_at          TIMESTAMPTZ  DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_customer_health_questionnaires_on_customer_id_created_at ON customer_health_questionnaires (customer_id, created_at);
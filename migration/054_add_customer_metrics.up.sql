@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS customer_metrics (
+  customer_id           BIGINT        PRIMARY KEY,
+  lifetime_spend        NUMERIC(12,2) NOT NULL DEFAULT 0,
+  average_ticket        NUMERIC(12,2) NOT NULL DEFAULT 0,
+  visit_count           INT           NOT NULL DEFAULT 0,
+  visit_frequency_days  NUMERIC(8,2),
+  days_since_last_visit INT,
+  no_show_rate          NUMERIC(5,4)  NOT NULL DEFAULT 0,
+  cancellation_rate     NUMERIC(5,4)  NOT NULL DEFAULT 0,
+  churn_risk_score      INT,
+  churn_risk_level      VARCHAR(10),
+  calculated_at         TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_customer_metrics_on_churn_risk_score ON customer_metrics (churn_risk_score);
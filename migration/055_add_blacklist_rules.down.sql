@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS customer_blacklist_decisions;
+DROP TABLE IF EXISTS blacklist_rules;
+
+ALTER TABLE bookings
+DROP COLUMN IF EXISTS cancelled_at;
+
+ALTER TABLE customers
+DROP COLUMN IF EXISTS requires_deposit;
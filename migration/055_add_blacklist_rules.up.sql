@@ -0,0 +1,47 @@
+ALTER TABLE customers
+ADD COLUMN IF NOT EXISTS requires_deposit BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE bookings
+ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+
+UPDATE bookings
+SET cancelled_at = updated_at
+WHERE status IN ('CANCELLED', 'NO_SHOW')
+  AND cancelled_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS blacklist_rules (
+  type              VARCHAR(20) PRIMARY KEY,
+  is_active         BOOLEAN     NOT NULL DEFAULT false,
+  threshold_count   INT         NOT NULL,
+  period_months     INT         NOT NULL,
+  late_cancel_hours INT,
+  action            VARCHAR(20) NOT NULL,
+  created_at        TIMESTAMPTZ DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO blacklist_rules (type, is_active, threshold_count, period_months, late_cancel_hours, action)
+VALUES
+  ('NO_SHOW', false, 2, 6, NULL, 'BLACKLIST'),
+  ('LATE_CANCEL', false, 3, 6, 24, 'DEPOSIT_REQUIRED')
+ON CONFLICT (type) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS customer_blacklist_decisions (
+  id              BIGINT       PRIMARY KEY,
+  customer_id     BIGINT       NOT NULL,
+  rule_type       VARCHAR(20)  NOT NULL,
+  action          VARCHAR(20)  NOT NULL,
+  threshold_count INT          NOT NULL,
+  period_months   INT          NOT NULL,
+  evidence        JSONB        NOT NULL DEFAULT '[]',
+  status          VARCHAR(20)  NOT NULL DEFAULT 'ACTIVE',
+  overturned_by   BIGINT,
+  overturn_reason VARCHAR(255),
+  overturned_at   TIMESTAMPTZ,
+  created_at      TIMESTAMPTZ  DEFAULT NOW(),
+  FOREIGN KEY (customer_id)   REFERENCES customers(id)   ON DELETE CASCADE,
+  FOREIGN KEY (overturned_by) REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_blacklist_decisions_on_customer_id ON customer_blacklist_decisions (customer_id, created_at);
+CREATE INDEX idx_customer_blacklist_decisions_on_status ON customer_blacklist_decisions (status, created_at);
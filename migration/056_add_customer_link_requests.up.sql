@@ -0,0 +1,20 @@
+-- a LINE registration asking to link a walk-in customer with the same phone and birthday waits for staff approval,
+-- the LINE account is registered as a new customer and merged into the walk-in customer once approved
+CREATE TABLE IF NOT EXISTS customer_link_requests (
+  id                BIGINT      PRIMARY KEY,
+  customer_id       BIGINT      NOT NULL,
+  link_customer_id  BIGINT      NOT NULL,
+  status            VARCHAR(10) NOT NULL,
+  customer_merge_id BIGINT,
+  note              TEXT,
+  reviewed_by       BIGINT,
+  reviewed_at       TIMESTAMPTZ,
+  created_at        TIMESTAMPTZ DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ DEFAULT NOW(),
+  FOREIGN KEY (customer_id)       REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (link_customer_id)  REFERENCES customers(id) ON DELETE CASCADE,
+  FOREIGN KEY (customer_merge_id) REFERENCES customer_merges(id) ON DELETE SET NULL,
+  FOREIGN KEY (reviewed_by)       REFERENCES staff_users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_customer_link_requests_on_status ON customer_link_requests (status, created_at);